@@ -0,0 +1,192 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/schema"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// ErrUnsupported indicates that the browser does not support the
+// invoked command. Use errors.Is to check for ErrUnsupported.
+var ErrUnsupported = errors.New("cdp: unsupported by the browser")
+
+// UnsupportedError is returned when a command is invoked that the
+// browser does not support (CapabilityInterceptor).
+type UnsupportedError struct {
+	Method string // The invoked method, e.g. "Page.navigate".
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("cdp: %s is not supported by the browser", e.Method)
+}
+
+// Is implements errors.Is for ErrUnsupported.
+func (e *UnsupportedError) Is(target error) bool { return target == ErrUnsupported }
+
+// Capabilities is a report of the domains supported by the browser,
+// compared to the domains compiled into the Client.
+type Capabilities struct {
+	ProtocolVersion string            // Version of the protocol compiled into the Client.
+	RemoteVersion   string            // Protocol version reported by the browser (Browser.getVersion).
+	Domains         map[string]string // Domains supported by the browser (name: version).
+	Missing         []string          // Domains in the Client that the browser lacks.
+	Experimental    []string          // Domains supported by the browser that are experimental.
+	Deprecated      []string          // Domains supported by the browser that are deprecated.
+	Unknown         []string          // Domains supported by the browser that are not in the Client.
+}
+
+// GetCapabilities requests the domains supported by the browser
+// (Schema.getDomains) and compares them with the domains compiled into
+// the Client. RemoteVersion is left empty if the browser does not
+// implement Browser.getVersion.
+//
+// The browser only reports domains, not commands. Commands that were
+// added to a domain in a newer protocol version than the one supported
+// by the browser are not detected.
+func GetCapabilities(ctx context.Context, conn *rpcc.Conn) (*Capabilities, error) {
+	reply, err := schema.NewClient(conn).GetDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := newCapabilities(reply.Domains)
+
+	version, err := browser.NewClient(conn).GetVersion(ctx)
+	if err != nil {
+		var rerr *rpcc.ResponseError
+		if !errors.As(err, &rerr) && !errors.Is(err, ErrUnsupported) {
+			return nil, err
+		}
+	} else {
+		c.RemoteVersion = version.ProtocolVersion
+	}
+	return c, nil
+}
+
+func newCapabilities(domains []schema.Domain) *Capabilities {
+	c := &Capabilities{
+		ProtocolVersion: ProtocolVersion,
+		Domains:         make(map[string]string),
+	}
+	for _, d := range domains {
+		c.Domains[d.Name] = d.Version
+
+		pd, ok := protocolDomains[d.Name]
+		switch {
+		case !ok:
+			c.Unknown = append(c.Unknown, d.Name)
+		case pd.experimental:
+			c.Experimental = append(c.Experimental, d.Name)
+		case pd.deprecated:
+			c.Deprecated = append(c.Deprecated, d.Name)
+		}
+	}
+	for name := range protocolDomains {
+		if _, ok := c.Domains[name]; !ok {
+			c.Missing = append(c.Missing, name)
+		}
+	}
+	sort.Strings(c.Missing)
+	sort.Strings(c.Experimental)
+	sort.Strings(c.Deprecated)
+	sort.Strings(c.Unknown)
+	return c
+}
+
+// Supports returns true if the browser supports the domain of method,
+// e.g. "Page" for "Page.navigate".
+func (c *Capabilities) Supports(method string) bool {
+	i := strings.IndexByte(method, '.')
+	if i < 0 {
+		return false
+	}
+	_, ok := c.Domains[method[:i]]
+	return ok
+}
+
+// UnaryInterceptor returns an interceptor that fails calls to methods
+// that are not supported (Supports) with an *UnsupportedError, without
+// sending them to the browser. Calls to commands that the browser does
+// not implement also fail with an *UnsupportedError.
+func (c *Capabilities) UnaryInterceptor() rpcc.UnaryInterceptor {
+	return func(ctx context.Context, method string, args, reply interface{}, conn *rpcc.Conn, invoker rpcc.Invoker) error {
+		if !c.Supports(method) {
+			return &UnsupportedError{Method: method}
+		}
+		return invokeSupported(ctx, method, args, reply, conn, invoker)
+	}
+}
+
+// invokeSupported invokes the method and converts a "method not found"
+// response error into an *UnsupportedError.
+func invokeSupported(ctx context.Context, method string, args, reply interface{}, conn *rpcc.Conn, invoker rpcc.Invoker) error {
+	err := invoker(ctx, method, args, reply, conn)
+	var rerr *rpcc.ResponseError
+	if errors.As(err, &rerr) && rerr.Code == rpcc.CodeMethodNotFound {
+		return &UnsupportedError{Method: method}
+	}
+	return err
+}
+
+// CapabilityInterceptor returns an interceptor (rpcc.WithUnaryInterceptor)
+// that fails calls to methods that the browser does not support with an
+// *UnsupportedError, instead of a "method not found" response error.
+// Commands that are missing from a supported domain are sent to the
+// browser, the "method not found" response error is converted into an
+// *UnsupportedError.
+//
+// The capabilities are requested (GetCapabilities) on the first call
+// for each connection, including session connections. If the browser
+// does not implement Schema.getDomains, all calls are allowed.
+//
+//	conn, err := rpcc.Dial(wsURL, rpcc.WithUnaryInterceptor(cdp.CapabilityInterceptor()))
+func CapabilityInterceptor() rpcc.UnaryInterceptor {
+	var (
+		mu   sync.Mutex
+		caps = make(map[*rpcc.Conn]*Capabilities)
+	)
+	return func(ctx context.Context, method string, args, reply interface{}, conn *rpcc.Conn, invoker rpcc.Invoker) error {
+		if method == "Schema.getDomains" {
+			return invoker(ctx, method, args, reply, conn)
+		}
+
+		mu.Lock()
+		c, ok := caps[conn]
+		mu.Unlock()
+		if !ok {
+			var r schema.GetDomainsReply
+			err := invoker(ctx, "Schema.getDomains", nil, &r, conn)
+			if err != nil {
+				var rerr *rpcc.ResponseError
+				if !errors.As(err, &rerr) {
+					return err
+				}
+				// Schema is not supported, allow all calls.
+			} else {
+				c = newCapabilities(r.Domains)
+			}
+			mu.Lock()
+			if _, ok := caps[conn]; !ok {
+				go func() {
+					<-conn.Context().Done()
+					mu.Lock()
+					delete(caps, conn)
+					mu.Unlock()
+				}()
+			}
+			caps[conn] = c
+			mu.Unlock()
+		}
+
+		if c != nil && !c.Supports(method) {
+			return &UnsupportedError{Method: method}
+		}
+		return invokeSupported(ctx, method, args, reply, conn, invoker)
+	}
+}
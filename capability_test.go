@@ -0,0 +1,123 @@
+package cdp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/schema"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func TestCapabilityInterceptor(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Schema.getDomains", cdptest.Reply(&schema.GetDomainsReply{
+		Domains: []schema.Domain{
+			{Name: "Browser", Version: "1.3"},
+			{Name: "Page", Version: "1.3"},
+			{Name: "Runtime", Version: "1.3"},
+			{Name: "Animation", Version: "1.3"},
+			{Name: "Future", Version: "1.4"},
+		},
+	}))
+	srv.Handle("Browser.getVersion", cdptest.Reply(&browser.GetVersionReply{
+		ProtocolVersion: "1.3",
+		Product:         "Chrome/80.0.3987.0",
+	}))
+	srv.Handle("Page.enable", cdptest.Reply(nil))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL, rpcc.WithUnaryInterceptor(cdp.CapabilityInterceptor()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := cdp.NewClient(conn)
+
+	caps, err := cdp.GetCapabilities(ctx, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caps.RemoteVersion != "1.3" {
+		t.Errorf("RemoteVersion: got %q, want %q", caps.RemoteVersion, "1.3")
+	}
+	if diff := cmp.Diff([]string{"Animation"}, caps.Experimental); diff != "" {
+		t.Errorf("Experimental: (-want +got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Future"}, caps.Unknown); diff != "" {
+		t.Errorf("Unknown: (-want +got)\n%s", diff)
+	}
+	if !containsString(caps.Missing, "Network") || containsString(caps.Missing, "Page") {
+		t.Errorf("Missing: got %v, want Network and not Page", caps.Missing)
+	}
+
+	err = c.Page.Enable(ctx)
+	if err != nil {
+		t.Errorf("Page.Enable: got %v, want nil", err)
+	}
+	err = c.Network.Enable(ctx, nil)
+	if !errors.Is(err, cdp.ErrUnsupported) {
+		t.Errorf("Network.Enable: got %v, want ErrUnsupported", err)
+	}
+	if srv.Called("Network.enable") {
+		t.Error("Network.enable was sent to the browser")
+	}
+	// Page is supported but the command is not (method not found).
+	err = c.Page.Close(ctx)
+	var uerr *cdp.UnsupportedError
+	if !errors.As(err, &uerr) || uerr.Method != "Page.close" {
+		t.Errorf("Page.Close: got %v, want UnsupportedError for Page.close", err)
+	}
+	// Once by GetCapabilities, once by the interceptor.
+	if n := len(srv.CallsTo("Schema.getDomains")); n != 2 {
+		t.Errorf("Schema.getDomains: got %d calls, want 2", n)
+	}
+}
+
+func TestGetCapabilities_NoBrowserDomain(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Schema.getDomains", cdptest.Reply(&schema.GetDomainsReply{
+		Domains: []schema.Domain{{Name: "Page", Version: "1.3"}},
+	}))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	caps, err := cdp.GetCapabilities(ctx, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caps.RemoteVersion != "" {
+		t.Errorf("RemoteVersion: got %q, want empty", caps.RemoteVersion)
+	}
+	if !caps.Supports("Page.navigate") {
+		t.Error("Supports(Page.navigate) = false, want true")
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
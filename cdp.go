@@ -50,6 +50,7 @@ import (
 	"github.com/mafredri/cdp/protocol/tracing"
 	"github.com/mafredri/cdp/protocol/webaudio"
 	"github.com/mafredri/cdp/protocol/webauthn"
+	"github.com/mafredri/cdp/rpcc"
 )
 
 // The Accessibility domain.
@@ -142,17 +143,26 @@ type Animation interface {
 	// Event AnimationCanceled
 	//
 	// Event for when an animation has been canceled.
-	AnimationCanceled(context.Context) (animation.CanceledClient, error)
+	AnimationCanceled(context.Context, ...rpcc.StreamOption) (animation.CanceledClient, error)
+
+	// OnAnimationCanceled registers a handler for AnimationCanceled events.
+	OnAnimationCanceled(fn func(*animation.CanceledReply)) (unsubscribe func(), err error)
 
 	// Event AnimationCreated
 	//
 	// Event for each animation that has been created.
-	AnimationCreated(context.Context) (animation.CreatedClient, error)
+	AnimationCreated(context.Context, ...rpcc.StreamOption) (animation.CreatedClient, error)
+
+	// OnAnimationCreated registers a handler for AnimationCreated events.
+	OnAnimationCreated(fn func(*animation.CreatedReply)) (unsubscribe func(), err error)
 
 	// Event AnimationStarted
 	//
 	// Event for animation that has been started.
-	AnimationStarted(context.Context) (animation.StartedClient, error)
+	AnimationStarted(context.Context, ...rpcc.StreamOption) (animation.StartedClient, error)
+
+	// OnAnimationStarted registers a handler for AnimationStarted events.
+	OnAnimationStarted(fn func(*animation.StartedReply)) (unsubscribe func(), err error)
 }
 
 // The ApplicationCache domain.
@@ -182,10 +192,16 @@ type ApplicationCache interface {
 	GetManifestForFrame(context.Context, *applicationcache.GetManifestForFrameArgs) (*applicationcache.GetManifestForFrameReply, error)
 
 	// Event ApplicationCacheStatusUpdated
-	ApplicationCacheStatusUpdated(context.Context) (applicationcache.StatusUpdatedClient, error)
+	ApplicationCacheStatusUpdated(context.Context, ...rpcc.StreamOption) (applicationcache.StatusUpdatedClient, error)
+
+	// OnApplicationCacheStatusUpdated registers a handler for ApplicationCacheStatusUpdated events.
+	OnApplicationCacheStatusUpdated(fn func(*applicationcache.StatusUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event NetworkStateUpdated
-	NetworkStateUpdated(context.Context) (applicationcache.NetworkStateUpdatedClient, error)
+	NetworkStateUpdated(context.Context, ...rpcc.StreamOption) (applicationcache.NetworkStateUpdatedClient, error)
+
+	// OnNetworkStateUpdated registers a handler for NetworkStateUpdated events.
+	OnNetworkStateUpdated(fn func(*applicationcache.NetworkStateUpdatedReply)) (unsubscribe func(), err error)
 }
 
 // The Audits domain. Audits domain allows investigation of page violations
@@ -212,7 +228,10 @@ type Audits interface {
 	Enable(context.Context) error
 
 	// Event IssueAdded
-	IssueAdded(context.Context) (audits.IssueAddedClient, error)
+	IssueAdded(context.Context, ...rpcc.StreamOption) (audits.IssueAddedClient, error)
+
+	// OnIssueAdded registers a handler for IssueAdded events.
+	OnIssueAdded(fn func(*audits.IssueAddedReply)) (unsubscribe func(), err error)
 }
 
 // The BackgroundService domain. Defines events for background web platform
@@ -243,13 +262,19 @@ type BackgroundService interface {
 	// Event RecordingStateChanged
 	//
 	// Called when the recording state for the service has been updated.
-	RecordingStateChanged(context.Context) (backgroundservice.RecordingStateChangedClient, error)
+	RecordingStateChanged(context.Context, ...rpcc.StreamOption) (backgroundservice.RecordingStateChangedClient, error)
+
+	// OnRecordingStateChanged registers a handler for RecordingStateChanged events.
+	OnRecordingStateChanged(fn func(*backgroundservice.RecordingStateChangedReply)) (unsubscribe func(), err error)
 
 	// Event BackgroundServiceEventReceived
 	//
 	// Called with all existing backgroundServiceEvents when enabled, and
 	// all new events afterwards if enabled and recording.
-	BackgroundServiceEventReceived(context.Context) (backgroundservice.EventReceivedClient, error)
+	BackgroundServiceEventReceived(context.Context, ...rpcc.StreamOption) (backgroundservice.EventReceivedClient, error)
+
+	// OnBackgroundServiceEventReceived registers a handler for BackgroundServiceEventReceived events.
+	OnBackgroundServiceEventReceived(fn func(*backgroundservice.EventReceivedReply)) (unsubscribe func(), err error)
 }
 
 // The Browser domain. The Browser domain defines methods and events for
@@ -494,30 +519,45 @@ type CSS interface {
 	//
 	// Fires whenever a web font is updated. A non-empty font parameter
 	// indicates a successfully loaded web font
-	FontsUpdated(context.Context) (css.FontsUpdatedClient, error)
+	FontsUpdated(context.Context, ...rpcc.StreamOption) (css.FontsUpdatedClient, error)
+
+	// OnFontsUpdated registers a handler for FontsUpdated events.
+	OnFontsUpdated(fn func(*css.FontsUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event MediaQueryResultChanged
 	//
 	// Fires whenever a MediaQuery result changes (for example, after a
 	// browser window has been resized.) The current implementation
 	// considers only viewport-dependent media features.
-	MediaQueryResultChanged(context.Context) (css.MediaQueryResultChangedClient, error)
+	MediaQueryResultChanged(context.Context, ...rpcc.StreamOption) (css.MediaQueryResultChangedClient, error)
+
+	// OnMediaQueryResultChanged registers a handler for MediaQueryResultChanged events.
+	OnMediaQueryResultChanged(fn func(*css.MediaQueryResultChangedReply)) (unsubscribe func(), err error)
 
 	// Event StyleSheetAdded
 	//
 	// Fired whenever an active document stylesheet is added.
-	StyleSheetAdded(context.Context) (css.StyleSheetAddedClient, error)
+	StyleSheetAdded(context.Context, ...rpcc.StreamOption) (css.StyleSheetAddedClient, error)
+
+	// OnStyleSheetAdded registers a handler for StyleSheetAdded events.
+	OnStyleSheetAdded(fn func(*css.StyleSheetAddedReply)) (unsubscribe func(), err error)
 
 	// Event StyleSheetChanged
 	//
 	// Fired whenever a stylesheet is changed as a result of the client
 	// operation.
-	StyleSheetChanged(context.Context) (css.StyleSheetChangedClient, error)
+	StyleSheetChanged(context.Context, ...rpcc.StreamOption) (css.StyleSheetChangedClient, error)
+
+	// OnStyleSheetChanged registers a handler for StyleSheetChanged events.
+	OnStyleSheetChanged(fn func(*css.StyleSheetChangedReply)) (unsubscribe func(), err error)
 
 	// Event StyleSheetRemoved
 	//
 	// Fired whenever an active document stylesheet is removed.
-	StyleSheetRemoved(context.Context) (css.StyleSheetRemovedClient, error)
+	StyleSheetRemoved(context.Context, ...rpcc.StreamOption) (css.StyleSheetRemovedClient, error)
+
+	// OnStyleSheetRemoved registers a handler for StyleSheetRemoved events.
+	OnStyleSheetRemoved(fn func(*css.StyleSheetRemovedReply)) (unsubscribe func(), err error)
 }
 
 // The CacheStorage domain.
@@ -590,13 +630,19 @@ type Cast interface {
 	//
 	// This is fired whenever the list of available sinks changes. A sink
 	// is a device or a software surface that you can cast to.
-	SinksUpdated(context.Context) (cast.SinksUpdatedClient, error)
+	SinksUpdated(context.Context, ...rpcc.StreamOption) (cast.SinksUpdatedClient, error)
+
+	// OnSinksUpdated registers a handler for SinksUpdated events.
+	OnSinksUpdated(fn func(*cast.SinksUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event IssueUpdated
 	//
 	// This is fired whenever the outstanding issue/error message changes.
 	// |issueMessage| is empty if there is no issue.
-	IssueUpdated(context.Context) (cast.IssueUpdatedClient, error)
+	IssueUpdated(context.Context, ...rpcc.StreamOption) (cast.IssueUpdatedClient, error)
+
+	// OnIssueUpdated registers a handler for IssueUpdated events.
+	OnIssueUpdated(fn func(*cast.IssueUpdatedReply)) (unsubscribe func(), err error)
 }
 
 // The Console domain.
@@ -623,7 +669,10 @@ type Console interface {
 	// Event MessageAdded
 	//
 	// Issued when new console message is added.
-	MessageAdded(context.Context) (console.MessageAddedClient, error)
+	MessageAdded(context.Context, ...rpcc.StreamOption) (console.MessageAddedClient, error)
+
+	// OnMessageAdded registers a handler for MessageAdded events.
+	OnMessageAdded(fn func(*console.MessageAddedReply)) (unsubscribe func(), err error)
 }
 
 // The DOM domain. This domain exposes DOM read/write operations. Each DOM
@@ -915,45 +964,69 @@ type DOM interface {
 	// Event AttributeModified
 	//
 	// Fired when `Element`'s attribute is modified.
-	AttributeModified(context.Context) (dom.AttributeModifiedClient, error)
+	AttributeModified(context.Context, ...rpcc.StreamOption) (dom.AttributeModifiedClient, error)
+
+	// OnAttributeModified registers a handler for AttributeModified events.
+	OnAttributeModified(fn func(*dom.AttributeModifiedReply)) (unsubscribe func(), err error)
 
 	// Event AttributeRemoved
 	//
 	// Fired when `Element`'s attribute is removed.
-	AttributeRemoved(context.Context) (dom.AttributeRemovedClient, error)
+	AttributeRemoved(context.Context, ...rpcc.StreamOption) (dom.AttributeRemovedClient, error)
+
+	// OnAttributeRemoved registers a handler for AttributeRemoved events.
+	OnAttributeRemoved(fn func(*dom.AttributeRemovedReply)) (unsubscribe func(), err error)
 
 	// Event CharacterDataModified
 	//
 	// Mirrors `DOMCharacterDataModified` event.
-	CharacterDataModified(context.Context) (dom.CharacterDataModifiedClient, error)
+	CharacterDataModified(context.Context, ...rpcc.StreamOption) (dom.CharacterDataModifiedClient, error)
+
+	// OnCharacterDataModified registers a handler for CharacterDataModified events.
+	OnCharacterDataModified(fn func(*dom.CharacterDataModifiedReply)) (unsubscribe func(), err error)
 
 	// Event ChildNodeCountUpdated
 	//
 	// Fired when `Container`'s child node count has changed.
-	ChildNodeCountUpdated(context.Context) (dom.ChildNodeCountUpdatedClient, error)
+	ChildNodeCountUpdated(context.Context, ...rpcc.StreamOption) (dom.ChildNodeCountUpdatedClient, error)
+
+	// OnChildNodeCountUpdated registers a handler for ChildNodeCountUpdated events.
+	OnChildNodeCountUpdated(fn func(*dom.ChildNodeCountUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event ChildNodeInserted
 	//
 	// Mirrors `DOMNodeInserted` event.
-	ChildNodeInserted(context.Context) (dom.ChildNodeInsertedClient, error)
+	ChildNodeInserted(context.Context, ...rpcc.StreamOption) (dom.ChildNodeInsertedClient, error)
+
+	// OnChildNodeInserted registers a handler for ChildNodeInserted events.
+	OnChildNodeInserted(fn func(*dom.ChildNodeInsertedReply)) (unsubscribe func(), err error)
 
 	// Event ChildNodeRemoved
 	//
 	// Mirrors `DOMNodeRemoved` event.
-	ChildNodeRemoved(context.Context) (dom.ChildNodeRemovedClient, error)
+	ChildNodeRemoved(context.Context, ...rpcc.StreamOption) (dom.ChildNodeRemovedClient, error)
+
+	// OnChildNodeRemoved registers a handler for ChildNodeRemoved events.
+	OnChildNodeRemoved(fn func(*dom.ChildNodeRemovedReply)) (unsubscribe func(), err error)
 
 	// Event DistributedNodesUpdated
 	//
 	// Called when distribution is changed.
 	//
 	// Note: This event is experimental.
-	DistributedNodesUpdated(context.Context) (dom.DistributedNodesUpdatedClient, error)
+	DistributedNodesUpdated(context.Context, ...rpcc.StreamOption) (dom.DistributedNodesUpdatedClient, error)
+
+	// OnDistributedNodesUpdated registers a handler for DistributedNodesUpdated events.
+	OnDistributedNodesUpdated(fn func(*dom.DistributedNodesUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event DocumentUpdated
 	//
 	// Fired when `Document` has been totally updated. Node ids are no
 	// longer valid.
-	DocumentUpdated(context.Context) (dom.DocumentUpdatedClient, error)
+	DocumentUpdated(context.Context, ...rpcc.StreamOption) (dom.DocumentUpdatedClient, error)
+
+	// OnDocumentUpdated registers a handler for DocumentUpdated events.
+	OnDocumentUpdated(fn func(*dom.DocumentUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event InlineStyleInvalidated
 	//
@@ -961,41 +1034,59 @@ type DOM interface {
 	// modification.
 	//
 	// Note: This event is experimental.
-	InlineStyleInvalidated(context.Context) (dom.InlineStyleInvalidatedClient, error)
+	InlineStyleInvalidated(context.Context, ...rpcc.StreamOption) (dom.InlineStyleInvalidatedClient, error)
+
+	// OnInlineStyleInvalidated registers a handler for InlineStyleInvalidated events.
+	OnInlineStyleInvalidated(fn func(*dom.InlineStyleInvalidatedReply)) (unsubscribe func(), err error)
 
 	// Event PseudoElementAdded
 	//
 	// Called when a pseudo element is added to an element.
 	//
 	// Note: This event is experimental.
-	PseudoElementAdded(context.Context) (dom.PseudoElementAddedClient, error)
+	PseudoElementAdded(context.Context, ...rpcc.StreamOption) (dom.PseudoElementAddedClient, error)
+
+	// OnPseudoElementAdded registers a handler for PseudoElementAdded events.
+	OnPseudoElementAdded(fn func(*dom.PseudoElementAddedReply)) (unsubscribe func(), err error)
 
 	// Event PseudoElementRemoved
 	//
 	// Called when a pseudo element is removed from an element.
 	//
 	// Note: This event is experimental.
-	PseudoElementRemoved(context.Context) (dom.PseudoElementRemovedClient, error)
+	PseudoElementRemoved(context.Context, ...rpcc.StreamOption) (dom.PseudoElementRemovedClient, error)
+
+	// OnPseudoElementRemoved registers a handler for PseudoElementRemoved events.
+	OnPseudoElementRemoved(fn func(*dom.PseudoElementRemovedReply)) (unsubscribe func(), err error)
 
 	// Event SetChildNodes
 	//
 	// Fired when backend wants to provide client with the missing DOM
 	// structure. This happens upon most of the calls requesting node ids.
-	SetChildNodes(context.Context) (dom.SetChildNodesClient, error)
+	SetChildNodes(context.Context, ...rpcc.StreamOption) (dom.SetChildNodesClient, error)
+
+	// OnSetChildNodes registers a handler for SetChildNodes events.
+	OnSetChildNodes(fn func(*dom.SetChildNodesReply)) (unsubscribe func(), err error)
 
 	// Event ShadowRootPopped
 	//
 	// Called when shadow root is popped from the element.
 	//
 	// Note: This event is experimental.
-	ShadowRootPopped(context.Context) (dom.ShadowRootPoppedClient, error)
+	ShadowRootPopped(context.Context, ...rpcc.StreamOption) (dom.ShadowRootPoppedClient, error)
+
+	// OnShadowRootPopped registers a handler for ShadowRootPopped events.
+	OnShadowRootPopped(fn func(*dom.ShadowRootPoppedReply)) (unsubscribe func(), err error)
 
 	// Event ShadowRootPushed
 	//
 	// Called when shadow root is pushed into the element.
 	//
 	// Note: This event is experimental.
-	ShadowRootPushed(context.Context) (dom.ShadowRootPushedClient, error)
+	ShadowRootPushed(context.Context, ...rpcc.StreamOption) (dom.ShadowRootPushedClient, error)
+
+	// OnShadowRootPushed registers a handler for ShadowRootPushed events.
+	OnShadowRootPushed(fn func(*dom.ShadowRootPushedReply)) (unsubscribe func(), err error)
 }
 
 // The DOMDebugger domain. DOM debugging allows setting breakpoints on
@@ -1115,16 +1206,28 @@ type DOMStorage interface {
 	SetDOMStorageItem(context.Context, *domstorage.SetDOMStorageItemArgs) error
 
 	// Event DOMStorageItemAdded
-	DOMStorageItemAdded(context.Context) (domstorage.ItemAddedClient, error)
+	DOMStorageItemAdded(context.Context, ...rpcc.StreamOption) (domstorage.ItemAddedClient, error)
+
+	// OnDOMStorageItemAdded registers a handler for DOMStorageItemAdded events.
+	OnDOMStorageItemAdded(fn func(*domstorage.ItemAddedReply)) (unsubscribe func(), err error)
 
 	// Event DOMStorageItemRemoved
-	DOMStorageItemRemoved(context.Context) (domstorage.ItemRemovedClient, error)
+	DOMStorageItemRemoved(context.Context, ...rpcc.StreamOption) (domstorage.ItemRemovedClient, error)
+
+	// OnDOMStorageItemRemoved registers a handler for DOMStorageItemRemoved events.
+	OnDOMStorageItemRemoved(fn func(*domstorage.ItemRemovedReply)) (unsubscribe func(), err error)
 
 	// Event DOMStorageItemUpdated
-	DOMStorageItemUpdated(context.Context) (domstorage.ItemUpdatedClient, error)
+	DOMStorageItemUpdated(context.Context, ...rpcc.StreamOption) (domstorage.ItemUpdatedClient, error)
+
+	// OnDOMStorageItemUpdated registers a handler for DOMStorageItemUpdated events.
+	OnDOMStorageItemUpdated(fn func(*domstorage.ItemUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event DOMStorageItemsCleared
-	DOMStorageItemsCleared(context.Context) (domstorage.ItemsClearedClient, error)
+	DOMStorageItemsCleared(context.Context, ...rpcc.StreamOption) (domstorage.ItemsClearedClient, error)
+
+	// OnDOMStorageItemsCleared registers a handler for DOMStorageItemsCleared events.
+	OnDOMStorageItemsCleared(fn func(*domstorage.ItemsClearedReply)) (unsubscribe func(), err error)
 }
 
 // The Database domain.
@@ -1150,7 +1253,10 @@ type Database interface {
 	GetDatabaseTableNames(context.Context, *database.GetDatabaseTableNamesArgs) (*database.GetDatabaseTableNamesReply, error)
 
 	// Event AddDatabase
-	AddDatabase(context.Context) (database.AddDatabaseClient, error)
+	AddDatabase(context.Context, ...rpcc.StreamOption) (database.AddDatabaseClient, error)
+
+	// OnAddDatabase registers a handler for AddDatabase events.
+	OnAddDatabase(fn func(*database.AddDatabaseReply)) (unsubscribe func(), err error)
 }
 
 // The Debugger domain. Debugger domain exposes JavaScript debugging
@@ -1352,29 +1458,44 @@ type Debugger interface {
 	// Event BreakpointResolved
 	//
 	// Fired when breakpoint is resolved to an actual script and location.
-	BreakpointResolved(context.Context) (debugger.BreakpointResolvedClient, error)
+	BreakpointResolved(context.Context, ...rpcc.StreamOption) (debugger.BreakpointResolvedClient, error)
+
+	// OnBreakpointResolved registers a handler for BreakpointResolved events.
+	OnBreakpointResolved(fn func(*debugger.BreakpointResolvedReply)) (unsubscribe func(), err error)
 
 	// Event Paused
 	//
 	// Fired when the virtual machine stopped on breakpoint or exception
 	// or any other stop criteria.
-	Paused(context.Context) (debugger.PausedClient, error)
+	Paused(context.Context, ...rpcc.StreamOption) (debugger.PausedClient, error)
+
+	// OnPaused registers a handler for Paused events.
+	OnPaused(fn func(*debugger.PausedReply)) (unsubscribe func(), err error)
 
 	// Event Resumed
 	//
 	// Fired when the virtual machine resumed execution.
-	Resumed(context.Context) (debugger.ResumedClient, error)
+	Resumed(context.Context, ...rpcc.StreamOption) (debugger.ResumedClient, error)
+
+	// OnResumed registers a handler for Resumed events.
+	OnResumed(fn func(*debugger.ResumedReply)) (unsubscribe func(), err error)
 
 	// Event ScriptFailedToParse
 	//
 	// Fired when virtual machine fails to parse the script.
-	ScriptFailedToParse(context.Context) (debugger.ScriptFailedToParseClient, error)
+	ScriptFailedToParse(context.Context, ...rpcc.StreamOption) (debugger.ScriptFailedToParseClient, error)
+
+	// OnScriptFailedToParse registers a handler for ScriptFailedToParse events.
+	OnScriptFailedToParse(fn func(*debugger.ScriptFailedToParseReply)) (unsubscribe func(), err error)
 
 	// Event ScriptParsed
 	//
 	// Fired when virtual machine parses script. This event is also fired
 	// for all known and uncollected scripts upon enabling debugger.
-	ScriptParsed(context.Context) (debugger.ScriptParsedClient, error)
+	ScriptParsed(context.Context, ...rpcc.StreamOption) (debugger.ScriptParsedClient, error)
+
+	// OnScriptParsed registers a handler for ScriptParsed events.
+	OnScriptParsed(fn func(*debugger.ScriptParsedReply)) (unsubscribe func(), err error)
 }
 
 // The DeviceOrientation domain.
@@ -1548,7 +1669,10 @@ type Emulation interface {
 	// VirtualTimePolicy has run out.
 	//
 	// Note: This event is experimental.
-	VirtualTimeBudgetExpired(context.Context) (emulation.VirtualTimeBudgetExpiredClient, error)
+	VirtualTimeBudgetExpired(context.Context, ...rpcc.StreamOption) (emulation.VirtualTimeBudgetExpiredClient, error)
+
+	// OnVirtualTimeBudgetExpired registers a handler for VirtualTimeBudgetExpired events.
+	OnVirtualTimeBudgetExpired(fn func(*emulation.VirtualTimeBudgetExpiredReply)) (unsubscribe func(), err error)
 }
 
 // The Fetch domain. A domain for letting clients substitute browser's network
@@ -1620,14 +1744,20 @@ type Fetch interface {
 	// responseErrorReason and responseStatusCode -- the request is at the
 	// response stage if either of these fields is present and in the
 	// request stage otherwise.
-	RequestPaused(context.Context) (fetch.RequestPausedClient, error)
+	RequestPaused(context.Context, ...rpcc.StreamOption) (fetch.RequestPausedClient, error)
+
+	// OnRequestPaused registers a handler for RequestPaused events.
+	OnRequestPaused(fn func(*fetch.RequestPausedReply)) (unsubscribe func(), err error)
 
 	// Event AuthRequired
 	//
 	// Issued when the domain is enabled with handleAuthRequests set to
 	// true. The request is paused until client responds with
 	// continueWithAuth.
-	AuthRequired(context.Context) (fetch.AuthRequiredClient, error)
+	AuthRequired(context.Context, ...rpcc.StreamOption) (fetch.AuthRequiredClient, error)
+
+	// OnAuthRequired registers a handler for AuthRequired events.
+	OnAuthRequired(fn func(*fetch.AuthRequiredReply)) (unsubscribe func(), err error)
 }
 
 // The HeadlessExperimental domain. This domain provides experimental commands
@@ -1661,7 +1791,10 @@ type HeadlessExperimental interface {
 	// BeginFrames. Deprecated. Issue beginFrame unconditionally instead
 	// and use result from beginFrame to detect whether the frames were
 	// suppressed.
-	NeedsBeginFramesChanged(context.Context) (headlessexperimental.NeedsBeginFramesChangedClient, error)
+	NeedsBeginFramesChanged(context.Context, ...rpcc.StreamOption) (headlessexperimental.NeedsBeginFramesChangedClient, error)
+
+	// OnNeedsBeginFramesChanged registers a handler for NeedsBeginFramesChanged events.
+	OnNeedsBeginFramesChanged(fn func(*headlessexperimental.NeedsBeginFramesChangedReply)) (unsubscribe func(), err error)
 }
 
 // The HeapProfiler domain.
@@ -1708,13 +1841,19 @@ type HeapProfiler interface {
 	TakeHeapSnapshot(context.Context, *heapprofiler.TakeHeapSnapshotArgs) error
 
 	// Event AddHeapSnapshotChunk
-	AddHeapSnapshotChunk(context.Context) (heapprofiler.AddHeapSnapshotChunkClient, error)
+	AddHeapSnapshotChunk(context.Context, ...rpcc.StreamOption) (heapprofiler.AddHeapSnapshotChunkClient, error)
+
+	// OnAddHeapSnapshotChunk registers a handler for AddHeapSnapshotChunk events.
+	OnAddHeapSnapshotChunk(fn func(*heapprofiler.AddHeapSnapshotChunkReply)) (unsubscribe func(), err error)
 
 	// Event HeapStatsUpdate
 	//
 	// If heap objects tracking has been started then backend may send
 	// update for one or more fragments
-	HeapStatsUpdate(context.Context) (heapprofiler.HeapStatsUpdateClient, error)
+	HeapStatsUpdate(context.Context, ...rpcc.StreamOption) (heapprofiler.HeapStatsUpdateClient, error)
+
+	// OnHeapStatsUpdate registers a handler for HeapStatsUpdate events.
+	OnHeapStatsUpdate(fn func(*heapprofiler.HeapStatsUpdateReply)) (unsubscribe func(), err error)
 
 	// Event LastSeenObjectID
 	//
@@ -1723,13 +1862,22 @@ type HeapProfiler interface {
 	// timestamp. If the were changes in the heap since last event then one
 	// or more heapStatsUpdate events will be sent before a new
 	// lastSeenObjectId event.
-	LastSeenObjectID(context.Context) (heapprofiler.LastSeenObjectIDClient, error)
+	LastSeenObjectID(context.Context, ...rpcc.StreamOption) (heapprofiler.LastSeenObjectIDClient, error)
+
+	// OnLastSeenObjectID registers a handler for LastSeenObjectID events.
+	OnLastSeenObjectID(fn func(*heapprofiler.LastSeenObjectIDReply)) (unsubscribe func(), err error)
 
 	// Event ReportHeapSnapshotProgress
-	ReportHeapSnapshotProgress(context.Context) (heapprofiler.ReportHeapSnapshotProgressClient, error)
+	ReportHeapSnapshotProgress(context.Context, ...rpcc.StreamOption) (heapprofiler.ReportHeapSnapshotProgressClient, error)
+
+	// OnReportHeapSnapshotProgress registers a handler for ReportHeapSnapshotProgress events.
+	OnReportHeapSnapshotProgress(fn func(*heapprofiler.ReportHeapSnapshotProgressReply)) (unsubscribe func(), err error)
 
 	// Event ResetProfiles
-	ResetProfiles(context.Context) (heapprofiler.ResetProfilesClient, error)
+	ResetProfiles(context.Context, ...rpcc.StreamOption) (heapprofiler.ResetProfilesClient, error)
+
+	// OnResetProfiles registers a handler for ResetProfiles events.
+	OnResetProfiles(fn func(*heapprofiler.ResetProfilesReply)) (unsubscribe func(), err error)
 }
 
 // The IO domain. Input/Output operations for streams produced by DevTools.
@@ -1880,17 +2028,26 @@ type Inspector interface {
 	//
 	// Fired when remote debugging connection is about to be terminated.
 	// Contains detach reason.
-	Detached(context.Context) (inspector.DetachedClient, error)
+	Detached(context.Context, ...rpcc.StreamOption) (inspector.DetachedClient, error)
+
+	// OnDetached registers a handler for Detached events.
+	OnDetached(fn func(*inspector.DetachedReply)) (unsubscribe func(), err error)
 
 	// Event TargetCrashed
 	//
 	// Fired when debugging target has crashed
-	TargetCrashed(context.Context) (inspector.TargetCrashedClient, error)
+	TargetCrashed(context.Context, ...rpcc.StreamOption) (inspector.TargetCrashedClient, error)
+
+	// OnTargetCrashed registers a handler for TargetCrashed events.
+	OnTargetCrashed(fn func(*inspector.TargetCrashedReply)) (unsubscribe func(), err error)
 
 	// Event TargetReloadedAfterCrash
 	//
 	// Fired when debugging target has reloaded after crash
-	TargetReloadedAfterCrash(context.Context) (inspector.TargetReloadedAfterCrashClient, error)
+	TargetReloadedAfterCrash(context.Context, ...rpcc.StreamOption) (inspector.TargetReloadedAfterCrashClient, error)
+
+	// OnTargetReloadedAfterCrash registers a handler for TargetReloadedAfterCrash events.
+	OnTargetReloadedAfterCrash(fn func(*inspector.TargetReloadedAfterCrashReply)) (unsubscribe func(), err error)
 }
 
 // The LayerTree domain.
@@ -1941,10 +2098,16 @@ type LayerTree interface {
 	SnapshotCommandLog(context.Context, *layertree.SnapshotCommandLogArgs) (*layertree.SnapshotCommandLogReply, error)
 
 	// Event LayerPainted
-	LayerPainted(context.Context) (layertree.LayerPaintedClient, error)
+	LayerPainted(context.Context, ...rpcc.StreamOption) (layertree.LayerPaintedClient, error)
+
+	// OnLayerPainted registers a handler for LayerPainted events.
+	OnLayerPainted(fn func(*layertree.LayerPaintedReply)) (unsubscribe func(), err error)
 
 	// Event LayerTreeDidChange
-	LayerTreeDidChange(context.Context) (layertree.DidChangeClient, error)
+	LayerTreeDidChange(context.Context, ...rpcc.StreamOption) (layertree.DidChangeClient, error)
+
+	// OnLayerTreeDidChange registers a handler for LayerTreeDidChange events.
+	OnLayerTreeDidChange(fn func(*layertree.DidChangeReply)) (unsubscribe func(), err error)
 }
 
 // The Log domain. Provides access to log entries.
@@ -1979,7 +2142,10 @@ type Log interface {
 	// Event EntryAdded
 	//
 	// Issued when new message was logged.
-	EntryAdded(context.Context) (log.EntryAddedClient, error)
+	EntryAdded(context.Context, ...rpcc.StreamOption) (log.EntryAddedClient, error)
+
+	// OnEntryAdded registers a handler for EntryAdded events.
+	OnEntryAdded(fn func(*log.EntryAddedReply)) (unsubscribe func(), err error)
 }
 
 // The Media domain. This domain allows detailed inspection of media elements
@@ -2001,31 +2167,46 @@ type Media interface {
 	// This can be called multiple times, and can be used to set /
 	// override / remove player properties. A null propValue indicates
 	// removal.
-	PlayerPropertiesChanged(context.Context) (media.PlayerPropertiesChangedClient, error)
+	PlayerPropertiesChanged(context.Context, ...rpcc.StreamOption) (media.PlayerPropertiesChangedClient, error)
+
+	// OnPlayerPropertiesChanged registers a handler for PlayerPropertiesChanged events.
+	OnPlayerPropertiesChanged(fn func(*media.PlayerPropertiesChangedReply)) (unsubscribe func(), err error)
 
 	// Event PlayerEventsAdded
 	//
 	// Send events as a list, allowing them to be batched on the browser
 	// for less congestion. If batched, events must ALWAYS be in
 	// chronological order.
-	PlayerEventsAdded(context.Context) (media.PlayerEventsAddedClient, error)
+	PlayerEventsAdded(context.Context, ...rpcc.StreamOption) (media.PlayerEventsAddedClient, error)
+
+	// OnPlayerEventsAdded registers a handler for PlayerEventsAdded events.
+	OnPlayerEventsAdded(fn func(*media.PlayerEventsAddedReply)) (unsubscribe func(), err error)
 
 	// Event PlayerMessagesLogged
 	//
 	// Send a list of any messages that need to be delivered.
-	PlayerMessagesLogged(context.Context) (media.PlayerMessagesLoggedClient, error)
+	PlayerMessagesLogged(context.Context, ...rpcc.StreamOption) (media.PlayerMessagesLoggedClient, error)
+
+	// OnPlayerMessagesLogged registers a handler for PlayerMessagesLogged events.
+	OnPlayerMessagesLogged(fn func(*media.PlayerMessagesLoggedReply)) (unsubscribe func(), err error)
 
 	// Event PlayerErrorsRaised
 	//
 	// Send a list of any errors that need to be delivered.
-	PlayerErrorsRaised(context.Context) (media.PlayerErrorsRaisedClient, error)
+	PlayerErrorsRaised(context.Context, ...rpcc.StreamOption) (media.PlayerErrorsRaisedClient, error)
+
+	// OnPlayerErrorsRaised registers a handler for PlayerErrorsRaised events.
+	OnPlayerErrorsRaised(fn func(*media.PlayerErrorsRaisedReply)) (unsubscribe func(), err error)
 
 	// Event PlayersCreated
 	//
 	// Called whenever a player is created, or when a new agent joins and
 	// receives a list of active players. If an agent is restored, it will
 	// receive the full list of player ids and all events again.
-	PlayersCreated(context.Context) (media.PlayersCreatedClient, error)
+	PlayersCreated(context.Context, ...rpcc.StreamOption) (media.PlayersCreatedClient, error)
+
+	// OnPlayersCreated registers a handler for PlayersCreated events.
+	OnPlayersCreated(fn func(*media.PlayersCreatedReply)) (unsubscribe func(), err error)
 }
 
 // The Memory domain.
@@ -2271,22 +2452,34 @@ type Network interface {
 	// Event DataReceived
 	//
 	// Fired when data chunk was received over the network.
-	DataReceived(context.Context) (network.DataReceivedClient, error)
+	DataReceived(context.Context, ...rpcc.StreamOption) (network.DataReceivedClient, error)
+
+	// OnDataReceived registers a handler for DataReceived events.
+	OnDataReceived(fn func(*network.DataReceivedReply)) (unsubscribe func(), err error)
 
 	// Event EventSourceMessageReceived
 	//
 	// Fired when EventSource message is received.
-	EventSourceMessageReceived(context.Context) (network.EventSourceMessageReceivedClient, error)
+	EventSourceMessageReceived(context.Context, ...rpcc.StreamOption) (network.EventSourceMessageReceivedClient, error)
+
+	// OnEventSourceMessageReceived registers a handler for EventSourceMessageReceived events.
+	OnEventSourceMessageReceived(fn func(*network.EventSourceMessageReceivedReply)) (unsubscribe func(), err error)
 
 	// Event LoadingFailed
 	//
 	// Fired when HTTP request has failed to load.
-	LoadingFailed(context.Context) (network.LoadingFailedClient, error)
+	LoadingFailed(context.Context, ...rpcc.StreamOption) (network.LoadingFailedClient, error)
+
+	// OnLoadingFailed registers a handler for LoadingFailed events.
+	OnLoadingFailed(fn func(*network.LoadingFailedReply)) (unsubscribe func(), err error)
 
 	// Event LoadingFinished
 	//
 	// Fired when HTTP request has finished loading.
-	LoadingFinished(context.Context) (network.LoadingFinishedClient, error)
+	LoadingFinished(context.Context, ...rpcc.StreamOption) (network.LoadingFinishedClient, error)
+
+	// OnLoadingFinished registers a handler for LoadingFinished events.
+	OnLoadingFinished(fn func(*network.LoadingFinishedReply)) (unsubscribe func(), err error)
 
 	// Event RequestIntercepted
 	//
@@ -2295,71 +2488,110 @@ type Network interface {
 	// Fetch.requestPaused instead.
 	//
 	// Note: This event is experimental.
-	RequestIntercepted(context.Context) (network.RequestInterceptedClient, error)
+	RequestIntercepted(context.Context, ...rpcc.StreamOption) (network.RequestInterceptedClient, error)
+
+	// OnRequestIntercepted registers a handler for RequestIntercepted events.
+	OnRequestIntercepted(fn func(*network.RequestInterceptedReply)) (unsubscribe func(), err error)
 
 	// Event RequestServedFromCache
 	//
 	// Fired if request ended up loading from cache.
-	RequestServedFromCache(context.Context) (network.RequestServedFromCacheClient, error)
+	RequestServedFromCache(context.Context, ...rpcc.StreamOption) (network.RequestServedFromCacheClient, error)
+
+	// OnRequestServedFromCache registers a handler for RequestServedFromCache events.
+	OnRequestServedFromCache(fn func(*network.RequestServedFromCacheReply)) (unsubscribe func(), err error)
 
 	// Event RequestWillBeSent
 	//
 	// Fired when page is about to send HTTP request.
-	RequestWillBeSent(context.Context) (network.RequestWillBeSentClient, error)
+	RequestWillBeSent(context.Context, ...rpcc.StreamOption) (network.RequestWillBeSentClient, error)
+
+	// OnRequestWillBeSent registers a handler for RequestWillBeSent events.
+	OnRequestWillBeSent(fn func(*network.RequestWillBeSentReply)) (unsubscribe func(), err error)
 
 	// Event ResourceChangedPriority
 	//
 	// Fired when resource loading priority is changed
 	//
 	// Note: This event is experimental.
-	ResourceChangedPriority(context.Context) (network.ResourceChangedPriorityClient, error)
+	ResourceChangedPriority(context.Context, ...rpcc.StreamOption) (network.ResourceChangedPriorityClient, error)
+
+	// OnResourceChangedPriority registers a handler for ResourceChangedPriority events.
+	OnResourceChangedPriority(fn func(*network.ResourceChangedPriorityReply)) (unsubscribe func(), err error)
 
 	// Event SignedExchangeReceived
 	//
 	// Fired when a signed exchange was received over the network
 	//
 	// Note: This event is experimental.
-	SignedExchangeReceived(context.Context) (network.SignedExchangeReceivedClient, error)
+	SignedExchangeReceived(context.Context, ...rpcc.StreamOption) (network.SignedExchangeReceivedClient, error)
+
+	// OnSignedExchangeReceived registers a handler for SignedExchangeReceived events.
+	OnSignedExchangeReceived(fn func(*network.SignedExchangeReceivedReply)) (unsubscribe func(), err error)
 
 	// Event ResponseReceived
 	//
 	// Fired when HTTP response is available.
-	ResponseReceived(context.Context) (network.ResponseReceivedClient, error)
+	ResponseReceived(context.Context, ...rpcc.StreamOption) (network.ResponseReceivedClient, error)
+
+	// OnResponseReceived registers a handler for ResponseReceived events.
+	OnResponseReceived(fn func(*network.ResponseReceivedReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketClosed
 	//
 	// Fired when WebSocket is closed.
-	WebSocketClosed(context.Context) (network.WebSocketClosedClient, error)
+	WebSocketClosed(context.Context, ...rpcc.StreamOption) (network.WebSocketClosedClient, error)
+
+	// OnWebSocketClosed registers a handler for WebSocketClosed events.
+	OnWebSocketClosed(fn func(*network.WebSocketClosedReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketCreated
 	//
 	// Fired upon WebSocket creation.
-	WebSocketCreated(context.Context) (network.WebSocketCreatedClient, error)
+	WebSocketCreated(context.Context, ...rpcc.StreamOption) (network.WebSocketCreatedClient, error)
+
+	// OnWebSocketCreated registers a handler for WebSocketCreated events.
+	OnWebSocketCreated(fn func(*network.WebSocketCreatedReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketFrameError
 	//
 	// Fired when WebSocket message error occurs.
-	WebSocketFrameError(context.Context) (network.WebSocketFrameErrorClient, error)
+	WebSocketFrameError(context.Context, ...rpcc.StreamOption) (network.WebSocketFrameErrorClient, error)
+
+	// OnWebSocketFrameError registers a handler for WebSocketFrameError events.
+	OnWebSocketFrameError(fn func(*network.WebSocketFrameErrorReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketFrameReceived
 	//
 	// Fired when WebSocket message is received.
-	WebSocketFrameReceived(context.Context) (network.WebSocketFrameReceivedClient, error)
+	WebSocketFrameReceived(context.Context, ...rpcc.StreamOption) (network.WebSocketFrameReceivedClient, error)
+
+	// OnWebSocketFrameReceived registers a handler for WebSocketFrameReceived events.
+	OnWebSocketFrameReceived(fn func(*network.WebSocketFrameReceivedReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketFrameSent
 	//
 	// Fired when WebSocket message is sent.
-	WebSocketFrameSent(context.Context) (network.WebSocketFrameSentClient, error)
+	WebSocketFrameSent(context.Context, ...rpcc.StreamOption) (network.WebSocketFrameSentClient, error)
+
+	// OnWebSocketFrameSent registers a handler for WebSocketFrameSent events.
+	OnWebSocketFrameSent(fn func(*network.WebSocketFrameSentReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketHandshakeResponseReceived
 	//
 	// Fired when WebSocket handshake response becomes available.
-	WebSocketHandshakeResponseReceived(context.Context) (network.WebSocketHandshakeResponseReceivedClient, error)
+	WebSocketHandshakeResponseReceived(context.Context, ...rpcc.StreamOption) (network.WebSocketHandshakeResponseReceivedClient, error)
+
+	// OnWebSocketHandshakeResponseReceived registers a handler for WebSocketHandshakeResponseReceived events.
+	OnWebSocketHandshakeResponseReceived(fn func(*network.WebSocketHandshakeResponseReceivedReply)) (unsubscribe func(), err error)
 
 	// Event WebSocketWillSendHandshakeRequest
 	//
 	// Fired when WebSocket is about to initiate handshake.
-	WebSocketWillSendHandshakeRequest(context.Context) (network.WebSocketWillSendHandshakeRequestClient, error)
+	WebSocketWillSendHandshakeRequest(context.Context, ...rpcc.StreamOption) (network.WebSocketWillSendHandshakeRequestClient, error)
+
+	// OnWebSocketWillSendHandshakeRequest registers a handler for WebSocketWillSendHandshakeRequest events.
+	OnWebSocketWillSendHandshakeRequest(fn func(*network.WebSocketWillSendHandshakeRequestReply)) (unsubscribe func(), err error)
 
 	// Event RequestWillBeSentExtraInfo
 	//
@@ -2370,7 +2602,10 @@ type Network interface {
 	// requestWillBeSentExtraInfo will be fired first for the same request.
 	//
 	// Note: This event is experimental.
-	RequestWillBeSentExtraInfo(context.Context) (network.RequestWillBeSentExtraInfoClient, error)
+	RequestWillBeSentExtraInfo(context.Context, ...rpcc.StreamOption) (network.RequestWillBeSentExtraInfoClient, error)
+
+	// OnRequestWillBeSentExtraInfo registers a handler for RequestWillBeSentExtraInfo events.
+	OnRequestWillBeSentExtraInfo(fn func(*network.RequestWillBeSentExtraInfoReply)) (unsubscribe func(), err error)
 
 	// Event ResponseReceivedExtraInfo
 	//
@@ -2381,7 +2616,10 @@ type Network interface {
 	// responseReceived.
 	//
 	// Note: This event is experimental.
-	ResponseReceivedExtraInfo(context.Context) (network.ResponseReceivedExtraInfoClient, error)
+	ResponseReceivedExtraInfo(context.Context, ...rpcc.StreamOption) (network.ResponseReceivedExtraInfoClient, error)
+
+	// OnResponseReceivedExtraInfo registers a handler for ResponseReceivedExtraInfo events.
+	OnResponseReceivedExtraInfo(fn func(*network.ResponseReceivedExtraInfoReply)) (unsubscribe func(), err error)
 }
 
 // The Overlay domain. This domain provides various functionality related to
@@ -2491,24 +2729,36 @@ type Overlay interface {
 	//
 	// Fired when the node should be inspected. This happens after call to
 	// `setInspectMode` or when user manually inspects an element.
-	InspectNodeRequested(context.Context) (overlay.InspectNodeRequestedClient, error)
+	InspectNodeRequested(context.Context, ...rpcc.StreamOption) (overlay.InspectNodeRequestedClient, error)
+
+	// OnInspectNodeRequested registers a handler for InspectNodeRequested events.
+	OnInspectNodeRequested(fn func(*overlay.InspectNodeRequestedReply)) (unsubscribe func(), err error)
 
 	// Event NodeHighlightRequested
 	//
 	// Fired when the node should be highlighted. This happens after call
 	// to `setInspectMode`.
-	NodeHighlightRequested(context.Context) (overlay.NodeHighlightRequestedClient, error)
+	NodeHighlightRequested(context.Context, ...rpcc.StreamOption) (overlay.NodeHighlightRequestedClient, error)
+
+	// OnNodeHighlightRequested registers a handler for NodeHighlightRequested events.
+	OnNodeHighlightRequested(fn func(*overlay.NodeHighlightRequestedReply)) (unsubscribe func(), err error)
 
 	// Event ScreenshotRequested
 	//
 	// Fired when user asks to capture screenshot of some area on the
 	// page.
-	ScreenshotRequested(context.Context) (overlay.ScreenshotRequestedClient, error)
+	ScreenshotRequested(context.Context, ...rpcc.StreamOption) (overlay.ScreenshotRequestedClient, error)
+
+	// OnScreenshotRequested registers a handler for ScreenshotRequested events.
+	OnScreenshotRequested(fn func(*overlay.ScreenshotRequestedReply)) (unsubscribe func(), err error)
 
 	// Event InspectModeCanceled
 	//
 	// Fired when user cancels the inspect mode.
-	InspectModeCanceled(context.Context) (overlay.InspectModeCanceledClient, error)
+	InspectModeCanceled(context.Context, ...rpcc.StreamOption) (overlay.InspectModeCanceledClient, error)
+
+	// OnInspectModeCanceled registers a handler for InspectModeCanceled events.
+	OnInspectModeCanceled(fn func(*overlay.InspectModeCanceledReply)) (unsubscribe func(), err error)
 }
 
 // The Page domain. Actions and events related to the inspected page belong to
@@ -2802,38 +3052,59 @@ type Page interface {
 	SetInterceptFileChooserDialog(context.Context, *page.SetInterceptFileChooserDialogArgs) error
 
 	// Event DOMContentEventFired
-	DOMContentEventFired(context.Context) (page.DOMContentEventFiredClient, error)
+	DOMContentEventFired(context.Context, ...rpcc.StreamOption) (page.DOMContentEventFiredClient, error)
+
+	// OnDOMContentEventFired registers a handler for DOMContentEventFired events.
+	OnDOMContentEventFired(fn func(*page.DOMContentEventFiredReply)) (unsubscribe func(), err error)
 
 	// Event FileChooserOpened
 	//
 	// Emitted only when `page.interceptFileChooser` is enabled.
-	FileChooserOpened(context.Context) (page.FileChooserOpenedClient, error)
+	FileChooserOpened(context.Context, ...rpcc.StreamOption) (page.FileChooserOpenedClient, error)
+
+	// OnFileChooserOpened registers a handler for FileChooserOpened events.
+	OnFileChooserOpened(fn func(*page.FileChooserOpenedReply)) (unsubscribe func(), err error)
 
 	// Event FrameAttached
 	//
 	// Fired when frame has been attached to its parent.
-	FrameAttached(context.Context) (page.FrameAttachedClient, error)
+	FrameAttached(context.Context, ...rpcc.StreamOption) (page.FrameAttachedClient, error)
+
+	// OnFrameAttached registers a handler for FrameAttached events.
+	OnFrameAttached(fn func(*page.FrameAttachedReply)) (unsubscribe func(), err error)
 
 	// Event FrameClearedScheduledNavigation
 	//
 	// Deprecated: Fired when frame no longer has a scheduled navigation.
-	FrameClearedScheduledNavigation(context.Context) (page.FrameClearedScheduledNavigationClient, error)
+	FrameClearedScheduledNavigation(context.Context, ...rpcc.StreamOption) (page.FrameClearedScheduledNavigationClient, error)
+
+	// OnFrameClearedScheduledNavigation registers a handler for FrameClearedScheduledNavigation events.
+	OnFrameClearedScheduledNavigation(fn func(*page.FrameClearedScheduledNavigationReply)) (unsubscribe func(), err error)
 
 	// Event FrameDetached
 	//
 	// Fired when frame has been detached from its parent.
-	FrameDetached(context.Context) (page.FrameDetachedClient, error)
+	FrameDetached(context.Context, ...rpcc.StreamOption) (page.FrameDetachedClient, error)
+
+	// OnFrameDetached registers a handler for FrameDetached events.
+	OnFrameDetached(fn func(*page.FrameDetachedReply)) (unsubscribe func(), err error)
 
 	// Event FrameNavigated
 	//
 	// Fired once navigation of the frame has completed. Frame is now
 	// associated with the new loader.
-	FrameNavigated(context.Context) (page.FrameNavigatedClient, error)
+	FrameNavigated(context.Context, ...rpcc.StreamOption) (page.FrameNavigatedClient, error)
+
+	// OnFrameNavigated registers a handler for FrameNavigated events.
+	OnFrameNavigated(fn func(*page.FrameNavigatedReply)) (unsubscribe func(), err error)
 
 	// Event FrameResized
 	//
 	// Note: This event is experimental.
-	FrameResized(context.Context) (page.FrameResizedClient, error)
+	FrameResized(context.Context, ...rpcc.StreamOption) (page.FrameResizedClient, error)
+
+	// OnFrameResized registers a handler for FrameResized events.
+	OnFrameResized(fn func(*page.FrameResizedReply)) (unsubscribe func(), err error)
 
 	// Event FrameRequestedNavigation
 	//
@@ -2841,71 +3112,107 @@ type Page interface {
 	// may still be canceled after the event is issued.
 	//
 	// Note: This event is experimental.
-	FrameRequestedNavigation(context.Context) (page.FrameRequestedNavigationClient, error)
+	FrameRequestedNavigation(context.Context, ...rpcc.StreamOption) (page.FrameRequestedNavigationClient, error)
+
+	// OnFrameRequestedNavigation registers a handler for FrameRequestedNavigation events.
+	OnFrameRequestedNavigation(fn func(*page.FrameRequestedNavigationReply)) (unsubscribe func(), err error)
 
 	// Event FrameScheduledNavigation
 	//
 	// Deprecated: Fired when frame schedules a potential navigation.
-	FrameScheduledNavigation(context.Context) (page.FrameScheduledNavigationClient, error)
+	FrameScheduledNavigation(context.Context, ...rpcc.StreamOption) (page.FrameScheduledNavigationClient, error)
+
+	// OnFrameScheduledNavigation registers a handler for FrameScheduledNavigation events.
+	OnFrameScheduledNavigation(fn func(*page.FrameScheduledNavigationReply)) (unsubscribe func(), err error)
 
 	// Event FrameStartedLoading
 	//
 	// Fired when frame has started loading.
 	//
 	// Note: This event is experimental.
-	FrameStartedLoading(context.Context) (page.FrameStartedLoadingClient, error)
+	FrameStartedLoading(context.Context, ...rpcc.StreamOption) (page.FrameStartedLoadingClient, error)
+
+	// OnFrameStartedLoading registers a handler for FrameStartedLoading events.
+	OnFrameStartedLoading(fn func(*page.FrameStartedLoadingReply)) (unsubscribe func(), err error)
 
 	// Event FrameStoppedLoading
 	//
 	// Fired when frame has stopped loading.
 	//
 	// Note: This event is experimental.
-	FrameStoppedLoading(context.Context) (page.FrameStoppedLoadingClient, error)
+	FrameStoppedLoading(context.Context, ...rpcc.StreamOption) (page.FrameStoppedLoadingClient, error)
+
+	// OnFrameStoppedLoading registers a handler for FrameStoppedLoading events.
+	OnFrameStoppedLoading(fn func(*page.FrameStoppedLoadingReply)) (unsubscribe func(), err error)
 
 	// Event DownloadWillBegin
 	//
 	// Fired when page is about to start a download.
 	//
 	// Note: This event is experimental.
-	DownloadWillBegin(context.Context) (page.DownloadWillBeginClient, error)
+	DownloadWillBegin(context.Context, ...rpcc.StreamOption) (page.DownloadWillBeginClient, error)
+
+	// OnDownloadWillBegin registers a handler for DownloadWillBegin events.
+	OnDownloadWillBegin(fn func(*page.DownloadWillBeginReply)) (unsubscribe func(), err error)
 
 	// Event DownloadProgress
 	//
 	// Fired when download makes progress. Last call has |done| == true.
 	//
 	// Note: This event is experimental.
-	DownloadProgress(context.Context) (page.DownloadProgressClient, error)
+	DownloadProgress(context.Context, ...rpcc.StreamOption) (page.DownloadProgressClient, error)
+
+	// OnDownloadProgress registers a handler for DownloadProgress events.
+	OnDownloadProgress(fn func(*page.DownloadProgressReply)) (unsubscribe func(), err error)
 
 	// Event InterstitialHidden
 	//
 	// Fired when interstitial page was hidden
-	InterstitialHidden(context.Context) (page.InterstitialHiddenClient, error)
+	InterstitialHidden(context.Context, ...rpcc.StreamOption) (page.InterstitialHiddenClient, error)
+
+	// OnInterstitialHidden registers a handler for InterstitialHidden events.
+	OnInterstitialHidden(fn func(*page.InterstitialHiddenReply)) (unsubscribe func(), err error)
 
 	// Event InterstitialShown
 	//
 	// Fired when interstitial page was shown
-	InterstitialShown(context.Context) (page.InterstitialShownClient, error)
+	InterstitialShown(context.Context, ...rpcc.StreamOption) (page.InterstitialShownClient, error)
+
+	// OnInterstitialShown registers a handler for InterstitialShown events.
+	OnInterstitialShown(fn func(*page.InterstitialShownReply)) (unsubscribe func(), err error)
 
 	// Event JavascriptDialogClosed
 	//
 	// Fired when a JavaScript initiated dialog (alert, confirm, prompt,
 	// or onbeforeunload) has been closed.
-	JavascriptDialogClosed(context.Context) (page.JavascriptDialogClosedClient, error)
+	JavascriptDialogClosed(context.Context, ...rpcc.StreamOption) (page.JavascriptDialogClosedClient, error)
+
+	// OnJavascriptDialogClosed registers a handler for JavascriptDialogClosed events.
+	OnJavascriptDialogClosed(fn func(*page.JavascriptDialogClosedReply)) (unsubscribe func(), err error)
 
 	// Event JavascriptDialogOpening
 	//
 	// Fired when a JavaScript initiated dialog (alert, confirm, prompt,
 	// or onbeforeunload) is about to open.
-	JavascriptDialogOpening(context.Context) (page.JavascriptDialogOpeningClient, error)
+	JavascriptDialogOpening(context.Context, ...rpcc.StreamOption) (page.JavascriptDialogOpeningClient, error)
+
+	// OnJavascriptDialogOpening registers a handler for JavascriptDialogOpening events.
+	OnJavascriptDialogOpening(fn func(*page.JavascriptDialogOpeningReply)) (unsubscribe func(), err error)
 
 	// Event LifecycleEvent
 	//
 	// Fired for top level page lifecycle events such as navigation, load,
 	// paint, etc.
-	LifecycleEvent(context.Context) (page.LifecycleEventClient, error)
+	LifecycleEvent(context.Context, ...rpcc.StreamOption) (page.LifecycleEventClient, error)
+
+	// OnLifecycleEvent registers a handler for LifecycleEvent events.
+	OnLifecycleEvent(fn func(*page.LifecycleEventReply)) (unsubscribe func(), err error)
 
 	// Event LoadEventFired
-	LoadEventFired(context.Context) (page.LoadEventFiredClient, error)
+	LoadEventFired(context.Context, ...rpcc.StreamOption) (page.LoadEventFiredClient, error)
+
+	// OnLoadEventFired registers a handler for LoadEventFired events.
+	OnLoadEventFired(fn func(*page.LoadEventFiredReply)) (unsubscribe func(), err error)
 
 	// Event NavigatedWithinDocument
 	//
@@ -2913,14 +3220,20 @@ type Page interface {
 	// API usage or anchor navigation.
 	//
 	// Note: This event is experimental.
-	NavigatedWithinDocument(context.Context) (page.NavigatedWithinDocumentClient, error)
+	NavigatedWithinDocument(context.Context, ...rpcc.StreamOption) (page.NavigatedWithinDocumentClient, error)
+
+	// OnNavigatedWithinDocument registers a handler for NavigatedWithinDocument events.
+	OnNavigatedWithinDocument(fn func(*page.NavigatedWithinDocumentReply)) (unsubscribe func(), err error)
 
 	// Event ScreencastFrame
 	//
 	// Compressed image data requested by the `startScreencast`.
 	//
 	// Note: This event is experimental.
-	ScreencastFrame(context.Context) (page.ScreencastFrameClient, error)
+	ScreencastFrame(context.Context, ...rpcc.StreamOption) (page.ScreencastFrameClient, error)
+
+	// OnScreencastFrame registers a handler for ScreencastFrame events.
+	OnScreencastFrame(fn func(*page.ScreencastFrameReply)) (unsubscribe func(), err error)
 
 	// Event ScreencastVisibilityChanged
 	//
@@ -2928,13 +3241,19 @@ type Page interface {
 	// hidden `.
 	//
 	// Note: This event is experimental.
-	ScreencastVisibilityChanged(context.Context) (page.ScreencastVisibilityChangedClient, error)
+	ScreencastVisibilityChanged(context.Context, ...rpcc.StreamOption) (page.ScreencastVisibilityChangedClient, error)
+
+	// OnScreencastVisibilityChanged registers a handler for ScreencastVisibilityChanged events.
+	OnScreencastVisibilityChanged(fn func(*page.ScreencastVisibilityChangedReply)) (unsubscribe func(), err error)
 
 	// Event WindowOpen
 	//
 	// Fired when a new window is going to be opened, via window.open(),
 	// link click, form submission, etc.
-	WindowOpen(context.Context) (page.WindowOpenClient, error)
+	WindowOpen(context.Context, ...rpcc.StreamOption) (page.WindowOpenClient, error)
+
+	// OnWindowOpen registers a handler for WindowOpen events.
+	OnWindowOpen(fn func(*page.WindowOpenReply)) (unsubscribe func(), err error)
 
 	// Event CompilationCacheProduced
 	//
@@ -2942,7 +3261,10 @@ type Page interface {
 	// Page.setGenerateCompilationCache is enabled.
 	//
 	// Note: This event is experimental.
-	CompilationCacheProduced(context.Context) (page.CompilationCacheProducedClient, error)
+	CompilationCacheProduced(context.Context, ...rpcc.StreamOption) (page.CompilationCacheProducedClient, error)
+
+	// OnCompilationCacheProduced registers a handler for CompilationCacheProduced events.
+	OnCompilationCacheProduced(fn func(*page.CompilationCacheProducedReply)) (unsubscribe func(), err error)
 }
 
 // The Performance domain.
@@ -2975,7 +3297,10 @@ type Performance interface {
 	// Event Metrics
 	//
 	// Current values of the metrics.
-	Metrics(context.Context) (performance.MetricsClient, error)
+	Metrics(context.Context, ...rpcc.StreamOption) (performance.MetricsClient, error)
+
+	// OnMetrics registers a handler for Metrics events.
+	OnMetrics(fn func(*performance.MetricsReply)) (unsubscribe func(), err error)
 }
 
 // The Profiler domain.
@@ -3067,13 +3392,19 @@ type Profiler interface {
 	GetRuntimeCallStats(context.Context) (*profiler.GetRuntimeCallStatsReply, error)
 
 	// Event ConsoleProfileFinished
-	ConsoleProfileFinished(context.Context) (profiler.ConsoleProfileFinishedClient, error)
+	ConsoleProfileFinished(context.Context, ...rpcc.StreamOption) (profiler.ConsoleProfileFinishedClient, error)
+
+	// OnConsoleProfileFinished registers a handler for ConsoleProfileFinished events.
+	OnConsoleProfileFinished(fn func(*profiler.ConsoleProfileFinishedReply)) (unsubscribe func(), err error)
 
 	// Event ConsoleProfileStarted
 	//
 	// Sent when new profile recording is started using console.profile()
 	// call.
-	ConsoleProfileStarted(context.Context) (profiler.ConsoleProfileStartedClient, error)
+	ConsoleProfileStarted(context.Context, ...rpcc.StreamOption) (profiler.ConsoleProfileStartedClient, error)
+
+	// OnConsoleProfileStarted registers a handler for ConsoleProfileStarted events.
+	OnConsoleProfileStarted(fn func(*profiler.ConsoleProfileStartedReply)) (unsubscribe func(), err error)
 
 	// Event PreciseCoverageDeltaUpdate
 	//
@@ -3084,7 +3415,10 @@ type Profiler interface {
 	// collection of coverage data immediately at a certain point in time.
 	//
 	// Note: This event is experimental.
-	PreciseCoverageDeltaUpdate(context.Context) (profiler.PreciseCoverageDeltaUpdateClient, error)
+	PreciseCoverageDeltaUpdate(context.Context, ...rpcc.StreamOption) (profiler.PreciseCoverageDeltaUpdateClient, error)
+
+	// OnPreciseCoverageDeltaUpdate registers a handler for PreciseCoverageDeltaUpdate events.
+	OnPreciseCoverageDeltaUpdate(fn func(*profiler.PreciseCoverageDeltaUpdateReply)) (unsubscribe func(), err error)
 }
 
 // The Runtime domain. Runtime domain exposes JavaScript runtime by means of
@@ -3228,43 +3562,67 @@ type Runtime interface {
 	// Notification is issued every time when binding is called.
 	//
 	// Note: This event is experimental.
-	BindingCalled(context.Context) (runtime.BindingCalledClient, error)
+	BindingCalled(context.Context, ...rpcc.StreamOption) (runtime.BindingCalledClient, error)
+
+	// OnBindingCalled registers a handler for BindingCalled events.
+	OnBindingCalled(fn func(*runtime.BindingCalledReply)) (unsubscribe func(), err error)
 
 	// Event ConsoleAPICalled
 	//
 	// Issued when console API was called.
-	ConsoleAPICalled(context.Context) (runtime.ConsoleAPICalledClient, error)
+	ConsoleAPICalled(context.Context, ...rpcc.StreamOption) (runtime.ConsoleAPICalledClient, error)
+
+	// OnConsoleAPICalled registers a handler for ConsoleAPICalled events.
+	OnConsoleAPICalled(fn func(*runtime.ConsoleAPICalledReply)) (unsubscribe func(), err error)
 
 	// Event ExceptionRevoked
 	//
 	// Issued when unhandled exception was revoked.
-	ExceptionRevoked(context.Context) (runtime.ExceptionRevokedClient, error)
+	ExceptionRevoked(context.Context, ...rpcc.StreamOption) (runtime.ExceptionRevokedClient, error)
+
+	// OnExceptionRevoked registers a handler for ExceptionRevoked events.
+	OnExceptionRevoked(fn func(*runtime.ExceptionRevokedReply)) (unsubscribe func(), err error)
 
 	// Event ExceptionThrown
 	//
 	// Issued when exception was thrown and unhandled.
-	ExceptionThrown(context.Context) (runtime.ExceptionThrownClient, error)
+	ExceptionThrown(context.Context, ...rpcc.StreamOption) (runtime.ExceptionThrownClient, error)
+
+	// OnExceptionThrown registers a handler for ExceptionThrown events.
+	OnExceptionThrown(fn func(*runtime.ExceptionThrownReply)) (unsubscribe func(), err error)
 
 	// Event ExecutionContextCreated
 	//
 	// Issued when new execution context is created.
-	ExecutionContextCreated(context.Context) (runtime.ExecutionContextCreatedClient, error)
+	ExecutionContextCreated(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextCreatedClient, error)
+
+	// OnExecutionContextCreated registers a handler for ExecutionContextCreated events.
+	OnExecutionContextCreated(fn func(*runtime.ExecutionContextCreatedReply)) (unsubscribe func(), err error)
 
 	// Event ExecutionContextDestroyed
 	//
 	// Issued when execution context is destroyed.
-	ExecutionContextDestroyed(context.Context) (runtime.ExecutionContextDestroyedClient, error)
+	ExecutionContextDestroyed(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextDestroyedClient, error)
+
+	// OnExecutionContextDestroyed registers a handler for ExecutionContextDestroyed events.
+	OnExecutionContextDestroyed(fn func(*runtime.ExecutionContextDestroyedReply)) (unsubscribe func(), err error)
 
 	// Event ExecutionContextsCleared
 	//
 	// Issued when all executionContexts were cleared in browser
-	ExecutionContextsCleared(context.Context) (runtime.ExecutionContextsClearedClient, error)
+	ExecutionContextsCleared(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextsClearedClient, error)
+
+	// OnExecutionContextsCleared registers a handler for ExecutionContextsCleared events.
+	OnExecutionContextsCleared(fn func(*runtime.ExecutionContextsClearedReply)) (unsubscribe func(), err error)
 
 	// Event InspectRequested
 	//
 	// Issued when object should be inspected (for example, as a result of
 	// inspect() command line API call).
-	InspectRequested(context.Context) (runtime.InspectRequestedClient, error)
+	InspectRequested(context.Context, ...rpcc.StreamOption) (runtime.InspectRequestedClient, error)
+
+	// OnInspectRequested registers a handler for InspectRequested events.
+	OnInspectRequested(fn func(*runtime.InspectRequestedReply)) (unsubscribe func(), err error)
 }
 
 // The Schema domain.
@@ -3317,19 +3675,28 @@ type Security interface {
 	// `handleCertificateError` command. Note: this event does not fire if
 	// the certificate error has been allowed internally. Only one client
 	// per target should override certificate errors at the same time.
-	CertificateError(context.Context) (security.CertificateErrorClient, error)
+	CertificateError(context.Context, ...rpcc.StreamOption) (security.CertificateErrorClient, error)
+
+	// OnCertificateError registers a handler for CertificateError events.
+	OnCertificateError(fn func(*security.CertificateErrorReply)) (unsubscribe func(), err error)
 
 	// Event VisibleSecurityStateChanged
 	//
 	// The security state of the page changed.
 	//
 	// Note: This event is experimental.
-	VisibleSecurityStateChanged(context.Context) (security.VisibleSecurityStateChangedClient, error)
+	VisibleSecurityStateChanged(context.Context, ...rpcc.StreamOption) (security.VisibleSecurityStateChangedClient, error)
+
+	// OnVisibleSecurityStateChanged registers a handler for VisibleSecurityStateChanged events.
+	OnVisibleSecurityStateChanged(fn func(*security.VisibleSecurityStateChangedReply)) (unsubscribe func(), err error)
 
 	// Event SecurityStateChanged
 	//
 	// The security state of the page changed.
-	SecurityStateChanged(context.Context) (security.StateChangedClient, error)
+	SecurityStateChanged(context.Context, ...rpcc.StreamOption) (security.StateChangedClient, error)
+
+	// OnSecurityStateChanged registers a handler for SecurityStateChanged events.
+	OnSecurityStateChanged(fn func(*security.StateChangedReply)) (unsubscribe func(), err error)
 }
 
 // The ServiceWorker domain.
@@ -3376,13 +3743,22 @@ type ServiceWorker interface {
 	UpdateRegistration(context.Context, *serviceworker.UpdateRegistrationArgs) error
 
 	// Event WorkerErrorReported
-	WorkerErrorReported(context.Context) (serviceworker.WorkerErrorReportedClient, error)
+	WorkerErrorReported(context.Context, ...rpcc.StreamOption) (serviceworker.WorkerErrorReportedClient, error)
+
+	// OnWorkerErrorReported registers a handler for WorkerErrorReported events.
+	OnWorkerErrorReported(fn func(*serviceworker.WorkerErrorReportedReply)) (unsubscribe func(), err error)
 
 	// Event WorkerRegistrationUpdated
-	WorkerRegistrationUpdated(context.Context) (serviceworker.WorkerRegistrationUpdatedClient, error)
+	WorkerRegistrationUpdated(context.Context, ...rpcc.StreamOption) (serviceworker.WorkerRegistrationUpdatedClient, error)
+
+	// OnWorkerRegistrationUpdated registers a handler for WorkerRegistrationUpdated events.
+	OnWorkerRegistrationUpdated(fn func(*serviceworker.WorkerRegistrationUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event WorkerVersionUpdated
-	WorkerVersionUpdated(context.Context) (serviceworker.WorkerVersionUpdatedClient, error)
+	WorkerVersionUpdated(context.Context, ...rpcc.StreamOption) (serviceworker.WorkerVersionUpdatedClient, error)
+
+	// OnWorkerVersionUpdated registers a handler for WorkerVersionUpdated events.
+	OnWorkerVersionUpdated(fn func(*serviceworker.WorkerVersionUpdatedReply)) (unsubscribe func(), err error)
 }
 
 // The Storage domain.
@@ -3439,22 +3815,34 @@ type Storage interface {
 	// Event CacheStorageContentUpdated
 	//
 	// A cache's contents have been modified.
-	CacheStorageContentUpdated(context.Context) (storage.CacheStorageContentUpdatedClient, error)
+	CacheStorageContentUpdated(context.Context, ...rpcc.StreamOption) (storage.CacheStorageContentUpdatedClient, error)
+
+	// OnCacheStorageContentUpdated registers a handler for CacheStorageContentUpdated events.
+	OnCacheStorageContentUpdated(fn func(*storage.CacheStorageContentUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event CacheStorageListUpdated
 	//
 	// A cache has been added/deleted.
-	CacheStorageListUpdated(context.Context) (storage.CacheStorageListUpdatedClient, error)
+	CacheStorageListUpdated(context.Context, ...rpcc.StreamOption) (storage.CacheStorageListUpdatedClient, error)
+
+	// OnCacheStorageListUpdated registers a handler for CacheStorageListUpdated events.
+	OnCacheStorageListUpdated(fn func(*storage.CacheStorageListUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event IndexedDBContentUpdated
 	//
 	// The origin's IndexedDB object store has been modified.
-	IndexedDBContentUpdated(context.Context) (storage.IndexedDBContentUpdatedClient, error)
+	IndexedDBContentUpdated(context.Context, ...rpcc.StreamOption) (storage.IndexedDBContentUpdatedClient, error)
+
+	// OnIndexedDBContentUpdated registers a handler for IndexedDBContentUpdated events.
+	OnIndexedDBContentUpdated(fn func(*storage.IndexedDBContentUpdatedReply)) (unsubscribe func(), err error)
 
 	// Event IndexedDBListUpdated
 	//
 	// The origin's IndexedDB database list has been modified.
-	IndexedDBListUpdated(context.Context) (storage.IndexedDBListUpdatedClient, error)
+	IndexedDBListUpdated(context.Context, ...rpcc.StreamOption) (storage.IndexedDBListUpdatedClient, error)
+
+	// OnIndexedDBListUpdated registers a handler for IndexedDBListUpdated events.
+	OnIndexedDBListUpdated(fn func(*storage.IndexedDBListUpdatedReply)) (unsubscribe func(), err error)
 }
 
 // The SystemInfo domain. The SystemInfo domain defines methods and events for
@@ -3596,7 +3984,10 @@ type Target interface {
 	// `attachToTarget` command.
 	//
 	// Note: This event is experimental.
-	AttachedToTarget(context.Context) (target.AttachedToTargetClient, error)
+	AttachedToTarget(context.Context, ...rpcc.StreamOption) (target.AttachedToTargetClient, error)
+
+	// OnAttachedToTarget registers a handler for AttachedToTarget events.
+	OnAttachedToTarget(fn func(*target.AttachedToTargetReply)) (unsubscribe func(), err error)
 
 	// Event DetachedFromTarget
 	//
@@ -3605,34 +3996,52 @@ type Target interface {
 	// if multiple sessions have been attached to it.
 	//
 	// Note: This event is experimental.
-	DetachedFromTarget(context.Context) (target.DetachedFromTargetClient, error)
+	DetachedFromTarget(context.Context, ...rpcc.StreamOption) (target.DetachedFromTargetClient, error)
+
+	// OnDetachedFromTarget registers a handler for DetachedFromTarget events.
+	OnDetachedFromTarget(fn func(*target.DetachedFromTargetReply)) (unsubscribe func(), err error)
 
 	// Event ReceivedMessageFromTarget
 	//
 	// Notifies about a new protocol message received from the session (as
 	// reported in `attachedToTarget` event).
-	ReceivedMessageFromTarget(context.Context) (target.ReceivedMessageFromTargetClient, error)
+	ReceivedMessageFromTarget(context.Context, ...rpcc.StreamOption) (target.ReceivedMessageFromTargetClient, error)
+
+	// OnReceivedMessageFromTarget registers a handler for ReceivedMessageFromTarget events.
+	OnReceivedMessageFromTarget(fn func(*target.ReceivedMessageFromTargetReply)) (unsubscribe func(), err error)
 
 	// Event TargetCreated
 	//
 	// Issued when a possible inspection target is created.
-	TargetCreated(context.Context) (target.CreatedClient, error)
+	TargetCreated(context.Context, ...rpcc.StreamOption) (target.CreatedClient, error)
+
+	// OnTargetCreated registers a handler for TargetCreated events.
+	OnTargetCreated(fn func(*target.CreatedReply)) (unsubscribe func(), err error)
 
 	// Event TargetDestroyed
 	//
 	// Issued when a target is destroyed.
-	TargetDestroyed(context.Context) (target.DestroyedClient, error)
+	TargetDestroyed(context.Context, ...rpcc.StreamOption) (target.DestroyedClient, error)
+
+	// OnTargetDestroyed registers a handler for TargetDestroyed events.
+	OnTargetDestroyed(fn func(*target.DestroyedReply)) (unsubscribe func(), err error)
 
 	// Event TargetCrashed
 	//
 	// Issued when a target has crashed.
-	TargetCrashed(context.Context) (target.CrashedClient, error)
+	TargetCrashed(context.Context, ...rpcc.StreamOption) (target.CrashedClient, error)
+
+	// OnTargetCrashed registers a handler for TargetCrashed events.
+	OnTargetCrashed(fn func(*target.CrashedReply)) (unsubscribe func(), err error)
 
 	// Event TargetInfoChanged
 	//
 	// Issued when some information about a target has changed. This only
 	// happens between `targetCreated` and `targetDestroyed`.
-	TargetInfoChanged(context.Context) (target.InfoChangedClient, error)
+	TargetInfoChanged(context.Context, ...rpcc.StreamOption) (target.InfoChangedClient, error)
+
+	// OnTargetInfoChanged registers a handler for TargetInfoChanged events.
+	OnTargetInfoChanged(fn func(*target.InfoChangedReply)) (unsubscribe func(), err error)
 }
 
 // The Tethering domain. The Tethering domain defines methods and events for
@@ -3654,7 +4063,10 @@ type Tethering interface {
 	//
 	// Informs that port was successfully bound and got a specified
 	// connection id.
-	Accepted(context.Context) (tethering.AcceptedClient, error)
+	Accepted(context.Context, ...rpcc.StreamOption) (tethering.AcceptedClient, error)
+
+	// OnAccepted registers a handler for Accepted events.
+	OnAccepted(fn func(*tethering.AcceptedReply)) (unsubscribe func(), err error)
 }
 
 // The Tracing domain.
@@ -3687,20 +4099,29 @@ type Tracing interface {
 	Start(context.Context, *tracing.StartArgs) error
 
 	// Event BufferUsage
-	BufferUsage(context.Context) (tracing.BufferUsageClient, error)
+	BufferUsage(context.Context, ...rpcc.StreamOption) (tracing.BufferUsageClient, error)
+
+	// OnBufferUsage registers a handler for BufferUsage events.
+	OnBufferUsage(fn func(*tracing.BufferUsageReply)) (unsubscribe func(), err error)
 
 	// Event DataCollected
 	//
 	// Contains an bucket of collected trace events. When tracing is
 	// stopped collected events will be send as a sequence of dataCollected
 	// events followed by tracingComplete event.
-	DataCollected(context.Context) (tracing.DataCollectedClient, error)
+	DataCollected(context.Context, ...rpcc.StreamOption) (tracing.DataCollectedClient, error)
+
+	// OnDataCollected registers a handler for DataCollected events.
+	OnDataCollected(fn func(*tracing.DataCollectedReply)) (unsubscribe func(), err error)
 
 	// Event TracingComplete
 	//
 	// Signals that tracing is stopped and there is no trace buffers
 	// pending flush, all data were delivered via dataCollected events.
-	TracingComplete(context.Context) (tracing.CompleteClient, error)
+	TracingComplete(context.Context, ...rpcc.StreamOption) (tracing.CompleteClient, error)
+
+	// OnTracingComplete registers a handler for TracingComplete events.
+	OnTracingComplete(fn func(*tracing.CompleteReply)) (unsubscribe func(), err error)
 }
 
 // The WebAudio domain. This domain allows inspection of Web Audio API.
@@ -3727,70 +4148,109 @@ type WebAudio interface {
 	// Event ContextCreated
 	//
 	// Notifies that a new BaseAudioContext has been created.
-	ContextCreated(context.Context) (webaudio.ContextCreatedClient, error)
+	ContextCreated(context.Context, ...rpcc.StreamOption) (webaudio.ContextCreatedClient, error)
+
+	// OnContextCreated registers a handler for ContextCreated events.
+	OnContextCreated(fn func(*webaudio.ContextCreatedReply)) (unsubscribe func(), err error)
 
 	// Event ContextWillBeDestroyed
 	//
 	// Notifies that an existing BaseAudioContext will be destroyed.
-	ContextWillBeDestroyed(context.Context) (webaudio.ContextWillBeDestroyedClient, error)
+	ContextWillBeDestroyed(context.Context, ...rpcc.StreamOption) (webaudio.ContextWillBeDestroyedClient, error)
+
+	// OnContextWillBeDestroyed registers a handler for ContextWillBeDestroyed events.
+	OnContextWillBeDestroyed(fn func(*webaudio.ContextWillBeDestroyedReply)) (unsubscribe func(), err error)
 
 	// Event ContextChanged
 	//
 	// Notifies that existing BaseAudioContext has changed some properties
 	// (id stays the same)..
-	ContextChanged(context.Context) (webaudio.ContextChangedClient, error)
+	ContextChanged(context.Context, ...rpcc.StreamOption) (webaudio.ContextChangedClient, error)
+
+	// OnContextChanged registers a handler for ContextChanged events.
+	OnContextChanged(fn func(*webaudio.ContextChangedReply)) (unsubscribe func(), err error)
 
 	// Event AudioListenerCreated
 	//
 	// Notifies that the construction of an AudioListener has finished.
-	AudioListenerCreated(context.Context) (webaudio.AudioListenerCreatedClient, error)
+	AudioListenerCreated(context.Context, ...rpcc.StreamOption) (webaudio.AudioListenerCreatedClient, error)
+
+	// OnAudioListenerCreated registers a handler for AudioListenerCreated events.
+	OnAudioListenerCreated(fn func(*webaudio.AudioListenerCreatedReply)) (unsubscribe func(), err error)
 
 	// Event AudioListenerWillBeDestroyed
 	//
 	// Notifies that a new AudioListener has been created.
-	AudioListenerWillBeDestroyed(context.Context) (webaudio.AudioListenerWillBeDestroyedClient, error)
+	AudioListenerWillBeDestroyed(context.Context, ...rpcc.StreamOption) (webaudio.AudioListenerWillBeDestroyedClient, error)
+
+	// OnAudioListenerWillBeDestroyed registers a handler for AudioListenerWillBeDestroyed events.
+	OnAudioListenerWillBeDestroyed(fn func(*webaudio.AudioListenerWillBeDestroyedReply)) (unsubscribe func(), err error)
 
 	// Event AudioNodeCreated
 	//
 	// Notifies that a new AudioNode has been created.
-	AudioNodeCreated(context.Context) (webaudio.AudioNodeCreatedClient, error)
+	AudioNodeCreated(context.Context, ...rpcc.StreamOption) (webaudio.AudioNodeCreatedClient, error)
+
+	// OnAudioNodeCreated registers a handler for AudioNodeCreated events.
+	OnAudioNodeCreated(fn func(*webaudio.AudioNodeCreatedReply)) (unsubscribe func(), err error)
 
 	// Event AudioNodeWillBeDestroyed
 	//
 	// Notifies that an existing AudioNode has been destroyed.
-	AudioNodeWillBeDestroyed(context.Context) (webaudio.AudioNodeWillBeDestroyedClient, error)
+	AudioNodeWillBeDestroyed(context.Context, ...rpcc.StreamOption) (webaudio.AudioNodeWillBeDestroyedClient, error)
+
+	// OnAudioNodeWillBeDestroyed registers a handler for AudioNodeWillBeDestroyed events.
+	OnAudioNodeWillBeDestroyed(fn func(*webaudio.AudioNodeWillBeDestroyedReply)) (unsubscribe func(), err error)
 
 	// Event AudioParamCreated
 	//
 	// Notifies that a new AudioParam has been created.
-	AudioParamCreated(context.Context) (webaudio.AudioParamCreatedClient, error)
+	AudioParamCreated(context.Context, ...rpcc.StreamOption) (webaudio.AudioParamCreatedClient, error)
+
+	// OnAudioParamCreated registers a handler for AudioParamCreated events.
+	OnAudioParamCreated(fn func(*webaudio.AudioParamCreatedReply)) (unsubscribe func(), err error)
 
 	// Event AudioParamWillBeDestroyed
 	//
 	// Notifies that an existing AudioParam has been destroyed.
-	AudioParamWillBeDestroyed(context.Context) (webaudio.AudioParamWillBeDestroyedClient, error)
+	AudioParamWillBeDestroyed(context.Context, ...rpcc.StreamOption) (webaudio.AudioParamWillBeDestroyedClient, error)
+
+	// OnAudioParamWillBeDestroyed registers a handler for AudioParamWillBeDestroyed events.
+	OnAudioParamWillBeDestroyed(fn func(*webaudio.AudioParamWillBeDestroyedReply)) (unsubscribe func(), err error)
 
 	// Event NodesConnected
 	//
 	// Notifies that two AudioNodes are connected.
-	NodesConnected(context.Context) (webaudio.NodesConnectedClient, error)
+	NodesConnected(context.Context, ...rpcc.StreamOption) (webaudio.NodesConnectedClient, error)
+
+	// OnNodesConnected registers a handler for NodesConnected events.
+	OnNodesConnected(fn func(*webaudio.NodesConnectedReply)) (unsubscribe func(), err error)
 
 	// Event NodesDisconnected
 	//
 	// Notifies that AudioNodes are disconnected. The destination can be
 	// null, and it means all the outgoing connections from the source are
 	// disconnected.
-	NodesDisconnected(context.Context) (webaudio.NodesDisconnectedClient, error)
+	NodesDisconnected(context.Context, ...rpcc.StreamOption) (webaudio.NodesDisconnectedClient, error)
+
+	// OnNodesDisconnected registers a handler for NodesDisconnected events.
+	OnNodesDisconnected(fn func(*webaudio.NodesDisconnectedReply)) (unsubscribe func(), err error)
 
 	// Event NodeParamConnected
 	//
 	// Notifies that an AudioNode is connected to an AudioParam.
-	NodeParamConnected(context.Context) (webaudio.NodeParamConnectedClient, error)
+	NodeParamConnected(context.Context, ...rpcc.StreamOption) (webaudio.NodeParamConnectedClient, error)
+
+	// OnNodeParamConnected registers a handler for NodeParamConnected events.
+	OnNodeParamConnected(fn func(*webaudio.NodeParamConnectedReply)) (unsubscribe func(), err error)
 
 	// Event NodeParamDisconnected
 	//
 	// Notifies that an AudioNode is disconnected to an AudioParam.
-	NodeParamDisconnected(context.Context) (webaudio.NodeParamDisconnectedClient, error)
+	NodeParamDisconnected(context.Context, ...rpcc.StreamOption) (webaudio.NodeParamDisconnectedClient, error)
+
+	// OnNodeParamDisconnected registers a handler for NodeParamDisconnected events.
+	OnNodeParamDisconnected(fn func(*webaudio.NodeParamDisconnectedReply)) (unsubscribe func(), err error)
 }
 
 // The WebAuthn domain. This domain allows configuring virtual authenticators
@@ -100,6 +100,8 @@ type Client struct {
 	Tracing              Tracing
 	WebAudio             WebAudio
 	WebAuthn             WebAuthn
+
+	conn *rpcc.Conn
 }
 
 // NewClient returns a new Client that uses conn
@@ -151,5 +153,11 @@ func NewClient(conn *rpcc.Conn) *Client {
 		Tracing:              tracing.NewClient(conn),
 		WebAudio:             webaudio.NewClient(conn),
 		WebAuthn:             webauthn.NewClient(conn),
+
+		conn: conn,
 	}
 }
+
+// Conn returns the connection used by the Client, it is nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn { return c.conn }
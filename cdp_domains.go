@@ -0,0 +1,62 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package cdp
+
+// ProtocolVersion is the version of the protocol definitions
+// that the Client was generated from.
+const ProtocolVersion = "1.3"
+
+// protocolDomain describes a domain that is compiled into the Client.
+type protocolDomain struct {
+	experimental bool
+	deprecated   bool
+}
+
+// protocolDomains contains all domains compiled into the Client.
+var protocolDomains = map[string]protocolDomain{
+	"Accessibility":        {experimental: true, deprecated: false},
+	"Animation":            {experimental: true, deprecated: false},
+	"ApplicationCache":     {experimental: true, deprecated: false},
+	"Audits":               {experimental: true, deprecated: false},
+	"BackgroundService":    {experimental: true, deprecated: false},
+	"Browser":              {experimental: false, deprecated: false},
+	"CSS":                  {experimental: true, deprecated: false},
+	"CacheStorage":         {experimental: true, deprecated: false},
+	"Cast":                 {experimental: true, deprecated: false},
+	"Console":              {experimental: false, deprecated: true},
+	"DOM":                  {experimental: false, deprecated: false},
+	"DOMDebugger":          {experimental: false, deprecated: false},
+	"DOMSnapshot":          {experimental: true, deprecated: false},
+	"DOMStorage":           {experimental: true, deprecated: false},
+	"Database":             {experimental: true, deprecated: false},
+	"Debugger":             {experimental: false, deprecated: false},
+	"DeviceOrientation":    {experimental: true, deprecated: false},
+	"Emulation":            {experimental: false, deprecated: false},
+	"Fetch":                {experimental: true, deprecated: false},
+	"HeadlessExperimental": {experimental: true, deprecated: false},
+	"HeapProfiler":         {experimental: true, deprecated: false},
+	"IO":                   {experimental: false, deprecated: false},
+	"IndexedDB":            {experimental: true, deprecated: false},
+	"Input":                {experimental: false, deprecated: false},
+	"Inspector":            {experimental: true, deprecated: false},
+	"LayerTree":            {experimental: true, deprecated: false},
+	"Log":                  {experimental: false, deprecated: false},
+	"Media":                {experimental: true, deprecated: false},
+	"Memory":               {experimental: true, deprecated: false},
+	"Network":              {experimental: false, deprecated: false},
+	"Overlay":              {experimental: true, deprecated: false},
+	"Page":                 {experimental: false, deprecated: false},
+	"Performance":          {experimental: false, deprecated: false},
+	"Profiler":             {experimental: false, deprecated: false},
+	"Runtime":              {experimental: false, deprecated: false},
+	"Schema":               {experimental: false, deprecated: true},
+	"Security":             {experimental: false, deprecated: false},
+	"ServiceWorker":        {experimental: true, deprecated: false},
+	"Storage":              {experimental: true, deprecated: false},
+	"SystemInfo":           {experimental: true, deprecated: false},
+	"Target":               {experimental: false, deprecated: false},
+	"Tethering":            {experimental: true, deprecated: false},
+	"Tracing":              {experimental: true, deprecated: false},
+	"WebAudio":             {experimental: true, deprecated: false},
+	"WebAuthn":             {experimental: true, deprecated: false},
+}
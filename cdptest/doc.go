@@ -0,0 +1,27 @@
+/*
+Package cdptest provides a fake CDP server for testing code that uses
+cdp.Client, without a browser.
+
+The Server accepts websocket connections and responds to requests via
+handlers registered per method. Events can be sent from handlers (before
+the response) or pushed at any time. All requests are recorded and can
+be inspected after the fact.
+
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	srv.Handle("Page.navigate", cdptest.Reply(&page.NavigateReply{FrameID: "frame1"}))
+
+	conn, err := rpcc.Dial(srv.WebSocketURL)
+	// ...
+	c := cdp.NewClient(conn)
+	reply, err := c.Page.Navigate(ctx, page.NewNavigateArgs("https://example.com"))
+	// ...
+	if !srv.Called("Page.navigate") {
+		t.Error("Page.navigate was not called")
+	}
+
+Sessions established by session.Manager are supported, both in flat
+session mode and via Target.sendMessageToTarget.
+*/
+package cdptest
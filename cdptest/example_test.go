@@ -0,0 +1,42 @@
+package cdptest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func Example() {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	srv.Handle("Browser.getVersion", cdptest.Reply(&browser.GetVersionReply{
+		ProtocolVersion: "1.3",
+		Product:         "Fake/1.0",
+	}))
+
+	ctx := context.Background()
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer conn.Close()
+
+	c := cdp.NewClient(conn)
+	v, err := c.Browser.GetVersion(ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v.Product)
+	fmt.Println(len(srv.CallsTo("Browser.getVersion")))
+
+	// Output:
+	// Fake/1.0
+	// 1
+}
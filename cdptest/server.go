@@ -0,0 +1,503 @@
+package cdptest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Error codes used by Server.
+const (
+	ErrorCodeServer         = rpcc.CodeServerError    // Handler returned an error.
+	ErrorCodeMethodNotFound = rpcc.CodeMethodNotFound // No handler for the method.
+	ErrorCodeInvalidParams  = rpcc.CodeInvalidParams  // Params could not be decoded.
+)
+
+// Request represents a request received by the Server.
+type Request struct {
+	ID        uint64          // Request ID, set by the client.
+	SessionID string          // Session ID, empty for the browser session.
+	Method    string          // Method, e.g. "Page.navigate".
+	Params    json.RawMessage // Raw params, may be empty.
+
+	emit   func(method string, params interface{}) error
+	attach func(info target.Info, waitingForDebugger bool) (string, error)
+}
+
+// Unmarshal decodes the params of the request onto v.
+func (r *Request) Unmarshal(v interface{}) error {
+	if len(r.Params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Params, v)
+}
+
+// Emit sends an event on the connection (and session) that the request
+// was received on. When called from a handler, the event is sent before
+// the response.
+func (r *Request) Emit(method string, params interface{}) error {
+	return r.emit(method, params)
+}
+
+// Attach attaches a new session (in flat session mode) to the target
+// described by info and sends Target.attachedToTarget on the connection
+// (and session) that the request was received on, like auto-attach
+// (Target.setAutoAttach) does. The session ID is returned.
+func (r *Request) Attach(info target.Info, waitingForDebugger bool) (sessionID string, err error) {
+	return r.attach(info, waitingForDebugger)
+}
+
+// HandlerFunc handles a request, the returned result is encoded as JSON
+// and sent as the response. A nil result is sent as an empty object.
+// When err is a *rpcc.ResponseError it is sent as is, other errors are
+// sent with the code ErrorCodeServer.
+type HandlerFunc func(req *Request) (result interface{}, err error)
+
+// Reply returns a HandlerFunc that always responds with result.
+func Reply(result interface{}) HandlerFunc {
+	return func(*Request) (interface{}, error) {
+		return result, nil
+	}
+}
+
+// Server is a fake CDP server for testing, it accepts websocket
+// connections on any path and dispatches requests to the handlers
+// registered via Handle.
+//
+// The Target domain commands attachToTarget, detachFromTarget and
+// sendMessageToTarget are handled by the Server to support sessions,
+// both in flat session mode and via sendMessageToTarget.
+type Server struct {
+	// URL is the base URL of the HTTP endpoints, it can be used with
+	// devtool.New.
+	URL string
+	// WebSocketURL is the websocket URL, it can be used with
+	// rpcc.Dial.
+	WebSocketURL string
+
+	srv      *httptest.Server
+	upgrader websocket.Upgrader
+
+	mu        sync.Mutex // Protects following.
+	handlers  map[string]HandlerFunc
+	calls     []Request
+	callC     chan struct{} // Closed and replaced when calls is appended to.
+	conns     map[*serverConn]struct{}
+	sessions  map[string]*serverSession
+	sessionID int
+}
+
+// NewServer starts and returns a new Server. The caller should call
+// Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		handlers: make(map[string]HandlerFunc),
+		callC:    make(chan struct{}),
+		conns:    make(map[*serverConn]struct{}),
+		sessions: make(map[string]*serverSession),
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	s.WebSocketURL = "ws" + strings.TrimPrefix(s.srv.URL, "http") + "/devtools/browser/cdptest"
+	return s
+}
+
+// Handle registers the handler for the method, replacing any existing
+// handler.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+// Calls returns all requests received by the Server, in order.
+// Requests sent via sendMessageToTarget are included both as
+// Target.sendMessageToTarget and as the unwrapped request.
+func (s *Server) Calls() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.calls...)
+}
+
+// CallsTo returns all requests received for method, in order.
+func (s *Server) CallsTo(method string) []Request {
+	var calls []Request
+	for _, r := range s.Calls() {
+		if r.Method == method {
+			calls = append(calls, r)
+		}
+	}
+	return calls
+}
+
+// Called reports whether method has been called at least once.
+func (s *Server) Called(method string) bool {
+	return len(s.CallsTo(method)) > 0
+}
+
+// WaitCall waits until method has been called n times and returns the
+// nth request. It is useful when the call is made asynchronously.
+func (s *Server) WaitCall(ctx context.Context, method string, n int) (Request, error) {
+	for {
+		s.mu.Lock()
+		callC := s.callC
+		s.mu.Unlock()
+
+		if calls := s.CallsTo(method); len(calls) >= n {
+			return calls[n-1], nil
+		}
+
+		select {
+		case <-callC:
+		case <-ctx.Done():
+			return Request{}, ctx.Err()
+		}
+	}
+}
+
+// Event sends an event to all connected clients (browser session).
+func (s *Server) Event(method string, params interface{}) error {
+	s.mu.Lock()
+	var conns []*serverConn
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	for _, c := range conns {
+		if err := c.event("", method, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SessionEvent sends an event to the target session, the event is
+// wrapped in Target.receivedMessageFromTarget unless the session was
+// attached in flat session mode.
+func (s *Server) SessionEvent(sessionID, method string, params interface{}) error {
+	s.mu.Lock()
+	ss, ok := s.sessions[sessionID]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("cdptest: unknown session %q", sessionID)
+	}
+	return ss.conn.event(sessionID, method, params)
+}
+
+// Sessions returns the IDs of all attached sessions.
+func (s *Server) Sessions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for id := range s.sessions {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// CloseClientConnections closes all client connections.
+func (s *Server) CloseClientConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.conns {
+		c.ws.Close()
+	}
+}
+
+// Close closes all client connections and shuts down the Server.
+func (s *Server) Close() {
+	s.CloseClientConnections()
+	s.srv.Close()
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/json/version":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"Browser":              "cdptest",
+			"Protocol-Version":     "1.3",
+			"webSocketDebuggerUrl": s.WebSocketURL,
+		})
+		return
+	case "/json", "/json/list":
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+		return
+	}
+
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &serverConn{srv: s, ws: ws}
+
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		for id, ss := range s.sessions {
+			if ss.conn == c {
+				delete(s.sessions, id)
+			}
+		}
+		s.mu.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		var req rpcc.Request
+		var params json.RawMessage
+		req.Args = &params
+		if err := ws.ReadJSON(&req); err != nil {
+			return
+		}
+		c.handle(&Request{
+			ID:        req.ID,
+			SessionID: req.SessionID,
+			Method:    req.Method,
+			Params:    params,
+		})
+	}
+}
+
+func (s *Server) addCall(req *Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, *req)
+	close(s.callC)
+	s.callC = make(chan struct{})
+}
+
+// serverSession represents a session attached via
+// Target.attachToTarget.
+type serverSession struct {
+	conn     *serverConn
+	targetID target.ID
+	flat     bool
+}
+
+// serverConn represents a client connection.
+type serverConn struct {
+	srv *Server
+	ws  *websocket.Conn
+	mu  sync.Mutex // Protects writes to ws.
+}
+
+type message struct {
+	ID        uint64              `json:"id,omitempty"`
+	SessionID string              `json:"sessionId,omitempty"`
+	Method    string              `json:"method,omitempty"`
+	Params    interface{}         `json:"params,omitempty"`
+	Result    interface{}         `json:"result,omitempty"`
+	Error     *rpcc.ResponseError `json:"error,omitempty"`
+}
+
+// send writes the message to the connection, or session.
+func (c *serverConn) send(sessionID string, m *message) error {
+	if sessionID != "" {
+		c.srv.mu.Lock()
+		ss, ok := c.srv.sessions[sessionID]
+		c.srv.mu.Unlock()
+		if !ok {
+			return fmt.Errorf("cdptest: unknown session %q", sessionID)
+		}
+		if ss.flat {
+			m.SessionID = sessionID
+		} else {
+			data, err := json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			m = &message{
+				Method: "Target.receivedMessageFromTarget",
+				Params: &target.ReceivedMessageFromTargetReply{
+					SessionID: target.SessionID(sessionID),
+					Message:   string(data),
+					TargetID:  &ss.targetID,
+				},
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ws.WriteJSON(m)
+}
+
+func (c *serverConn) event(sessionID, method string, params interface{}) error {
+	if params == nil {
+		params = struct{}{} // Like CDP, always send params.
+	}
+	return c.send(sessionID, &message{Method: method, Params: params})
+}
+
+func (c *serverConn) handle(req *Request) {
+	req.emit = func(method string, params interface{}) error {
+		return c.event(req.SessionID, method, params)
+	}
+	req.attach = func(info target.Info, waitingForDebugger bool) (string, error) {
+		id := c.newSession(info.TargetID, true)
+		return id, c.event(req.SessionID, "Target.attachedToTarget", &target.AttachedToTargetReply{
+			SessionID:          target.SessionID(id),
+			TargetInfo:         info,
+			WaitingForDebugger: waitingForDebugger,
+		})
+	}
+	c.srv.addCall(req)
+
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "Target.attachToTarget":
+		result, err = c.attachToTarget(req)
+	case "Target.detachFromTarget":
+		result, err = c.detachFromTarget(req)
+	case "Target.sendMessageToTarget":
+		result, err = c.sendMessageToTarget(req)
+	default:
+		c.srv.mu.Lock()
+		h, ok := c.srv.handlers[req.Method]
+		c.srv.mu.Unlock()
+		if !ok {
+			err = &rpcc.ResponseError{
+				Code:    ErrorCodeMethodNotFound,
+				Message: "'" + req.Method + "' wasn't found",
+			}
+			break
+		}
+		result, err = h(req)
+	}
+
+	resp := &message{ID: req.ID, Result: result}
+	if err != nil {
+		resp.Result = nil
+		if rerr, ok := err.(*rpcc.ResponseError); ok {
+			resp.Error = rerr
+		} else {
+			resp.Error = &rpcc.ResponseError{
+				Code:    ErrorCodeServer,
+				Message: err.Error(),
+			}
+		}
+	} else if resp.Result == nil {
+		resp.Result = struct{}{}
+	}
+	// The client may already be gone, there is nobody to report the
+	// error to.
+	_ = c.send(req.SessionID, resp)
+}
+
+func invalidParams(err error) error {
+	return &rpcc.ResponseError{
+		Code:    ErrorCodeInvalidParams,
+		Message: "Invalid parameters",
+		Data:    err.Error(),
+	}
+}
+
+func (c *serverConn) attachToTarget(req *Request) (interface{}, error) {
+	var args target.AttachToTargetArgs
+	if err := req.Unmarshal(&args); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	id := c.newSession(args.TargetID, args.Flatten != nil && *args.Flatten)
+	return &target.AttachToTargetReply{SessionID: target.SessionID(id)}, nil
+}
+
+// newSession registers a new session to the target on the connection.
+func (c *serverConn) newSession(targetID target.ID, flat bool) string {
+	c.srv.mu.Lock()
+	defer c.srv.mu.Unlock()
+
+	c.srv.sessionID++
+	id := "session-" + strconv.Itoa(c.srv.sessionID)
+	c.srv.sessions[id] = &serverSession{
+		conn:     c,
+		targetID: targetID,
+		flat:     flat,
+	}
+	return id
+}
+
+func (c *serverConn) detachFromTarget(req *Request) (interface{}, error) {
+	var args target.DetachFromTargetArgs
+	if err := req.Unmarshal(&args); err != nil {
+		return nil, invalidParams(err)
+	}
+	if args.SessionID == nil {
+		return nil, invalidParams(fmt.Errorf("sessionId is required"))
+	}
+	id := string(*args.SessionID)
+
+	c.srv.mu.Lock()
+	ss, ok := c.srv.sessions[id]
+	delete(c.srv.sessions, id)
+	c.srv.mu.Unlock()
+
+	if !ok {
+		return nil, &rpcc.ResponseError{
+			Code:    ErrorCodeServer,
+			Message: "No session with given id",
+		}
+	}
+	err := c.event("", "Target.detachedFromTarget", &target.DetachedFromTargetReply{
+		SessionID: target.SessionID(id),
+		TargetID:  &ss.targetID,
+	})
+	return nil, err
+}
+
+func (c *serverConn) sendMessageToTarget(req *Request) (interface{}, error) {
+	var args target.SendMessageToTargetArgs
+	if err := req.Unmarshal(&args); err != nil {
+		return nil, invalidParams(err)
+	}
+	if args.SessionID == nil {
+		return nil, invalidParams(fmt.Errorf("sessionId is required"))
+	}
+
+	var msg rpcc.Request
+	var params json.RawMessage
+	msg.Args = &params
+	if err := json.Unmarshal([]byte(args.Message), &msg); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	c.srv.mu.Lock()
+	_, ok := c.srv.sessions[string(*args.SessionID)]
+	c.srv.mu.Unlock()
+	if !ok {
+		return nil, &rpcc.ResponseError{
+			Code:    ErrorCodeServer,
+			Message: "No session with given id",
+		}
+	}
+
+	// The response to the wrapped request is sent via
+	// receivedMessageFromTarget, before the response to
+	// sendMessageToTarget.
+	c.handle(&Request{
+		ID:        msg.ID,
+		SessionID: string(*args.SessionID),
+		Method:    msg.Method,
+		Params:    params,
+	})
+	return nil, nil
+}
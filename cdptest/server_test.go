@@ -0,0 +1,238 @@
+package cdptest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+func TestServer(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Page.navigate", func(req *cdptest.Request) (interface{}, error) {
+		var args page.NavigateArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		err := req.Emit("Page.frameNavigated", map[string]interface{}{
+			"frame": map[string]string{"id": "frame1", "url": args.URL},
+		})
+		return &page.NavigateReply{FrameID: "frame1"}, err
+	})
+	srv.Handle("Page.reload", func(req *cdptest.Request) (interface{}, error) {
+		return nil, errors.New("reload failed")
+	})
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := cdp.NewClient(conn)
+
+	nav, err := c.Page.FrameNavigated(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nav.Close()
+
+	reply, err := c.Page.Navigate(ctx, page.NewNavigateArgs("https://example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.FrameID != "frame1" {
+		t.Errorf("Navigate: got frame %q, want %q", reply.FrameID, "frame1")
+	}
+	ev, err := nav.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Frame.URL != "https://example.com" {
+		t.Errorf("FrameNavigated: got URL %q, want %q", ev.Frame.URL, "https://example.com")
+	}
+
+	err = c.Page.Reload(ctx, nil)
+	if rerr, ok := errors.Unwrap(err).(*rpcc.ResponseError); !ok || rerr.Code != cdptest.ErrorCodeServer {
+		t.Errorf("Reload: got %v, want ResponseError with code %d", err, cdptest.ErrorCodeServer)
+	}
+	err = c.Page.Enable(ctx)
+	if rerr, ok := errors.Unwrap(err).(*rpcc.ResponseError); !ok || rerr.Code != cdptest.ErrorCodeMethodNotFound {
+		t.Errorf("Enable: got %v, want ResponseError with code %d", err, cdptest.ErrorCodeMethodNotFound)
+	}
+
+	var methods []string
+	for _, r := range srv.Calls() {
+		methods = append(methods, r.Method)
+	}
+	want := []string{"Page.navigate", "Page.reload", "Page.enable"}
+	if len(methods) != len(want) {
+		t.Fatalf("Calls: got %v, want %v", methods, want)
+	}
+	for i := range want {
+		if methods[i] != want[i] {
+			t.Errorf("Calls: got %v, want %v", methods, want)
+		}
+	}
+	if !srv.Called("Page.enable") || srv.Called("Page.stopLoading") {
+		t.Error("Called: got wrong result")
+	}
+
+	// Events pushed by the server.
+	loaded, err := c.Page.LoadEventFired(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer loaded.Close()
+	if err = srv.Event("Page.loadEventFired", map[string]float64{"timestamp": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = loaded.Recv(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_WaitCall(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Runtime.enable", cdptest.Reply(nil))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := cdp.NewClient(conn)
+
+	go c.Runtime.Enable(ctx)
+
+	req, err := srv.WaitCall(ctx, "Runtime.enable", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != "Runtime.enable" {
+		t.Errorf("WaitCall: got %q, want %q", req.Method, "Runtime.enable")
+	}
+}
+
+func TestServer_DevTools(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	v, err := devtool.New(srv.URL).Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.WebSocketDebuggerURL != srv.WebSocketURL {
+		t.Errorf("Version: got %q, want %q", v.WebSocketDebuggerURL, srv.WebSocketURL)
+	}
+}
+
+func TestServer_SessionManager(t *testing.T) {
+	for _, flat := range []bool{false, true} {
+		flat := flat
+		name := "SendMessageToTarget"
+		if flat {
+			name = "Flat"
+		}
+		t.Run(name, func(t *testing.T) {
+			srv := cdptest.NewServer()
+			defer srv.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			srv.Handle("Runtime.evaluate", func(req *cdptest.Request) (interface{}, error) {
+				err := req.Emit("Runtime.executionContextsCleared", nil)
+				return &runtime.EvaluateReply{
+					Result: runtime.RemoteObject{Type: "string", Value: []byte(`"` + req.SessionID + `"`)},
+				}, err
+			})
+
+			conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			var m *session.Manager
+			if flat {
+				m, err = session.NewFlatManager(conn)
+			} else {
+				m, err = session.NewManager(cdp.NewClient(conn))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer m.Close()
+
+			sconn, err := m.Dial(ctx, target.ID("target1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer sconn.Close()
+			c := cdp.NewClient(sconn)
+
+			cleared, err := c.Runtime.ExecutionContextsCleared(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer cleared.Close()
+
+			reply, err := c.Runtime.Evaluate(ctx, runtime.NewEvaluateArgs("1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := srv.Sessions()
+			if len(ids) != 1 || string(reply.Result.Value) != `"`+ids[0]+`"` {
+				t.Errorf("Evaluate: got %s, want session %v", reply.Result.Value, ids)
+			}
+			if _, err = cleared.Recv(); err != nil {
+				t.Fatal(err)
+			}
+
+			calls := srv.CallsTo("Runtime.evaluate")
+			if len(calls) != 1 || calls[0].SessionID != ids[0] {
+				t.Errorf("CallsTo: got %v, want one call in session %s", calls, ids[0])
+			}
+
+			// Events pushed to the session.
+			err = srv.SessionEvent(ids[0], "Runtime.executionContextsCleared", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err = cleared.Recv(); err != nil {
+				t.Fatal(err)
+			}
+			if err = sconn.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if !srv.Called("Target.detachFromTarget") {
+				t.Error("Target.detachFromTarget was not called")
+			}
+			if ids = srv.Sessions(); len(ids) != 0 {
+				t.Errorf("Sessions: got %v, want none", ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mafredri/cdp/cmd/cdpgen/proto"
+)
+
+// fastJSON enables the generation of MarshalJSON for command arguments
+// and UnmarshalJSON for command and event replies (-fast-json). The
+// methods avoid reflection for the properties of a primitive kind and
+// use encoding/json for the rest.
+var fastJSON bool
+
+// fastjsonImport is the package used by the generated methods.
+const fastjsonImport = "github.com/mafredri/cdp/internal/fastjson"
+
+// kindMap maps the named protocol types (package.Name) to the kind of
+// their underlying type: string, int, float64, bool, time or raw.
+var kindMap = map[string]string{
+	"internal.PageFrameID":           "string",
+	"internal.BrowserContextID":      "string",
+	"internal.NetworkTimeSinceEpoch": "time",
+	"page.FrameID":                   "string",
+	"browser.ContextID":              "string",
+	"network.TimeSinceEpoch":         "time",
+}
+
+// registerKind records the kind of the type t in d, if it has one.
+func registerKind(d proto.Domain, t proto.AnyType) {
+	pkg := strings.ToLower(d.Domain)
+	var kind string
+	switch typ := t.GoType(pkg, d); typ {
+	case "string", "int", "float64", "bool":
+		kind = typ
+	case "enum":
+		kind = "string"
+	case "time.Time":
+		kind = "time" // Defined as float64, see domainTypeTime.
+	case "RawMessage", "json.RawMessage", "[]byte":
+		kind = "raw"
+	default:
+		return
+	}
+	kindMap[pkg+"."+t.Name(d)] = kind
+}
+
+// kindOf returns the kind of ptype (without pointer) and the type to
+// convert to, if any.
+func (g *Generator) kindOf(ptype string) (kind, conv string) {
+	switch ptype {
+	case "string", "int", "float64", "bool":
+		return ptype, ""
+	case "json.RawMessage":
+		return "raw", ""
+	}
+	key := ptype
+	if !strings.ContainsRune(key, '.') {
+		key = g.pkg + "." + key
+	}
+	if strings.HasPrefix(ptype, "[]") || strings.HasPrefix(ptype, "map[") {
+		return "raw", ""
+	}
+	return kindMap[key], ptype
+}
+
+// fastDecoder returns the fastjson function that decodes kind.
+func fastDecoder(kind string) (fn, typ string) {
+	switch kind {
+	case "string":
+		return "String", "string"
+	case "int":
+		return "Int", "int"
+	case "float64", "time":
+		return "Float", "float64"
+	case "bool":
+		return "Bool", "bool"
+	}
+	return "", ""
+}
+
+// fastUnmarshal generates UnmarshalJSON for the struct name.
+func (g *Generator) fastUnmarshal(d proto.Domain, name string, props []proto.AnyType) {
+	if !fastJSON || len(props) == 0 {
+		return
+	}
+
+	g.Printf(`
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *%s) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+`, name)
+	for _, prop := range props {
+		field := "r." + prop.ExportedName(d)
+		ptype := g.propType(d, name, prop, true)
+		ptr := strings.HasPrefix(ptype, "*")
+		kind, conv := g.kindOf(strings.TrimPrefix(ptype, "*"))
+		fn, typ := fastDecoder(kind)
+
+		g.Printf("\t\tcase %q:\n", prop.NameName)
+		switch {
+		case fn == "":
+			g.Printf("\t\t\terr = json.Unmarshal(value, &%s)\n", field)
+		case !ptr && conv == "":
+			g.Printf("\t\t\t%s, err = fastjson.%s(value)\n", field, fn)
+		default:
+			if ptr {
+				g.Printf("\t\t\tif fastjson.IsNull(value) {\n\t\t\t\t%s = nil\n\t\t\t\tbreak\n\t\t\t}\n", field)
+			}
+			g.Printf("\t\t\tvar v %s\n\t\t\tv, err = fastjson.%s(value)\n", typ, fn)
+			switch {
+			case ptr && conv == "":
+				g.Printf("\t\t\t%s = &v\n", field)
+			case ptr:
+				g.Printf("\t\t\tc := %s(v)\n\t\t\t%s = &c\n", conv, field)
+			default:
+				g.Printf("\t\t\t%s = %s(v)\n", field, conv)
+			}
+		}
+	}
+	g.Printf(`		}
+		return err
+	})
+}
+`)
+}
+
+// fastMarshal generates MarshalJSON for the struct name. Nothing is
+// generated if the omitempty behavior of a property cannot be
+// replicated.
+func (g *Generator) fastMarshal(d proto.Domain, name string, props []proto.AnyType) {
+	if !fastJSON || len(props) == 0 {
+		return
+	}
+
+	var body strings.Builder
+	for _, prop := range props {
+		field := "a." + prop.ExportedName(d)
+		if prop.Optional {
+			field = "a." + OptionalPropPrefix + prop.ExportedName(d)
+		}
+		ptype := g.propType(d, name, prop, true)
+		ptr := strings.HasPrefix(ptype, "*")
+		kind, conv := g.kindOf(strings.TrimPrefix(ptype, "*"))
+
+		indent := "\t"
+		value := field
+		if prop.Optional {
+			var cond string
+			switch {
+			case ptr:
+				cond = field + " != nil"
+				value = "*" + field
+			case kind == "string":
+				cond = field + ` != ""`
+			case kind == "time":
+				cond = field + " != 0"
+			case kind == "raw":
+				cond = "len(" + field + ") > 0"
+			case ptype == "interface{}":
+				cond = field + " != nil"
+			default:
+				return
+			}
+			fmt.Fprintf(&body, "\tif %s {\n", cond)
+			indent = "\t\t"
+		}
+
+		fmt.Fprintf(&body, "%sb = fastjson.AppendKey(b, %q)\n", indent, prop.NameName)
+		switch kind {
+		case "string":
+			if conv != "" {
+				value = fmt.Sprintf("string(%s)", valueOf(field, ptr))
+			}
+			fmt.Fprintf(&body, "%sb = fastjson.AppendString(b, %s)\n", indent, value)
+		case "int":
+			if conv != "" {
+				value = fmt.Sprintf("int(%s)", valueOf(field, ptr))
+			}
+			fmt.Fprintf(&body, "%sb = fastjson.AppendInt(b, %s)\n", indent, value)
+		case "bool":
+			if conv != "" {
+				value = fmt.Sprintf("bool(%s)", valueOf(field, ptr))
+			}
+			fmt.Fprintf(&body, "%sb = fastjson.AppendBool(b, %s)\n", indent, value)
+		case "float64":
+			if conv != "" {
+				value = fmt.Sprintf("float64(%s)", valueOf(field, ptr))
+			}
+			fmt.Fprintf(&body, "%sif b, err = fastjson.AppendFloat(b, %s); err != nil {\n%s\treturn nil, err\n%s}\n", indent, value, indent, indent)
+		default:
+			fmt.Fprintf(&body, "%sif b, err = fastjson.AppendValue(b, %s); err != nil {\n%s\treturn nil, err\n%s}\n", indent, field, indent, indent)
+		}
+		if prop.Optional {
+			body.WriteString("\t}\n")
+		}
+	}
+
+	g.Printf(`
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *%s) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+%s	b = append(b, '}')
+	return b, err
+}
+`, name, body.String())
+}
+
+// valueOf returns the expression for the value of field.
+func valueOf(field string, ptr bool) string {
+	if ptr {
+		return "*" + field
+	}
+	return field
+}
@@ -43,45 +43,256 @@ func mkdir(name string) error {
 	return err
 }
 
+// profile describes a set of protocol definitions that is generated
+// into its own package tree.
+type profile struct {
+	pkg    string   // Name of the client package.
+	name   string   // Name of the protocol, used in comments.
+	protos []string // Default protocol definitions.
+
+	// breakCycles breaks import cycles between domains by using raw
+	// JSON. The chrome profile handles them via the internal package.
+	breakCycles bool
+}
+
+var profiles = map[string]profile{
+	"chrome": {
+		pkg:  "cdp",
+		name: "Chrome DevTools Protocol",
+		// Set by the -browser-proto and -js-proto flags.
+	},
+	"node": {
+		pkg:    "node",
+		name:   "Node.js Inspector Protocol",
+		protos: []string{"./protodef/node.json", "./protodef/node_protocol.json"},
+	},
+	"edge": {
+		pkg:         "edge",
+		name:        "Microsoft Edge DevTools Protocol",
+		protos:      []string{"./protodef/edge.json"},
+		breakCycles: true,
+	},
+}
+
+func writeIfNotExist(name string, data []byte) error {
+	_, err := os.Stat(name)
+	if err == nil {
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+	log.Printf("Writing %s...", name)
+	return ioutil.WriteFile(name, data, 0644)
+}
+
+// walkTypes calls fn for every type, property, parameter and return
+// value in d, including nested ones.
+func walkTypes(d proto.Domain, fn func(t *proto.AnyType)) {
+	var walk func(types []proto.AnyType)
+	walk = func(types []proto.AnyType) {
+		for i := range types {
+			t := &types[i]
+			fn(t)
+			if t.Items != nil {
+				fn(t.Items)
+			}
+			walk(t.Properties)
+		}
+	}
+	walk(d.Types)
+	for _, c := range d.Commands {
+		walk(c.Parameters)
+		walk(c.Returns)
+	}
+	for _, e := range d.Events {
+		walk(e.Parameters)
+	}
+}
+
+// refDomain returns the domain referenced by t, if any.
+func refDomain(t *proto.AnyType) string {
+	if i := strings.IndexByte(t.Ref, '.'); i > 0 {
+		return t.Ref[:i]
+	}
+	return ""
+}
+
+// useRawJSON replaces the reference or inline object t with raw JSON.
+func useRawJSON(t *proto.AnyType) {
+	if t.Ref != "" {
+		desc := fmt.Sprintf("Type %s.", t.Ref)
+		if t.Description != "" {
+			desc = t.Description + " " + desc
+		}
+		t.Description = desc
+	}
+	t.Ref = ""
+	t.Type = "object"
+	t.Properties = nil
+}
+
+// fixUnsupportedTypes replaces references to types that are not part
+// of domains, and inline object types, with raw JSON. Older protocol
+// definitions (e.g. edge.json) reference types that they do not
+// define.
+func fixUnsupportedTypes(domains []proto.Domain) {
+	known := make(map[string]bool)
+	for _, d := range domains {
+		for _, t := range d.Types {
+			known[d.Domain+"."+t.IDName] = true
+		}
+	}
+	for _, d := range domains {
+		walkTypes(d, func(t *proto.AnyType) {
+			ref := t.Ref
+			if ref != "" && refDomain(t) == "" {
+				ref = d.Domain + "." + ref
+			}
+			if ref != "" && !known[ref] {
+				log.Printf("Missing type %s, using raw JSON", ref)
+				useRawJSON(t)
+			}
+			if t.IDName == "" && t.Type == "object" && len(t.Properties) > 0 {
+				log.Printf("Inline object %s, using raw JSON", t.NameName)
+				useRawJSON(t)
+			}
+		})
+	}
+}
+
+// breakImportCycles replaces references that would result in an import
+// cycle between the domain packages with raw JSON.
+func breakImportCycles(domains []proto.Domain) {
+	index := make(map[string]int)
+	for i, d := range domains {
+		index[d.Domain] = i
+	}
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(domains))
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		walkTypes(domains[i], func(t *proto.AnyType) {
+			j, ok := index[refDomain(t)]
+			if !ok || j == i {
+				return
+			}
+			switch state[j] {
+			case unvisited:
+				visit(j)
+			case visiting:
+				log.Printf("Import cycle for %s in %s, using raw JSON", t.Ref, domains[i].Domain)
+				useRawJSON(t)
+			}
+		})
+		state[i] = visited
+	}
+	for i := range domains {
+		if state[i] == unvisited {
+			visit(i)
+		}
+	}
+}
+
+// internalErrorSrc is written to the internal package of profiles that
+// do not provide their own.
+const internalErrorSrc = `package internal
+
+import (
+	"fmt"
+)
+
+// OpError represents an operational error.
+type OpError struct {
+	Domain string
+	Op     string
+	Err    error
+}
+
+func (e OpError) Error() string {
+	return fmt.Sprintf("cdp.%s: %s: %s", e.Domain, e.Op, e.Err.Error())
+}
+
+// Cause implements error causer.
+func (e *OpError) Cause() error {
+	return e.Err
+}
+
+// Unwrap implements Wrapper.
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+type causer interface{ Cause() error }
+type wrapper interface{ Unwrap() error }
+
+var (
+	_ error   = (*OpError)(nil)
+	_ causer  = (*OpError)(nil)
+	_ wrapper = (*OpError)(nil)
+)
+`
+
 func main() {
 	var (
 		destPkg          string
+		profileName      string
 		browserProtoJSON string
 		jsProtoFileJSON  string
 	)
 	flag.StringVar(&destPkg, "dest-pkg", "", "Destination for generated cdp package (inside $GOPATH)")
-	flag.StringVar(&browserProtoJSON, "browser-proto", "./protodef/browser_protocol.json", "Path to browser protocol")
-	flag.StringVar(&jsProtoFileJSON, "js-proto", "./protodef/js_protocol.json", "Path to JS protocol")
+	flag.StringVar(&profileName, "profile", "chrome", "Protocol profile to generate (chrome, node or edge)")
+	flag.StringVar(&browserProtoJSON, "browser-proto", "./protodef/browser_protocol.json", "Path to browser protocol (chrome profile)")
+	flag.StringVar(&jsProtoFileJSON, "js-proto", "./protodef/js_protocol.json", "Path to JS protocol (chrome profile)")
+	flag.BoolVar(&fastJSON, "fast-json", false, "Generate MarshalJSON and UnmarshalJSON methods that avoid reflection")
 	flag.Parse()
 
 	if destPkg == "" {
 		fmt.Fprintln(os.Stderr, "error: dest-pkg must be set")
 		os.Exit(1)
 	}
+	prof, ok := profiles[profileName]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error: unknown profile %q\n", profileName)
+		os.Exit(1)
+	}
+	if profileName == "chrome" {
+		prof.protos = []string{browserProtoJSON, jsProtoFileJSON}
+	}
 
-	var protocol, jsProtocol proto.Protocol
-	protocolData, err := ioutil.ReadFile(browserProtoJSON)
-	panicErr(err)
-
-	err = json.Unmarshal(protocolData, &protocol)
-	panicErr(err)
-
-	jsProtocolData, err := ioutil.ReadFile(jsProtoFileJSON)
-	panicErr(err)
+	var protocol proto.Protocol
+	for i, name := range prof.protos {
+		var p proto.Protocol
+		data, err := ioutil.ReadFile(name)
+		panicErr(err)
 
-	err = json.Unmarshal(jsProtocolData, &jsProtocol)
-	panicErr(err)
+		err = json.Unmarshal(data, &p)
+		panicErr(err)
 
-	protocol.Domains = append(protocol.Domains, jsProtocol.Domains...)
+		if i == 0 {
+			protocol.Version = p.Version
+		}
+		protocol.Domains = append(protocol.Domains, p.Domains...)
+	}
 	sort.Slice(protocol.Domains, func(i, j int) bool {
 		return protocol.Domains[i].Domain < protocol.Domains[j].Domain
 	})
+	fixUnsupportedTypes(protocol.Domains)
+	if prof.breakCycles {
+		breakImportCycles(protocol.Domains)
+	}
 
 	protoDest := path.Join(destPkg, "protocol")
 	imports := []string{
 		"github.com/mafredri/cdp/rpcc",
-		"github.com/mafredri/cdp/protocol/internal",
-		"github.com/mafredri/cdp/protocol",
+		fastjsonImport,
+		path.Join(protoDest, "internal"),
+		protoDest,
 	}
 	for i, d := range protocol.Domains {
 		dLower := strings.ToLower(d.Name())
@@ -89,6 +300,7 @@ func main() {
 
 		for ii, t := range d.Types {
 			nam := t.Name(d)
+			registerKind(d, t)
 			if isNonPointer(d.Domain, d, t) {
 				nonPtrMap[nam] = true
 				nonPtrMap[d.Domain+"."+nam] = true
@@ -121,12 +333,18 @@ func main() {
 	g.imports = imports
 
 	// Define the cdp Client.
-	cdp.pkg = "cdp"
+	cdp.pkg = prof.pkg
 	cdp.dir = destPkg
+	err := mkdir(cdp.path())
+	panicErr(err)
 	cdp.PackageHeader("")
-	cdp.CdpClient(protocol.Domains)
+	cdp.CdpClient(prof.name, protocol.Domains)
 	cdp.writeFile("cdp_client.go")
 
+	cdp.PackageHeader("")
+	cdp.CdpDomains(protocol.Version, protocol.Domains)
+	cdp.writeFile("cdp_domains.go")
+
 	// Package cdp/protocol.
 	g.pkg = "protocol"
 	g.dir = protoDest
@@ -136,6 +354,10 @@ func main() {
 	// Package cdp/protocol/internal.
 	g.pkg = "internal"
 	g.dir = path.Join(protoDest, "internal")
+	err = mkdir(g.path())
+	panicErr(err)
+	err = writeIfNotExist(path.Join(g.path(), "error.go"), []byte(internalErrorSrc))
+	panicErr(err)
 	for _, d := range protocol.Domains {
 		// Write circular types to internal package.
 		for _, it := range []struct {
@@ -310,7 +532,7 @@ func (g *Generator) format() []byte {
 }
 
 // CdpClient creates the cdp.Client type.
-func (g *Generator) CdpClient(domains []proto.Domain) {
+func (g *Generator) CdpClient(protoName string, domains []proto.Domain) {
 	g.hasContent = true
 	var fields, newFields Generator
 	for _, d := range domains {
@@ -318,21 +540,54 @@ func (g *Generator) CdpClient(domains []proto.Domain) {
 		newFields.Printf("\t\t%s: %s.NewClient(conn),\n", d.Name(), strings.ToLower(d.Name()))
 	}
 	g.Printf(`
-// Client represents a Chrome DevTools Protocol client that can be used to
+// Client represents a %[3]s client that can be used to
 // invoke methods or listen to events in every CDP domain. The Client consumes
 // a rpcc connection, used to invoke the methods.
 type Client struct {
-	%s
+	%[1]s
+
+	conn *rpcc.Conn
 }
 
 // NewClient returns a new Client that uses conn
 // for communication with the debugging target.
 func NewClient(conn *rpcc.Conn) *Client {
 	return &Client{
-		%s
+		%[2]s
+		conn: conn,
 	}
 }
-`, fields.buf.Bytes(), newFields.buf.Bytes())
+
+// Conn returns the connection used by the Client, it is nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn { return c.conn }
+`, fields.buf.Bytes(), newFields.buf.Bytes(), protoName)
+}
+
+// CdpDomains creates the table of domains that are compiled into the
+// Client.
+func (g *Generator) CdpDomains(version proto.Version, domains []proto.Domain) {
+	g.hasContent = true
+	var entries Generator
+	for _, d := range domains {
+		entries.Printf("\t%q: {experimental: %t, deprecated: %t},\n", d.Domain, d.Experimental, d.Deprecated)
+	}
+	g.Printf(`
+// ProtocolVersion is the version of the protocol definitions
+// that the Client was generated from.
+const ProtocolVersion = "%[1]s.%[2]s"
+
+// protocolDomain describes a domain that is compiled into the Client.
+type protocolDomain struct {
+	experimental bool
+	deprecated   bool
+}
+
+// protocolDomains contains all domains compiled into the Client.
+var protocolDomains = map[string]protocolDomain{
+	%[3]s
+}
+`, version.Major, version.Minor, entries.buf.Bytes())
 }
 
 // PackageHeader writes the header for a package.
@@ -413,7 +668,8 @@ type %[3]s interface{`, comment, desc, d.Name())
 		if e.Experimental {
 			desc += "\n//\n// Note: This event is experimental."
 		}
-		g.Printf("\n\t// Event %s%s\n\t%s(context.Context) (%s.%s, error)\n", e.Name(), desc, e.Name(), strings.ToLower(d.Name()), eventClient)
+		g.Printf("\n\t// Event %s%s\n\t%s(context.Context, ...rpcc.StreamOption) (%s.%s, error)\n", e.Name(), desc, e.Name(), strings.ToLower(d.Name()), eventClient)
+		g.Printf("\n\t// On%[1]s registers a handler for %[1]s events.\n\tOn%[1]s(fn func(*%[2]s.%[3]s)) (unsubscribe func(), err error)\n", e.Name(), strings.ToLower(d.Name()), e.ReplyName(d))
 	}
 	g.Printf("}\n")
 }
@@ -534,8 +790,8 @@ func Test%[1]s_%[2]s(t *testing.T) {
 
 		// Implement event on domain.
 		g.Printf(`
-func (d *domainClient) %s(ctx context.Context) (%s, error) {
-	s, err := rpcc.NewStream(ctx, %q, d.conn)
+func (d *domainClient) %s(ctx context.Context, opts ...rpcc.StreamOption) (%s, error) {
+	s, err := rpcc.NewStream(ctx, %q, d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -543,6 +799,23 @@ func (d *domainClient) %s(ctx context.Context) (%s, error) {
 }
 `, e.Name(), eventClient, d.Domain+"."+e.NameName, eventClientImpl)
 
+		g.Printf(`
+// On%[1]s registers fn to be called for each
+// %[1]s event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) On%[1]s(fn func(*%[2]s)) (unsubscribe func(), err error) {
+	return rpcc.Handle(%[3]q, d.conn, func(args []byte) error {
+		ev := new(%[2]s)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+`, e.Name(), e.ReplyName(d), d.Domain+"."+e.NameName)
+
 		g.Printf(`
 type %[4]s struct { rpcc.Stream }
 
@@ -633,22 +906,11 @@ func (g *Generator) DomainType(d proto.Domain, t proto.AnyType) {
 func (g *Generator) printStructProperties(d proto.Domain, name string, props []proto.AnyType, ptrOptional, renameOptional bool) {
 	for _, prop := range props {
 		jsontag := prop.NameName
-		ptype := prop.GoType(g.pkg, d)
-
-		// Make all optional properties into pointers, unless they are slices.
+		ptype := g.propType(d, name, prop, ptrOptional)
 		if prop.Optional {
-			isNonPtr := nonPtrMap[ptype]
-			if ptrOptional && !isNonPtr && !isNonPointer(g.pkg, d, prop) {
-				ptype = "*" + ptype
-			}
 			jsontag += ",omitempty"
 		}
 
-		// Avoid recursive type definitions.
-		if ptype == name {
-			ptype = "*" + ptype
-		}
-
 		exportedName := prop.ExportedName(d)
 		if renameOptional && prop.Optional {
 			exportedName = OptionalPropPrefix + exportedName
@@ -689,6 +951,25 @@ func (g *Generator) printStructProperties(d proto.Domain, name string, props []p
 	}
 }
 
+// propType returns the Go type of the property prop in the struct name.
+func (g *Generator) propType(d proto.Domain, name string, prop proto.AnyType, ptrOptional bool) string {
+	ptype := prop.GoType(g.pkg, d)
+
+	// Make all optional properties into pointers, unless they are slices.
+	if prop.Optional {
+		isNonPtr := nonPtrMap[ptype]
+		if ptrOptional && !isNonPtr && !isNonPointer(g.pkg, d, prop) {
+			ptype = "*" + ptype
+		}
+	}
+
+	// Avoid recursive type definitions.
+	if ptype == name {
+		ptype = "*" + ptype
+	}
+	return ptype
+}
+
 func enforceSingleLine(s string) string {
 	return strings.Replace(s, "\n//", "", -1)
 }
@@ -1011,6 +1292,7 @@ func New%[1]s(%[2]s) *%[1]s {
 `
 	sig := c.ArgsSignature(g.pkg, d)
 	g.Printf(newfmt, c.ArgsName(d), sig, c.ArgsAssign("args", d), c.ArgsName(d))
+	g.fastMarshal(d, c.ArgsName(d), c.Parameters)
 
 	// Test the new arguments.
 	testInit := ""
@@ -1078,6 +1360,7 @@ type %[1]s struct {
 `, c.ReplyName(d), c.Name(), d.Name())
 	g.printStructProperties(d, c.ReplyName(d), c.Returns, true, false)
 	g.Printf("}\n\n")
+	g.fastUnmarshal(d, c.ReplyName(d), c.Returns)
 }
 
 // EventType generates the type for CDP event names.
@@ -1130,6 +1413,7 @@ type %[1]s struct {
 `, e.ReplyName(d), e.Name())
 	g.printStructProperties(d, e.ReplyName(d), e.Parameters, true, false)
 	g.Printf("}\n")
+	g.fastUnmarshal(d, e.ReplyName(d), e.Parameters)
 }
 
 func quotedImports(imports []string) string {
@@ -0,0 +1,98 @@
+// The cdpproxy command is a passthrough proxy for the Chrome DevTools
+// Protocol. It multiplexes DevTools clients onto one browser connection.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/rpcc/replay"
+)
+
+func main() {
+	var (
+		listen  string
+		remote  string
+		logging bool
+		record  string
+	)
+	flag.StringVar(&listen, "listen", "localhost:9223", "Address to listen on")
+	flag.StringVar(&remote, "remote", "http://localhost:9222", "DevTools HTTP endpoint of the browser")
+	flag.BoolVar(&logging, "log", false, "Log all traffic")
+	flag.StringVar(&record, "record", "", "Record the browser traffic to file (JSONL, see rpcc/replay)")
+	flag.Parse()
+
+	if err := run(listen, remote, logging, record); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(listen, remote string, logging bool, record string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dialCancel()
+
+	v, err := devtool.New(remote).Version(dialCtx)
+	if err != nil {
+		return err
+	}
+	if v.WebSocketDebuggerURL == "" {
+		return fmt.Errorf("browser (%s) does not support the browser endpoint", v.Browser)
+	}
+
+	opts := []proxyOption{withRemote(remote)}
+	if logging {
+		opts = append(opts, withLog(log.Printf))
+	}
+	if record != "" {
+		f, err := os.Create(record)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		opts = append(opts, withRecorder(replay.NewRecorder(f)))
+	}
+
+	p, err := newProxy(dialCtx, v.WebSocketDebuggerURL, opts...)
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	srv := &http.Server{Addr: listen, Handler: p}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-p.conn.Context().Done():
+		}
+		srv.Close()
+	}()
+
+	log.Printf("Proxying %s (%s) on http://%s", remote, v.Browser, listen)
+	err = srv.ListenAndServe()
+	if err == http.ErrServerClosed {
+		if ctx.Err() != nil {
+			return nil // Interrupted.
+		}
+		return errors.New("browser connection closed")
+	}
+	return err
+}
@@ -0,0 +1,721 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/rpcc/replay"
+)
+
+// Client requests use the request IDs in [proxyIDBase, proxyIDMax],
+// the IDs below it are used by rpcc (e.g. for Target.attachToTarget).
+// The browser only accepts IDs that fit in an int32.
+const (
+	proxyIDBase = 1 << 30
+	proxyIDMax  = 1<<31 - 1
+)
+
+// clientBacklog is the number of messages that can be queued for a
+// client, a client that falls behind is disconnected.
+const clientBacklog = 4096
+
+// message represents a request, response or event.
+type message struct {
+	ID        uint64              `json:"id,omitempty"`
+	Method    string              `json:"method,omitempty"`
+	Params    json.RawMessage     `json:"params,omitempty"`
+	Result    json.RawMessage     `json:"result,omitempty"`
+	Error     *rpcc.ResponseError `json:"error,omitempty"`
+	SessionID string              `json:"sessionId,omitempty"`
+}
+
+// pendingCall is a client request awaiting a response from the
+// browser.
+type pendingCall struct {
+	client   *client
+	id       uint64 // Request ID chosen by the client.
+	method   string
+	targetID string // Target of Target.attachToTarget.
+}
+
+// errSessionNotFound is returned for messages to sessions that are not
+// owned by the client.
+var errSessionNotFound = &rpcc.ResponseError{
+	Code:    rpcc.CodeServerError,
+	Message: "Session with given id not found.",
+}
+
+// proxy multiplexes the clients onto one browser connection.
+type proxy struct {
+	conn      *rpcc.Conn
+	codec     *proxyCodec
+	remote    *devtool.DevTools // Used for the /json facade.
+	remoteURL string
+	logf      func(format string, v ...interface{})
+
+	mu       sync.Mutex // Protects following.
+	seq      uint64
+	pending  map[uint64]pendingCall
+	clients  map[*client]struct{}
+	sessions map[string]*client // Session owners.
+	// Targets being attached by Target.attachToTarget, the client is
+	// nil for page clients.
+	attaching map[string]*client
+	nextID    int
+}
+
+// proxyOption represents a function that sets a proxy option.
+type proxyOption func(*proxy, *[]rpcc.DialOption)
+
+// withLog logs all traffic via logf.
+func withLog(logf func(format string, v ...interface{})) proxyOption {
+	return func(p *proxy, _ *[]rpcc.DialOption) {
+		p.logf = logf
+	}
+}
+
+// withRecorder records all traffic between the proxy and the browser,
+// the recording can be replayed with replay.Dial.
+func withRecorder(rec *replay.Recorder) proxyOption {
+	return func(p *proxy, _ *[]rpcc.DialOption) {
+		p.codec.newCodec = rec.Codec(p.codec.newCodec)
+	}
+}
+
+// withRemote enables the /json facade for the DevTools HTTP endpoint
+// at remoteURL, other requests (e.g. the DevTools frontend) are
+// forwarded to it.
+func withRemote(remoteURL string) proxyOption {
+	return func(p *proxy, _ *[]rpcc.DialOption) {
+		p.remote = devtool.New(remoteURL)
+		p.remoteURL = remoteURL
+	}
+}
+
+// withDialOptions sets the options used to dial the browser.
+func withDialOptions(opts ...rpcc.DialOption) proxyOption {
+	return func(_ *proxy, o *[]rpcc.DialOption) {
+		*o = append(*o, opts...)
+	}
+}
+
+// newProxy connects to the browser at wsURL.
+func newProxy(ctx context.Context, wsURL string, opts ...proxyOption) (*proxy, error) {
+	p := &proxy{
+		logf:      func(string, ...interface{}) {},
+		pending:   make(map[uint64]pendingCall),
+		clients:   make(map[*client]struct{}),
+		sessions:  make(map[string]*client),
+		attaching: make(map[string]*client),
+	}
+	p.codec = &proxyCodec{p: p, newCodec: rpcc.NewFastCodec}
+
+	var dialOpts []rpcc.DialOption
+	for _, o := range opts {
+		o(p, &dialOpts)
+	}
+	dialOpts = append(dialOpts, rpcc.WithCodec(p.codec.wrap))
+
+	conn, err := rpcc.DialContext(ctx, wsURL, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	p.conn = conn
+
+	go func() {
+		// Disconnect the clients when the browser is gone.
+		<-conn.Context().Done()
+		p.Close()
+	}()
+	return p, nil
+}
+
+// Close closes the browser connection and all clients.
+func (p *proxy) Close() error {
+	p.mu.Lock()
+	clients := p.clients
+	p.clients = make(map[*client]struct{})
+	p.mu.Unlock()
+	for c := range clients {
+		c.close()
+	}
+	return p.conn.Close()
+}
+
+// ServeHTTP serves the websocket endpoints (/devtools/browser/ and
+// /devtools/page/) and the /json facade. Other requests are forwarded
+// to the remote, e.g. the DevTools frontend.
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/devtools/browser"):
+		p.serveClient(w, r, "")
+	case strings.HasPrefix(r.URL.Path, "/devtools/page/"):
+		p.serveClient(w, r, path.Base(r.URL.Path))
+	case p.remote == nil:
+		http.NotFound(w, r)
+	case r.URL.Path == "/json/version":
+		p.serveVersion(w, r)
+	case r.URL.Path == "/json" || r.URL.Path == "/json/list":
+		p.serveList(w, r)
+	case r.URL.Path == "/json/new":
+		p.serveNew(w, r)
+	default:
+		p.forward(w, r)
+	}
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// serveClient serves a client connection. Page clients (targetID) are
+// attached to the target via a flat session on the browser connection.
+func (p *proxy) serveClient(w http.ResponseWriter, r *http.Request, targetID string) {
+	var sessionID string
+	if targetID != "" {
+		// Target.attachedToTarget is not sent to other clients.
+		p.mu.Lock()
+		p.attaching[targetID] = nil
+		p.mu.Unlock()
+
+		args := target.NewAttachToTargetArgs(target.ID(targetID)).SetFlatten(true)
+		reply, err := target.NewClient(p.conn).AttachToTarget(r.Context(), args)
+
+		p.mu.Lock()
+		delete(p.attaching, targetID)
+		p.mu.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		sessionID = string(reply.SessionID)
+	}
+
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		p.detach(sessionID)
+		return
+	}
+
+	c := &client{
+		ws:      ws,
+		session: sessionID,
+		send:    make(chan []byte, clientBacklog),
+		done:    make(chan struct{}),
+	}
+	p.mu.Lock()
+	p.nextID++
+	c.id = p.nextID
+	p.clients[c] = struct{}{}
+	if sessionID != "" {
+		p.sessions[sessionID] = c
+	}
+	p.mu.Unlock()
+
+	go c.writeLoop()
+	p.readLoop(c)
+	p.removeClient(c)
+}
+
+// readLoop forwards the requests from c to the browser.
+func (p *proxy) readLoop(c *client) {
+	defer c.close()
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		p.logf("client %d -> browser: %s", c.id, data)
+
+		var m message
+		if err = json.Unmarshal(data, &m); err != nil {
+			c.write(&message{Error: &rpcc.ResponseError{Code: rpcc.CodeParseError, Message: "Message must be a valid JSON"}})
+			continue
+		}
+
+		if err = p.forwardRequest(c, &m); err != nil {
+			rerr, ok := err.(*rpcc.ResponseError)
+			if !ok {
+				rerr = &rpcc.ResponseError{Code: rpcc.CodeServerError, Message: err.Error()}
+			}
+			c.write(&message{ID: m.ID, Error: rerr, SessionID: c.clientSession(m.SessionID)})
+		}
+	}
+}
+
+func (p *proxy) forwardRequest(c *client, m *message) error {
+	sessionID := m.SessionID
+	if sessionID == "" {
+		sessionID = c.session
+	}
+
+	call := pendingCall{client: c, id: m.ID, method: m.Method}
+
+	p.mu.Lock()
+	if err := p.authorize(c, sessionID, m, &call); err != nil {
+		p.mu.Unlock()
+		return err
+	}
+	id := p.nextRequestID()
+	p.pending[id] = call
+	p.mu.Unlock()
+
+	req := &rpcc.Request{ID: id, Method: m.Method, SessionID: sessionID}
+	if len(m.Params) > 0 {
+		req.Args = m.Params
+	}
+	err := p.codec.writeRequest(req)
+	if err != nil {
+		p.mu.Lock()
+		delete(p.pending, id)
+		p.mu.Unlock()
+	}
+	return err
+}
+
+// nextRequestID returns an unused request ID for a client request, the
+// IDs wrap around before they overflow. The caller must hold p.mu.
+func (p *proxy) nextRequestID() uint64 {
+	for {
+		id := proxyIDBase + p.seq%(proxyIDMax-proxyIDBase+1)
+		p.seq++
+		if _, ok := p.pending[id]; !ok {
+			return id
+		}
+	}
+}
+
+// authorize checks that c owns the session that m is sent to, and the
+// session that m refers to (e.g. Target.detachFromTarget). The Target
+// domain settings of c are recorded. The caller must hold p.mu.
+func (p *proxy) authorize(c *client, sessionID string, m *message, call *pendingCall) error {
+	if sessionID != "" && p.sessions[sessionID] != c {
+		return errSessionNotFound
+	}
+
+	switch m.Method {
+	case "Target.sendMessageToTarget", "Target.detachFromTarget":
+		var args struct {
+			SessionID string `json:"sessionId"`
+		}
+		if err := json.Unmarshal(m.Params, &args); err != nil || p.sessions[args.SessionID] != c {
+			return errSessionNotFound
+		}
+	case "Target.attachToTarget":
+		var args target.AttachToTargetArgs
+		if json.Unmarshal(m.Params, &args) == nil {
+			call.targetID = string(args.TargetID)
+			p.attaching[call.targetID] = c
+		}
+	case "Target.setDiscoverTargets":
+		var args target.SetDiscoverTargetsArgs
+		if sessionID == "" && json.Unmarshal(m.Params, &args) == nil {
+			c.discover = args.Discover
+		}
+	case "Target.setAutoAttach":
+		var args target.SetAutoAttachArgs
+		if sessionID == "" && json.Unmarshal(m.Params, &args) == nil {
+			c.autoAttach = args.AutoAttach
+		}
+	}
+	return nil
+}
+
+// route delivers a response or event received from the browser to the
+// client(s). It returns false if the response belongs to rpcc.
+func (p *proxy) route(r *rpcc.Response) bool {
+	if r.Method == "" {
+		if r.ID < proxyIDBase || r.ID > proxyIDMax {
+			return false
+		}
+
+		p.mu.Lock()
+		call, ok := p.pending[r.ID]
+		delete(p.pending, r.ID)
+		if ok && call.method == "Target.attachToTarget" {
+			if p.attaching[call.targetID] == call.client {
+				delete(p.attaching, call.targetID)
+			}
+			var reply target.AttachToTargetReply
+			if r.Error == nil && json.Unmarshal(r.Result, &reply) == nil && reply.SessionID != "" {
+				p.sessions[string(reply.SessionID)] = call.client
+			}
+		}
+		p.mu.Unlock()
+		if !ok {
+			return true // The client is gone.
+		}
+
+		result := r.Result
+		if r.Error != nil {
+			result = nil
+		}
+		call.client.write(&message{
+			ID:        call.id,
+			Result:    result,
+			Error:     r.Error,
+			SessionID: call.client.clientSession(r.SessionID),
+		})
+		return true
+	}
+
+	m := &message{Method: r.Method, Params: r.Args, SessionID: r.SessionID}
+
+	p.mu.Lock()
+	var clients []*client
+	if r.SessionID != "" {
+		// Session events are only sent to the owner, events for
+		// sessions without an owner are dropped.
+		if owner := p.sessions[r.SessionID]; owner != nil {
+			clients = append(clients, owner)
+			if r.Method == "Target.attachedToTarget" {
+				// Sessions attached to a session belong to the
+				// same client.
+				var ev target.AttachedToTargetReply
+				if json.Unmarshal(r.Args, &ev) == nil {
+					p.sessions[string(ev.SessionID)] = owner
+				}
+			}
+		}
+	} else {
+		clients = p.browserEventClients(r)
+	}
+	if r.Method == "Target.detachedFromTarget" {
+		var ev target.DetachedFromTargetReply
+		if json.Unmarshal(r.Args, &ev) == nil {
+			sessionID := string(ev.SessionID)
+			if c := p.sessions[sessionID]; c != nil && c.session == sessionID {
+				// The target of a page client is gone.
+				defer c.close()
+			}
+			delete(p.sessions, sessionID)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, c := range clients {
+		cm := *m
+		cm.SessionID = c.clientSession(m.SessionID)
+		c.write(&cm)
+	}
+	return true
+}
+
+// browserEventClients returns the clients that receive the browser
+// event r. Events for a session are only sent to the owner and target
+// discovery events to the clients that enabled discovery, other clients
+// do not learn about the targets. The caller must hold p.mu.
+func (p *proxy) browserEventClients(r *rpcc.Response) []*client {
+	var clients []*client
+	switch r.Method {
+	case "Target.attachedToTarget":
+		var ev target.AttachedToTargetReply
+		if json.Unmarshal(r.Args, &ev) != nil {
+			return nil
+		}
+		sessionID := string(ev.SessionID)
+		owner, ok := p.sessions[sessionID]
+		if !ok {
+			// Attached by Target.attachToTarget (the event
+			// precedes the response) or by the browser
+			// (Target.setAutoAttach).
+			owner, ok = p.attaching[string(ev.TargetInfo.TargetID)]
+			if !ok {
+				owner = p.autoAttachClient()
+			}
+			if owner == nil {
+				return nil
+			}
+			p.sessions[sessionID] = owner
+		}
+		clients = append(clients, owner)
+	case "Target.detachedFromTarget", "Target.receivedMessageFromTarget":
+		var ev struct {
+			SessionID string `json:"sessionId"`
+		}
+		if json.Unmarshal(r.Args, &ev) == nil {
+			if owner := p.sessions[ev.SessionID]; owner != nil {
+				clients = append(clients, owner)
+			}
+		}
+	case "Target.targetCreated", "Target.targetInfoChanged", "Target.targetDestroyed", "Target.targetCrashed":
+		for c := range p.clients {
+			if c.discover {
+				clients = append(clients, c)
+			}
+		}
+	default:
+		for c := range p.clients {
+			if c.session == "" {
+				clients = append(clients, c)
+			}
+		}
+	}
+	return clients
+}
+
+// autoAttachClient returns the client that owns the sessions attached
+// by the browser, the first client that enabled Target.setAutoAttach.
+// The caller must hold p.mu.
+func (p *proxy) autoAttachClient() (owner *client) {
+	for c := range p.clients {
+		if c.autoAttach && (owner == nil || c.id < owner.id) {
+			owner = c
+		}
+	}
+	return owner
+}
+
+// removeClient removes c and detaches the sessions owned by it.
+func (p *proxy) removeClient(c *client) {
+	p.mu.Lock()
+	delete(p.clients, c)
+	var sessions []string
+	for id, owner := range p.sessions {
+		if owner == c {
+			sessions = append(sessions, id)
+			delete(p.sessions, id)
+		}
+	}
+	for id, call := range p.pending {
+		if call.client == c {
+			delete(p.pending, id)
+		}
+	}
+	for id, owner := range p.attaching {
+		if owner == c {
+			delete(p.attaching, id)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, id := range sessions {
+		p.detach(id)
+	}
+}
+
+// detach detaches the session, if any. Errors are ignored, the session
+// may already be detached.
+func (p *proxy) detach(sessionID string) {
+	if sessionID == "" {
+		return
+	}
+	args := target.NewDetachFromTargetArgs().SetSessionID(target.SessionID(sessionID))
+	_ = target.NewClient(p.conn).DetachFromTarget(p.conn.Context(), args)
+}
+
+// serveVersion serves the remote version with the websocket URL of the
+// proxy.
+func (p *proxy) serveVersion(w http.ResponseWriter, r *http.Request) {
+	v, err := p.remote.Version(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if v.WebSocketDebuggerURL != "" {
+		v.WebSocketDebuggerURL = proxyWebSocketURL(r, "/devtools/browser/"+path.Base(v.WebSocketDebuggerURL))
+	}
+	writeJSON(w, v)
+}
+
+// serveList serves the remote targets with the websocket URLs of the
+// proxy.
+func (p *proxy) serveList(w http.ResponseWriter, r *http.Request) {
+	list, err := p.remote.List(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	for _, t := range list {
+		rewriteTarget(r, t)
+	}
+	writeJSON(w, list)
+}
+
+// serveNew creates a new target on the remote.
+func (p *proxy) serveNew(w http.ResponseWriter, r *http.Request) {
+	openURL, err := url.QueryUnescape(r.URL.RawQuery)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	t, err := p.remote.CreateURL(r.Context(), openURL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	rewriteTarget(r, t)
+	writeJSON(w, t)
+}
+
+// forward forwards the request to the remote.
+func (p *proxy) forward(w http.ResponseWriter, r *http.Request) {
+	u, err := url.Parse(p.remoteURL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	rp := httputil.NewSingleHostReverseProxy(u)
+	director := rp.Director
+	rp.Director = func(r *http.Request) {
+		director(r)
+		r.Host = u.Host // The remote only allows IP or localhost.
+	}
+	rp.ServeHTTP(w, r)
+}
+
+func proxyWebSocketURL(r *http.Request, p string) string {
+	scheme := "ws"
+	if r.TLS != nil {
+		scheme = "wss"
+	}
+	return scheme + "://" + r.Host + p
+}
+
+// rewriteTarget replaces the websocket URLs of t with the URLs of the
+// proxy.
+func rewriteTarget(r *http.Request, t *devtool.Target) {
+	if t.WebSocketDebuggerURL == "" {
+		return
+	}
+	wsURL := proxyWebSocketURL(r, "/devtools/page/"+t.ID)
+	if i := strings.Index(t.DevToolsFrontendURL, "ws="); i >= 0 {
+		end := strings.IndexByte(t.DevToolsFrontendURL[i:], '&')
+		rest := ""
+		if end >= 0 {
+			rest = t.DevToolsFrontendURL[i+end:]
+		}
+		t.DevToolsFrontendURL = t.DevToolsFrontendURL[:i] + "ws=" + strings.TrimPrefix(wsURL, "ws://") + rest
+	}
+	t.WebSocketDebuggerURL = wsURL
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "   ")
+	enc.Encode(v)
+}
+
+// client represents a DevTools client connected to the proxy.
+type client struct {
+	id      int
+	ws      *websocket.Conn
+	session string // Session ID for page clients, empty for browser clients.
+
+	// Target domain settings, protected by proxy.mu.
+	discover   bool // Target.setDiscoverTargets.
+	autoAttach bool // Target.setAutoAttach.
+
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// clientSession returns the session ID as seen by the client, the
+// session of a page client is implicit.
+func (c *client) clientSession(sessionID string) string {
+	if sessionID == c.session {
+		return ""
+	}
+	return sessionID
+}
+
+// write queues m for writing, the client is closed if it has fallen
+// behind.
+func (c *client) write(m *message) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		log.Printf("cdpproxy: client %d: %v", c.id, err)
+		return
+	}
+	select {
+	case c.send <- data:
+	case <-c.done:
+	default:
+		log.Printf("cdpproxy: client %d: backlog full, disconnecting", c.id)
+		c.close()
+	}
+}
+
+func (c *client) writeLoop() {
+	for {
+		select {
+		case data := <-c.send:
+			if err := c.ws.WriteMessage(websocket.TextMessage, data); err != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.ws.Close()
+	})
+}
+
+// proxyCodec wraps the codec of the browser connection, it routes the
+// responses and events for clients and passes the rest on to rpcc.
+type proxyCodec struct {
+	p        *proxy
+	newCodec func(conn io.ReadWriter) rpcc.Codec
+
+	mu   sync.Mutex // Protects writes to next.
+	next rpcc.Codec
+}
+
+var _ rpcc.Codec = (*proxyCodec)(nil)
+
+func (c *proxyCodec) wrap(conn io.ReadWriter) rpcc.Codec {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next = c.newCodec(conn)
+	return c
+}
+
+// WriteRequest implements rpcc.Codec.
+func (c *proxyCodec) WriteRequest(r *rpcc.Request) error {
+	return c.writeRequest(r)
+}
+
+func (c *proxyCodec) writeRequest(r *rpcc.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.next == nil {
+		return errors.New("cdpproxy: not connected")
+	}
+	return c.next.WriteRequest(r)
+}
+
+// ReadResponse implements rpcc.Codec.
+func (c *proxyCodec) ReadResponse(r *rpcc.Response) error {
+	for {
+		*r = rpcc.Response{}
+		if err := c.next.ReadResponse(r); err != nil {
+			return err
+		}
+		c.p.logf("browser -> proxy: %s", r)
+		if !c.p.route(r) {
+			return nil
+		}
+	}
+}
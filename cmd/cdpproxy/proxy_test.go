@@ -0,0 +1,373 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/rpcc/replay"
+)
+
+type testProxy struct {
+	srv   *cdptest.Server
+	p     *proxy
+	http  *httptest.Server
+	wsURL string
+}
+
+func newTestProxy(t *testing.T, opts ...proxyOption) *testProxy {
+	srv := cdptest.NewServer()
+	opts = append([]proxyOption{withRemote(srv.URL)}, opts...)
+	p, err := newProxy(context.Background(), srv.WebSocketURL, opts...)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	hs := httptest.NewServer(p)
+	return &testProxy{
+		srv:   srv,
+		p:     p,
+		http:  hs,
+		wsURL: "ws" + strings.TrimPrefix(hs.URL, "http"),
+	}
+}
+
+func (tp *testProxy) Close() {
+	tp.p.Close()
+	tp.http.Close()
+	tp.srv.Close()
+}
+
+func (tp *testProxy) dial(t *testing.T, path string) *rpcc.Conn {
+	conn, err := rpcc.Dial(tp.wsURL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestProxy_Multiplex(t *testing.T) {
+	tp := newTestProxy(t)
+	defer tp.Close()
+
+	tp.srv.Handle("Test.echo", func(req *cdptest.Request) (interface{}, error) {
+		return req.Params, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Both clients start from the same request ID.
+	c1 := tp.dial(t, "/devtools/browser/1")
+	defer c1.Close()
+	c2 := tp.dial(t, "/devtools/browser/2")
+	defer c2.Close()
+
+	for i, conn := range []*rpcc.Conn{c1, c2, c1} {
+		var reply struct{ N int }
+		err := rpcc.Invoke(ctx, "Test.echo", map[string]int{"n": i}, &reply, conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reply.N != i {
+			t.Errorf("Invoke() reply = %d, want %d", reply.N, i)
+		}
+	}
+
+	err := rpcc.Invoke(ctx, "Test.missing", nil, nil, c2)
+	if !errors.Is(err, rpcc.ErrMethodNotFound) {
+		t.Errorf("Invoke() error = %v, want method not found", err)
+	}
+}
+
+func TestProxy_RequestIDs(t *testing.T) {
+	tp := newTestProxy(t)
+	defer tp.Close()
+
+	tp.srv.Handle("Test.call", cdptest.Reply(nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn := tp.dial(t, "/devtools/browser/1")
+	defer conn.Close()
+
+	// Start near the end of the range, the IDs wrap around and skip
+	// the IDs of pending calls.
+	tp.p.mu.Lock()
+	tp.p.seq = proxyIDMax - proxyIDBase
+	tp.p.pending[proxyIDBase] = pendingCall{}
+	tp.p.mu.Unlock()
+
+	for i := 0; i < 3; i++ {
+		if err := rpcc.Invoke(ctx, "Test.call", nil, nil, conn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got []uint64
+	for _, req := range tp.srv.CallsTo("Test.call") {
+		if req.ID > math.MaxInt32 {
+			t.Errorf("request ID %d does not fit in int32", req.ID)
+		}
+		got = append(got, req.ID)
+	}
+	want := []uint64{proxyIDMax, proxyIDBase + 1, proxyIDBase + 2}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("request IDs = %v, want %v", got, want)
+	}
+}
+
+func TestProxy_PageEvents(t *testing.T) {
+	tp := newTestProxy(t)
+	defer tp.Close()
+
+	tp.srv.Handle("Page.navigate", cdptest.Reply(map[string]string{"frameId": "F1"}))
+	tp.srv.Handle("Target.setDiscoverTargets", cdptest.Reply(nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	browser := tp.dial(t, "/devtools/browser/1")
+	defer browser.Close()
+	page := tp.dial(t, "/devtools/page/T1")
+	defer page.Close()
+
+	browserEv, err := rpcc.NewStream(ctx, "*", browser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pageEv, err := rpcc.NewStream(ctx, "*", page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rpcc.Invoke(ctx, "Target.setDiscoverTargets", map[string]bool{"discover": true}, nil, browser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reply struct{ FrameID string }
+	err = rpcc.Invoke(ctx, "Page.navigate", map[string]string{"url": "about:blank"}, &reply, page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := tp.srv.CallsTo("Page.navigate")[0]
+	if req.SessionID == "" {
+		t.Error("Page.navigate was not sent on a session")
+	}
+
+	if err = tp.srv.SessionEvent(req.SessionID, "Page.loadEventFired", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.srv.Event("Target.targetCreated", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var m rpcc.Message
+	if err = pageEv.RecvMsg(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Method != "Page.loadEventFired" {
+		t.Errorf("page got %s, want Page.loadEventFired", m.Method)
+	}
+	if err = browserEv.RecvMsg(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Method != "Target.targetCreated" {
+		t.Errorf("browser got %s, want Target.targetCreated", m.Method)
+	}
+
+	// Closing the page client detaches the session.
+	page.Close()
+	if _, err = tp.srv.WaitCall(ctx, "Target.detachFromTarget", 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProxy_SessionIsolation(t *testing.T) {
+	tp := newTestProxy(t)
+	defer tp.Close()
+
+	tp.srv.Handle("Test.echo", cdptest.Reply(nil))
+	tp.srv.Handle("Target.setAutoAttach", cdptest.Reply(nil))
+	tp.srv.Handle("Test.autoAttach", func(req *cdptest.Request) (interface{}, error) {
+		_, err := req.Attach(target.Info{TargetID: "T2", Type: "page"}, false)
+		return nil, err
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c1 := tp.dial(t, "/devtools/browser/1")
+	defer c1.Close()
+	c2 := tp.dial(t, "/devtools/browser/2")
+	defer c2.Close()
+
+	ev1, err := rpcc.NewStream(ctx, "*", c1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev2, err := rpcc.NewStream(ctx, "*", c2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reply target.AttachToTargetReply
+	err = rpcc.Invoke(ctx, "Target.attachToTarget", target.NewAttachToTargetArgs("T1").SetFlatten(true), &reply, c1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1 := string(reply.SessionID)
+	s1c1, err := rpcc.NewSession(s1, c1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s1ev, err := rpcc.NewStream(ctx, "*", s1c1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sessions of other clients are rejected.
+	s1c2, err := rpcc.NewSession(s1, c2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = rpcc.Invoke(ctx, "Test.echo", nil, nil, s1c2); !errors.Is(err, rpcc.ErrServer) {
+		t.Errorf("Invoke() in session of other client: got %v, want server error", err)
+	}
+	err = rpcc.Invoke(ctx, "Target.detachFromTarget", target.NewDetachFromTargetArgs().SetSessionID(reply.SessionID), nil, c2)
+	if !errors.Is(err, rpcc.ErrServer) {
+		t.Errorf("Target.detachFromTarget of other client: got %v, want server error", err)
+	}
+	if tp.srv.Called("Test.echo") || tp.srv.Called("Target.detachFromTarget") {
+		t.Error("messages for the session of another client were forwarded")
+	}
+
+	// Sessions attached by the browser belong to the client that
+	// enabled auto-attach.
+	err = rpcc.Invoke(ctx, "Target.setAutoAttach", target.NewSetAutoAttachArgs(true, false).SetFlatten(true), nil, c2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = rpcc.Invoke(ctx, "Test.autoAttach", nil, nil, c2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tp.srv.SessionEvent(s1, "Page.loadEventFired", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.srv.Event("Target.targetCreated", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = tp.srv.Event("Browser.done", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	recv := func(s rpcc.Stream) (methods []string) {
+		for {
+			var m rpcc.Message
+			if err := s.RecvMsg(&m); err != nil {
+				t.Fatal(err)
+			}
+			methods = append(methods, m.Method)
+			if m.Method == "Browser.done" {
+				return methods
+			}
+		}
+	}
+	var m rpcc.Message
+	if err = s1ev.RecvMsg(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Method != "Page.loadEventFired" {
+		t.Errorf("client 1 session got %s, want Page.loadEventFired", m.Method)
+	}
+	if got, want := recv(ev1), "Browser.done"; strings.Join(got, ",") != want {
+		t.Errorf("client 1 got %v, want %s", got, want)
+	}
+	if got, want := recv(ev2), "Target.attachedToTarget,Browser.done"; strings.Join(got, ",") != want {
+		t.Errorf("client 2 got %v, want %s", got, want)
+	}
+}
+
+func TestProxy_Facade(t *testing.T) {
+	tp := newTestProxy(t)
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	v, err := devtool.New(tp.http.URL).Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := tp.wsURL + "/devtools/browser/cdptest"
+	if v.WebSocketDebuggerURL != want {
+		t.Errorf("Version() WebSocketDebuggerURL = %q, want %q", v.WebSocketDebuggerURL, want)
+	}
+
+	conn, err := rpcc.DialContext(ctx, v.WebSocketDebuggerURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestRewriteTarget(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://proxy:9223/json/list", nil)
+	tt := &devtool.Target{
+		ID:                   "T1",
+		DevToolsFrontendURL:  "/devtools/inspector.html?ws=localhost:9222/devtools/page/T1&x=1",
+		WebSocketDebuggerURL: "ws://localhost:9222/devtools/page/T1",
+	}
+	rewriteTarget(r, tt)
+	if want := "ws://proxy:9223/devtools/page/T1"; tt.WebSocketDebuggerURL != want {
+		t.Errorf("WebSocketDebuggerURL = %q, want %q", tt.WebSocketDebuggerURL, want)
+	}
+	if want := "/devtools/inspector.html?ws=proxy:9223/devtools/page/T1&x=1"; tt.DevToolsFrontendURL != want {
+		t.Errorf("DevToolsFrontendURL = %q, want %q", tt.DevToolsFrontendURL, want)
+	}
+}
+
+func TestProxy_Record(t *testing.T) {
+	var buf bytes.Buffer
+	rec := replay.NewRecorder(&buf)
+	tp := newTestProxy(t, withRecorder(rec))
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tp.srv.Handle("Test.call", cdptest.Reply(nil))
+	conn := tp.dial(t, "/devtools/browser/1")
+	defer conn.Close()
+	if err := rpcc.Invoke(ctx, "Test.call", nil, nil, conn); err != nil {
+		t.Fatal(err)
+	}
+	tp.p.Close()
+
+	var methods []string
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var e replay.Entry
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Type == replay.Request {
+			methods = append(methods, e.Method)
+		}
+	}
+	if len(methods) != 1 || methods[0] != "Test.call" {
+		t.Errorf("recorded requests = %v, want [Test.call]", methods)
+	}
+}
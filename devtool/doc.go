@@ -10,6 +10,17 @@ should be started with debugging enabled:
 	./<path>/EdgeDiagnosticsAdapter.exe --port 9223
 	node --inspect=9224
 
+Alternatively, a local Chrome or Chromium browser can be started via Launch:
+
+	browser, err := devtool.Launch(context.Background())
+	if err != nil {
+		// Handle error.
+	}
+	defer browser.Close() // Kill the browser and remove temporary files.
+
+	conn, err := rpcc.Dial(browser.WebSocketURL)
+	// ...
+
 Create a new DevTools instance that interacts with the given URL:
 
 	devt := devtool.New("http://127.0.0.1:9222")
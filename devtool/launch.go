@@ -0,0 +1,266 @@
+package devtool
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/mafredri/cdp/internal/errors"
+)
+
+// LaunchOption represents a function that sets a Launch option.
+type LaunchOption func(*launchOptions)
+
+// WithExecPath returns a LaunchOption that sets the path to the browser
+// executable. By default the executable is looked up by LookExecPath.
+func WithExecPath(path string) LaunchOption {
+	return func(o *launchOptions) {
+		o.execPath = path
+	}
+}
+
+// WithFlags returns a LaunchOption that appends flags to the command
+// line of the browser, e.g. "--window-size=1280,720".
+func WithFlags(flags ...string) LaunchOption {
+	return func(o *launchOptions) {
+		o.flags = append(o.flags, flags...)
+	}
+}
+
+// WithHeadless returns a LaunchOption that sets headless mode, the
+// browser is started in headless mode by default.
+func WithHeadless(headless bool) LaunchOption {
+	return func(o *launchOptions) {
+		o.headless = headless
+	}
+}
+
+// WithUserDataDir returns a LaunchOption that sets the user data
+// directory for the browser. By default a temporary directory is
+// created and it is removed when the browser is closed. A user data
+// directory set via this option is not removed.
+func WithUserDataDir(dir string) LaunchOption {
+	return func(o *launchOptions) {
+		o.userDataDir = dir
+	}
+}
+
+type launchOptions struct {
+	execPath    string
+	flags       []string
+	headless    bool
+	userDataDir string
+}
+
+// Browser represents a browser process started by Launch.
+type Browser struct {
+	// DevTools for the DevTools endpoint of the browser.
+	DevTools *DevTools
+	// WebSocketURL is the browser websocket debugger URL, e.g.
+	// "ws://127.0.0.1:38547/devtools/browser/<id>".
+	WebSocketURL string
+
+	cmd     *exec.Cmd
+	tempDir string // Removed on Close.
+	done    chan struct{}
+	err     error // Set before done is closed.
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Launch starts a new browser process with the remote debugging port
+// enabled. The port is chosen by the browser and the websocket URL is
+// read from its output. The returned Browser must be closed, Close
+// kills the browser (including child processes) and removes temporary
+// files.
+//
+// The context is only used for starting the browser, cancellation after
+// Launch has returned has no effect.
+func Launch(ctx context.Context, opts ...LaunchOption) (*Browser, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	o := launchOptions{headless: true}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	if o.execPath == "" {
+		p, err := LookExecPath()
+		if err != nil {
+			return nil, err
+		}
+		o.execPath = p
+	}
+
+	b := &Browser{done: make(chan struct{})}
+	if o.userDataDir == "" {
+		dir, err := ioutil.TempDir("", "cdp-devtool")
+		if err != nil {
+			return nil, err
+		}
+		b.tempDir = dir
+		o.userDataDir = dir
+	}
+
+	args := []string{
+		"--remote-debugging-port=0",
+		"--user-data-dir=" + o.userDataDir,
+		"--no-first-run",
+		"--no-default-browser-check",
+	}
+	if o.headless {
+		args = append(args, "--headless")
+	}
+	args = append(args, o.flags...)
+	args = append(args, "about:blank")
+
+	b.cmd = exec.Command(o.execPath, args...)
+	setProcessGroup(b.cmd)
+
+	stderr, err := b.cmd.StderrPipe()
+	if err != nil {
+		b.cleanup()
+		return nil, err
+	}
+	if err = b.cmd.Start(); err != nil {
+		b.cleanup()
+		return nil, errors.Wrapf(err, "devtool: Launch: could not start %s", o.execPath)
+	}
+
+	wsURL := make(chan string, 1)
+	go func() {
+		var output []string
+		found := false
+		sc := bufio.NewScanner(stderr)
+		for sc.Scan() {
+			line := sc.Text()
+			if !found {
+				if u := parseListening(line); u != "" {
+					found = true
+					wsURL <- u
+					continue
+				}
+				output = append(output, line)
+			}
+			// Keep reading, the browser blocks on a full pipe.
+		}
+		b.err = b.cmd.Wait()
+		if !found {
+			b.err = fmt.Errorf("devtool: Launch: browser exited before listening: %v: %s",
+				b.err, strings.Join(output, "\n"))
+		}
+		close(b.done)
+	}()
+
+	select {
+	case b.WebSocketURL = <-wsURL:
+	case <-b.done:
+		b.cleanup()
+		return nil, b.err
+	case <-ctx.Done():
+		b.Close()
+		return nil, ctx.Err()
+	}
+
+	u, err := url.Parse(b.WebSocketURL)
+	if err != nil {
+		b.Close()
+		return nil, err
+	}
+	b.DevTools = New("http://" + u.Host)
+
+	return b, nil
+}
+
+// parseListening returns the websocket URL from the output line that
+// announces the DevTools endpoint, an empty string is returned for
+// other lines.
+func parseListening(line string) string {
+	const prefix = "DevTools listening on "
+	i := strings.Index(line, prefix)
+	if i == -1 {
+		return ""
+	}
+	return strings.TrimSpace(line[i+len(prefix):])
+}
+
+// Done returns a channel that is closed when the browser process exits.
+func (b *Browser) Done() <-chan struct{} {
+	return b.done
+}
+
+// Close kills the browser and all of its child processes, waits for
+// the browser to exit and removes the temporary user data directory.
+func (b *Browser) Close() error {
+	b.closeOnce.Do(func() {
+		select {
+		case <-b.done:
+		default:
+			b.closeErr = killProcessGroup(b.cmd)
+			<-b.done
+		}
+		if err := b.cleanup(); err != nil && b.closeErr == nil {
+			b.closeErr = err
+		}
+	})
+	return b.closeErr
+}
+
+func (b *Browser) cleanup() error {
+	if b.tempDir == "" {
+		return nil
+	}
+	return os.RemoveAll(b.tempDir)
+}
+
+// LookExecPath searches for a Chrome or Chromium executable. The
+// environment variable CHROME_PATH, when set, takes precedence.
+func LookExecPath() (string, error) {
+	if p := os.Getenv("CHROME_PATH"); p != "" {
+		return p, nil
+	}
+
+	var names []string
+	switch runtime.GOOS {
+	case "darwin":
+		names = []string{
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		}
+	case "windows":
+		names = []string{
+			"chrome.exe",
+			os.Getenv("ProgramFiles") + `\Google\Chrome\Application\chrome.exe`,
+			os.Getenv("ProgramFiles(x86)") + `\Google\Chrome\Application\chrome.exe`,
+			os.Getenv("LocalAppData") + `\Google\Chrome\Application\chrome.exe`,
+		}
+	default:
+		names = []string{
+			"google-chrome",
+			"google-chrome-stable",
+			"chromium",
+			"chromium-browser",
+			"chrome",
+		}
+	}
+
+	for _, name := range names {
+		if p, err := exec.LookPath(name); err == nil {
+			return p, nil
+		}
+	}
+	return "", errors.New("devtool: could not find a Chrome or Chromium executable")
+}
+
+var _ io.Closer = (*Browser)(nil)
@@ -0,0 +1,163 @@
+//go:build !windows
+// +build !windows
+
+package devtool
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// writeFakeBrowser writes a shell script that behaves like a browser,
+// it records its arguments and pid of a child process in dir.
+func writeFakeBrowser(t *testing.T, dir, script string) string {
+	t.Helper()
+	p := filepath.Join(dir, "fake-browser")
+	err := ioutil.WriteFile(p, []byte("#!/bin/sh\n"+script), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestLaunch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devtool-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	execPath := writeFakeBrowser(t, dir, `
+echo "$@" > "`+dir+`/args"
+echo "[0101/000000.000000:WARNING:fake.cc(1)] Starting..." >&2
+echo "" >&2
+echo "DevTools listening on ws://127.0.0.1:9333/devtools/browser/fake-id" >&2
+sleep 30 &
+echo $! > "`+dir+`/child"
+wait
+`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	b, err := Launch(ctx, WithExecPath(execPath), WithFlags("--fake-flag"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	if want := "ws://127.0.0.1:9333/devtools/browser/fake-id"; b.WebSocketURL != want {
+		t.Errorf("WebSocketURL: got %q, want %q", b.WebSocketURL, want)
+	}
+	if want := "http://127.0.0.1:9333"; b.DevTools.url != want {
+		t.Errorf("DevTools url: got %q, want %q", b.DevTools.url, want)
+	}
+
+	var args, child []byte
+	for ctx.Err() == nil {
+		args, _ = ioutil.ReadFile(filepath.Join(dir, "args"))
+		child, _ = ioutil.ReadFile(filepath.Join(dir, "child"))
+		if len(args) > 0 && len(child) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var userDataDir string
+	for _, arg := range strings.Fields(string(args)) {
+		if strings.HasPrefix(arg, "--user-data-dir=") {
+			userDataDir = strings.TrimPrefix(arg, "--user-data-dir=")
+		}
+	}
+	for _, want := range []string{"--headless", "--remote-debugging-port=0", "--fake-flag"} {
+		if !strings.Contains(string(args), want) {
+			t.Errorf("args: got %q, want %s", args, want)
+		}
+	}
+	if _, err = os.Stat(userDataDir); err != nil {
+		t.Errorf("user data dir: %v", err)
+	}
+
+	if err = b.Close(); err != nil {
+		t.Error(err)
+	}
+	select {
+	case <-b.Done():
+	default:
+		t.Error("Done: browser has not exited after Close")
+	}
+	if _, err = os.Stat(userDataDir); !os.IsNotExist(err) {
+		t.Errorf("user data dir was not removed: %v", err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(child)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Allow the child process to exit.
+	running := true
+	for i := 0; i < 100 && running; i++ {
+		time.Sleep(10 * time.Millisecond)
+		running = processRunning(pid)
+	}
+	if running {
+		t.Errorf("child process %d is still running after Close", pid)
+	}
+}
+
+// processRunning reports whether the process exists and is not a
+// zombie (killed but not yet reaped).
+func processRunning(pid int) bool {
+	stat, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err == nil {
+		i := strings.LastIndex(string(stat), ")")
+		return i == -1 || !strings.HasPrefix(string(stat[i+1:]), " Z")
+	}
+	return syscall.Kill(pid, 0) == nil
+}
+
+func TestLaunch_ExitBeforeListening(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devtool-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	execPath := writeFakeBrowser(t, dir, `
+echo "bad flag" >&2
+exit 1
+`)
+
+	_, err = Launch(context.Background(), WithExecPath(execPath), WithUserDataDir(dir))
+	if err == nil || !strings.Contains(err.Error(), "bad flag") {
+		t.Errorf("Launch: got %v, want error containing output", err)
+	}
+	if _, err = os.Stat(dir); err != nil {
+		t.Errorf("user data dir set by option was removed: %v", err)
+	}
+}
+
+func TestLaunch_ContextCanceled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "devtool-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	execPath := writeFakeBrowser(t, dir, "sleep 30\n")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = Launch(ctx, WithExecPath(execPath))
+	if err != context.DeadlineExceeded {
+		t.Errorf("Launch: got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
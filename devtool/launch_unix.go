@@ -0,0 +1,24 @@
+//go:build !windows
+// +build !windows
+
+package devtool
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts the process in a new process group so that
+// the browser and its child processes can be killed together.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills the process group of cmd.
+func killProcessGroup(cmd *exec.Cmd) error {
+	err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	if err == syscall.ESRCH {
+		return nil // Already exited.
+	}
+	return err
+}
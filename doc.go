@@ -42,6 +42,54 @@ Methods can be invoked from the Client:
 	}
 	// ...
 
+The browser may not support every domain compiled into the Client.
+GetCapabilities reports the supported domains (Schema.getDomains) and
+the CapabilityInterceptor makes calls to unsupported domains fail fast
+with ErrUnsupported:
+
+	conn, err := rpcc.Dial(wsURL, rpcc.WithUnaryInterceptor(cdp.CapabilityInterceptor()))
+	// ...
+	err = c.Animation.Enable(ctx)
+	if errors.Is(err, cdp.ErrUnsupported) {
+		// The browser does not support the Animation domain.
+	}
+
+The protocol types implement json.Marshaler and json.Unmarshaler, the
+methods are generated (cdpgen -fast-json) to avoid reflection when
+encoding and decoding. They are used by default.
+
+Errors can be classified with IsStaleNode, IsContextNotFound,
+IsTargetClosed and IsTimeout, e.g. for retries:
+
+	box, err := c.DOM.GetBoxModel(ctx, dom.NewGetBoxModelArgs().SetNodeID(nodeID))
+	if cdp.IsStaleNode(err) {
+		// Request the node again.
+	}
+
+Navigate navigates and waits for the new document, combining Page.navigate
+with lifecycle events. Navigation failures are returned as
+*NavigationError:
+
+	_, err = cdp.Navigate(ctx, c, "https://www.google.com", cdp.WaitFor(cdp.WaitLoad|cdp.WaitNetworkIdle))
+	if errors.Is(err, cdp.ErrNavigationAborted) {
+		// Replaced by another navigation.
+	}
+
+Elements can be queried by CSS selector or XPath as an *ElementHandle, which
+hides the node and object IDs of the DOM and Runtime domains. Handles of
+removed elements, or of a replaced document, return ErrStaleElement:
+
+	button, err := cdp.Query(ctx, c, "button[type=submit]")
+	if err != nil {
+		// Handle error.
+	}
+	defer button.Release(ctx)
+
+	err = button.Click(ctx)
+	if errors.Is(err, cdp.ErrStaleElement) {
+		// Query the element again.
+	}
+
 Domain events
 
 Event clients are used to handle events sent over the protocol. A client
@@ -113,6 +161,18 @@ events is important:
 Use the Ready channel to detect which synchronized event client is ready to
 Recv.
 
+Event handlers are an alternative to event clients. A handler is called
+for each event, in the order that events arrive on the connection (for
+all handlers), on a dispatcher goroutine:
+
+	unsubscribe, err := c.Network.OnRequestWillBeSent(func(ev *network.RequestWillBeSentReply) {
+		fmt.Println("Request:", ev.Request.URL)
+	})
+	if err != nil {
+		// Handle error.
+	}
+	defer unsubscribe()
+
 The session package can be used to control multiple targets (e.g. pages) with a
 single websocket connection.
 
@@ -145,7 +205,7 @@ package cdp
 
 // Generate protcol definition using cdpgen.
 //go:generate go install github.com/mafredri/cdp/cmd/cdpgen
-//go:generate cdpgen -dest-pkg github.com/mafredri/cdp -browser-proto ./cmd/cdpgen/protodef/browser_protocol.json -js-proto ./cmd/cdpgen/protodef/js_protocol.json
+//go:generate cdpgen -fast-json -dest-pkg github.com/mafredri/cdp -browser-proto ./cmd/cdpgen/protodef/browser_protocol.json -js-proto ./cmd/cdpgen/protodef/js_protocol.json
 
 // Update code samples in README.
 //go:generate embedmd -w README.md
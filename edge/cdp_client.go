@@ -0,0 +1,43 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package edge
+
+import (
+	"github.com/mafredri/cdp/edge/protocol/css"
+	"github.com/mafredri/cdp/edge/protocol/debugger"
+	"github.com/mafredri/cdp/edge/protocol/dom"
+	"github.com/mafredri/cdp/edge/protocol/page"
+	"github.com/mafredri/cdp/edge/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Client represents a Microsoft Edge DevTools Protocol client that can be used to
+// invoke methods or listen to events in every CDP domain. The Client consumes
+// a rpcc connection, used to invoke the methods.
+type Client struct {
+	CSS      CSS
+	DOM      DOM
+	Debugger Debugger
+	Page     Page
+	Runtime  Runtime
+
+	conn *rpcc.Conn
+}
+
+// NewClient returns a new Client that uses conn
+// for communication with the debugging target.
+func NewClient(conn *rpcc.Conn) *Client {
+	return &Client{
+		CSS:      css.NewClient(conn),
+		DOM:      dom.NewClient(conn),
+		Debugger: debugger.NewClient(conn),
+		Page:     page.NewClient(conn),
+		Runtime:  runtime.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the connection used by the Client, it is nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn { return c.conn }
@@ -0,0 +1,21 @@
+/*
+Package edge provides type-safe bindings for the Microsoft Edge
+(EdgeHTML) DevTools Protocol.
+
+The edge Client requires an rpcc connection:
+
+	conn, err := rpcc.DialContext(ctx, wsURL)
+	if err != nil {
+		// Handle error.
+	}
+	defer conn.Close()
+
+	c := edge.NewClient(conn)
+	// ...
+
+The packages are generated by cdpgen (-profile edge) and live in their
+own tree (edge/protocol), they can be used alongside package cdp. Types
+that the protocol definition references but does not define, or that
+would result in an import cycle, are represented as raw JSON.
+*/
+package edge
@@ -0,0 +1,661 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package edge
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/edge/protocol/css"
+	"github.com/mafredri/cdp/edge/protocol/debugger"
+	"github.com/mafredri/cdp/edge/protocol/dom"
+	"github.com/mafredri/cdp/edge/protocol/page"
+	"github.com/mafredri/cdp/edge/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// The CSS domain. This domain exposes CSS read/write operations. All CSS
+// objects (stylesheets, rules, and styles) have an associated id used in
+// subsequent operations on the related object. Each object type has a specific
+// id structure, and those are not interchangeable between objects of different
+// kinds. CSS objects can be loaded using the get*ForNode() calls (which accept
+// a DOM node id). A client can also discover all the existing stylesheets with
+// the getAllStyleSheets() method (or keeping track of the
+// styleSheetAdded/styleSheetRemoved events) and subsequently load the required
+// stylesheet contents using the getStyleSheet[Text]() methods.
+type CSS interface {
+	// Command Enable
+	//
+	// Enables the CSS agent for the given page. Clients should not assume
+	// that the CSS agent has been enabled until the result of this command
+	// is received.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables the CSS agent for the given page.
+	Disable(context.Context) error
+
+	// Command GetMatchedStylesForNode
+	//
+	// Returns requested styles for a DOM node identified by nodeId.
+	GetMatchedStylesForNode(context.Context, *css.GetMatchedStylesForNodeArgs) (*css.GetMatchedStylesForNodeReply, error)
+
+	// Command GetInlineStylesForNode
+	//
+	// Returns the styles defined inline (explicitly in the "style"
+	// attribute and implicitly, using DOM attributes) for a DOM node
+	// identified by nodeId.
+	GetInlineStylesForNode(context.Context, *css.GetInlineStylesForNodeArgs) (*css.GetInlineStylesForNodeReply, error)
+
+	// Command GetComputedStyleForNode
+	//
+	// Returns the computed style for a DOM node identified by nodeId.
+	GetComputedStyleForNode(context.Context, *css.GetComputedStyleForNodeArgs) (*css.GetComputedStyleForNodeReply, error)
+
+	// Command GetStyleSheetText
+	//
+	// Returns the current textual content and the URL for a stylesheet.
+	GetStyleSheetText(context.Context, *css.GetStyleSheetTextArgs) (*css.GetStyleSheetTextReply, error)
+
+	// Event MediaQueryResultChanged
+	//
+	// Fires whenever a MediaQuery result changes (for example, after a
+	// browser window has been resized.) The current implementation
+	// considers only viewport-dependent media features.
+	MediaQueryResultChanged(context.Context, ...rpcc.StreamOption) (css.MediaQueryResultChangedClient, error)
+
+	// OnMediaQueryResultChanged registers a handler for MediaQueryResultChanged events.
+	OnMediaQueryResultChanged(fn func(*css.MediaQueryResultChangedReply)) (unsubscribe func(), err error)
+
+	// Event StyleSheetChanged
+	//
+	// Fired whenever a stylesheet is changed as a result of the client
+	// operation.
+	StyleSheetChanged(context.Context, ...rpcc.StreamOption) (css.StyleSheetChangedClient, error)
+
+	// OnStyleSheetChanged registers a handler for StyleSheetChanged events.
+	OnStyleSheetChanged(fn func(*css.StyleSheetChangedReply)) (unsubscribe func(), err error)
+
+	// Event StyleSheetAdded
+	//
+	// Fired whenever an active document stylesheet is added.
+	StyleSheetAdded(context.Context, ...rpcc.StreamOption) (css.StyleSheetAddedClient, error)
+
+	// OnStyleSheetAdded registers a handler for StyleSheetAdded events.
+	OnStyleSheetAdded(fn func(*css.StyleSheetAddedReply)) (unsubscribe func(), err error)
+
+	// Event StyleSheetRemoved
+	//
+	// Fired whenever an active document stylesheet is removed.
+	StyleSheetRemoved(context.Context, ...rpcc.StreamOption) (css.StyleSheetRemovedClient, error)
+
+	// OnStyleSheetRemoved registers a handler for StyleSheetRemoved events.
+	OnStyleSheetRemoved(fn func(*css.StyleSheetRemovedReply)) (unsubscribe func(), err error)
+
+	// Event LayoutEditorChange
+	LayoutEditorChange(context.Context, ...rpcc.StreamOption) (css.LayoutEditorChangeClient, error)
+
+	// OnLayoutEditorChange registers a handler for LayoutEditorChange events.
+	OnLayoutEditorChange(fn func(*css.LayoutEditorChangeReply)) (unsubscribe func(), err error)
+}
+
+// The DOM domain. This domain exposes DOM read/write operations. Each DOM
+// Node is represented with its mirror object that has an id. This id can be
+// used to get additional information on the Node, resolve it into the
+// JavaScript object wrapper, etc. It is important that client receives DOM
+// events only for the nodes that are known to the client. Backend keeps track
+// of the nodes that were sent to the client and never sends the same node
+// twice. It is client's responsibility to collect information about the nodes
+// that were sent to the client.
+//
+// Note that iframe owner elements will return corresponding document elements
+// as their child nodes.
+type DOM interface {
+	// Command Enable
+	//
+	// Enables DOM agent for the given page.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables DOM agent for the given page.
+	Disable(context.Context) error
+
+	// Command GetDocument
+	//
+	// Returns the root DOM node to the caller.
+	GetDocument(context.Context) (*dom.GetDocumentReply, error)
+
+	// Command RequestChildNodes
+	//
+	// Requests that children of the node with given id are returned to
+	// the caller in form of setChildNodes events where not only immediate
+	// children are retrieved, but all children down to the specified
+	// depth.
+	RequestChildNodes(context.Context, *dom.RequestChildNodesArgs) error
+
+	// Command HighlightNode
+	//
+	// Highlights DOM node with given id or with the given JavaScript
+	// object wrapper. Either nodeId or objectId must be specified.
+	HighlightNode(context.Context, *dom.HighlightNodeArgs) error
+
+	// Command HideHighlight
+	//
+	// Hides DOM node highlight.
+	HideHighlight(context.Context) error
+
+	// Command PushNodeByPathToFrontend
+	//
+	// Requests that the node is sent to the caller given its path. //
+	// FIXME, use XPath
+	PushNodeByPathToFrontend(context.Context, *dom.PushNodeByPathToFrontendArgs) (*dom.PushNodeByPathToFrontendReply, error)
+
+	// Command PushNodesByBackendIDsToFrontend
+	//
+	// Requests that a batch of nodes is sent to the caller given their
+	// backend node ids.
+	PushNodesByBackendIDsToFrontend(context.Context, *dom.PushNodesByBackendIDsToFrontendArgs) (*dom.PushNodesByBackendIDsToFrontendReply, error)
+
+	// Command GetAttributes
+	//
+	// Returns attributes for the specified node.
+	GetAttributes(context.Context, *dom.GetAttributesArgs) (*dom.GetAttributesReply, error)
+
+	// Event DocumentUpdated
+	//
+	// Fired when Document has been totally updated. Node ids are no
+	// longer valid.
+	DocumentUpdated(context.Context, ...rpcc.StreamOption) (dom.DocumentUpdatedClient, error)
+
+	// OnDocumentUpdated registers a handler for DocumentUpdated events.
+	OnDocumentUpdated(fn func(*dom.DocumentUpdatedReply)) (unsubscribe func(), err error)
+
+	// Event InspectNodeRequested
+	//
+	// Fired when the node should be inspected. This happens after call to
+	// setInspectMode.
+	InspectNodeRequested(context.Context, ...rpcc.StreamOption) (dom.InspectNodeRequestedClient, error)
+
+	// OnInspectNodeRequested registers a handler for InspectNodeRequested events.
+	OnInspectNodeRequested(fn func(*dom.InspectNodeRequestedReply)) (unsubscribe func(), err error)
+
+	// Event SetChildNodes
+	//
+	// Fired when backend wants to provide client with the missing DOM
+	// structure. This happens upon most of the calls requesting node ids.
+	SetChildNodes(context.Context, ...rpcc.StreamOption) (dom.SetChildNodesClient, error)
+
+	// OnSetChildNodes registers a handler for SetChildNodes events.
+	OnSetChildNodes(fn func(*dom.SetChildNodesReply)) (unsubscribe func(), err error)
+
+	// Event AttributeModified
+	//
+	// Fired when Element's attribute is modified.
+	AttributeModified(context.Context, ...rpcc.StreamOption) (dom.AttributeModifiedClient, error)
+
+	// OnAttributeModified registers a handler for AttributeModified events.
+	OnAttributeModified(fn func(*dom.AttributeModifiedReply)) (unsubscribe func(), err error)
+
+	// Event AttributeRemoved
+	//
+	// Fired when Element's attribute is removed.
+	AttributeRemoved(context.Context, ...rpcc.StreamOption) (dom.AttributeRemovedClient, error)
+
+	// OnAttributeRemoved registers a handler for AttributeRemoved events.
+	OnAttributeRemoved(fn func(*dom.AttributeRemovedReply)) (unsubscribe func(), err error)
+
+	// Event InlineStyleInvalidated
+	//
+	// Fired when Element's inline style is modified via a CSS property
+	// modification.
+	InlineStyleInvalidated(context.Context, ...rpcc.StreamOption) (dom.InlineStyleInvalidatedClient, error)
+
+	// OnInlineStyleInvalidated registers a handler for InlineStyleInvalidated events.
+	OnInlineStyleInvalidated(fn func(*dom.InlineStyleInvalidatedReply)) (unsubscribe func(), err error)
+
+	// Event CharacterDataModified
+	//
+	// Mirrors DOMCharacterDataModified event.
+	CharacterDataModified(context.Context, ...rpcc.StreamOption) (dom.CharacterDataModifiedClient, error)
+
+	// OnCharacterDataModified registers a handler for CharacterDataModified events.
+	OnCharacterDataModified(fn func(*dom.CharacterDataModifiedReply)) (unsubscribe func(), err error)
+
+	// Event ChildNodeCountUpdated
+	//
+	// Fired when Container's child node count has changed.
+	ChildNodeCountUpdated(context.Context, ...rpcc.StreamOption) (dom.ChildNodeCountUpdatedClient, error)
+
+	// OnChildNodeCountUpdated registers a handler for ChildNodeCountUpdated events.
+	OnChildNodeCountUpdated(fn func(*dom.ChildNodeCountUpdatedReply)) (unsubscribe func(), err error)
+
+	// Event ChildNodeInserted
+	//
+	// Mirrors DOMNodeInserted event.
+	ChildNodeInserted(context.Context, ...rpcc.StreamOption) (dom.ChildNodeInsertedClient, error)
+
+	// OnChildNodeInserted registers a handler for ChildNodeInserted events.
+	OnChildNodeInserted(fn func(*dom.ChildNodeInsertedReply)) (unsubscribe func(), err error)
+
+	// Event ChildNodeRemoved
+	//
+	// Mirrors DOMNodeRemoved event.
+	ChildNodeRemoved(context.Context, ...rpcc.StreamOption) (dom.ChildNodeRemovedClient, error)
+
+	// OnChildNodeRemoved registers a handler for ChildNodeRemoved events.
+	OnChildNodeRemoved(fn func(*dom.ChildNodeRemovedReply)) (unsubscribe func(), err error)
+
+	// Event ShadowRootPushed
+	//
+	// Called when shadow root is pushed into the element.
+	ShadowRootPushed(context.Context, ...rpcc.StreamOption) (dom.ShadowRootPushedClient, error)
+
+	// OnShadowRootPushed registers a handler for ShadowRootPushed events.
+	OnShadowRootPushed(fn func(*dom.ShadowRootPushedReply)) (unsubscribe func(), err error)
+
+	// Event ShadowRootPopped
+	//
+	// Called when shadow root is popped from the element.
+	ShadowRootPopped(context.Context, ...rpcc.StreamOption) (dom.ShadowRootPoppedClient, error)
+
+	// OnShadowRootPopped registers a handler for ShadowRootPopped events.
+	OnShadowRootPopped(fn func(*dom.ShadowRootPoppedReply)) (unsubscribe func(), err error)
+
+	// Event PseudoElementAdded
+	//
+	// Called when a pseudo element is added to an element.
+	PseudoElementAdded(context.Context, ...rpcc.StreamOption) (dom.PseudoElementAddedClient, error)
+
+	// OnPseudoElementAdded registers a handler for PseudoElementAdded events.
+	OnPseudoElementAdded(fn func(*dom.PseudoElementAddedReply)) (unsubscribe func(), err error)
+
+	// Event PseudoElementRemoved
+	//
+	// Called when a pseudo element is removed from an element.
+	PseudoElementRemoved(context.Context, ...rpcc.StreamOption) (dom.PseudoElementRemovedClient, error)
+
+	// OnPseudoElementRemoved registers a handler for PseudoElementRemoved events.
+	OnPseudoElementRemoved(fn func(*dom.PseudoElementRemovedReply)) (unsubscribe func(), err error)
+
+	// Event DistributedNodesUpdated
+	//
+	// Called when distribution is changed.
+	DistributedNodesUpdated(context.Context, ...rpcc.StreamOption) (dom.DistributedNodesUpdatedClient, error)
+
+	// OnDistributedNodesUpdated registers a handler for DistributedNodesUpdated events.
+	OnDistributedNodesUpdated(fn func(*dom.DistributedNodesUpdatedReply)) (unsubscribe func(), err error)
+}
+
+// The Debugger domain. Debugger domain exposes JavaScript debugging
+// capabilities. It allows setting and removing breakpoints, stepping through
+// execution, exploring stack traces, etc.
+type Debugger interface {
+	// Command Enable
+	//
+	// Enables debugger for the given page. Clients should not assume that
+	// the debugging has been enabled until the result for this command is
+	// received.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables debugger for given page.
+	Disable(context.Context) error
+
+	// Command SetBreakpointByURL
+	//
+	// Sets JavaScript breakpoint at given location specified either by
+	// URL or URL regex. Once this command is issued, all existing parsed
+	// scripts will have breakpoints resolved and returned in locations
+	// property. Further matching script parsing will result in subsequent
+	// breakpointResolved events issued. This logical breakpoint will
+	// survive page reloads.
+	SetBreakpointByURL(context.Context, *debugger.SetBreakpointByURLArgs) (*debugger.SetBreakpointByURLReply, error)
+
+	// Command RemoveBreakpoint
+	//
+	// Removes JavaScript breakpoint.
+	RemoveBreakpoint(context.Context, *debugger.RemoveBreakpointArgs) error
+
+	// Command ContinueToLocation
+	//
+	// Continues execution until specific location is reached.
+	ContinueToLocation(context.Context, *debugger.ContinueToLocationArgs) error
+
+	// Command StepOver
+	//
+	// Steps over the statement.
+	StepOver(context.Context) error
+
+	// Command StepInto
+	//
+	// Steps into the function call.
+	StepInto(context.Context) error
+
+	// Command StepOut
+	//
+	// Steps out of the function call.
+	StepOut(context.Context) error
+
+	// Command Pause
+	//
+	// Stops on the next JavaScript statement.
+	Pause(context.Context) error
+
+	// Command Resume
+	//
+	// Resumes JavaScript execution.
+	Resume(context.Context) error
+
+	// Command CanSetScriptSource
+	//
+	// Always returns true.
+	CanSetScriptSource(context.Context) (*debugger.CanSetScriptSourceReply, error)
+
+	// Command GetScriptSource
+	//
+	// Returns source for the script with given id.
+	GetScriptSource(context.Context, *debugger.GetScriptSourceArgs) (*debugger.GetScriptSourceReply, error)
+
+	// Event GlobalObjectCleared
+	//
+	// Called when global has been cleared and debugger client should
+	// reset its state. Happens upon navigation or reload.
+	GlobalObjectCleared(context.Context, ...rpcc.StreamOption) (debugger.GlobalObjectClearedClient, error)
+
+	// OnGlobalObjectCleared registers a handler for GlobalObjectCleared events.
+	OnGlobalObjectCleared(fn func(*debugger.GlobalObjectClearedReply)) (unsubscribe func(), err error)
+
+	// Event ScriptParsed
+	//
+	// Fired when virtual machine parses script. This event is also fired
+	// for all known and uncollected scripts upon enabling debugger.
+	ScriptParsed(context.Context, ...rpcc.StreamOption) (debugger.ScriptParsedClient, error)
+
+	// OnScriptParsed registers a handler for ScriptParsed events.
+	OnScriptParsed(fn func(*debugger.ScriptParsedReply)) (unsubscribe func(), err error)
+
+	// Event ScriptFailedToParse
+	//
+	// Fired when virtual machine fails to parse the script.
+	ScriptFailedToParse(context.Context, ...rpcc.StreamOption) (debugger.ScriptFailedToParseClient, error)
+
+	// OnScriptFailedToParse registers a handler for ScriptFailedToParse events.
+	OnScriptFailedToParse(fn func(*debugger.ScriptFailedToParseReply)) (unsubscribe func(), err error)
+
+	// Event BreakpointResolved
+	//
+	// Fired when breakpoint is resolved to an actual script and location.
+	BreakpointResolved(context.Context, ...rpcc.StreamOption) (debugger.BreakpointResolvedClient, error)
+
+	// OnBreakpointResolved registers a handler for BreakpointResolved events.
+	OnBreakpointResolved(fn func(*debugger.BreakpointResolvedReply)) (unsubscribe func(), err error)
+
+	// Event Paused
+	//
+	// Fired when the virtual machine stopped on breakpoint or exception
+	// or any other stop criteria.
+	Paused(context.Context, ...rpcc.StreamOption) (debugger.PausedClient, error)
+
+	// OnPaused registers a handler for Paused events.
+	OnPaused(fn func(*debugger.PausedReply)) (unsubscribe func(), err error)
+
+	// Event Resumed
+	//
+	// Fired when the virtual machine resumed execution.
+	Resumed(context.Context, ...rpcc.StreamOption) (debugger.ResumedClient, error)
+
+	// OnResumed registers a handler for Resumed events.
+	OnResumed(fn func(*debugger.ResumedReply)) (unsubscribe func(), err error)
+
+	// Event PromiseUpdated
+	//
+	// Fired when a Promise is created, updated or garbage collected.
+	PromiseUpdated(context.Context, ...rpcc.StreamOption) (debugger.PromiseUpdatedClient, error)
+
+	// OnPromiseUpdated registers a handler for PromiseUpdated events.
+	OnPromiseUpdated(fn func(*debugger.PromiseUpdatedReply)) (unsubscribe func(), err error)
+
+	// Event AsyncOperationStarted
+	//
+	// Fired when an async operation is scheduled (while in a debugger
+	// stepping session).
+	AsyncOperationStarted(context.Context, ...rpcc.StreamOption) (debugger.AsyncOperationStartedClient, error)
+
+	// OnAsyncOperationStarted registers a handler for AsyncOperationStarted events.
+	OnAsyncOperationStarted(fn func(*debugger.AsyncOperationStartedReply)) (unsubscribe func(), err error)
+
+	// Event AsyncOperationCompleted
+	//
+	// Fired when an async operation is completed (while in a debugger
+	// stepping session).
+	AsyncOperationCompleted(context.Context, ...rpcc.StreamOption) (debugger.AsyncOperationCompletedClient, error)
+
+	// OnAsyncOperationCompleted registers a handler for AsyncOperationCompleted events.
+	OnAsyncOperationCompleted(fn func(*debugger.AsyncOperationCompletedReply)) (unsubscribe func(), err error)
+}
+
+// The Page domain. Actions and events related to the inspected page belong to
+// the page domain.
+type Page interface {
+	// Command Enable
+	//
+	// Enables page domain notifications.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables page domain notifications.
+	Disable(context.Context) error
+
+	// Command Reload
+	//
+	// Reloads given page optionally ignoring the cache.
+	Reload(context.Context, *page.ReloadArgs) error
+
+	// Command Navigate
+	//
+	// Navigates current page to the given URL.
+	Navigate(context.Context, *page.NavigateArgs) (*page.NavigateReply, error)
+
+	// Command GetNavigationHistory
+	//
+	// Returns navigation history for the current page.
+	GetNavigationHistory(context.Context) (*page.GetNavigationHistoryReply, error)
+
+	// Command GetResourceTree
+	//
+	// Returns present frame / resource tree structure.
+	GetResourceTree(context.Context) (*page.GetResourceTreeReply, error)
+
+	// Command CaptureScreenshot
+	//
+	// Capture page screenshot.
+	CaptureScreenshot(context.Context) (*page.CaptureScreenshotReply, error)
+
+	// Command CanScreencast
+	//
+	// Tells whether screencast is supported.
+	CanScreencast(context.Context) (*page.CanScreencastReply, error)
+
+	// Command StartScreencast
+	//
+	// Starts sending each frame using the screencastFrame event.
+	StartScreencast(context.Context, *page.StartScreencastArgs) error
+
+	// Command StopScreencast
+	//
+	// Stops sending each frame in the screencastFrame.
+	StopScreencast(context.Context) error
+
+	// Command ScreencastFrameAck
+	//
+	// Acknowledges that a screencast frame has been received by the
+	// frontend.
+	ScreencastFrameAck(context.Context, *page.ScreencastFrameAckArgs) error
+
+	// Command SetShowViewportSizeOnResize
+	//
+	// Paints viewport size upon main frame resize.
+	SetShowViewportSizeOnResize(context.Context, *page.SetShowViewportSizeOnResizeArgs) error
+
+	// Event DOMContentEventFired
+	DOMContentEventFired(context.Context, ...rpcc.StreamOption) (page.DOMContentEventFiredClient, error)
+
+	// OnDOMContentEventFired registers a handler for DOMContentEventFired events.
+	OnDOMContentEventFired(fn func(*page.DOMContentEventFiredReply)) (unsubscribe func(), err error)
+
+	// Event LoadEventFired
+	LoadEventFired(context.Context, ...rpcc.StreamOption) (page.LoadEventFiredClient, error)
+
+	// OnLoadEventFired registers a handler for LoadEventFired events.
+	OnLoadEventFired(fn func(*page.LoadEventFiredReply)) (unsubscribe func(), err error)
+
+	// Event FrameAttached
+	//
+	// Fired when frame has been attached to its parent.
+	FrameAttached(context.Context, ...rpcc.StreamOption) (page.FrameAttachedClient, error)
+
+	// OnFrameAttached registers a handler for FrameAttached events.
+	OnFrameAttached(fn func(*page.FrameAttachedReply)) (unsubscribe func(), err error)
+
+	// Event FrameNavigated
+	//
+	// Fired once navigation of the frame has completed. Frame is now
+	// associated with the new loader.
+	FrameNavigated(context.Context, ...rpcc.StreamOption) (page.FrameNavigatedClient, error)
+
+	// OnFrameNavigated registers a handler for FrameNavigated events.
+	OnFrameNavigated(fn func(*page.FrameNavigatedReply)) (unsubscribe func(), err error)
+
+	// Event FrameDetached
+	//
+	// Fired when frame has been detached from its parent.
+	FrameDetached(context.Context, ...rpcc.StreamOption) (page.FrameDetachedClient, error)
+
+	// OnFrameDetached registers a handler for FrameDetached events.
+	OnFrameDetached(fn func(*page.FrameDetachedReply)) (unsubscribe func(), err error)
+
+	// Event FrameStartedLoading
+	//
+	// Fired when frame has started loading.
+	FrameStartedLoading(context.Context, ...rpcc.StreamOption) (page.FrameStartedLoadingClient, error)
+
+	// OnFrameStartedLoading registers a handler for FrameStartedLoading events.
+	OnFrameStartedLoading(fn func(*page.FrameStartedLoadingReply)) (unsubscribe func(), err error)
+
+	// Event FrameStoppedLoading
+	//
+	// Fired when frame has stopped loading.
+	FrameStoppedLoading(context.Context, ...rpcc.StreamOption) (page.FrameStoppedLoadingClient, error)
+
+	// OnFrameStoppedLoading registers a handler for FrameStoppedLoading events.
+	OnFrameStoppedLoading(fn func(*page.FrameStoppedLoadingReply)) (unsubscribe func(), err error)
+
+	// Event FrameScheduledNavigation
+	//
+	// Fired when frame schedules a potential navigation.
+	FrameScheduledNavigation(context.Context, ...rpcc.StreamOption) (page.FrameScheduledNavigationClient, error)
+
+	// OnFrameScheduledNavigation registers a handler for FrameScheduledNavigation events.
+	OnFrameScheduledNavigation(fn func(*page.FrameScheduledNavigationReply)) (unsubscribe func(), err error)
+
+	// Event FrameClearedScheduledNavigation
+	//
+	// Fired when frame no longer has a scheduled navigation.
+	FrameClearedScheduledNavigation(context.Context, ...rpcc.StreamOption) (page.FrameClearedScheduledNavigationClient, error)
+
+	// OnFrameClearedScheduledNavigation registers a handler for FrameClearedScheduledNavigation events.
+	OnFrameClearedScheduledNavigation(fn func(*page.FrameClearedScheduledNavigationReply)) (unsubscribe func(), err error)
+
+	// Event FrameResized
+	FrameResized(context.Context, ...rpcc.StreamOption) (page.FrameResizedClient, error)
+
+	// OnFrameResized registers a handler for FrameResized events.
+	OnFrameResized(fn func(*page.FrameResizedReply)) (unsubscribe func(), err error)
+
+	// Event ScreencastFrame
+	//
+	// Compressed image data requested by the startScreencast.
+	ScreencastFrame(context.Context, ...rpcc.StreamOption) (page.ScreencastFrameClient, error)
+
+	// OnScreencastFrame registers a handler for ScreencastFrame events.
+	OnScreencastFrame(fn func(*page.ScreencastFrameReply)) (unsubscribe func(), err error)
+
+	// Event ScreencastVisibilityChanged
+	//
+	// Fired when the page with currently enabled screencast was shown or
+	// hidden .
+	ScreencastVisibilityChanged(context.Context, ...rpcc.StreamOption) (page.ScreencastVisibilityChangedClient, error)
+
+	// OnScreencastVisibilityChanged registers a handler for ScreencastVisibilityChanged events.
+	OnScreencastVisibilityChanged(fn func(*page.ScreencastVisibilityChangedReply)) (unsubscribe func(), err error)
+}
+
+// The Runtime domain. Runtime domain exposes JavaScript runtime by means of
+// remote evaluation and mirror objects. Evaluation results are returned as
+// mirror object that expose object type, string representation and unique
+// identifier that can be used for further object reference. Original objects
+// are maintained in memory unless they are either explicitly released or are
+// released along with the other objects in their object group.
+type Runtime interface {
+	// Command Evaluate
+	//
+	// Evaluates expression on global object.
+	Evaluate(context.Context, *runtime.EvaluateArgs) (*runtime.EvaluateReply, error)
+
+	// Command CallFunctionOn
+	//
+	// Calls function with given declaration on the given object. Object
+	// group of the result is inherited from the target object.
+	CallFunctionOn(context.Context, *runtime.CallFunctionOnArgs) (*runtime.CallFunctionOnReply, error)
+
+	// Command GetProperties
+	//
+	// Returns properties of a given object. Object group of the result is
+	// inherited from the target object.
+	GetProperties(context.Context, *runtime.GetPropertiesArgs) (*runtime.GetPropertiesReply, error)
+
+	// Command Run
+	//
+	// Tells inspected instance(worker or page) that it can run in case it
+	// was started paused.
+	Run(context.Context) error
+
+	// Command Enable
+	//
+	// Enables reporting of execution contexts creation by means of
+	// executionContextCreated event. When the reporting gets enabled the
+	// event will be sent immediately for each existing execution context.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables reporting of execution contexts creation.
+	Disable(context.Context) error
+
+	// Event ExecutionContextCreated
+	//
+	// Issued when new execution context is created.
+	ExecutionContextCreated(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextCreatedClient, error)
+
+	// OnExecutionContextCreated registers a handler for ExecutionContextCreated events.
+	OnExecutionContextCreated(fn func(*runtime.ExecutionContextCreatedReply)) (unsubscribe func(), err error)
+
+	// Event ExecutionContextDestroyed
+	//
+	// Issued when execution context is destroyed.
+	ExecutionContextDestroyed(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextDestroyedClient, error)
+
+	// OnExecutionContextDestroyed registers a handler for ExecutionContextDestroyed events.
+	OnExecutionContextDestroyed(fn func(*runtime.ExecutionContextDestroyedReply)) (unsubscribe func(), err error)
+
+	// Event ExecutionContextsCleared
+	//
+	// Issued when all executionContexts were cleared in browser
+	ExecutionContextsCleared(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextsClearedClient, error)
+
+	// OnExecutionContextsCleared registers a handler for ExecutionContextsCleared events.
+	OnExecutionContextsCleared(fn func(*runtime.ExecutionContextsClearedReply)) (unsubscribe func(), err error)
+}
@@ -0,0 +1,80 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"github.com/mafredri/cdp/edge/protocol/dom"
+)
+
+// GetMatchedStylesForNodeArgs represents the arguments for GetMatchedStylesForNode in the CSS domain.
+type GetMatchedStylesForNodeArgs struct {
+	NodeID dom.NodeID `json:"nodeId"` // No description.
+}
+
+// NewGetMatchedStylesForNodeArgs initializes GetMatchedStylesForNodeArgs with the required arguments.
+func NewGetMatchedStylesForNodeArgs(nodeID dom.NodeID) *GetMatchedStylesForNodeArgs {
+	args := new(GetMatchedStylesForNodeArgs)
+	args.NodeID = nodeID
+	return args
+}
+
+// GetMatchedStylesForNodeReply represents the return values for GetMatchedStylesForNode in the CSS domain.
+type GetMatchedStylesForNodeReply struct {
+	InlineStyle     *Style                `json:"inlineStyle,omitempty"`     // Inline style for the specified DOM node.
+	AttributesStyle *Style                `json:"attributesStyle,omitempty"` // Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+	MatchedCSSRules []RuleMatch           `json:"matchedCSSRules,omitempty"` // CSS rules matching this node, from all applicable stylesheets.
+	PseudoElements  []PseudoIDMatches     `json:"pseudoElements,omitempty"`  // Pseudo style matches for this node.
+	Inherited       []InheritedStyleEntry `json:"inherited,omitempty"`       // A chain of inherited styles (from the immediate node parent up to the DOM tree root).
+}
+
+// GetInlineStylesForNodeArgs represents the arguments for GetInlineStylesForNode in the CSS domain.
+type GetInlineStylesForNodeArgs struct {
+	NodeID dom.NodeID `json:"nodeId"` // No description.
+}
+
+// NewGetInlineStylesForNodeArgs initializes GetInlineStylesForNodeArgs with the required arguments.
+func NewGetInlineStylesForNodeArgs(nodeID dom.NodeID) *GetInlineStylesForNodeArgs {
+	args := new(GetInlineStylesForNodeArgs)
+	args.NodeID = nodeID
+	return args
+}
+
+// GetInlineStylesForNodeReply represents the return values for GetInlineStylesForNode in the CSS domain.
+type GetInlineStylesForNodeReply struct {
+	InlineStyle     *Style `json:"inlineStyle,omitempty"`     // Inline style for the specified DOM node.
+	AttributesStyle *Style `json:"attributesStyle,omitempty"` // Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+}
+
+// GetComputedStyleForNodeArgs represents the arguments for GetComputedStyleForNode in the CSS domain.
+type GetComputedStyleForNodeArgs struct {
+	NodeID dom.NodeID `json:"nodeId"` // No description.
+}
+
+// NewGetComputedStyleForNodeArgs initializes GetComputedStyleForNodeArgs with the required arguments.
+func NewGetComputedStyleForNodeArgs(nodeID dom.NodeID) *GetComputedStyleForNodeArgs {
+	args := new(GetComputedStyleForNodeArgs)
+	args.NodeID = nodeID
+	return args
+}
+
+// GetComputedStyleForNodeReply represents the return values for GetComputedStyleForNode in the CSS domain.
+type GetComputedStyleForNodeReply struct {
+	ComputedStyle []ComputedStyleProperty `json:"computedStyle"` // Computed style for the specified DOM node.
+}
+
+// GetStyleSheetTextArgs represents the arguments for GetStyleSheetText in the CSS domain.
+type GetStyleSheetTextArgs struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
+}
+
+// NewGetStyleSheetTextArgs initializes GetStyleSheetTextArgs with the required arguments.
+func NewGetStyleSheetTextArgs(styleSheetID StyleSheetID) *GetStyleSheetTextArgs {
+	args := new(GetStyleSheetTextArgs)
+	args.StyleSheetID = styleSheetID
+	return args
+}
+
+// GetStyleSheetTextReply represents the return values for GetStyleSheetText in the CSS domain.
+type GetStyleSheetTextReply struct {
+	Text string `json:"text"` // The stylesheet text.
+}
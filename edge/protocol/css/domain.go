@@ -0,0 +1,297 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package css implements the CSS domain. This domain exposes CSS read/write
+// operations. All CSS objects (stylesheets, rules, and styles) have an
+// associated id used in subsequent operations on the related object. Each
+// object type has a specific id structure, and those are not interchangeable
+// between objects of different kinds. CSS objects can be loaded using the
+// get*ForNode() calls (which accept a DOM node id). A client can also discover
+// all the existing stylesheets with the getAllStyleSheets() method (or keeping
+// track of the styleSheetAdded/styleSheetRemoved events) and subsequently load
+// the required stylesheet contents using the getStyleSheet[Text]() methods.
+package css
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the CSS domain. This domain exposes CSS
+// read/write operations. All CSS objects (stylesheets, rules, and styles) have
+// an associated id used in subsequent operations on the related object. Each
+// object type has a specific id structure, and those are not interchangeable
+// between objects of different kinds. CSS objects can be loaded using the
+// get*ForNode() calls (which accept a DOM node id). A client can also discover
+// all the existing stylesheets with the getAllStyleSheets() method (or keeping
+// track of the styleSheetAdded/styleSheetRemoved events) and subsequently load
+// the required stylesheet contents using the getStyleSheet[Text]() methods.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the CSS domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the CSS method. Enables the CSS agent for the given page.
+// Clients should not assume that the CSS agent has been enabled until the
+// result of this command is received.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "CSS.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the CSS method. Disables the CSS agent for the given page.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "CSS.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// GetMatchedStylesForNode invokes the CSS method. Returns requested styles
+// for a DOM node identified by nodeId.
+func (d *domainClient) GetMatchedStylesForNode(ctx context.Context, args *GetMatchedStylesForNodeArgs) (reply *GetMatchedStylesForNodeReply, err error) {
+	reply = new(GetMatchedStylesForNodeReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "CSS.getMatchedStylesForNode", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "CSS.getMatchedStylesForNode", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "GetMatchedStylesForNode", Err: err}
+	}
+	return
+}
+
+// GetInlineStylesForNode invokes the CSS method. Returns the styles defined
+// inline (explicitly in the "style" attribute and implicitly, using DOM
+// attributes) for a DOM node identified by nodeId.
+func (d *domainClient) GetInlineStylesForNode(ctx context.Context, args *GetInlineStylesForNodeArgs) (reply *GetInlineStylesForNodeReply, err error) {
+	reply = new(GetInlineStylesForNodeReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "CSS.getInlineStylesForNode", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "CSS.getInlineStylesForNode", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "GetInlineStylesForNode", Err: err}
+	}
+	return
+}
+
+// GetComputedStyleForNode invokes the CSS method. Returns the computed style
+// for a DOM node identified by nodeId.
+func (d *domainClient) GetComputedStyleForNode(ctx context.Context, args *GetComputedStyleForNodeArgs) (reply *GetComputedStyleForNodeReply, err error) {
+	reply = new(GetComputedStyleForNodeReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "CSS.getComputedStyleForNode", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "CSS.getComputedStyleForNode", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "GetComputedStyleForNode", Err: err}
+	}
+	return
+}
+
+// GetStyleSheetText invokes the CSS method. Returns the current textual
+// content and the URL for a stylesheet.
+func (d *domainClient) GetStyleSheetText(ctx context.Context, args *GetStyleSheetTextArgs) (reply *GetStyleSheetTextReply, err error) {
+	reply = new(GetStyleSheetTextReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "CSS.getStyleSheetText", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "CSS.getStyleSheetText", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "CSS", Op: "GetStyleSheetText", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) MediaQueryResultChanged(ctx context.Context, opts ...rpcc.StreamOption) (MediaQueryResultChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.mediaQueryResultChanged", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &mediaQueryResultChangedClient{Stream: s}, nil
+}
+
+// OnMediaQueryResultChanged registers fn to be called for each
+// MediaQueryResultChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnMediaQueryResultChanged(fn func(*MediaQueryResultChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.mediaQueryResultChanged", d.conn, func(args []byte) error {
+		ev := new(MediaQueryResultChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type mediaQueryResultChangedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *mediaQueryResultChangedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *mediaQueryResultChangedClient) Recv() (*MediaQueryResultChangedReply, error) {
+	event := new(MediaQueryResultChangedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "MediaQueryResultChanged Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) StyleSheetChanged(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetChanged", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &styleSheetChangedClient{Stream: s}, nil
+}
+
+// OnStyleSheetChanged registers fn to be called for each
+// StyleSheetChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetChanged(fn func(*StyleSheetChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetChanged", d.conn, func(args []byte) error {
+		ev := new(StyleSheetChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type styleSheetChangedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *styleSheetChangedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *styleSheetChangedClient) Recv() (*StyleSheetChangedReply, error) {
+	event := new(StyleSheetChangedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "StyleSheetChanged Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) StyleSheetAdded(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetAdded", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &styleSheetAddedClient{Stream: s}, nil
+}
+
+// OnStyleSheetAdded registers fn to be called for each
+// StyleSheetAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetAdded(fn func(*StyleSheetAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetAdded", d.conn, func(args []byte) error {
+		ev := new(StyleSheetAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type styleSheetAddedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *styleSheetAddedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *styleSheetAddedClient) Recv() (*StyleSheetAddedReply, error) {
+	event := new(StyleSheetAddedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "StyleSheetAdded Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) StyleSheetRemoved(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetRemoved", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &styleSheetRemovedClient{Stream: s}, nil
+}
+
+// OnStyleSheetRemoved registers fn to be called for each
+// StyleSheetRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetRemoved(fn func(*StyleSheetRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetRemoved", d.conn, func(args []byte) error {
+		ev := new(StyleSheetRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type styleSheetRemovedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *styleSheetRemovedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *styleSheetRemovedClient) Recv() (*StyleSheetRemovedReply, error) {
+	event := new(StyleSheetRemovedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "StyleSheetRemoved Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) LayoutEditorChange(ctx context.Context, opts ...rpcc.StreamOption) (LayoutEditorChangeClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.layoutEditorChange", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &layoutEditorChangeClient{Stream: s}, nil
+}
+
+// OnLayoutEditorChange registers fn to be called for each
+// LayoutEditorChange event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLayoutEditorChange(fn func(*LayoutEditorChangeReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.layoutEditorChange", d.conn, func(args []byte) error {
+		ev := new(LayoutEditorChangeReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type layoutEditorChangeClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *layoutEditorChangeClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *layoutEditorChangeClient) Recv() (*LayoutEditorChangeReply, error) {
+	event := new(LayoutEditorChangeReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "CSS", Op: "LayoutEditorChange Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// MediaQueryResultChangedClient is a client for MediaQueryResultChanged events.
+// Fires whenever a MediaQuery result changes (for example, after a browser
+// window has been resized.) The current implementation considers only
+// viewport-dependent media features.
+type MediaQueryResultChangedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*MediaQueryResultChangedReply, error)
+	rpcc.Stream
+}
+
+// MediaQueryResultChangedReply is the reply for MediaQueryResultChanged events.
+type MediaQueryResultChangedReply struct {
+}
+
+// StyleSheetChangedClient is a client for StyleSheetChanged events. Fired
+// whenever a stylesheet is changed as a result of the client operation.
+type StyleSheetChangedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*StyleSheetChangedReply, error)
+	rpcc.Stream
+}
+
+// StyleSheetChangedReply is the reply for StyleSheetChanged events.
+type StyleSheetChangedReply struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
+}
+
+// StyleSheetAddedClient is a client for StyleSheetAdded events. Fired
+// whenever an active document stylesheet is added.
+type StyleSheetAddedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*StyleSheetAddedReply, error)
+	rpcc.Stream
+}
+
+// StyleSheetAddedReply is the reply for StyleSheetAdded events.
+type StyleSheetAddedReply struct {
+	Header StyleSheetHeader `json:"header"` // Added stylesheet metainfo.
+}
+
+// StyleSheetRemovedClient is a client for StyleSheetRemoved events. Fired
+// whenever an active document stylesheet is removed.
+type StyleSheetRemovedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*StyleSheetRemovedReply, error)
+	rpcc.Stream
+}
+
+// StyleSheetRemovedReply is the reply for StyleSheetRemoved events.
+type StyleSheetRemovedReply struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"` // Identifier of the removed stylesheet.
+}
+
+// LayoutEditorChangeClient is a client for LayoutEditorChange events.
+type LayoutEditorChangeClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*LayoutEditorChangeReply, error)
+	rpcc.Stream
+}
+
+// LayoutEditorChangeReply is the reply for LayoutEditorChange events.
+type LayoutEditorChangeReply struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"` // Identifier of the stylesheet where the modification occurred.
+	ChangeRange  SourceRange  `json:"changeRange"`  // Range where the modification occurred.
+}
@@ -0,0 +1,176 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"github.com/mafredri/cdp/edge/protocol/dom"
+	"github.com/mafredri/cdp/edge/protocol/page"
+)
+
+// StyleSheetID
+type StyleSheetID string
+
+// StyleSheetOrigin Stylesheet type: "injected" for stylesheets injected via
+// extension, "user-agent" for user-agent stylesheets, "inspector" for
+// stylesheets created by the inspector (i.e. those holding the "via inspector"
+// rules), "regular" for regular stylesheets.
+type StyleSheetOrigin string
+
+// StyleSheetOrigin as enums.
+const (
+	StyleSheetOriginNotSet    StyleSheetOrigin = ""
+	StyleSheetOriginInjected  StyleSheetOrigin = "injected"
+	StyleSheetOriginUserAgent StyleSheetOrigin = "user-agent"
+	StyleSheetOriginInspector StyleSheetOrigin = "inspector"
+	StyleSheetOriginRegular   StyleSheetOrigin = "regular"
+)
+
+func (e StyleSheetOrigin) Valid() bool {
+	switch e {
+	case "injected", "user-agent", "inspector", "regular":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e StyleSheetOrigin) String() string {
+	return string(e)
+}
+
+// PseudoIDMatches CSS rule collection for a single pseudo style.
+type PseudoIDMatches struct {
+	PseudoID int         `json:"pseudoId"` // Pseudo style identifier (see enum PseudoId in ComputedStyleConstants.h).
+	Matches  []RuleMatch `json:"matches"`  // Matches of CSS rules applicable to the pseudo style.
+}
+
+// InheritedStyleEntry Inherited CSS rule collection from ancestor node.
+type InheritedStyleEntry struct {
+	InlineStyle     *Style      `json:"inlineStyle,omitempty"` // The ancestor node's inline style, if any, in the style inheritance chain.
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules"`       // Matches of CSS rules matching the ancestor node in the style inheritance chain.
+}
+
+// RuleMatch Match data for a CSS rule.
+type RuleMatch struct {
+	Rule              Rule  `json:"rule"`              // CSS rule in the match.
+	MatchingSelectors []int `json:"matchingSelectors"` // Matching selector indices in the rule's selectorList selectors (0-based).
+}
+
+// Selector Data for a simple selector (these are delimited by commas in a
+// selector list).
+type Selector struct {
+	Value string       `json:"value"`           // Selector text.
+	Range *SourceRange `json:"range,omitempty"` // Selector range in the underlying resource (if available).
+}
+
+// SelectorList Selector list data.
+type SelectorList struct {
+	Selectors []Selector `json:"selectors"` // Selectors in the list.
+	Text      string     `json:"text"`      // Rule selector text.
+}
+
+// StyleSheetHeader CSS stylesheet metainformation.
+type StyleSheetHeader struct {
+	StyleSheetID StyleSheetID       `json:"styleSheetId"`           // The stylesheet identifier.
+	FrameID      page.FrameID       `json:"frameId"`                // Owner frame identifier.
+	SourceURL    string             `json:"sourceURL"`              // Stylesheet resource URL.
+	SourceMapURL *string            `json:"sourceMapURL,omitempty"` // URL of source map associated with the stylesheet (if any).
+	Origin       StyleSheetOrigin   `json:"origin"`                 // Stylesheet origin.
+	Title        string             `json:"title"`                  // Stylesheet title.
+	OwnerNode    *dom.BackendNodeID `json:"ownerNode,omitempty"`    // The backend id for the owner node of the stylesheet.
+	Disabled     bool               `json:"disabled"`               // Denotes whether the stylesheet is disabled.
+	HasSourceURL *bool              `json:"hasSourceURL,omitempty"` // Whether the sourceURL field value comes from the sourceURL comment.
+	IsInline     bool               `json:"isInline"`               // Whether this stylesheet is created for STYLE tag by parser. This flag is not set for document.written STYLE tags.
+	StartLine    float64            `json:"startLine"`              // Line offset of the stylesheet within the resource (zero based).
+	StartColumn  float64            `json:"startColumn"`            // Column offset of the stylesheet within the resource (zero based).
+}
+
+// Rule CSS rule representation.
+type Rule struct {
+	StyleSheetID *StyleSheetID    `json:"styleSheetId,omitempty"` // The css style sheet identifier (absent for user agent stylesheet and user-specified stylesheet rules) this rule came from.
+	SelectorList SelectorList     `json:"selectorList"`           // Rule selector data.
+	Origin       StyleSheetOrigin `json:"origin"`                 // Parent stylesheet's origin.
+	Style        Style            `json:"style"`                  // Associated style declaration.
+	Media        []Media          `json:"media,omitempty"`        // Media list array (for rules involving media queries). The array enumerates media queries starting with the innermost one, going outwards.
+}
+
+// SourceRange Text range within a resource. All numbers are zero-based.
+type SourceRange struct {
+	StartLine   int `json:"startLine"`   // Start line of range.
+	StartColumn int `json:"startColumn"` // Start column of range (inclusive).
+	EndLine     int `json:"endLine"`     // End line of range
+	EndColumn   int `json:"endColumn"`   // End column of range (exclusive).
+}
+
+// ShorthandEntry
+type ShorthandEntry struct {
+	Name      string `json:"name"`                // Shorthand name.
+	Value     string `json:"value"`               // Shorthand value.
+	Important *bool  `json:"important,omitempty"` // Whether the property has "!important" annotation (implies false if absent).
+}
+
+// ComputedStyleProperty
+type ComputedStyleProperty struct {
+	Name  string `json:"name"`  // Computed style property name.
+	Value string `json:"value"` // Computed style property value.
+}
+
+// Style CSS style representation.
+type Style struct {
+	StyleSheetID     *StyleSheetID    `json:"styleSheetId,omitempty"` // The css style sheet identifier (absent for user agent stylesheet and user-specified stylesheet rules) this rule came from.
+	CSSProperties    []Property       `json:"cssProperties"`          // CSS properties in the style.
+	ShorthandEntries []ShorthandEntry `json:"shorthandEntries"`       // Computed values for all shorthands found in the style.
+	CSSText          *string          `json:"cssText,omitempty"`      // Style declaration text (if available).
+	Range            *SourceRange     `json:"range,omitempty"`        // Style declaration range in the enclosing stylesheet (if available).
+}
+
+// Property CSS property declaration data.
+type Property struct {
+	Name      string       `json:"name"`                // The property name.
+	Value     string       `json:"value"`               // The property value.
+	Important *bool        `json:"important,omitempty"` // Whether the property has "!important" annotation (implies false if absent).
+	Implicit  *bool        `json:"implicit,omitempty"`  // Whether the property is implicit (implies false if absent).
+	Text      *string      `json:"text,omitempty"`      // The full property text as specified in the style.
+	ParsedOk  *bool        `json:"parsedOk,omitempty"`  // Whether the property is understood by the browser (implies true if absent).
+	Disabled  *bool        `json:"disabled,omitempty"`  // Whether the property is disabled by the user (present for source-based properties only).
+	Range     *SourceRange `json:"range,omitempty"`     // The entire property range in the enclosing style declaration (if available).
+}
+
+// Media CSS media rule descriptor.
+type Media struct {
+	Text string `json:"text"` // Media query text.
+	// Source Source of the media query: "mediaRule" if specified by a
+	// @media rule, "importRule" if specified by an @import rule,
+	// "linkedSheet" if specified by a "media" attribute in a linked
+	// stylesheet's LINK tag, "inlineSheet" if specified by a "media"
+	// attribute in an inline stylesheet's STYLE tag.
+	//
+	// Values: "mediaRule", "importRule", "linkedSheet", "inlineSheet".
+	Source             string        `json:"source"`
+	SourceURL          *string       `json:"sourceURL,omitempty"`          // URL of the document containing the media query description.
+	Range              *SourceRange  `json:"range,omitempty"`              // The associated rule (@media or @import) header range in the enclosing stylesheet (if available).
+	ParentStyleSheetID *StyleSheetID `json:"parentStyleSheetId,omitempty"` // Identifier of the stylesheet containing this object (if exists).
+	MediaList          []MediaQuery  `json:"mediaList,omitempty"`          // Array of media queries.
+}
+
+// MediaQuery Media query descriptor.
+type MediaQuery struct {
+	Expressions []MediaQueryExpression `json:"expressions"` // Array of media query expressions.
+	Active      bool                   `json:"active"`      // Whether the media query condition is satisfied.
+}
+
+// MediaQueryExpression Media query expression descriptor.
+type MediaQueryExpression struct {
+	Value          float64      `json:"value"`                    // Media query expression value.
+	Unit           string       `json:"unit"`                     // Media query expression units.
+	Feature        string       `json:"feature"`                  // Media query expression feature.
+	ValueRange     *SourceRange `json:"valueRange,omitempty"`     // The associated range of the value text in the enclosing stylesheet (if available).
+	ComputedLength *float64     `json:"computedLength,omitempty"` // Computed length of media query expression (if applicable).
+}
+
+// PlatformFontUsage Information about amount of glyphs that were rendered
+// with given font.
+type PlatformFontUsage struct {
+	FamilyName string  `json:"familyName"` // Font's family name reported by platform.
+	GlyphCount float64 `json:"glyphCount"` // Amount of glyphs that were rendered with this font.
+}
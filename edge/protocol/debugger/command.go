@@ -0,0 +1,109 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package debugger
+
+// SetBreakpointByURLArgs represents the arguments for SetBreakpointByURL in the Debugger domain.
+type SetBreakpointByURLArgs struct {
+	LineNumber   int     `json:"lineNumber"`             // Line number to set breakpoint at.
+	URL          *string `json:"url,omitempty"`          // URL of the resources to set breakpoint on.
+	URLRegex     *string `json:"urlRegex,omitempty"`     // Regex pattern for the URLs of the resources to set breakpoints on. Either url or urlRegex must be specified.
+	ColumnNumber *int    `json:"columnNumber,omitempty"` // Offset in the line to set breakpoint at.
+	Condition    *string `json:"condition,omitempty"`    // Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true.
+}
+
+// NewSetBreakpointByURLArgs initializes SetBreakpointByURLArgs with the required arguments.
+func NewSetBreakpointByURLArgs(lineNumber int) *SetBreakpointByURLArgs {
+	args := new(SetBreakpointByURLArgs)
+	args.LineNumber = lineNumber
+	return args
+}
+
+// SetURL sets the URL optional argument. URL of the resources to set
+// breakpoint on.
+func (a *SetBreakpointByURLArgs) SetURL(url string) *SetBreakpointByURLArgs {
+	a.URL = &url
+	return a
+}
+
+// SetURLRegex sets the URLRegex optional argument. Regex pattern for
+// the URLs of the resources to set breakpoints on. Either url or
+// urlRegex must be specified.
+func (a *SetBreakpointByURLArgs) SetURLRegex(urlRegex string) *SetBreakpointByURLArgs {
+	a.URLRegex = &urlRegex
+	return a
+}
+
+// SetColumnNumber sets the ColumnNumber optional argument. Offset in
+// the line to set breakpoint at.
+func (a *SetBreakpointByURLArgs) SetColumnNumber(columnNumber int) *SetBreakpointByURLArgs {
+	a.ColumnNumber = &columnNumber
+	return a
+}
+
+// SetCondition sets the Condition optional argument. Expression to
+// use as a breakpoint condition. When specified, debugger will only
+// stop on the breakpoint if this expression evaluates to true.
+func (a *SetBreakpointByURLArgs) SetCondition(condition string) *SetBreakpointByURLArgs {
+	a.Condition = &condition
+	return a
+}
+
+// SetBreakpointByURLReply represents the return values for SetBreakpointByURL in the Debugger domain.
+type SetBreakpointByURLReply struct {
+	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
+	Locations    []Location   `json:"locations"`    // List of the locations this breakpoint resolved into upon addition.
+}
+
+// RemoveBreakpointArgs represents the arguments for RemoveBreakpoint in the Debugger domain.
+type RemoveBreakpointArgs struct {
+	BreakpointID BreakpointID `json:"breakpointId"` // No description.
+}
+
+// NewRemoveBreakpointArgs initializes RemoveBreakpointArgs with the required arguments.
+func NewRemoveBreakpointArgs(breakpointID BreakpointID) *RemoveBreakpointArgs {
+	args := new(RemoveBreakpointArgs)
+	args.BreakpointID = breakpointID
+	return args
+}
+
+// ContinueToLocationArgs represents the arguments for ContinueToLocation in the Debugger domain.
+type ContinueToLocationArgs struct {
+	Location               Location `json:"location"`                         // Location to continue to.
+	InterstatementLocation *bool    `json:"interstatementLocation,omitempty"` // Allows breakpoints at the intemediate positions inside statements.
+}
+
+// NewContinueToLocationArgs initializes ContinueToLocationArgs with the required arguments.
+func NewContinueToLocationArgs(location Location) *ContinueToLocationArgs {
+	args := new(ContinueToLocationArgs)
+	args.Location = location
+	return args
+}
+
+// SetInterstatementLocation sets the InterstatementLocation optional argument.
+// Allows breakpoints at the intemediate positions inside statements.
+func (a *ContinueToLocationArgs) SetInterstatementLocation(interstatementLocation bool) *ContinueToLocationArgs {
+	a.InterstatementLocation = &interstatementLocation
+	return a
+}
+
+// CanSetScriptSourceReply represents the return values for CanSetScriptSource in the Debugger domain.
+type CanSetScriptSourceReply struct {
+	Result bool `json:"result"` // True if setScriptSource is supported.
+}
+
+// GetScriptSourceArgs represents the arguments for GetScriptSource in the Debugger domain.
+type GetScriptSourceArgs struct {
+	ScriptID ScriptID `json:"scriptId"` // Id of the script to get source for.
+}
+
+// NewGetScriptSourceArgs initializes GetScriptSourceArgs with the required arguments.
+func NewGetScriptSourceArgs(scriptID ScriptID) *GetScriptSourceArgs {
+	args := new(GetScriptSourceArgs)
+	args.ScriptID = scriptID
+	return args
+}
+
+// GetScriptSourceReply represents the return values for GetScriptSource in the Debugger domain.
+type GetScriptSourceReply struct {
+	ScriptSource string `json:"scriptSource"` // Script source.
+}
@@ -0,0 +1,485 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package debugger implements the Debugger domain. Debugger domain exposes
+// JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
+package debugger
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the Debugger domain. Debugger domain exposes
+// JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Debugger domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the Debugger method. Enables debugger for the given page.
+// Clients should not assume that the debugging has been enabled until the
+// result for this command is received.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Debugger method. Disables debugger for given page.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// SetBreakpointByURL invokes the Debugger method. Sets JavaScript breakpoint
+// at given location specified either by URL or URL regex. Once this command is
+// issued, all existing parsed scripts will have breakpoints resolved and
+// returned in locations property. Further matching script parsing will result
+// in subsequent breakpointResolved events issued. This logical breakpoint will
+// survive page reloads.
+func (d *domainClient) SetBreakpointByURL(ctx context.Context, args *SetBreakpointByURLArgs) (reply *SetBreakpointByURLReply, err error) {
+	reply = new(SetBreakpointByURLReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Debugger.setBreakpointByUrl", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Debugger.setBreakpointByUrl", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "SetBreakpointByURL", Err: err}
+	}
+	return
+}
+
+// RemoveBreakpoint invokes the Debugger method. Removes JavaScript
+// breakpoint.
+func (d *domainClient) RemoveBreakpoint(ctx context.Context, args *RemoveBreakpointArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Debugger.removeBreakpoint", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Debugger.removeBreakpoint", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "RemoveBreakpoint", Err: err}
+	}
+	return
+}
+
+// ContinueToLocation invokes the Debugger method. Continues execution until
+// specific location is reached.
+func (d *domainClient) ContinueToLocation(ctx context.Context, args *ContinueToLocationArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Debugger.continueToLocation", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Debugger.continueToLocation", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "ContinueToLocation", Err: err}
+	}
+	return
+}
+
+// StepOver invokes the Debugger method. Steps over the statement.
+func (d *domainClient) StepOver(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.stepOver", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "StepOver", Err: err}
+	}
+	return
+}
+
+// StepInto invokes the Debugger method. Steps into the function call.
+func (d *domainClient) StepInto(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.stepInto", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "StepInto", Err: err}
+	}
+	return
+}
+
+// StepOut invokes the Debugger method. Steps out of the function call.
+func (d *domainClient) StepOut(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.stepOut", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "StepOut", Err: err}
+	}
+	return
+}
+
+// Pause invokes the Debugger method. Stops on the next JavaScript statement.
+func (d *domainClient) Pause(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.pause", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "Pause", Err: err}
+	}
+	return
+}
+
+// Resume invokes the Debugger method. Resumes JavaScript execution.
+func (d *domainClient) Resume(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Debugger.resume", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "Resume", Err: err}
+	}
+	return
+}
+
+// CanSetScriptSource invokes the Debugger method. Always returns true.
+func (d *domainClient) CanSetScriptSource(ctx context.Context) (reply *CanSetScriptSourceReply, err error) {
+	reply = new(CanSetScriptSourceReply)
+	err = rpcc.Invoke(ctx, "Debugger.canSetScriptSource", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "CanSetScriptSource", Err: err}
+	}
+	return
+}
+
+// GetScriptSource invokes the Debugger method. Returns source for the script
+// with given id.
+func (d *domainClient) GetScriptSource(ctx context.Context, args *GetScriptSourceArgs) (reply *GetScriptSourceReply, err error) {
+	reply = new(GetScriptSourceReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Debugger.getScriptSource", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Debugger.getScriptSource", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Debugger", Op: "GetScriptSource", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) GlobalObjectCleared(ctx context.Context, opts ...rpcc.StreamOption) (GlobalObjectClearedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.globalObjectCleared", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &globalObjectClearedClient{Stream: s}, nil
+}
+
+// OnGlobalObjectCleared registers fn to be called for each
+// GlobalObjectCleared event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnGlobalObjectCleared(fn func(*GlobalObjectClearedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.globalObjectCleared", d.conn, func(args []byte) error {
+		ev := new(GlobalObjectClearedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type globalObjectClearedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *globalObjectClearedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *globalObjectClearedClient) Recv() (*GlobalObjectClearedReply, error) {
+	event := new(GlobalObjectClearedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "GlobalObjectCleared Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ScriptParsed(ctx context.Context, opts ...rpcc.StreamOption) (ScriptParsedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.scriptParsed", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &scriptParsedClient{Stream: s}, nil
+}
+
+// OnScriptParsed registers fn to be called for each
+// ScriptParsed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScriptParsed(fn func(*ScriptParsedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.scriptParsed", d.conn, func(args []byte) error {
+		ev := new(ScriptParsedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type scriptParsedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *scriptParsedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *scriptParsedClient) Recv() (*ScriptParsedReply, error) {
+	event := new(ScriptParsedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "ScriptParsed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ScriptFailedToParse(ctx context.Context, opts ...rpcc.StreamOption) (ScriptFailedToParseClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.scriptFailedToParse", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &scriptFailedToParseClient{Stream: s}, nil
+}
+
+// OnScriptFailedToParse registers fn to be called for each
+// ScriptFailedToParse event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScriptFailedToParse(fn func(*ScriptFailedToParseReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.scriptFailedToParse", d.conn, func(args []byte) error {
+		ev := new(ScriptFailedToParseReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type scriptFailedToParseClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *scriptFailedToParseClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *scriptFailedToParseClient) Recv() (*ScriptFailedToParseReply, error) {
+	event := new(ScriptFailedToParseReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "ScriptFailedToParse Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) BreakpointResolved(ctx context.Context, opts ...rpcc.StreamOption) (BreakpointResolvedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.breakpointResolved", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &breakpointResolvedClient{Stream: s}, nil
+}
+
+// OnBreakpointResolved registers fn to be called for each
+// BreakpointResolved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnBreakpointResolved(fn func(*BreakpointResolvedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.breakpointResolved", d.conn, func(args []byte) error {
+		ev := new(BreakpointResolvedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type breakpointResolvedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *breakpointResolvedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *breakpointResolvedClient) Recv() (*BreakpointResolvedReply, error) {
+	event := new(BreakpointResolvedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "BreakpointResolved Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) Paused(ctx context.Context, opts ...rpcc.StreamOption) (PausedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.paused", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pausedClient{Stream: s}, nil
+}
+
+// OnPaused registers fn to be called for each
+// Paused event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPaused(fn func(*PausedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.paused", d.conn, func(args []byte) error {
+		ev := new(PausedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type pausedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *pausedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *pausedClient) Recv() (*PausedReply, error) {
+	event := new(PausedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "Paused Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) Resumed(ctx context.Context, opts ...rpcc.StreamOption) (ResumedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.resumed", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resumedClient{Stream: s}, nil
+}
+
+// OnResumed registers fn to be called for each
+// Resumed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResumed(fn func(*ResumedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.resumed", d.conn, func(args []byte) error {
+		ev := new(ResumedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type resumedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *resumedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *resumedClient) Recv() (*ResumedReply, error) {
+	event := new(ResumedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "Resumed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) PromiseUpdated(ctx context.Context, opts ...rpcc.StreamOption) (PromiseUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.promiseUpdated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &promiseUpdatedClient{Stream: s}, nil
+}
+
+// OnPromiseUpdated registers fn to be called for each
+// PromiseUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPromiseUpdated(fn func(*PromiseUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.promiseUpdated", d.conn, func(args []byte) error {
+		ev := new(PromiseUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type promiseUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *promiseUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *promiseUpdatedClient) Recv() (*PromiseUpdatedReply, error) {
+	event := new(PromiseUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "PromiseUpdated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) AsyncOperationStarted(ctx context.Context, opts ...rpcc.StreamOption) (AsyncOperationStartedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.asyncOperationStarted", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &asyncOperationStartedClient{Stream: s}, nil
+}
+
+// OnAsyncOperationStarted registers fn to be called for each
+// AsyncOperationStarted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAsyncOperationStarted(fn func(*AsyncOperationStartedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.asyncOperationStarted", d.conn, func(args []byte) error {
+		ev := new(AsyncOperationStartedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type asyncOperationStartedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *asyncOperationStartedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *asyncOperationStartedClient) Recv() (*AsyncOperationStartedReply, error) {
+	event := new(AsyncOperationStartedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "AsyncOperationStarted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) AsyncOperationCompleted(ctx context.Context, opts ...rpcc.StreamOption) (AsyncOperationCompletedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.asyncOperationCompleted", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &asyncOperationCompletedClient{Stream: s}, nil
+}
+
+// OnAsyncOperationCompleted registers fn to be called for each
+// AsyncOperationCompleted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAsyncOperationCompleted(fn func(*AsyncOperationCompletedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.asyncOperationCompleted", d.conn, func(args []byte) error {
+		ev := new(AsyncOperationCompletedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type asyncOperationCompletedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *asyncOperationCompletedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *asyncOperationCompletedClient) Recv() (*AsyncOperationCompletedReply, error) {
+	event := new(AsyncOperationCompletedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Debugger", Op: "AsyncOperationCompleted Recv", Err: err}
+	}
+	return event, nil
+}
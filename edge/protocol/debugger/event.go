@@ -0,0 +1,167 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// GlobalObjectClearedClient is a client for GlobalObjectCleared events.
+// Called when global has been cleared and debugger client should reset its
+// state. Happens upon navigation or reload.
+type GlobalObjectClearedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*GlobalObjectClearedReply, error)
+	rpcc.Stream
+}
+
+// GlobalObjectClearedReply is the reply for GlobalObjectCleared events.
+type GlobalObjectClearedReply struct {
+}
+
+// ScriptParsedClient is a client for ScriptParsed events. Fired when virtual
+// machine parses script. This event is also fired for all known and
+// uncollected scripts upon enabling debugger.
+type ScriptParsedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ScriptParsedReply, error)
+	rpcc.Stream
+}
+
+// ScriptParsedReply is the reply for ScriptParsed events.
+type ScriptParsedReply struct {
+	ScriptID         ScriptID `json:"scriptId"`                   // Identifier of the script parsed.
+	URL              string   `json:"url"`                        // URL or name of the script parsed (if any).
+	StartLine        int      `json:"startLine"`                  // Line offset of the script within the resource with given URL (for script tags).
+	StartColumn      int      `json:"startColumn"`                // Column offset of the script within the resource with given URL.
+	EndLine          int      `json:"endLine"`                    // Last line of the script.
+	EndColumn        int      `json:"endColumn"`                  // Length of the last line of the script.
+	IsContentScript  *bool    `json:"isContentScript,omitempty"`  // Determines whether this script is a user extension script.
+	IsInternalScript *bool    `json:"isInternalScript,omitempty"` // Determines whether this script is an internal script.
+	SourceMapURL     *string  `json:"sourceMapURL,omitempty"`     // URL of source map associated with script (if any).
+	HasSourceURL     *bool    `json:"hasSourceURL,omitempty"`     // True, if this script has sourceURL.
+}
+
+// ScriptFailedToParseClient is a client for ScriptFailedToParse events. Fired
+// when virtual machine fails to parse the script.
+type ScriptFailedToParseClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ScriptFailedToParseReply, error)
+	rpcc.Stream
+}
+
+// ScriptFailedToParseReply is the reply for ScriptFailedToParse events.
+type ScriptFailedToParseReply struct {
+	ScriptID         ScriptID `json:"scriptId"`                   // Identifier of the script parsed.
+	URL              string   `json:"url"`                        // URL or name of the script parsed (if any).
+	StartLine        int      `json:"startLine"`                  // Line offset of the script within the resource with given URL (for script tags).
+	StartColumn      int      `json:"startColumn"`                // Column offset of the script within the resource with given URL.
+	EndLine          int      `json:"endLine"`                    // Last line of the script.
+	EndColumn        int      `json:"endColumn"`                  // Length of the last line of the script.
+	IsContentScript  *bool    `json:"isContentScript,omitempty"`  // Determines whether this script is a user extension script.
+	IsInternalScript *bool    `json:"isInternalScript,omitempty"` // Determines whether this script is an internal script.
+	SourceMapURL     *string  `json:"sourceMapURL,omitempty"`     // URL of source map associated with script (if any).
+	HasSourceURL     *bool    `json:"hasSourceURL,omitempty"`     // True, if this script has sourceURL.
+}
+
+// BreakpointResolvedClient is a client for BreakpointResolved events. Fired
+// when breakpoint is resolved to an actual script and location.
+type BreakpointResolvedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*BreakpointResolvedReply, error)
+	rpcc.Stream
+}
+
+// BreakpointResolvedReply is the reply for BreakpointResolved events.
+type BreakpointResolvedReply struct {
+	BreakpointID BreakpointID `json:"breakpointId"` // Breakpoint unique identifier.
+	Location     Location     `json:"location"`     // Actual breakpoint location.
+}
+
+// PausedClient is a client for Paused events. Fired when the virtual machine
+// stopped on breakpoint or exception or any other stop criteria.
+type PausedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*PausedReply, error)
+	rpcc.Stream
+}
+
+// PausedReply is the reply for Paused events.
+type PausedReply struct {
+	CallFrames []CallFrame `json:"callFrames"` // Call stack the virtual machine stopped on.
+	// Reason Pause reason.
+	//
+	// Values: "XHR", "DOM", "EventListener", "exception", "assert", "CSPViolation", "debugCommand", "promiseRejection", "AsyncOperation", "other".
+	Reason          string          `json:"reason"`
+	Data            json.RawMessage `json:"data,omitempty"`            // Object containing break-specific auxiliary properties.
+	HitBreakpoints  []string        `json:"hitBreakpoints,omitempty"`  // Hit breakpoints IDs
+	AsyncStackTrace *StackTrace     `json:"asyncStackTrace,omitempty"` // Async stack trace, if any.
+}
+
+// ResumedClient is a client for Resumed events. Fired when the virtual
+// machine resumed execution.
+type ResumedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ResumedReply, error)
+	rpcc.Stream
+}
+
+// ResumedReply is the reply for Resumed events.
+type ResumedReply struct {
+}
+
+// PromiseUpdatedClient is a client for PromiseUpdated events. Fired when a
+// Promise is created, updated or garbage collected.
+type PromiseUpdatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*PromiseUpdatedReply, error)
+	rpcc.Stream
+}
+
+// PromiseUpdatedReply is the reply for PromiseUpdated events.
+type PromiseUpdatedReply struct {
+	// EventType Type of the event.
+	//
+	// Values: "new", "update", "gc".
+	EventType string         `json:"eventType"`
+	Promise   PromiseDetails `json:"promise"` // Information about the updated Promise.
+}
+
+// AsyncOperationStartedClient is a client for AsyncOperationStarted events.
+// Fired when an async operation is scheduled (while in a debugger stepping
+// session).
+type AsyncOperationStartedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*AsyncOperationStartedReply, error)
+	rpcc.Stream
+}
+
+// AsyncOperationStartedReply is the reply for AsyncOperationStarted events.
+type AsyncOperationStartedReply struct {
+	Operation AsyncOperation `json:"operation"` // Information about the async operation.
+}
+
+// AsyncOperationCompletedClient is a client for AsyncOperationCompleted events.
+// Fired when an async operation is completed (while in a debugger stepping
+// session).
+type AsyncOperationCompletedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*AsyncOperationCompletedReply, error)
+	rpcc.Stream
+}
+
+// AsyncOperationCompletedReply is the reply for AsyncOperationCompleted events.
+type AsyncOperationCompletedReply struct {
+	ID int `json:"id"` // ID of the async operation that was completed.
+}
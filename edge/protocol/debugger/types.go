@@ -0,0 +1,125 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+)
+
+// BreakpointID Breakpoint identifier.
+type BreakpointID string
+
+// ScriptID Unique script identifier.
+type ScriptID string
+
+// CallFrameID Call frame identifier.
+type CallFrameID string
+
+// Location Location in the source code.
+type Location struct {
+	ScriptID     ScriptID `json:"scriptId"`               // Script identifier as reported in the Debugger.scriptParsed.
+	LineNumber   int      `json:"lineNumber"`             // Line number in the script (0-based).
+	ColumnNumber *int     `json:"columnNumber,omitempty"` // Column number in the script (0-based).
+}
+
+// FunctionDetails Information about the function.
+type FunctionDetails struct {
+	Location     *Location `json:"location,omitempty"`   // Location of the function, none for native functions.
+	FunctionName string    `json:"functionName"`         // Name of the function.
+	IsGenerator  bool      `json:"isGenerator"`          // Whether this is a generator function.
+	ScopeChain   []Scope   `json:"scopeChain,omitempty"` // Scope chain for this closure.
+}
+
+// GeneratorObjectDetails Information about the generator object.
+type GeneratorObjectDetails struct {
+	Function     json.RawMessage `json:"function"`     // Generator function. Type Runtime.RemoteObject.
+	FunctionName string          `json:"functionName"` // Name of the generator function.
+	// Status Current generator object status.
+	//
+	// Values: "running", "suspended", "closed".
+	Status   string    `json:"status"`
+	Location *Location `json:"location,omitempty"` // If suspended, location where generator function was suspended (e.g. location of the last 'yield'). Otherwise, location of the generator function.
+}
+
+// CollectionEntry Collection entry.
+type CollectionEntry struct {
+	Key   json.RawMessage `json:"key,omitempty"` // Entry key of a map-like collection, otherwise not provided. Type Runtime.RemoteObject.
+	Value json.RawMessage `json:"value"`         // Entry value. Type Runtime.RemoteObject.
+}
+
+// CallFrame JavaScript call frame. Array of call frames form the call stack.
+type CallFrame struct {
+	CallFrameID      CallFrameID     `json:"callFrameId"`                // Call frame identifier. This identifier is only valid while the virtual machine is paused.
+	FunctionName     string          `json:"functionName"`               // Name of the JavaScript function called on this call frame.
+	FunctionLocation *Location       `json:"functionLocation,omitempty"` // Location in the source code.
+	Location         Location        `json:"location"`                   // Location in the source code.
+	ScopeChain       []Scope         `json:"scopeChain"`                 // Scope chain for this call frame.
+	This             json.RawMessage `json:"this"`                       // this object for this call frame. Type Runtime.RemoteObject.
+	ReturnValue      json.RawMessage `json:"returnValue,omitempty"`      // The value being returned, if the function is at return point. Type Runtime.RemoteObject.
+}
+
+// StackTrace JavaScript call stack, including async stack traces.
+type StackTrace struct {
+	CallFrames      []CallFrame `json:"callFrames"`                // Call frames of the stack trace.
+	Description     *string     `json:"description,omitempty"`     // String label of this stack trace. For async traces this may be a name of the function that initiated the async call.
+	AsyncStackTrace *StackTrace `json:"asyncStackTrace,omitempty"` // Async stack trace, if any.
+}
+
+// Scope Scope description.
+type Scope struct {
+	// Type Scope type.
+	//
+	// Values: "global", "local", "with", "closure", "catch", "block", "script".
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"` // Object representing the scope. For global and with scopes it represents the actual object; for the rest of the scopes, it is artificial transient object enumerating scope variables as its properties. Type Runtime.RemoteObject.
+}
+
+// ExceptionDetails Detailed information on exception (or error) that was
+// thrown during script compilation or execution.
+type ExceptionDetails struct {
+	Text       string          `json:"text"`                 // Exception text.
+	URL        *string         `json:"url,omitempty"`        // URL of the message origin.
+	ScriptID   *string         `json:"scriptId,omitempty"`   // Script ID of the message origin.
+	Line       *int            `json:"line,omitempty"`       // Line number in the resource that generated this message.
+	Column     *int            `json:"column,omitempty"`     // Column number in the resource that generated this message.
+	StackTrace json.RawMessage `json:"stackTrace,omitempty"` // JavaScript stack trace for assertions and error messages. Type Console.StackTrace.
+}
+
+// SetScriptSourceError Error data for setScriptSource command. compileError
+// is a case type for uncompilable script source error.
+type SetScriptSourceError struct {
+	CompileError json.RawMessage `json:"compileError,omitempty"` // No description.
+}
+
+// PromiseDetails Information about the promise. All fields but id are
+// optional and if present they reflect the new state of the property on the
+// promise with given id.
+type PromiseDetails struct {
+	ID int `json:"id"` // Unique id of the promise.
+	// Status Status of the promise.
+	//
+	// Values: "pending", "resolved", "rejected".
+	Status               *string         `json:"status,omitempty"`
+	ParentID             *int            `json:"parentId,omitempty"`             // Id of the parent promise.
+	CallFrame            json.RawMessage `json:"callFrame,omitempty"`            // Top call frame on promise creation. Type Console.CallFrame.
+	CreationTime         *float64        `json:"creationTime,omitempty"`         // Creation time of the promise.
+	SettlementTime       *float64        `json:"settlementTime,omitempty"`       // Settlement time of the promise.
+	CreationStack        json.RawMessage `json:"creationStack,omitempty"`        // JavaScript stack trace on promise creation. Type Console.StackTrace.
+	AsyncCreationStack   json.RawMessage `json:"asyncCreationStack,omitempty"`   // JavaScript asynchronous stack trace on promise creation, if available. Type Console.AsyncStackTrace.
+	SettlementStack      json.RawMessage `json:"settlementStack,omitempty"`      // JavaScript stack trace on promise settlement. Type Console.StackTrace.
+	AsyncSettlementStack json.RawMessage `json:"asyncSettlementStack,omitempty"` // JavaScript asynchronous stack trace on promise settlement, if available. Type Console.AsyncStackTrace.
+}
+
+// AsyncOperation Information about the async operation.
+type AsyncOperation struct {
+	ID              int             `json:"id"`                        // Unique id of the async operation.
+	Description     string          `json:"description"`               // String description of the async operation.
+	StackTrace      json.RawMessage `json:"stackTrace,omitempty"`      // Stack trace where async operation was scheduled. Type Console.StackTrace.
+	AsyncStackTrace json.RawMessage `json:"asyncStackTrace,omitempty"` // Asynchronous stack trace where async operation was scheduled, if available. Type Console.AsyncStackTrace.
+}
+
+// SearchMatch Search match for resource.
+type SearchMatch struct {
+	LineNumber  float64 `json:"lineNumber"`  // Line number in resource content.
+	LineContent string  `json:"lineContent"` // Line with match content.
+}
@@ -0,0 +1,120 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"github.com/mafredri/cdp/edge/protocol/runtime"
+)
+
+// GetDocumentReply represents the return values for GetDocument in the DOM domain.
+type GetDocumentReply struct {
+	Root Node `json:"root"` // Resulting node.
+}
+
+// RequestChildNodesArgs represents the arguments for RequestChildNodes in the DOM domain.
+type RequestChildNodesArgs struct {
+	NodeID NodeID `json:"nodeId"`          // Id of the node to get children for.
+	Depth  *int   `json:"depth,omitempty"` // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+}
+
+// NewRequestChildNodesArgs initializes RequestChildNodesArgs with the required arguments.
+func NewRequestChildNodesArgs(nodeID NodeID) *RequestChildNodesArgs {
+	args := new(RequestChildNodesArgs)
+	args.NodeID = nodeID
+	return args
+}
+
+// SetDepth sets the Depth optional argument. The maximum depth at
+// which children should be retrieved, defaults to 1. Use -1 for the
+// entire subtree or provide an integer larger than 0.
+func (a *RequestChildNodesArgs) SetDepth(depth int) *RequestChildNodesArgs {
+	a.Depth = &depth
+	return a
+}
+
+// HighlightNodeArgs represents the arguments for HighlightNode in the DOM domain.
+type HighlightNodeArgs struct {
+	HighlightConfig HighlightConfig         `json:"highlightConfig"`         // A descriptor for the highlight appearance.
+	NodeID          *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node to highlight.
+	BackendNodeID   *BackendNodeID          `json:"backendNodeId,omitempty"` // Identifier of the backend node to highlight.
+	ObjectID        *runtime.RemoteObjectID `json:"objectId,omitempty"`      // JavaScript object id of the node to be highlighted.
+}
+
+// NewHighlightNodeArgs initializes HighlightNodeArgs with the required arguments.
+func NewHighlightNodeArgs(highlightConfig HighlightConfig) *HighlightNodeArgs {
+	args := new(HighlightNodeArgs)
+	args.HighlightConfig = highlightConfig
+	return args
+}
+
+// SetNodeID sets the NodeID optional argument. Identifier of the node
+// to highlight.
+func (a *HighlightNodeArgs) SetNodeID(nodeID NodeID) *HighlightNodeArgs {
+	a.NodeID = &nodeID
+	return a
+}
+
+// SetBackendNodeID sets the BackendNodeID optional argument.
+// Identifier of the backend node to highlight.
+func (a *HighlightNodeArgs) SetBackendNodeID(backendNodeID BackendNodeID) *HighlightNodeArgs {
+	a.BackendNodeID = &backendNodeID
+	return a
+}
+
+// SetObjectID sets the ObjectID optional argument. JavaScript object
+// id of the node to be highlighted.
+func (a *HighlightNodeArgs) SetObjectID(objectID runtime.RemoteObjectID) *HighlightNodeArgs {
+	a.ObjectID = &objectID
+	return a
+}
+
+// PushNodeByPathToFrontendArgs represents the arguments for PushNodeByPathToFrontend in the DOM domain.
+type PushNodeByPathToFrontendArgs struct {
+	Path string `json:"path"` // Path to node in the proprietary format.
+}
+
+// NewPushNodeByPathToFrontendArgs initializes PushNodeByPathToFrontendArgs with the required arguments.
+func NewPushNodeByPathToFrontendArgs(path string) *PushNodeByPathToFrontendArgs {
+	args := new(PushNodeByPathToFrontendArgs)
+	args.Path = path
+	return args
+}
+
+// PushNodeByPathToFrontendReply represents the return values for PushNodeByPathToFrontend in the DOM domain.
+type PushNodeByPathToFrontendReply struct {
+	NodeID NodeID `json:"nodeId"` // Id of the node for given path.
+}
+
+// PushNodesByBackendIDsToFrontendArgs represents the arguments for PushNodesByBackendIDsToFrontend in the DOM domain.
+type PushNodesByBackendIDsToFrontendArgs struct {
+	BackendNodeIDs []BackendNodeID `json:"backendNodeIds"` // The array of backend node ids.
+}
+
+// NewPushNodesByBackendIDsToFrontendArgs initializes PushNodesByBackendIDsToFrontendArgs with the required arguments.
+func NewPushNodesByBackendIDsToFrontendArgs(backendNodeIDs []BackendNodeID) *PushNodesByBackendIDsToFrontendArgs {
+	args := new(PushNodesByBackendIDsToFrontendArgs)
+	args.BackendNodeIDs = backendNodeIDs
+	return args
+}
+
+// PushNodesByBackendIDsToFrontendReply represents the return values for PushNodesByBackendIDsToFrontend in the DOM domain.
+type PushNodesByBackendIDsToFrontendReply struct {
+	NodeIDs []NodeID `json:"nodeIds"` // The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.
+}
+
+// GetAttributesArgs represents the arguments for GetAttributes in the DOM domain.
+type GetAttributesArgs struct {
+	NodeID NodeID `json:"nodeId"` // Id of the node to retrieve attibutes for.
+}
+
+// NewGetAttributesArgs initializes GetAttributesArgs with the required arguments.
+func NewGetAttributesArgs(nodeID NodeID) *GetAttributesArgs {
+	args := new(GetAttributesArgs)
+	args.NodeID = nodeID
+	return args
+}
+
+// GetAttributesReply represents the return values for GetAttributes in the DOM domain.
+type GetAttributesReply struct {
+	Attributes []string `json:"attributes"` // An interleaved array of node attribute names and values.
+}
@@ -0,0 +1,694 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package dom implements the DOM domain. This domain exposes DOM read/write
+// operations. Each DOM Node is represented with its mirror object that has an
+// id. This id can be used to get additional information on the Node, resolve
+// it into the JavaScript object wrapper, etc. It is important that client
+// receives DOM events only for the nodes that are known to the client. Backend
+// keeps track of the nodes that were sent to the client and never sends the
+// same node twice. It is client's responsibility to collect information about
+// the nodes that were sent to the client.
+//
+// Note that iframe owner elements will return corresponding document elements
+// as their child nodes.
+package dom
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the DOM domain. This domain exposes DOM
+// read/write operations. Each DOM Node is represented with its mirror object
+// that has an id. This id can be used to get additional information on the
+// Node, resolve it into the JavaScript object wrapper, etc. It is important
+// that client receives DOM events only for the nodes that are known to the
+// client. Backend keeps track of the nodes that were sent to the client and
+// never sends the same node twice. It is client's responsibility to collect
+// information about the nodes that were sent to the client.
+//
+// Note that iframe owner elements will return corresponding document elements
+// as their child nodes.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the DOM domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the DOM method. Enables DOM agent for the given page.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "DOM.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the DOM method. Disables DOM agent for the given page.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "DOM.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// GetDocument invokes the DOM method. Returns the root DOM node to the
+// caller.
+func (d *domainClient) GetDocument(ctx context.Context) (reply *GetDocumentReply, err error) {
+	reply = new(GetDocumentReply)
+	err = rpcc.Invoke(ctx, "DOM.getDocument", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "GetDocument", Err: err}
+	}
+	return
+}
+
+// RequestChildNodes invokes the DOM method. Requests that children of the
+// node with given id are returned to the caller in form of setChildNodes
+// events where not only immediate children are retrieved, but all children
+// down to the specified depth.
+func (d *domainClient) RequestChildNodes(ctx context.Context, args *RequestChildNodesArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "DOM.requestChildNodes", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "DOM.requestChildNodes", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "RequestChildNodes", Err: err}
+	}
+	return
+}
+
+// HighlightNode invokes the DOM method. Highlights DOM node with given id or
+// with the given JavaScript object wrapper. Either nodeId or objectId must be
+// specified.
+func (d *domainClient) HighlightNode(ctx context.Context, args *HighlightNodeArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "DOM.highlightNode", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "DOM.highlightNode", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "HighlightNode", Err: err}
+	}
+	return
+}
+
+// HideHighlight invokes the DOM method. Hides DOM node highlight.
+func (d *domainClient) HideHighlight(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "DOM.hideHighlight", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "HideHighlight", Err: err}
+	}
+	return
+}
+
+// PushNodeByPathToFrontend invokes the DOM method. Requests that the node is
+// sent to the caller given its path. // FIXME, use XPath
+func (d *domainClient) PushNodeByPathToFrontend(ctx context.Context, args *PushNodeByPathToFrontendArgs) (reply *PushNodeByPathToFrontendReply, err error) {
+	reply = new(PushNodeByPathToFrontendReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "DOM.pushNodeByPathToFrontend", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "DOM.pushNodeByPathToFrontend", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "PushNodeByPathToFrontend", Err: err}
+	}
+	return
+}
+
+// PushNodesByBackendIDsToFrontend invokes the DOM method. Requests that a
+// batch of nodes is sent to the caller given their backend node ids.
+func (d *domainClient) PushNodesByBackendIDsToFrontend(ctx context.Context, args *PushNodesByBackendIDsToFrontendArgs) (reply *PushNodesByBackendIDsToFrontendReply, err error) {
+	reply = new(PushNodesByBackendIDsToFrontendReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "DOM.pushNodesByBackendIdsToFrontend", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "DOM.pushNodesByBackendIdsToFrontend", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "PushNodesByBackendIDsToFrontend", Err: err}
+	}
+	return
+}
+
+// GetAttributes invokes the DOM method. Returns attributes for the specified
+// node.
+func (d *domainClient) GetAttributes(ctx context.Context, args *GetAttributesArgs) (reply *GetAttributesReply, err error) {
+	reply = new(GetAttributesReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "DOM.getAttributes", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "DOM.getAttributes", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "DOM", Op: "GetAttributes", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) DocumentUpdated(ctx context.Context, opts ...rpcc.StreamOption) (DocumentUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.documentUpdated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &documentUpdatedClient{Stream: s}, nil
+}
+
+// OnDocumentUpdated registers fn to be called for each
+// DocumentUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDocumentUpdated(fn func(*DocumentUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.documentUpdated", d.conn, func(args []byte) error {
+		ev := new(DocumentUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type documentUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *documentUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *documentUpdatedClient) Recv() (*DocumentUpdatedReply, error) {
+	event := new(DocumentUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "DocumentUpdated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) InspectNodeRequested(ctx context.Context, opts ...rpcc.StreamOption) (InspectNodeRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.inspectNodeRequested", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &inspectNodeRequestedClient{Stream: s}, nil
+}
+
+// OnInspectNodeRequested registers fn to be called for each
+// InspectNodeRequested event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInspectNodeRequested(fn func(*InspectNodeRequestedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.inspectNodeRequested", d.conn, func(args []byte) error {
+		ev := new(InspectNodeRequestedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type inspectNodeRequestedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *inspectNodeRequestedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *inspectNodeRequestedClient) Recv() (*InspectNodeRequestedReply, error) {
+	event := new(InspectNodeRequestedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "InspectNodeRequested Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) SetChildNodes(ctx context.Context, opts ...rpcc.StreamOption) (SetChildNodesClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.setChildNodes", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &setChildNodesClient{Stream: s}, nil
+}
+
+// OnSetChildNodes registers fn to be called for each
+// SetChildNodes event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnSetChildNodes(fn func(*SetChildNodesReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.setChildNodes", d.conn, func(args []byte) error {
+		ev := new(SetChildNodesReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type setChildNodesClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *setChildNodesClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *setChildNodesClient) Recv() (*SetChildNodesReply, error) {
+	event := new(SetChildNodesReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "SetChildNodes Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) AttributeModified(ctx context.Context, opts ...rpcc.StreamOption) (AttributeModifiedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.attributeModified", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &attributeModifiedClient{Stream: s}, nil
+}
+
+// OnAttributeModified registers fn to be called for each
+// AttributeModified event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttributeModified(fn func(*AttributeModifiedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.attributeModified", d.conn, func(args []byte) error {
+		ev := new(AttributeModifiedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type attributeModifiedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *attributeModifiedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *attributeModifiedClient) Recv() (*AttributeModifiedReply, error) {
+	event := new(AttributeModifiedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "AttributeModified Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) AttributeRemoved(ctx context.Context, opts ...rpcc.StreamOption) (AttributeRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.attributeRemoved", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &attributeRemovedClient{Stream: s}, nil
+}
+
+// OnAttributeRemoved registers fn to be called for each
+// AttributeRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttributeRemoved(fn func(*AttributeRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.attributeRemoved", d.conn, func(args []byte) error {
+		ev := new(AttributeRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type attributeRemovedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *attributeRemovedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *attributeRemovedClient) Recv() (*AttributeRemovedReply, error) {
+	event := new(AttributeRemovedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "AttributeRemoved Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) InlineStyleInvalidated(ctx context.Context, opts ...rpcc.StreamOption) (InlineStyleInvalidatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.inlineStyleInvalidated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &inlineStyleInvalidatedClient{Stream: s}, nil
+}
+
+// OnInlineStyleInvalidated registers fn to be called for each
+// InlineStyleInvalidated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInlineStyleInvalidated(fn func(*InlineStyleInvalidatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.inlineStyleInvalidated", d.conn, func(args []byte) error {
+		ev := new(InlineStyleInvalidatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type inlineStyleInvalidatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *inlineStyleInvalidatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *inlineStyleInvalidatedClient) Recv() (*InlineStyleInvalidatedReply, error) {
+	event := new(InlineStyleInvalidatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "InlineStyleInvalidated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) CharacterDataModified(ctx context.Context, opts ...rpcc.StreamOption) (CharacterDataModifiedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.characterDataModified", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &characterDataModifiedClient{Stream: s}, nil
+}
+
+// OnCharacterDataModified registers fn to be called for each
+// CharacterDataModified event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCharacterDataModified(fn func(*CharacterDataModifiedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.characterDataModified", d.conn, func(args []byte) error {
+		ev := new(CharacterDataModifiedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type characterDataModifiedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *characterDataModifiedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *characterDataModifiedClient) Recv() (*CharacterDataModifiedReply, error) {
+	event := new(CharacterDataModifiedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "CharacterDataModified Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ChildNodeCountUpdated(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeCountUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeCountUpdated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &childNodeCountUpdatedClient{Stream: s}, nil
+}
+
+// OnChildNodeCountUpdated registers fn to be called for each
+// ChildNodeCountUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeCountUpdated(fn func(*ChildNodeCountUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeCountUpdated", d.conn, func(args []byte) error {
+		ev := new(ChildNodeCountUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type childNodeCountUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *childNodeCountUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *childNodeCountUpdatedClient) Recv() (*ChildNodeCountUpdatedReply, error) {
+	event := new(ChildNodeCountUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "ChildNodeCountUpdated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ChildNodeInserted(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeInsertedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeInserted", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &childNodeInsertedClient{Stream: s}, nil
+}
+
+// OnChildNodeInserted registers fn to be called for each
+// ChildNodeInserted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeInserted(fn func(*ChildNodeInsertedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeInserted", d.conn, func(args []byte) error {
+		ev := new(ChildNodeInsertedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type childNodeInsertedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *childNodeInsertedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *childNodeInsertedClient) Recv() (*ChildNodeInsertedReply, error) {
+	event := new(ChildNodeInsertedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "ChildNodeInserted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ChildNodeRemoved(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeRemoved", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &childNodeRemovedClient{Stream: s}, nil
+}
+
+// OnChildNodeRemoved registers fn to be called for each
+// ChildNodeRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeRemoved(fn func(*ChildNodeRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeRemoved", d.conn, func(args []byte) error {
+		ev := new(ChildNodeRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type childNodeRemovedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *childNodeRemovedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *childNodeRemovedClient) Recv() (*ChildNodeRemovedReply, error) {
+	event := new(ChildNodeRemovedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "ChildNodeRemoved Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ShadowRootPushed(ctx context.Context, opts ...rpcc.StreamOption) (ShadowRootPushedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPushed", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &shadowRootPushedClient{Stream: s}, nil
+}
+
+// OnShadowRootPushed registers fn to be called for each
+// ShadowRootPushed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnShadowRootPushed(fn func(*ShadowRootPushedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.shadowRootPushed", d.conn, func(args []byte) error {
+		ev := new(ShadowRootPushedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type shadowRootPushedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *shadowRootPushedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *shadowRootPushedClient) Recv() (*ShadowRootPushedReply, error) {
+	event := new(ShadowRootPushedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "ShadowRootPushed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ShadowRootPopped(ctx context.Context, opts ...rpcc.StreamOption) (ShadowRootPoppedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPopped", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &shadowRootPoppedClient{Stream: s}, nil
+}
+
+// OnShadowRootPopped registers fn to be called for each
+// ShadowRootPopped event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnShadowRootPopped(fn func(*ShadowRootPoppedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.shadowRootPopped", d.conn, func(args []byte) error {
+		ev := new(ShadowRootPoppedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type shadowRootPoppedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *shadowRootPoppedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *shadowRootPoppedClient) Recv() (*ShadowRootPoppedReply, error) {
+	event := new(ShadowRootPoppedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "ShadowRootPopped Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) PseudoElementAdded(ctx context.Context, opts ...rpcc.StreamOption) (PseudoElementAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementAdded", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pseudoElementAddedClient{Stream: s}, nil
+}
+
+// OnPseudoElementAdded registers fn to be called for each
+// PseudoElementAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPseudoElementAdded(fn func(*PseudoElementAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.pseudoElementAdded", d.conn, func(args []byte) error {
+		ev := new(PseudoElementAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type pseudoElementAddedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *pseudoElementAddedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *pseudoElementAddedClient) Recv() (*PseudoElementAddedReply, error) {
+	event := new(PseudoElementAddedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "PseudoElementAdded Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) PseudoElementRemoved(ctx context.Context, opts ...rpcc.StreamOption) (PseudoElementRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementRemoved", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pseudoElementRemovedClient{Stream: s}, nil
+}
+
+// OnPseudoElementRemoved registers fn to be called for each
+// PseudoElementRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPseudoElementRemoved(fn func(*PseudoElementRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.pseudoElementRemoved", d.conn, func(args []byte) error {
+		ev := new(PseudoElementRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type pseudoElementRemovedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *pseudoElementRemovedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *pseudoElementRemovedClient) Recv() (*PseudoElementRemovedReply, error) {
+	event := new(PseudoElementRemovedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "PseudoElementRemoved Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DistributedNodesUpdated(ctx context.Context, opts ...rpcc.StreamOption) (DistributedNodesUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.distributedNodesUpdated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &distributedNodesUpdatedClient{Stream: s}, nil
+}
+
+// OnDistributedNodesUpdated registers fn to be called for each
+// DistributedNodesUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDistributedNodesUpdated(fn func(*DistributedNodesUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.distributedNodesUpdated", d.conn, func(args []byte) error {
+		ev := new(DistributedNodesUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type distributedNodesUpdatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *distributedNodesUpdatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *distributedNodesUpdatedClient) Recv() (*DistributedNodesUpdatedReply, error) {
+	event := new(DistributedNodesUpdatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "DOM", Op: "DistributedNodesUpdated Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,153 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+)
+
+// ReloadArgs represents the arguments for Reload in the Page domain.
+type ReloadArgs struct {
+	IgnoreCache            *bool   `json:"ignoreCache,omitempty"`            // If true, browser cache is ignored (as if the user pressed Shift+refresh).
+	ScriptToEvaluateOnLoad *string `json:"scriptToEvaluateOnLoad,omitempty"` // If set, the script will be injected into all frames of the inspected page after reload.
+}
+
+// NewReloadArgs initializes ReloadArgs with the required arguments.
+func NewReloadArgs() *ReloadArgs {
+	args := new(ReloadArgs)
+
+	return args
+}
+
+// SetIgnoreCache sets the IgnoreCache optional argument. If true,
+// browser cache is ignored (as if the user pressed Shift+refresh).
+func (a *ReloadArgs) SetIgnoreCache(ignoreCache bool) *ReloadArgs {
+	a.IgnoreCache = &ignoreCache
+	return a
+}
+
+// SetScriptToEvaluateOnLoad sets the ScriptToEvaluateOnLoad optional argument.
+// If set, the script will be injected into all frames of the inspected
+// page after reload.
+func (a *ReloadArgs) SetScriptToEvaluateOnLoad(scriptToEvaluateOnLoad string) *ReloadArgs {
+	a.ScriptToEvaluateOnLoad = &scriptToEvaluateOnLoad
+	return a
+}
+
+// NavigateArgs represents the arguments for Navigate in the Page domain.
+type NavigateArgs struct {
+	URL string `json:"url"` // URL to navigate the page to.
+}
+
+// NewNavigateArgs initializes NavigateArgs with the required arguments.
+func NewNavigateArgs(url string) *NavigateArgs {
+	args := new(NavigateArgs)
+	args.URL = url
+	return args
+}
+
+// NavigateReply represents the return values for Navigate in the Page domain.
+type NavigateReply struct {
+	FrameID FrameID `json:"frameId"` // Frame id that will be navigated.
+}
+
+// GetNavigationHistoryReply represents the return values for GetNavigationHistory in the Page domain.
+type GetNavigationHistoryReply struct {
+	CurrentIndex int               `json:"currentIndex"` // Index of the current navigation history entry.
+	Entries      []json.RawMessage `json:"entries"`      // Array of navigation history entries.
+}
+
+// GetResourceTreeReply represents the return values for GetResourceTree in the Page domain.
+type GetResourceTreeReply struct {
+	FrameTree json.RawMessage `json:"frameTree"` // Present frame / resource tree structure. Type FrameResourceTree.
+}
+
+// CaptureScreenshotReply represents the return values for CaptureScreenshot in the Page domain.
+type CaptureScreenshotReply struct {
+	Data []byte `json:"data"` // Base64-encoded image data (PNG).
+}
+
+// CanScreencastReply represents the return values for CanScreencast in the Page domain.
+type CanScreencastReply struct {
+	Result bool `json:"result"` // True if screencast is supported.
+}
+
+// StartScreencastArgs represents the arguments for StartScreencast in the Page domain.
+type StartScreencastArgs struct {
+	// Format Image compression format.
+	//
+	// Values: "jpeg", "png".
+	Format    *string `json:"format,omitempty"`
+	Quality   *int    `json:"quality,omitempty"`   // Compression quality from range [0..100].
+	MaxWidth  *int    `json:"maxWidth,omitempty"`  // Maximum screenshot width.
+	MaxHeight *int    `json:"maxHeight,omitempty"` // Maximum screenshot height.
+}
+
+// NewStartScreencastArgs initializes StartScreencastArgs with the required arguments.
+func NewStartScreencastArgs() *StartScreencastArgs {
+	args := new(StartScreencastArgs)
+
+	return args
+}
+
+// SetFormat sets the Format optional argument. Image compression
+// format.
+//
+// Values: "jpeg", "png".
+func (a *StartScreencastArgs) SetFormat(format string) *StartScreencastArgs {
+	a.Format = &format
+	return a
+}
+
+// SetQuality sets the Quality optional argument. Compression quality
+// from range [0..100].
+func (a *StartScreencastArgs) SetQuality(quality int) *StartScreencastArgs {
+	a.Quality = &quality
+	return a
+}
+
+// SetMaxWidth sets the MaxWidth optional argument. Maximum screenshot
+// width.
+func (a *StartScreencastArgs) SetMaxWidth(maxWidth int) *StartScreencastArgs {
+	a.MaxWidth = &maxWidth
+	return a
+}
+
+// SetMaxHeight sets the MaxHeight optional argument. Maximum
+// screenshot height.
+func (a *StartScreencastArgs) SetMaxHeight(maxHeight int) *StartScreencastArgs {
+	a.MaxHeight = &maxHeight
+	return a
+}
+
+// ScreencastFrameAckArgs represents the arguments for ScreencastFrameAck in the Page domain.
+type ScreencastFrameAckArgs struct {
+	FrameNumber int `json:"frameNumber"` // Frame number.
+}
+
+// NewScreencastFrameAckArgs initializes ScreencastFrameAckArgs with the required arguments.
+func NewScreencastFrameAckArgs(frameNumber int) *ScreencastFrameAckArgs {
+	args := new(ScreencastFrameAckArgs)
+	args.FrameNumber = frameNumber
+	return args
+}
+
+// SetShowViewportSizeOnResizeArgs represents the arguments for SetShowViewportSizeOnResize in the Page domain.
+type SetShowViewportSizeOnResizeArgs struct {
+	Show     bool  `json:"show"`               // Whether to paint size or not.
+	ShowGrid *bool `json:"showGrid,omitempty"` // Whether to paint grid as well.
+}
+
+// NewSetShowViewportSizeOnResizeArgs initializes SetShowViewportSizeOnResizeArgs with the required arguments.
+func NewSetShowViewportSizeOnResizeArgs(show bool) *SetShowViewportSizeOnResizeArgs {
+	args := new(SetShowViewportSizeOnResizeArgs)
+	args.Show = show
+	return args
+}
+
+// SetShowGrid sets the ShowGrid optional argument. Whether to paint
+// grid as well.
+func (a *SetShowViewportSizeOnResizeArgs) SetShowGrid(showGrid bool) *SetShowViewportSizeOnResizeArgs {
+	a.ShowGrid = &showGrid
+	return a
+}
@@ -0,0 +1,595 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package page implements the Page domain. Actions and events related to the
+// inspected page belong to the page domain.
+package page
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the Page domain. Actions and events related to
+// the inspected page belong to the page domain.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Page domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the Page method. Enables page domain notifications.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Page.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Page method. Disables page domain notifications.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Page.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// Reload invokes the Page method. Reloads given page optionally ignoring the
+// cache.
+func (d *domainClient) Reload(ctx context.Context, args *ReloadArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.reload", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.reload", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "Reload", Err: err}
+	}
+	return
+}
+
+// Navigate invokes the Page method. Navigates current page to the given URL.
+func (d *domainClient) Navigate(ctx context.Context, args *NavigateArgs) (reply *NavigateReply, err error) {
+	reply = new(NavigateReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.navigate", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.navigate", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "Navigate", Err: err}
+	}
+	return
+}
+
+// GetNavigationHistory invokes the Page method. Returns navigation history
+// for the current page.
+func (d *domainClient) GetNavigationHistory(ctx context.Context) (reply *GetNavigationHistoryReply, err error) {
+	reply = new(GetNavigationHistoryReply)
+	err = rpcc.Invoke(ctx, "Page.getNavigationHistory", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "GetNavigationHistory", Err: err}
+	}
+	return
+}
+
+// GetResourceTree invokes the Page method. Returns present frame / resource
+// tree structure.
+func (d *domainClient) GetResourceTree(ctx context.Context) (reply *GetResourceTreeReply, err error) {
+	reply = new(GetResourceTreeReply)
+	err = rpcc.Invoke(ctx, "Page.getResourceTree", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "GetResourceTree", Err: err}
+	}
+	return
+}
+
+// CaptureScreenshot invokes the Page method. Capture page screenshot.
+func (d *domainClient) CaptureScreenshot(ctx context.Context) (reply *CaptureScreenshotReply, err error) {
+	reply = new(CaptureScreenshotReply)
+	err = rpcc.Invoke(ctx, "Page.captureScreenshot", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "CaptureScreenshot", Err: err}
+	}
+	return
+}
+
+// CanScreencast invokes the Page method. Tells whether screencast is
+// supported.
+func (d *domainClient) CanScreencast(ctx context.Context) (reply *CanScreencastReply, err error) {
+	reply = new(CanScreencastReply)
+	err = rpcc.Invoke(ctx, "Page.canScreencast", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "CanScreencast", Err: err}
+	}
+	return
+}
+
+// StartScreencast invokes the Page method. Starts sending each frame using
+// the screencastFrame event.
+func (d *domainClient) StartScreencast(ctx context.Context, args *StartScreencastArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.startScreencast", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.startScreencast", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "StartScreencast", Err: err}
+	}
+	return
+}
+
+// StopScreencast invokes the Page method. Stops sending each frame in the
+// screencastFrame.
+func (d *domainClient) StopScreencast(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Page.stopScreencast", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "StopScreencast", Err: err}
+	}
+	return
+}
+
+// ScreencastFrameAck invokes the Page method. Acknowledges that a screencast
+// frame has been received by the frontend.
+func (d *domainClient) ScreencastFrameAck(ctx context.Context, args *ScreencastFrameAckArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.screencastFrameAck", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.screencastFrameAck", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "ScreencastFrameAck", Err: err}
+	}
+	return
+}
+
+// SetShowViewportSizeOnResize invokes the Page method. Paints viewport size
+// upon main frame resize.
+func (d *domainClient) SetShowViewportSizeOnResize(ctx context.Context, args *SetShowViewportSizeOnResizeArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Page.setShowViewportSizeOnResize", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Page.setShowViewportSizeOnResize", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Page", Op: "SetShowViewportSizeOnResize", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) DOMContentEventFired(ctx context.Context, opts ...rpcc.StreamOption) (DOMContentEventFiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.domContentEventFired", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &dOMContentEventFiredClient{Stream: s}, nil
+}
+
+// OnDOMContentEventFired registers fn to be called for each
+// DOMContentEventFired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMContentEventFired(fn func(*DOMContentEventFiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.domContentEventFired", d.conn, func(args []byte) error {
+		ev := new(DOMContentEventFiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type dOMContentEventFiredClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *dOMContentEventFiredClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *dOMContentEventFiredClient) Recv() (*DOMContentEventFiredReply, error) {
+	event := new(DOMContentEventFiredReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "DOMContentEventFired Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) LoadEventFired(ctx context.Context, opts ...rpcc.StreamOption) (LoadEventFiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.loadEventFired", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &loadEventFiredClient{Stream: s}, nil
+}
+
+// OnLoadEventFired registers fn to be called for each
+// LoadEventFired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLoadEventFired(fn func(*LoadEventFiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.loadEventFired", d.conn, func(args []byte) error {
+		ev := new(LoadEventFiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type loadEventFiredClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *loadEventFiredClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *loadEventFiredClient) Recv() (*LoadEventFiredReply, error) {
+	event := new(LoadEventFiredReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "LoadEventFired Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameAttached(ctx context.Context, opts ...rpcc.StreamOption) (FrameAttachedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameAttached", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameAttachedClient{Stream: s}, nil
+}
+
+// OnFrameAttached registers fn to be called for each
+// FrameAttached event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameAttached(fn func(*FrameAttachedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameAttached", d.conn, func(args []byte) error {
+		ev := new(FrameAttachedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameAttachedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameAttachedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameAttachedClient) Recv() (*FrameAttachedReply, error) {
+	event := new(FrameAttachedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameAttached Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameNavigated(ctx context.Context, opts ...rpcc.StreamOption) (FrameNavigatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameNavigated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameNavigatedClient{Stream: s}, nil
+}
+
+// OnFrameNavigated registers fn to be called for each
+// FrameNavigated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameNavigated(fn func(*FrameNavigatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameNavigated", d.conn, func(args []byte) error {
+		ev := new(FrameNavigatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameNavigatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameNavigatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameNavigatedClient) Recv() (*FrameNavigatedReply, error) {
+	event := new(FrameNavigatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameNavigated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameDetached(ctx context.Context, opts ...rpcc.StreamOption) (FrameDetachedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameDetached", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameDetachedClient{Stream: s}, nil
+}
+
+// OnFrameDetached registers fn to be called for each
+// FrameDetached event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameDetached(fn func(*FrameDetachedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameDetached", d.conn, func(args []byte) error {
+		ev := new(FrameDetachedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameDetachedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameDetachedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameDetachedClient) Recv() (*FrameDetachedReply, error) {
+	event := new(FrameDetachedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameDetached Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameStartedLoading(ctx context.Context, opts ...rpcc.StreamOption) (FrameStartedLoadingClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameStartedLoading", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameStartedLoadingClient{Stream: s}, nil
+}
+
+// OnFrameStartedLoading registers fn to be called for each
+// FrameStartedLoading event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameStartedLoading(fn func(*FrameStartedLoadingReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameStartedLoading", d.conn, func(args []byte) error {
+		ev := new(FrameStartedLoadingReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameStartedLoadingClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameStartedLoadingClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameStartedLoadingClient) Recv() (*FrameStartedLoadingReply, error) {
+	event := new(FrameStartedLoadingReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameStartedLoading Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameStoppedLoading(ctx context.Context, opts ...rpcc.StreamOption) (FrameStoppedLoadingClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameStoppedLoading", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameStoppedLoadingClient{Stream: s}, nil
+}
+
+// OnFrameStoppedLoading registers fn to be called for each
+// FrameStoppedLoading event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameStoppedLoading(fn func(*FrameStoppedLoadingReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameStoppedLoading", d.conn, func(args []byte) error {
+		ev := new(FrameStoppedLoadingReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameStoppedLoadingClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameStoppedLoadingClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameStoppedLoadingClient) Recv() (*FrameStoppedLoadingReply, error) {
+	event := new(FrameStoppedLoadingReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameStoppedLoading Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameScheduledNavigation(ctx context.Context, opts ...rpcc.StreamOption) (FrameScheduledNavigationClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameScheduledNavigation", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameScheduledNavigationClient{Stream: s}, nil
+}
+
+// OnFrameScheduledNavigation registers fn to be called for each
+// FrameScheduledNavigation event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameScheduledNavigation(fn func(*FrameScheduledNavigationReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameScheduledNavigation", d.conn, func(args []byte) error {
+		ev := new(FrameScheduledNavigationReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameScheduledNavigationClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameScheduledNavigationClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameScheduledNavigationClient) Recv() (*FrameScheduledNavigationReply, error) {
+	event := new(FrameScheduledNavigationReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameScheduledNavigation Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameClearedScheduledNavigation(ctx context.Context, opts ...rpcc.StreamOption) (FrameClearedScheduledNavigationClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameClearedScheduledNavigation", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameClearedScheduledNavigationClient{Stream: s}, nil
+}
+
+// OnFrameClearedScheduledNavigation registers fn to be called for each
+// FrameClearedScheduledNavigation event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameClearedScheduledNavigation(fn func(*FrameClearedScheduledNavigationReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameClearedScheduledNavigation", d.conn, func(args []byte) error {
+		ev := new(FrameClearedScheduledNavigationReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameClearedScheduledNavigationClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameClearedScheduledNavigationClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameClearedScheduledNavigationClient) Recv() (*FrameClearedScheduledNavigationReply, error) {
+	event := new(FrameClearedScheduledNavigationReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameClearedScheduledNavigation Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) FrameResized(ctx context.Context, opts ...rpcc.StreamOption) (FrameResizedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameResized", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &frameResizedClient{Stream: s}, nil
+}
+
+// OnFrameResized registers fn to be called for each
+// FrameResized event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameResized(fn func(*FrameResizedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameResized", d.conn, func(args []byte) error {
+		ev := new(FrameResizedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type frameResizedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *frameResizedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *frameResizedClient) Recv() (*FrameResizedReply, error) {
+	event := new(FrameResizedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "FrameResized Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ScreencastFrame(ctx context.Context, opts ...rpcc.StreamOption) (ScreencastFrameClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.screencastFrame", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &screencastFrameClient{Stream: s}, nil
+}
+
+// OnScreencastFrame registers fn to be called for each
+// ScreencastFrame event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScreencastFrame(fn func(*ScreencastFrameReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.screencastFrame", d.conn, func(args []byte) error {
+		ev := new(ScreencastFrameReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type screencastFrameClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *screencastFrameClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *screencastFrameClient) Recv() (*ScreencastFrameReply, error) {
+	event := new(ScreencastFrameReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "ScreencastFrame Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ScreencastVisibilityChanged(ctx context.Context, opts ...rpcc.StreamOption) (ScreencastVisibilityChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.screencastVisibilityChanged", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &screencastVisibilityChangedClient{Stream: s}, nil
+}
+
+// OnScreencastVisibilityChanged registers fn to be called for each
+// ScreencastVisibilityChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScreencastVisibilityChanged(fn func(*ScreencastVisibilityChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.screencastVisibilityChanged", d.conn, func(args []byte) error {
+		ev := new(ScreencastVisibilityChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type screencastVisibilityChangedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *screencastVisibilityChangedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *screencastVisibilityChangedClient) Recv() (*ScreencastVisibilityChangedReply, error) {
+	event := new(ScreencastVisibilityChangedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Page", Op: "ScreencastVisibilityChanged Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// DOMContentEventFiredClient is a client for DOMContentEventFired events.
+type DOMContentEventFiredClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DOMContentEventFiredReply, error)
+	rpcc.Stream
+}
+
+// DOMContentEventFiredReply is the reply for DOMContentEventFired events.
+type DOMContentEventFiredReply struct {
+	Timestamp float64 `json:"timestamp"` // No description.
+}
+
+// LoadEventFiredClient is a client for LoadEventFired events.
+type LoadEventFiredClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*LoadEventFiredReply, error)
+	rpcc.Stream
+}
+
+// LoadEventFiredReply is the reply for LoadEventFired events.
+type LoadEventFiredReply struct {
+	Timestamp float64 `json:"timestamp"` // No description.
+}
+
+// FrameAttachedClient is a client for FrameAttached events. Fired when frame
+// has been attached to its parent.
+type FrameAttachedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameAttachedReply, error)
+	rpcc.Stream
+}
+
+// FrameAttachedReply is the reply for FrameAttached events.
+type FrameAttachedReply struct {
+	FrameID       FrameID `json:"frameId"`       // Id of the frame that has been attached.
+	ParentFrameID FrameID `json:"parentFrameId"` // Parent frame identifier.
+}
+
+// FrameNavigatedClient is a client for FrameNavigated events. Fired once
+// navigation of the frame has completed. Frame is now associated with the new
+// loader.
+type FrameNavigatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameNavigatedReply, error)
+	rpcc.Stream
+}
+
+// FrameNavigatedReply is the reply for FrameNavigated events.
+type FrameNavigatedReply struct {
+	Frame Frame `json:"frame"` // Frame object.
+}
+
+// FrameDetachedClient is a client for FrameDetached events. Fired when frame
+// has been detached from its parent.
+type FrameDetachedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameDetachedReply, error)
+	rpcc.Stream
+}
+
+// FrameDetachedReply is the reply for FrameDetached events.
+type FrameDetachedReply struct {
+	FrameID FrameID `json:"frameId"` // Id of the frame that has been detached.
+}
+
+// FrameStartedLoadingClient is a client for FrameStartedLoading events. Fired
+// when frame has started loading.
+type FrameStartedLoadingClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameStartedLoadingReply, error)
+	rpcc.Stream
+}
+
+// FrameStartedLoadingReply is the reply for FrameStartedLoading events.
+type FrameStartedLoadingReply struct {
+	FrameID FrameID `json:"frameId"` // Id of the frame that has started loading.
+}
+
+// FrameStoppedLoadingClient is a client for FrameStoppedLoading events. Fired
+// when frame has stopped loading.
+type FrameStoppedLoadingClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameStoppedLoadingReply, error)
+	rpcc.Stream
+}
+
+// FrameStoppedLoadingReply is the reply for FrameStoppedLoading events.
+type FrameStoppedLoadingReply struct {
+	FrameID FrameID `json:"frameId"` // Id of the frame that has stopped loading.
+}
+
+// FrameScheduledNavigationClient is a client for FrameScheduledNavigation events.
+// Fired when frame schedules a potential navigation.
+type FrameScheduledNavigationClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameScheduledNavigationReply, error)
+	rpcc.Stream
+}
+
+// FrameScheduledNavigationReply is the reply for FrameScheduledNavigation events.
+type FrameScheduledNavigationReply struct {
+	FrameID FrameID `json:"frameId"` // Id of the frame that has scheduled a navigation.
+	Delay   float64 `json:"delay"`   // Delay (in seconds) until the navigation is scheduled to begin. The navigation is not guaranteed to start.
+}
+
+// FrameClearedScheduledNavigationClient is a client for FrameClearedScheduledNavigation events.
+// Fired when frame no longer has a scheduled navigation.
+type FrameClearedScheduledNavigationClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameClearedScheduledNavigationReply, error)
+	rpcc.Stream
+}
+
+// FrameClearedScheduledNavigationReply is the reply for FrameClearedScheduledNavigation events.
+type FrameClearedScheduledNavigationReply struct {
+	FrameID FrameID `json:"frameId"` // Id of the frame that has cleared its scheduled navigation.
+}
+
+// FrameResizedClient is a client for FrameResized events.
+type FrameResizedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*FrameResizedReply, error)
+	rpcc.Stream
+}
+
+// FrameResizedReply is the reply for FrameResized events.
+type FrameResizedReply struct {
+}
+
+// ScreencastFrameClient is a client for ScreencastFrame events. Compressed
+// image data requested by the startScreencast.
+type ScreencastFrameClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ScreencastFrameReply, error)
+	rpcc.Stream
+}
+
+// ScreencastFrameReply is the reply for ScreencastFrame events.
+type ScreencastFrameReply struct {
+	Data        []byte                  `json:"data"`                  // Base64-encoded compressed image.
+	Metadata    ScreencastFrameMetadata `json:"metadata"`              // Screencast frame metadata.
+	FrameNumber *int                    `json:"frameNumber,omitempty"` // Frame number.
+}
+
+// ScreencastVisibilityChangedClient is a client for ScreencastVisibilityChanged events.
+// Fired when the page with currently enabled screencast was shown or hidden .
+type ScreencastVisibilityChangedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ScreencastVisibilityChangedReply, error)
+	rpcc.Stream
+}
+
+// ScreencastVisibilityChangedReply is the reply for ScreencastVisibilityChanged events.
+type ScreencastVisibilityChangedReply struct {
+	Visible bool `json:"visible"` // True if the page is visible.
+}
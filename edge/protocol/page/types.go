@@ -0,0 +1,67 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+)
+
+// ResourceType Resource type as it was perceived by the rendering engine.
+type ResourceType string
+
+// ResourceType as enums.
+const (
+	ResourceTypeNotSet      ResourceType = ""
+	ResourceTypeDocument    ResourceType = "Document"
+	ResourceTypeStylesheet  ResourceType = "Stylesheet"
+	ResourceTypeImage       ResourceType = "Image"
+	ResourceTypeMedia       ResourceType = "Media"
+	ResourceTypeFont        ResourceType = "Font"
+	ResourceTypeScript      ResourceType = "Script"
+	ResourceTypeTextTrack   ResourceType = "TextTrack"
+	ResourceTypeXHR         ResourceType = "XHR"
+	ResourceTypeFetch       ResourceType = "Fetch"
+	ResourceTypeEventSource ResourceType = "EventSource"
+	ResourceTypeWebSocket   ResourceType = "WebSocket"
+	ResourceTypeOther       ResourceType = "Other"
+)
+
+func (e ResourceType) Valid() bool {
+	switch e {
+	case "Document", "Stylesheet", "Image", "Media", "Font", "Script", "TextTrack", "XHR", "Fetch", "EventSource", "WebSocket", "Other":
+		return true
+	default:
+		return false
+	}
+}
+
+func (e ResourceType) String() string {
+	return string(e)
+}
+
+// FrameID Unique frame identifier.
+type FrameID = internal.PageFrameID
+
+// Frame Information about the Frame on the page.
+type Frame struct {
+	ID             FrameID         `json:"id"`                 // Frame unique identifier.
+	ParentID       *FrameID        `json:"parentId,omitempty"` // Parent frame identifier.
+	LoaderID       json.RawMessage `json:"loaderId"`           // Identifier of the loader associated with this frame. Type Network.LoaderId.
+	Name           *string         `json:"name,omitempty"`     // Frame's name as specified in the tag.
+	URL            string          `json:"url"`                // Frame document's URL.
+	SecurityOrigin string          `json:"securityOrigin"`     // Frame document's security origin.
+	MimeType       string          `json:"mimeType"`           // Frame document's mimeType as determined by the browser.
+}
+
+// ScreencastFrameMetadata Screencast frame metadata
+type ScreencastFrameMetadata struct {
+	OffsetTop       float64  `json:"offsetTop"`           // Top offset in DIP.
+	PageScaleFactor float64  `json:"pageScaleFactor"`     // Page scale factor.
+	DeviceWidth     float64  `json:"deviceWidth"`         // Device screen width in DIP.
+	DeviceHeight    float64  `json:"deviceHeight"`        // Device screen height in DIP.
+	ScrollOffsetX   float64  `json:"scrollOffsetX"`       // Position of horizontal scroll in CSS pixels.
+	ScrollOffsetY   float64  `json:"scrollOffsetY"`       // Position of vertical scroll in CSS pixels.
+	Timestamp       *float64 `json:"timestamp,omitempty"` // Frame swap timestamp.
+}
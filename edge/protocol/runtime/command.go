@@ -0,0 +1,180 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"github.com/mafredri/cdp/edge/protocol/debugger"
+)
+
+// EvaluateArgs represents the arguments for Evaluate in the Runtime domain.
+type EvaluateArgs struct {
+	Expression                           string              `json:"expression"`                                     // Expression to evaluate.
+	ObjectGroup                          *string             `json:"objectGroup,omitempty"`                          // Symbolic group name that can be used to release multiple objects.
+	IncludeCommandLineAPI                *bool               `json:"includeCommandLineAPI,omitempty"`                // Determines whether Command Line API should be available during the evaluation.
+	DoNotPauseOnExceptionsAndMuteConsole *bool               `json:"doNotPauseOnExceptionsAndMuteConsole,omitempty"` // Specifies whether evaluation should stop on exceptions and mute console. Overrides setPauseOnException state.
+	ContextID                            *ExecutionContextID `json:"contextId,omitempty"`                            // Specifies in which isolated context to perform evaluation. Each content script lives in an isolated context and this parameter may be used to specify one of those contexts. If the parameter is omitted or 0 the evaluation will be performed in the context of the inspected page.
+	ReturnByValue                        *bool               `json:"returnByValue,omitempty"`                        // Whether the result is expected to be a JSON object that should be sent by value.
+	GeneratePreview                      *bool               `json:"generatePreview,omitempty"`                      // Whether preview should be generated for the result.
+}
+
+// NewEvaluateArgs initializes EvaluateArgs with the required arguments.
+func NewEvaluateArgs(expression string) *EvaluateArgs {
+	args := new(EvaluateArgs)
+	args.Expression = expression
+	return args
+}
+
+// SetObjectGroup sets the ObjectGroup optional argument. Symbolic
+// group name that can be used to release multiple objects.
+func (a *EvaluateArgs) SetObjectGroup(objectGroup string) *EvaluateArgs {
+	a.ObjectGroup = &objectGroup
+	return a
+}
+
+// SetIncludeCommandLineAPI sets the IncludeCommandLineAPI optional argument.
+// Determines whether Command Line API should be available during the
+// evaluation.
+func (a *EvaluateArgs) SetIncludeCommandLineAPI(includeCommandLineAPI bool) *EvaluateArgs {
+	a.IncludeCommandLineAPI = &includeCommandLineAPI
+	return a
+}
+
+// SetDoNotPauseOnExceptionsAndMuteConsole sets the DoNotPauseOnExceptionsAndMuteConsole optional argument.
+// Specifies whether evaluation should stop on exceptions and mute
+// console. Overrides setPauseOnException state.
+func (a *EvaluateArgs) SetDoNotPauseOnExceptionsAndMuteConsole(doNotPauseOnExceptionsAndMuteConsole bool) *EvaluateArgs {
+	a.DoNotPauseOnExceptionsAndMuteConsole = &doNotPauseOnExceptionsAndMuteConsole
+	return a
+}
+
+// SetContextID sets the ContextID optional argument. Specifies in
+// which isolated context to perform evaluation. Each content script
+// lives in an isolated context and this parameter may be used to
+// specify one of those contexts. If the parameter is omitted or 0 the
+// evaluation will be performed in the context of the inspected page.
+func (a *EvaluateArgs) SetContextID(contextID ExecutionContextID) *EvaluateArgs {
+	a.ContextID = &contextID
+	return a
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object that should be sent by
+// value.
+func (a *EvaluateArgs) SetReturnByValue(returnByValue bool) *EvaluateArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+func (a *EvaluateArgs) SetGeneratePreview(generatePreview bool) *EvaluateArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// EvaluateReply represents the return values for Evaluate in the Runtime domain.
+type EvaluateReply struct {
+	Result           RemoteObject               `json:"result"`                     // Evaluation result.
+	WasThrown        *bool                      `json:"wasThrown,omitempty"`        // True if the result was thrown during the evaluation.
+	ExceptionDetails *debugger.ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
+}
+
+// CallFunctionOnArgs represents the arguments for CallFunctionOn in the Runtime domain.
+type CallFunctionOnArgs struct {
+	ObjectID                             RemoteObjectID `json:"objectId"`                                       // Identifier of the object to call function on.
+	FunctionDeclaration                  string         `json:"functionDeclaration"`                            // Declaration of the function to call.
+	Arguments                            []CallArgument `json:"arguments,omitempty"`                            // Call arguments. All call arguments must belong to the same JavaScript world as the target object.
+	DoNotPauseOnExceptionsAndMuteConsole *bool          `json:"doNotPauseOnExceptionsAndMuteConsole,omitempty"` // Specifies whether function call should stop on exceptions and mute console. Overrides setPauseOnException state.
+	ReturnByValue                        *bool          `json:"returnByValue,omitempty"`                        // Whether the result is expected to be a JSON object which should be sent by value.
+	GeneratePreview                      *bool          `json:"generatePreview,omitempty"`                      // Whether preview should be generated for the result.
+}
+
+// NewCallFunctionOnArgs initializes CallFunctionOnArgs with the required arguments.
+func NewCallFunctionOnArgs(objectID RemoteObjectID, functionDeclaration string) *CallFunctionOnArgs {
+	args := new(CallFunctionOnArgs)
+	args.ObjectID = objectID
+	args.FunctionDeclaration = functionDeclaration
+	return args
+}
+
+// SetArguments sets the Arguments optional argument. Call arguments.
+// All call arguments must belong to the same JavaScript world as the
+// target object.
+func (a *CallFunctionOnArgs) SetArguments(arguments []CallArgument) *CallFunctionOnArgs {
+	a.Arguments = arguments
+	return a
+}
+
+// SetDoNotPauseOnExceptionsAndMuteConsole sets the DoNotPauseOnExceptionsAndMuteConsole optional argument.
+// Specifies whether function call should stop on exceptions and mute
+// console. Overrides setPauseOnException state.
+func (a *CallFunctionOnArgs) SetDoNotPauseOnExceptionsAndMuteConsole(doNotPauseOnExceptionsAndMuteConsole bool) *CallFunctionOnArgs {
+	a.DoNotPauseOnExceptionsAndMuteConsole = &doNotPauseOnExceptionsAndMuteConsole
+	return a
+}
+
+// SetReturnByValue sets the ReturnByValue optional argument. Whether
+// the result is expected to be a JSON object which should be sent by
+// value.
+func (a *CallFunctionOnArgs) SetReturnByValue(returnByValue bool) *CallFunctionOnArgs {
+	a.ReturnByValue = &returnByValue
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the result.
+func (a *CallFunctionOnArgs) SetGeneratePreview(generatePreview bool) *CallFunctionOnArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// CallFunctionOnReply represents the return values for CallFunctionOn in the Runtime domain.
+type CallFunctionOnReply struct {
+	Result    RemoteObject `json:"result"`              // Call result.
+	WasThrown *bool        `json:"wasThrown,omitempty"` // True if the result was thrown during the evaluation.
+}
+
+// GetPropertiesArgs represents the arguments for GetProperties in the Runtime domain.
+type GetPropertiesArgs struct {
+	ObjectID               RemoteObjectID `json:"objectId"`                         // Identifier of the object to return properties for.
+	OwnProperties          *bool          `json:"ownProperties,omitempty"`          // If true, returns properties belonging only to the element itself, not to its prototype chain.
+	AccessorPropertiesOnly *bool          `json:"accessorPropertiesOnly,omitempty"` // If true, returns accessor properties (with getter/setter) only; internal properties are not returned either.
+	GeneratePreview        *bool          `json:"generatePreview,omitempty"`        // Whether preview should be generated for the results.
+}
+
+// NewGetPropertiesArgs initializes GetPropertiesArgs with the required arguments.
+func NewGetPropertiesArgs(objectID RemoteObjectID) *GetPropertiesArgs {
+	args := new(GetPropertiesArgs)
+	args.ObjectID = objectID
+	return args
+}
+
+// SetOwnProperties sets the OwnProperties optional argument. If true,
+// returns properties belonging only to the element itself, not to its
+// prototype chain.
+func (a *GetPropertiesArgs) SetOwnProperties(ownProperties bool) *GetPropertiesArgs {
+	a.OwnProperties = &ownProperties
+	return a
+}
+
+// SetAccessorPropertiesOnly sets the AccessorPropertiesOnly optional argument.
+// If true, returns accessor properties (with getter/setter) only;
+// internal properties are not returned either.
+func (a *GetPropertiesArgs) SetAccessorPropertiesOnly(accessorPropertiesOnly bool) *GetPropertiesArgs {
+	a.AccessorPropertiesOnly = &accessorPropertiesOnly
+	return a
+}
+
+// SetGeneratePreview sets the GeneratePreview optional argument.
+// Whether preview should be generated for the results.
+func (a *GetPropertiesArgs) SetGeneratePreview(generatePreview bool) *GetPropertiesArgs {
+	a.GeneratePreview = &generatePreview
+	return a
+}
+
+// GetPropertiesReply represents the return values for GetProperties in the Runtime domain.
+type GetPropertiesReply struct {
+	Result             []PropertyDescriptor         `json:"result"`                       // Object properties.
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"` // Internal object properties (only of the element itself).
+	ExceptionDetails   *debugger.ExceptionDetails   `json:"exceptionDetails,omitempty"`   // Exception details.
+}
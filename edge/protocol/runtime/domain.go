@@ -0,0 +1,217 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package runtime implements the Runtime domain. Runtime domain exposes
+// JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type,
+// string representation and unique identifier that can be used for further
+// object reference. Original objects are maintained in memory unless they are
+// either explicitly released or are released along with the other objects in
+// their object group.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/edge/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the Runtime domain. Runtime domain exposes
+// JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type,
+// string representation and unique identifier that can be used for further
+// object reference. Original objects are maintained in memory unless they are
+// either explicitly released or are released along with the other objects in
+// their object group.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Runtime domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Evaluate invokes the Runtime method. Evaluates expression on global object.
+func (d *domainClient) Evaluate(ctx context.Context, args *EvaluateArgs) (reply *EvaluateReply, err error) {
+	reply = new(EvaluateReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Runtime.evaluate", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Runtime.evaluate", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "Evaluate", Err: err}
+	}
+	return
+}
+
+// CallFunctionOn invokes the Runtime method. Calls function with given
+// declaration on the given object. Object group of the result is inherited
+// from the target object.
+func (d *domainClient) CallFunctionOn(ctx context.Context, args *CallFunctionOnArgs) (reply *CallFunctionOnReply, err error) {
+	reply = new(CallFunctionOnReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Runtime.callFunctionOn", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Runtime.callFunctionOn", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "CallFunctionOn", Err: err}
+	}
+	return
+}
+
+// GetProperties invokes the Runtime method. Returns properties of a given
+// object. Object group of the result is inherited from the target object.
+func (d *domainClient) GetProperties(ctx context.Context, args *GetPropertiesArgs) (reply *GetPropertiesReply, err error) {
+	reply = new(GetPropertiesReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Runtime.getProperties", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Runtime.getProperties", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "GetProperties", Err: err}
+	}
+	return
+}
+
+// Run invokes the Runtime method. Tells inspected instance(worker or page)
+// that it can run in case it was started paused.
+func (d *domainClient) Run(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Runtime.run", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "Run", Err: err}
+	}
+	return
+}
+
+// Enable invokes the Runtime method. Enables reporting of execution contexts
+// creation by means of executionContextCreated event. When the reporting gets
+// enabled the event will be sent immediately for each existing execution
+// context.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Runtime.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Runtime method. Disables reporting of execution
+// contexts creation.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Runtime.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Runtime", Op: "Disable", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) ExecutionContextCreated(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextCreated", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &executionContextCreatedClient{Stream: s}, nil
+}
+
+// OnExecutionContextCreated registers fn to be called for each
+// ExecutionContextCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextCreated(fn func(*ExecutionContextCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextCreated", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type executionContextCreatedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *executionContextCreatedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *executionContextCreatedClient) Recv() (*ExecutionContextCreatedReply, error) {
+	event := new(ExecutionContextCreatedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Runtime", Op: "ExecutionContextCreated Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ExecutionContextDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextDestroyed", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &executionContextDestroyedClient{Stream: s}, nil
+}
+
+// OnExecutionContextDestroyed registers fn to be called for each
+// ExecutionContextDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextDestroyed(fn func(*ExecutionContextDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextDestroyed", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type executionContextDestroyedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *executionContextDestroyedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *executionContextDestroyedClient) Recv() (*ExecutionContextDestroyedReply, error) {
+	event := new(ExecutionContextDestroyedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Runtime", Op: "ExecutionContextDestroyed Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ExecutionContextsCleared(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextsClearedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextsCleared", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &executionContextsClearedClient{Stream: s}, nil
+}
+
+// OnExecutionContextsCleared registers fn to be called for each
+// ExecutionContextsCleared event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextsCleared(fn func(*ExecutionContextsClearedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextsCleared", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextsClearedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type executionContextsClearedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *executionContextsClearedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *executionContextsClearedClient) Recv() (*ExecutionContextsClearedReply, error) {
+	event := new(ExecutionContextsClearedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Runtime", Op: "ExecutionContextsCleared Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,48 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// ExecutionContextCreatedClient is a client for ExecutionContextCreated events.
+// Issued when new execution context is created.
+type ExecutionContextCreatedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ExecutionContextCreatedReply, error)
+	rpcc.Stream
+}
+
+// ExecutionContextCreatedReply is the reply for ExecutionContextCreated events.
+type ExecutionContextCreatedReply struct {
+	Context ExecutionContextDescription `json:"context"` // A newly created execution contex.
+}
+
+// ExecutionContextDestroyedClient is a client for ExecutionContextDestroyed events.
+// Issued when execution context is destroyed.
+type ExecutionContextDestroyedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ExecutionContextDestroyedReply, error)
+	rpcc.Stream
+}
+
+// ExecutionContextDestroyedReply is the reply for ExecutionContextDestroyed events.
+type ExecutionContextDestroyedReply struct {
+	ExecutionContextID ExecutionContextID `json:"executionContextId"` // Id of the destroyed context
+}
+
+// ExecutionContextsClearedClient is a client for ExecutionContextsCleared events.
+// Issued when all executionContexts were cleared in browser
+type ExecutionContextsClearedClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ExecutionContextsClearedReply, error)
+	rpcc.Stream
+}
+
+// ExecutionContextsClearedReply is the reply for ExecutionContextsCleared events.
+type ExecutionContextsClearedReply struct {
+}
@@ -0,0 +1,79 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+)
+
+// RemoteObjectID Unique object identifier.
+type RemoteObjectID string
+
+// RemoteObject Mirror object referencing original JavaScript object.
+type RemoteObject struct {
+	// Type Object type.
+	//
+	// Values: "object", "function", "undefined", "string", "number", "boolean", "symbol".
+	Type string `json:"type"`
+	// Subtype Object subtype hint. Specified for object type values only.
+	//
+	// Values: "array", "null", "node", "regexp", "date", "map", "set", "iterator", "generator", "error".
+	Subtype       *string         `json:"subtype,omitempty"`
+	ClassName     *string         `json:"className,omitempty"`     // Object class (constructor) name. Specified for object type values only.
+	Value         json.RawMessage `json:"value,omitempty"`         // Remote object value in case of primitive values or JSON values (if it was requested), or description string if the value can not be JSON-stringified (like NaN, Infinity, -Infinity, -0).
+	Description   *string         `json:"description,omitempty"`   // String representation of the object.
+	ObjectID      *RemoteObjectID `json:"objectId,omitempty"`      // Unique object identifier (for non-primitive values).
+	Preview       json.RawMessage `json:"preview,omitempty"`       // Preview containing abbreviated property values. Specified for object type values only. Type ObjectPreview.
+	CustomPreview json.RawMessage `json:"customPreview,omitempty"` // Type CustomPreview.
+}
+
+// EntryPreview
+type EntryPreview struct {
+	Key   json.RawMessage `json:"key,omitempty"` // Preview of the key. Specified for map-like collection entries. Type ObjectPreview.
+	Value json.RawMessage `json:"value"`         // Preview of the value. Type ObjectPreview.
+}
+
+// PropertyDescriptor Object property descriptor.
+type PropertyDescriptor struct {
+	Name         string        `json:"name"`                // Property name or symbol description.
+	Value        *RemoteObject `json:"value,omitempty"`     // The value associated with the property.
+	Writable     *bool         `json:"writable,omitempty"`  // True if the value associated with the property may be changed (data descriptors only).
+	Get          *RemoteObject `json:"get,omitempty"`       // A function which serves as a getter for the property, or undefined if there is no getter (accessor descriptors only).
+	Set          *RemoteObject `json:"set,omitempty"`       // A function which serves as a setter for the property, or undefined if there is no setter (accessor descriptors only).
+	Configurable bool          `json:"configurable"`        // True if the type of this property descriptor may be changed and if the property may be deleted from the corresponding object.
+	Enumerable   bool          `json:"enumerable"`          // True if this property shows up during enumeration of the properties on the corresponding object.
+	WasThrown    *bool         `json:"wasThrown,omitempty"` // True if the result was thrown during the evaluation.
+	IsOwn        *bool         `json:"isOwn,omitempty"`     // True if the property is owned for the object.
+	Symbol       *RemoteObject `json:"symbol,omitempty"`    // Property symbol object, if the property is of the symbol type.
+}
+
+// InternalPropertyDescriptor Object internal property descriptor. This
+// property isn't normally visible in JavaScript code.
+type InternalPropertyDescriptor struct {
+	Name  string        `json:"name"`            // Conventional property name.
+	Value *RemoteObject `json:"value,omitempty"` // The value associated with the property.
+}
+
+// CallArgument Represents function call argument. Either remote object id
+// objectId or primitive value or neither of (for undefined) them should be
+// specified.
+type CallArgument struct {
+	Value    json.RawMessage `json:"value,omitempty"`    // Primitive value, or description string if the value can not be JSON-stringified (like NaN, Infinity, -Infinity, -0).
+	ObjectID *RemoteObjectID `json:"objectId,omitempty"` // Remote object handle.
+	// Type Object type.
+	//
+	// Values: "object", "function", "undefined", "string", "number", "boolean", "symbol".
+	Type *string `json:"type,omitempty"`
+}
+
+// ExecutionContextID Id of an execution context.
+type ExecutionContextID int
+
+// ExecutionContextDescription Description of an isolated world.
+type ExecutionContextDescription struct {
+	ID      ExecutionContextID `json:"id"`             // Unique id of the execution context. It can be used to specify in which execution context script evaluation should be performed.
+	Type    *string            `json:"type,omitempty"` // Context type. It is used e.g. to distinguish content scripts from web page script.
+	Origin  string             `json:"origin"`         // Execution context origin.
+	Name    string             `json:"name"`           // Human readable name describing given context.
+	FrameID string             `json:"frameId"`        // Id of the owning frame. May be an empty string if the context is not associated with a frame.
+}
@@ -0,0 +1,485 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+)
+
+// ErrStaleElement indicates that an ElementHandle refers to a node that
+// was removed from the document, or to a document that was replaced
+// (e.g. by a navigation). Use errors.Is to check for ErrStaleElement.
+var ErrStaleElement = errors.New("cdp: stale element")
+
+// ErrElementNotFound is returned by Query when no element matches.
+var ErrElementNotFound = errors.New("cdp: element not found")
+
+// StaleElementError is returned by ElementHandle methods when the
+// element is stale.
+type StaleElementError struct {
+	Op  string // The operation, e.g. "Click".
+	Err error  // The underlying error, if any.
+}
+
+func (e *StaleElementError) Error() string {
+	msg := fmt.Sprintf("cdp: ElementHandle.%s: element is stale (removed from the document or the document was replaced)", e.Op)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Is implements errors.Is for ErrStaleElement.
+func (e *StaleElementError) Is(target error) bool { return target == ErrStaleElement }
+
+// Unwrap returns the underlying error.
+func (e *StaleElementError) Unwrap() error { return e.Err }
+
+// staleMarker is thrown by functions called on elements that are no
+// longer connected to the document.
+const staleMarker = "cdp: element is not connected"
+
+// ElementHandle represents an element in the page. It is a reference to
+// the JavaScript object of the element (Runtime.RemoteObjectID) and its
+// DOM node (dom.BackendNodeID). Node IDs (dom.NodeID) are not used,
+// queries do not request the document (DOM.getDocument) and do not
+// invalidate the node IDs held by the caller.
+//
+// Handles become stale when the element is removed from the document
+// or the document is replaced, methods then return a
+// *StaleElementError. Release must be called when the handle is no
+// longer used.
+type ElementHandle struct {
+	c             *Client
+	objectID      runtime.RemoteObjectID
+	backendNodeID dom.BackendNodeID
+}
+
+// ObjectID returns the ID of the JavaScript object of the element.
+func (h *ElementHandle) ObjectID() runtime.RemoteObjectID { return h.objectID }
+
+// BackendNodeID returns the backend node ID of the element.
+func (h *ElementHandle) BackendNodeID() dom.BackendNodeID { return h.backendNodeID }
+
+// document returns a handle for the document.
+func document(ctx context.Context, c *Client) (*ElementHandle, error) {
+	reply, err := c.Runtime.Evaluate(ctx, runtime.NewEvaluateArgs("document"))
+	if err != nil {
+		return nil, err
+	}
+	if reply.Result.ObjectID == nil {
+		return nil, errors.New("cdp: document could not be resolved")
+	}
+	objectID := *reply.Result.ObjectID
+	desc, err := c.DOM.DescribeNode(ctx, dom.NewDescribeNodeArgs().SetObjectID(objectID))
+	if err != nil {
+		c.Runtime.ReleaseObject(ctx, runtime.NewReleaseObjectArgs(objectID))
+		return nil, err
+	}
+	return &ElementHandle{c: c, objectID: objectID, backendNodeID: desc.Node.BackendNodeID}, nil
+}
+
+// Query returns the first element in the document that matches the
+// CSS selector, or ErrElementNotFound.
+func Query(ctx context.Context, c *Client, selector string) (*ElementHandle, error) {
+	doc, err := document(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Release(ctx)
+	return doc.Query(ctx, selector)
+}
+
+// QueryAll returns all elements in the document that match the CSS
+// selector.
+func QueryAll(ctx context.Context, c *Client, selector string) ([]*ElementHandle, error) {
+	doc, err := document(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Release(ctx)
+	return doc.QueryAll(ctx, selector)
+}
+
+// QueryXPath returns the first element in the document that matches
+// the XPath expression, or ErrElementNotFound.
+func QueryXPath(ctx context.Context, c *Client, expr string) (*ElementHandle, error) {
+	doc, err := document(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Release(ctx)
+	return doc.QueryXPath(ctx, expr)
+}
+
+// QueryAllXPath returns all elements in the document that match the
+// XPath expression.
+func QueryAllXPath(ctx context.Context, c *Client, expr string) ([]*ElementHandle, error) {
+	doc, err := document(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer doc.Release(ctx)
+	return doc.QueryAllXPath(ctx, expr)
+}
+
+// Query returns the first descendant of the element that matches the
+// CSS selector (querySelector), or ErrElementNotFound.
+func (h *ElementHandle) Query(ctx context.Context, selector string) (*ElementHandle, error) {
+	return first(h.query(ctx, "Query", selectorFunc, selector, true))
+}
+
+// QueryAll returns all descendants of the element that match the CSS
+// selector (querySelectorAll), in document order.
+func (h *ElementHandle) QueryAll(ctx context.Context, selector string) ([]*ElementHandle, error) {
+	return h.query(ctx, "QueryAll", selectorFunc, selector, false)
+}
+
+// QueryXPath returns the first node that matches the XPath expression,
+// evaluated with the element as context node, or ErrElementNotFound.
+func (h *ElementHandle) QueryXPath(ctx context.Context, expr string) (*ElementHandle, error) {
+	return first(h.query(ctx, "QueryXPath", xpathFunc, expr, true))
+}
+
+// QueryAllXPath returns all nodes that match the XPath expression,
+// evaluated with the element as context node, in document order.
+func (h *ElementHandle) QueryAllXPath(ctx context.Context, expr string) ([]*ElementHandle, error) {
+	return h.query(ctx, "QueryAllXPath", xpathFunc, expr, false)
+}
+
+// first returns the first handle, or ErrElementNotFound.
+func first(handles []*ElementHandle, err error) (*ElementHandle, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(handles) == 0 {
+		return nil, ErrElementNotFound
+	}
+	return handles[0], nil
+}
+
+const selectorFunc = `function(selector, first) {
+	if (first) {
+		const node = this.querySelector(selector);
+		return node ? [node] : [];
+	}
+	return Array.from(this.querySelectorAll(selector));
+}`
+
+const xpathFunc = `function(expr, first) {
+	const doc = this.ownerDocument || this;
+	const r = doc.evaluate(expr, this, null, XPathResult.ORDERED_NODE_SNAPSHOT_TYPE, null);
+	const nodes = [];
+	for (let i = 0; i < r.snapshotLength && !(first && i > 0); i++) {
+		nodes.push(r.snapshotItem(i));
+	}
+	return nodes;
+}`
+
+// query calls fn on the element, fn returns an array of the matching
+// nodes. The element is checked for staleness by call.
+func (h *ElementHandle) query(ctx context.Context, op, fn, expr string, first bool) ([]*ElementHandle, error) {
+	reply, err := h.call(ctx, op, fn, false, expr, first)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Result.ObjectID == nil {
+		return nil, fmt.Errorf("cdp: ElementHandle.%s: no result", op)
+	}
+	array := *reply.Result.ObjectID
+	defer h.c.Runtime.ReleaseObject(ctx, runtime.NewReleaseObjectArgs(array))
+
+	props, err := h.c.Runtime.GetProperties(ctx, runtime.NewGetPropertiesArgs(array).SetOwnProperties(true))
+	if err != nil {
+		return nil, h.wrap(op, err)
+	}
+
+	type indexed struct {
+		i  int
+		id runtime.RemoteObjectID
+	}
+	var nodes []indexed
+	for _, p := range props.Result {
+		i, err := strconv.Atoi(p.Name)
+		if err != nil || p.Value == nil || p.Value.ObjectID == nil {
+			continue // E.g. length.
+		}
+		nodes = append(nodes, indexed{i: i, id: *p.Value.ObjectID})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].i < nodes[j].i })
+
+	handles := make([]*ElementHandle, 0, len(nodes))
+	for i, n := range nodes {
+		desc, err := h.c.DOM.DescribeNode(ctx, dom.NewDescribeNodeArgs().SetObjectID(n.id))
+		if err != nil {
+			releaseAll(ctx, handles)
+			// The remaining nodes have no handles yet.
+			for _, n := range nodes[i:] {
+				h.c.Runtime.ReleaseObject(ctx, runtime.NewReleaseObjectArgs(n.id))
+			}
+			return nil, h.wrap(op, err)
+		}
+		handles = append(handles, &ElementHandle{c: h.c, objectID: n.id, backendNodeID: desc.Node.BackendNodeID})
+	}
+	return handles, nil
+}
+
+// Text returns the text content of the element (textContent).
+func (h *ElementHandle) Text(ctx context.Context) (string, error) {
+	var text string
+	err := h.callValue(ctx, "Text", `function() { return this.textContent || ""; }`, &text)
+	return text, err
+}
+
+// Attribute returns the value of the named attribute, ok is false if
+// the element does not have the attribute.
+func (h *ElementHandle) Attribute(ctx context.Context, name string) (value string, ok bool, err error) {
+	var v *string
+	err = h.callValue(ctx, "Attribute", `function(name) { return this.getAttribute(name); }`, &v, name)
+	if err != nil || v == nil {
+		return "", false, err
+	}
+	return *v, true, nil
+}
+
+// BoundingBox returns the border box of the element (DOM.getBoxModel),
+// relative to the main frame viewport, in CSS pixels.
+func (h *ElementHandle) BoundingBox(ctx context.Context) (dom.Rect, error) {
+	if err := h.check(ctx, "BoundingBox"); err != nil {
+		return dom.Rect{}, err
+	}
+	model, err := h.boxModel(ctx, "BoundingBox")
+	if err != nil {
+		return dom.Rect{}, err
+	}
+	return quadRect(model.Border), nil
+}
+
+func (h *ElementHandle) boxModel(ctx context.Context, op string) (*dom.BoxModel, error) {
+	reply, err := h.c.DOM.GetBoxModel(ctx, dom.NewGetBoxModelArgs().SetObjectID(h.objectID))
+	if err != nil {
+		return nil, h.wrap(op, err)
+	}
+	return &reply.Model, nil
+}
+
+// ScrollIntoView scrolls the element into view, if needed
+// (DOM.scrollIntoViewIfNeeded).
+func (h *ElementHandle) ScrollIntoView(ctx context.Context) error {
+	if err := h.check(ctx, "ScrollIntoView"); err != nil {
+		return err
+	}
+	return h.scrollIntoView(ctx, "ScrollIntoView")
+}
+
+func (h *ElementHandle) scrollIntoView(ctx context.Context, op string) error {
+	err := h.c.DOM.ScrollIntoViewIfNeeded(ctx, dom.NewScrollIntoViewIfNeededArgs().SetObjectID(h.objectID))
+	return h.wrap(op, err)
+}
+
+// Click scrolls the element into view and clicks (left mouse button)
+// the center of its content box (Input.dispatchMouseEvent).
+func (h *ElementHandle) Click(ctx context.Context) error {
+	if err := h.check(ctx, "Click"); err != nil {
+		return err
+	}
+	if err := h.scrollIntoView(ctx, "Click"); err != nil {
+		return err
+	}
+	model, err := h.boxModel(ctx, "Click")
+	if err != nil {
+		return err
+	}
+	x, y := quadCenter(model.Content)
+
+	for _, args := range []*input.DispatchMouseEventArgs{
+		input.NewDispatchMouseEventArgs("mouseMoved", x, y),
+		input.NewDispatchMouseEventArgs("mousePressed", x, y).
+			SetButton(input.MouseButtonLeft).SetButtons(1).SetClickCount(1),
+		input.NewDispatchMouseEventArgs("mouseReleased", x, y).
+			SetButton(input.MouseButtonLeft).SetClickCount(1),
+	} {
+		if err = h.c.Input.DispatchMouseEvent(ctx, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type focuses the element and types text, one key event per
+// character (Input.dispatchKeyEvent). Newlines are typed as Enter.
+func (h *ElementHandle) Type(ctx context.Context, text string) error {
+	if _, err := h.call(ctx, "Type", `function() { this.focus(); }`, false); err != nil {
+		return err
+	}
+	for _, r := range text {
+		key, txt := string(r), string(r)
+		if r == '\n' || r == '\r' {
+			key, txt = "Enter", "\r"
+		}
+		down := input.NewDispatchKeyEventArgs("keyDown").SetKey(key).SetText(txt)
+		if err := h.c.Input.DispatchKeyEvent(ctx, down); err != nil {
+			return err
+		}
+		up := input.NewDispatchKeyEventArgs("keyUp").SetKey(key)
+		if err := h.c.Input.DispatchKeyEvent(ctx, up); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Screenshot scrolls the element into view and captures a screenshot
+// (PNG) of its border box (Page.captureScreenshot).
+func (h *ElementHandle) Screenshot(ctx context.Context) ([]byte, error) {
+	if err := h.check(ctx, "Screenshot"); err != nil {
+		return nil, err
+	}
+	if err := h.scrollIntoView(ctx, "Screenshot"); err != nil {
+		return nil, err
+	}
+	model, err := h.boxModel(ctx, "Screenshot")
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := h.c.Page.GetLayoutMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The box model is relative to the viewport, the clip to the
+	// document.
+	box := quadRect(model.Border)
+	clip := page.Viewport{
+		X:      box.X + float64(metrics.LayoutViewport.PageX),
+		Y:      box.Y + float64(metrics.LayoutViewport.PageY),
+		Width:  box.Width,
+		Height: box.Height,
+		Scale:  1,
+	}
+	reply, err := h.c.Page.CaptureScreenshot(ctx, page.NewCaptureScreenshotArgs().SetFormat("png").SetClip(clip))
+	if err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// Release releases the JavaScript object of the element
+// (Runtime.releaseObject). Releasing a stale handle is not an error.
+func (h *ElementHandle) Release(ctx context.Context) error {
+	err := h.c.Runtime.ReleaseObject(ctx, runtime.NewReleaseObjectArgs(h.objectID))
+	if errors.Is(h.wrap("Release", err), ErrStaleElement) {
+		return nil
+	}
+	return err
+}
+
+func releaseAll(ctx context.Context, handles []*ElementHandle) {
+	for _, eh := range handles {
+		eh.Release(ctx)
+	}
+}
+
+// check returns a *StaleElementError if the element is stale.
+func (h *ElementHandle) check(ctx context.Context, op string) error {
+	_, err := h.call(ctx, op, `function() {}`, false)
+	return err
+}
+
+// call calls fn on the element (Runtime.callFunctionOn), fn throws if
+// the element is no longer connected to the document.
+func (h *ElementHandle) call(ctx context.Context, op, fn string, byValue bool, args ...interface{}) (*runtime.CallFunctionOnReply, error) {
+	callArgs := make([]runtime.CallArgument, 0, len(args))
+	for _, a := range args {
+		v, err := json.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		callArgs = append(callArgs, runtime.CallArgument{Value: v})
+	}
+	decl := `function(...args) {
+	if (!this.isConnected) {
+		throw new Error("` + staleMarker + `");
+	}
+	return (` + fn + `).apply(this, args);
+}`
+	reply, err := h.c.Runtime.CallFunctionOn(ctx, runtime.NewCallFunctionOnArgs(decl).
+		SetObjectID(h.objectID).
+		SetArguments(callArgs).
+		SetReturnByValue(byValue))
+	if err != nil {
+		return nil, h.wrap(op, err)
+	}
+	if ex := reply.ExceptionDetails; ex != nil {
+		text := ex.Text
+		if ex.Exception != nil && ex.Exception.Description != nil {
+			text = *ex.Exception.Description
+		}
+		if strings.Contains(text, staleMarker) {
+			return nil, &StaleElementError{Op: op}
+		}
+		return nil, fmt.Errorf("cdp: ElementHandle.%s: %s", op, text)
+	}
+	return reply, nil
+}
+
+// callValue calls fn on the element and decodes the result onto v.
+func (h *ElementHandle) callValue(ctx context.Context, op, fn string, v interface{}, args ...interface{}) error {
+	reply, err := h.call(ctx, op, fn, true, args...)
+	if err != nil {
+		return err
+	}
+	if len(reply.Result.Value) == 0 {
+		return nil // Undefined.
+	}
+	return json.Unmarshal(reply.Result.Value, v)
+}
+
+// wrap returns a *StaleElementError if err was caused by a stale node,
+// object or execution context.
+func (h *ElementHandle) wrap(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if IsStaleNode(err) || IsContextNotFound(err) || serverErrorHasPrefix(err, objectNotFoundMessages) {
+		return &StaleElementError{Op: op, Err: err}
+	}
+	return err
+}
+
+// quadRect returns the bounding rectangle of the quad.
+func quadRect(q dom.Quad) dom.Rect {
+	if len(q) < 8 {
+		return dom.Rect{}
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i := 0; i+1 < len(q); i += 2 {
+		minX, maxX = math.Min(minX, q[i]), math.Max(maxX, q[i])
+		minY, maxY = math.Min(minY, q[i+1]), math.Max(maxY, q[i+1])
+	}
+	return dom.Rect{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// quadCenter returns the center of the quad.
+func quadCenter(q dom.Quad) (x, y float64) {
+	n := float64(len(q) / 2)
+	if n == 0 {
+		return 0, 0
+	}
+	for i := 0; i+1 < len(q); i += 2 {
+		x += q[i]
+		y += q[i+1]
+	}
+	return x / n, y / n
+}
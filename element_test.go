@@ -0,0 +1,422 @@
+package cdp_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/input"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// fakeDOM serves a document (backend node 1) with a button (2) and a
+// paragraph (3). Object IDs are "obj-<backend node ID>".
+type fakeDOM struct {
+	mu           sync.Mutex
+	disconnected map[string]bool // Objects removed from the document.
+	replaced     bool            // Document replaced, all objects are gone.
+}
+
+func backendFromObject(id string) (int, error) {
+	return strconv.Atoi(strings.TrimPrefix(id, "obj-"))
+}
+
+func (f *fakeDOM) object(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.replaced {
+		return errors.New("Could not find object with given id")
+	}
+	return nil
+}
+
+func newElementTest(t *testing.T, f *fakeDOM) (*cdptest.Server, *cdp.Client, func()) {
+	srv := cdptest.NewServer()
+	srv.Handle("Runtime.evaluate", cdptest.Reply(map[string]interface{}{
+		"result": map[string]interface{}{"type": "object", "subtype": "node", "objectId": "obj-1"},
+	}))
+	srv.Handle("DOM.describeNode", func(req *cdptest.Request) (interface{}, error) {
+		var args dom.DescribeNodeArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		backend, err := backendFromObject(string(*args.ObjectID))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"node": map[string]interface{}{"nodeId": 0, "backendNodeId": backend, "nodeType": 1, "nodeName": "X", "localName": "x", "nodeValue": ""},
+		}, nil
+	})
+	srv.Handle("DOM.getBoxModel", func(req *cdptest.Request) (interface{}, error) {
+		var args dom.GetBoxModelArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		if err := f.object(string(*args.ObjectID)); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"model": map[string]interface{}{
+				"content": []float64{12, 22, 108, 22, 108, 58, 12, 58},
+				"padding": []float64{11, 21, 109, 21, 109, 59, 11, 59},
+				"border":  []float64{10, 20, 110, 20, 110, 60, 10, 60},
+				"margin":  []float64{10, 20, 110, 20, 110, 60, 10, 60},
+				"width":   100,
+				"height":  40,
+			},
+		}, nil
+	})
+	srv.Handle("Runtime.callFunctionOn", func(req *cdptest.Request) (interface{}, error) {
+		var args runtime.CallFunctionOnArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		id := string(*args.ObjectID)
+		if err := f.object(id); err != nil {
+			return nil, err
+		}
+		f.mu.Lock()
+		disconnected := f.disconnected[id]
+		f.mu.Unlock()
+		if disconnected {
+			return map[string]interface{}{
+				"result": map[string]interface{}{"type": "object", "subtype": "error"},
+				"exceptionDetails": map[string]interface{}{
+					"exceptionId": 1, "text": "Uncaught", "lineNumber": 0, "columnNumber": 0,
+					"exception": map[string]interface{}{"type": "object", "description": "Error: cdp: element is not connected\n    at <anonymous>"},
+				},
+			}, nil
+		}
+		fn := args.FunctionDeclaration
+		switch {
+		case strings.Contains(fn, "textContent"):
+			return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "Submit"}}, nil
+		case strings.Contains(fn, "getAttribute"):
+			if string(args.Arguments[0].Value) == `"type"` {
+				return map[string]interface{}{"result": map[string]interface{}{"type": "string", "value": "submit"}}, nil
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "null", "value": nil}}, nil
+		case strings.Contains(fn, "querySelector"), strings.Contains(fn, "XPathResult"):
+			var expr string
+			if err := json.Unmarshal(args.Arguments[0].Value, &expr); err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"result": map[string]interface{}{"type": "object", "subtype": "array", "objectId": "arr-" + expr}}, nil
+		}
+		return map[string]interface{}{"result": map[string]interface{}{"type": "undefined"}}, nil
+	})
+	srv.Handle("Runtime.getProperties", func(req *cdptest.Request) (interface{}, error) {
+		var args runtime.GetPropertiesArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		// Arrays are "arr-<selector or expression>".
+		var objects []string
+		switch strings.TrimPrefix(string(args.ObjectID), "arr-") {
+		case "button":
+			objects = []string{"obj-2"}
+		case "p":
+			objects = []string{"obj-3"}
+		case "button, p", "//button | //p":
+			objects = []string{"obj-2", "obj-3"}
+		}
+		// Properties are not in index order.
+		var props []map[string]interface{}
+		for i := len(objects) - 1; i >= 0; i-- {
+			props = append(props, map[string]interface{}{
+				"name": strconv.Itoa(i), "configurable": true, "enumerable": true,
+				"value": map[string]interface{}{"type": "object", "subtype": "node", "objectId": objects[i]},
+			})
+		}
+		props = append(props, map[string]interface{}{
+			"name": "length", "configurable": false, "enumerable": false,
+			"value": map[string]interface{}{"type": "number", "value": len(objects)},
+		})
+		return map[string]interface{}{"result": props}, nil
+	})
+	srv.Handle("Page.getLayoutMetrics", cdptest.Reply(map[string]interface{}{
+		"layoutViewport": map[string]int{"pageX": 0, "pageY": 100, "clientWidth": 800, "clientHeight": 600},
+		"visualViewport": map[string]int{"offsetX": 0, "offsetY": 0, "pageX": 0, "pageY": 100, "clientWidth": 800, "clientHeight": 600, "scale": 1},
+		"contentSize":    map[string]int{"x": 0, "y": 0, "width": 800, "height": 2000},
+	}))
+	srv.Handle("Page.captureScreenshot", cdptest.Reply(map[string]string{"data": base64.StdEncoding.EncodeToString([]byte("png"))}))
+	for _, method := range []string{
+		"DOM.scrollIntoViewIfNeeded",
+		"Input.dispatchMouseEvent",
+		"Input.dispatchKeyEvent",
+		"Runtime.releaseObject",
+	} {
+		srv.Handle(method, cdptest.Reply(nil))
+	}
+
+	conn, err := rpcc.Dial(srv.WebSocketURL)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return srv, cdp.NewClient(conn), func() {
+		conn.Close()
+		srv.Close()
+	}
+}
+
+func TestElementHandle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv, c, done := newElementTest(t, &fakeDOM{})
+	defer done()
+
+	button, err := cdp.Query(ctx, c, "button")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if button.ObjectID() != "obj-2" || button.BackendNodeID() != 2 {
+		t.Errorf("Query() = %s (%d), want obj-2 (2)", button.ObjectID(), button.BackendNodeID())
+	}
+
+	text, err := button.Text(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "Submit" {
+		t.Errorf("Text() = %q, want %q", text, "Submit")
+	}
+
+	typ, ok, err := button.Attribute(ctx, "type")
+	if err != nil || !ok || typ != "submit" {
+		t.Errorf("Attribute(type) = %q, %t, %v; want submit, true, <nil>", typ, ok, err)
+	}
+	_, ok, err = button.Attribute(ctx, "disabled")
+	if err != nil || ok {
+		t.Errorf("Attribute(disabled) = _, %t, %v; want false, <nil>", ok, err)
+	}
+
+	box, err := button.BoundingBox(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dom.Rect{X: 10, Y: 20, Width: 100, Height: 40}, box); diff != "" {
+		t.Errorf("BoundingBox() (-want +got):\n%s", diff)
+	}
+
+	if err = button.Click(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !srv.Called("DOM.scrollIntoViewIfNeeded") {
+		t.Error("Click() did not scroll into view")
+	}
+	var mouse []string
+	for _, req := range srv.CallsTo("Input.dispatchMouseEvent") {
+		var args input.DispatchMouseEventArgs
+		if err = req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		mouse = append(mouse, fmt.Sprintf("%s %v,%v %s", args.Type, args.X, args.Y, args.Button))
+	}
+	wantMouse := []string{"mouseMoved 60,40 ", "mousePressed 60,40 left", "mouseReleased 60,40 left"}
+	if diff := cmp.Diff(wantMouse, mouse); diff != "" {
+		t.Errorf("Click() mouse events (-want +got):\n%s", diff)
+	}
+
+	if err = button.Type(ctx, "hi\n"); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, req := range srv.CallsTo("Input.dispatchKeyEvent") {
+		var args input.DispatchKeyEventArgs
+		if err = req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, args.Type+" "+*args.Key)
+	}
+	wantKeys := []string{"keyDown h", "keyUp h", "keyDown i", "keyUp i", "keyDown Enter", "keyUp Enter"}
+	if diff := cmp.Diff(wantKeys, keys); diff != "" {
+		t.Errorf("Type() key events (-want +got):\n%s", diff)
+	}
+
+	img, err := button.Screenshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(img) != "png" {
+		t.Errorf("Screenshot() = %q, want %q", img, "png")
+	}
+	var shot page.CaptureScreenshotArgs
+	if err = srv.CallsTo("Page.captureScreenshot")[0].Unmarshal(&shot); err != nil {
+		t.Fatal(err)
+	}
+	wantClip := &page.Viewport{X: 10, Y: 120, Width: 100, Height: 40, Scale: 1}
+	if diff := cmp.Diff(wantClip, shot.Clip); diff != "" {
+		t.Errorf("Screenshot() clip (-want +got):\n%s", diff)
+	}
+
+	if err = button.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElementHandle_Query(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv, c, done := newElementTest(t, &fakeDOM{})
+	defer done()
+
+	_, err := cdp.Query(ctx, c, "input")
+	if !errors.Is(err, cdp.ErrElementNotFound) {
+		t.Errorf("Query(input) error = %v, want ErrElementNotFound", err)
+	}
+
+	ids := func(handles []*cdp.ElementHandle) (ids []runtime.RemoteObjectID) {
+		for _, h := range handles {
+			ids = append(ids, h.ObjectID())
+		}
+		return ids
+	}
+	want := []runtime.RemoteObjectID{"obj-2", "obj-3"}
+
+	all, err := cdp.QueryAll(ctx, c, "button, p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, ids(all)); diff != "" {
+		t.Errorf("QueryAll() (-want +got):\n%s", diff)
+	}
+
+	xall, err := cdp.QueryAllXPath(ctx, c, "//button | //p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, ids(xall)); diff != "" {
+		t.Errorf("QueryAllXPath() (-want +got):\n%s", diff)
+	}
+	if xall[1].BackendNodeID() != 3 {
+		t.Errorf("QueryAllXPath()[1].BackendNodeID() = %d, want 3", xall[1].BackendNodeID())
+	}
+	if srv.Called("DOM.getDocument") {
+		t.Error("DOM.getDocument was called, node IDs of the caller are invalidated")
+	}
+
+	p, err := all[0].Query(ctx, "p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ObjectID() != "obj-3" {
+		t.Errorf("Query(p) = %s, want obj-3", p.ObjectID())
+	}
+}
+
+func TestElementHandle_QueryReleasesOnError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv, c, done := newElementTest(t, &fakeDOM{})
+	defer done()
+
+	// The document and the first node are described, the second
+	// node is not.
+	srv.Handle("DOM.describeNode", func(req *cdptest.Request) (interface{}, error) {
+		var args dom.DescribeNodeArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		if *args.ObjectID == "obj-3" {
+			return nil, errors.New("describe failed")
+		}
+		backend, err := backendFromObject(string(*args.ObjectID))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"node": map[string]interface{}{"nodeId": 0, "backendNodeId": backend, "nodeType": 1, "nodeName": "X", "localName": "x", "nodeValue": ""},
+		}, nil
+	})
+
+	if _, err := cdp.QueryAll(ctx, c, "button, p"); err == nil {
+		t.Fatal("QueryAll() error = nil, want error")
+	}
+
+	var released []string
+	for _, req := range srv.CallsTo("Runtime.releaseObject") {
+		var args runtime.ReleaseObjectArgs
+		if err := req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		released = append(released, string(args.ObjectID))
+	}
+	sort.Strings(released)
+	want := []string{"arr-button, p", "obj-1", "obj-2", "obj-3"}
+	if diff := cmp.Diff(want, released); diff != "" {
+		t.Errorf("released objects (-want +got):\n%s", diff)
+	}
+}
+
+func TestElementHandle_Stale(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f := &fakeDOM{disconnected: make(map[string]bool)}
+	_, c, done := newElementTest(t, f)
+	defer done()
+
+	button, err := cdp.Query(ctx, c, "button")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Removed from the document.
+	f.mu.Lock()
+	f.disconnected["obj-2"] = true
+	f.mu.Unlock()
+
+	err = button.Click(ctx)
+	if !errors.Is(err, cdp.ErrStaleElement) {
+		t.Errorf("Click() error = %v, want ErrStaleElement", err)
+	}
+	var serr *cdp.StaleElementError
+	if !errors.As(err, &serr) || serr.Op != "Click" {
+		t.Errorf("Click() error = %#v, want *StaleElementError{Op: Click}", err)
+	}
+	_, err = button.Query(ctx, "p")
+	if !errors.As(err, &serr) || serr.Op != "Query" {
+		t.Errorf("Query() error = %#v, want *StaleElementError{Op: Query}", err)
+	}
+	_, err = button.QueryAll(ctx, "p")
+	if !errors.As(err, &serr) || serr.Op != "QueryAll" {
+		t.Errorf("QueryAll() error = %#v, want *StaleElementError{Op: QueryAll}", err)
+	}
+
+	// Document replaced.
+	f.mu.Lock()
+	f.replaced = true
+	f.mu.Unlock()
+
+	_, err = button.Text(ctx)
+	if !errors.Is(err, cdp.ErrStaleElement) {
+		t.Errorf("Text() error = %v, want ErrStaleElement", err)
+	}
+	if !errors.Is(err, rpcc.ErrServer) {
+		t.Errorf("Text() error = %v, want to wrap rpcc.ErrServer", err)
+	}
+	_, err = button.Query(ctx, "p")
+	if !errors.Is(err, cdp.ErrStaleElement) {
+		t.Errorf("Query() error = %v, want ErrStaleElement", err)
+	}
+}
@@ -1,7 +1,11 @@
 package cdp
 
 import (
+	"context"
+	"strings"
+
 	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/rpcc"
 )
 
 // ErrorCause returns the underlying cause for this error, if possible.
@@ -9,3 +13,76 @@ import (
 //
 // Deprecated: Use errors.Unwrap, errors.Is or errors.As instead.
 func ErrorCause(err error) error { return errors.Cause(err) }
+
+// Known messages of server errors (rpcc.ErrServer), by condition.
+var (
+	staleNodeMessages = []string{
+		"No node with given id found",
+		"Could not find node with given id",
+		"No node found for given backend id",
+		"Node with given id does not belong to the document",
+	}
+	contextNotFoundMessages = []string{
+		"Cannot find context with specified id",
+		"Cannot find default execution context",
+		"Execution context was destroyed",
+	}
+	objectNotFoundMessages = []string{
+		"Could not find object with given id",
+		"Cannot find object with given id",
+	}
+	targetClosedMessages = []string{
+		"Target closed",
+		"No target with given id found",
+		"Session with given id not found",
+		"Inspected target navigated or closed",
+	}
+)
+
+// serverErrorHasPrefix returns true if the chain of err contains a
+// server error (rpcc.ErrServer) with a message that starts with one of
+// prefixes.
+func serverErrorHasPrefix(err error, prefixes []string) bool {
+	var rerr *rpcc.ResponseError
+	if !errors.As(err, &rerr) || !errors.Is(rerr, rpcc.ErrServer) {
+		return false
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(rerr.Message, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStaleNode returns true if err was caused by a node (DOM) that no
+// longer exists, e.g. "No node with given id found". The node should
+// be requested again.
+func IsStaleNode(err error) bool {
+	return serverErrorHasPrefix(err, staleNodeMessages)
+}
+
+// IsContextNotFound returns true if err was caused by a missing or
+// destroyed execution context (Runtime), e.g. after a navigation.
+func IsContextNotFound(err error) bool {
+	return serverErrorHasPrefix(err, contextNotFoundMessages)
+}
+
+// IsTargetClosed returns true if err was caused by the target (or its
+// session) being closed, or by the connection closing.
+func IsTargetClosed(err error) bool {
+	if serverErrorHasPrefix(err, targetClosedMessages) {
+		return true
+	}
+	return errors.Is(err, rpcc.ErrConnClosing)
+}
+
+// IsTimeout returns true if err was caused by a timeout, e.g. an
+// exceeded context deadline or a network timeout.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var terr interface{ Timeout() bool }
+	return errors.As(err, &terr) && terr.Timeout()
+}
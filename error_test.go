@@ -1,8 +1,12 @@
 package cdp
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
+
+	"github.com/mafredri/cdp/rpcc"
 )
 
 type opError struct {
@@ -39,3 +43,33 @@ func TestErrorCause(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorClassification(t *testing.T) {
+	serverErr := func(msg string) error {
+		return fmt.Errorf("cdp.DOM: DescribeNode: %w", &rpcc.ResponseError{Code: rpcc.CodeServerError, Message: msg})
+	}
+	tests := []struct {
+		name string
+		fn   func(error) bool
+		err  error
+		want bool
+	}{
+		{"IsStaleNode", IsStaleNode, serverErr("No node with given id found"), true},
+		{"IsStaleNode other code", IsStaleNode, &rpcc.ResponseError{Code: rpcc.CodeInvalidParams, Message: "No node with given id found"}, false},
+		{"IsStaleNode other error", IsStaleNode, serverErr("Could not compute box model."), false},
+		{"IsContextNotFound", IsContextNotFound, serverErr("Cannot find context with specified id"), true},
+		{"IsTargetClosed", IsTargetClosed, serverErr("Session with given id not found."), true},
+		{"IsTargetClosed conn", IsTargetClosed, fmt.Errorf("cdp.Page: Enable: %w", rpcc.ErrConnClosing), true},
+		{"IsTargetClosed stream", IsTargetClosed, rpcc.ErrStreamClosing, false},
+		{"IsTargetClosed nil", IsTargetClosed, nil, false},
+		{"IsTimeout", IsTimeout, fmt.Errorf("cdp.Page: Enable: %w", context.DeadlineExceeded), true},
+		{"IsTimeout canceled", IsTimeout, context.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.err); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/*
+
+Package har implements a Recorder that assembles the Network domain events of
+a target into a HAR 1.2 log (HTTP Archive), e.g. for performance reports.
+
+Requests are tracked by request ID, including redirects, and the resource
+timing reported by the browser is converted into HAR timings.
+
+	r, err := har.NewRecorder(ctx, c, har.WithResponseBody())
+	if err != nil {
+		// Handle error.
+	}
+
+	_, err = cdp.Navigate(ctx, c, "https://www.google.com", cdp.WaitFor(cdp.WaitNetworkIdle))
+	// ...
+
+	err = r.Stop(ctx) // Waits for pending response bodies.
+
+	f, err := os.Create("google.har")
+	if err != nil {
+		// Handle error.
+	}
+	defer f.Close()
+
+	_, err = r.WriteTo(f)
+
+*/
+package har
@@ -0,0 +1,141 @@
+package har
+
+import "time"
+
+// HAR is the root of a HAR 1.2 document, see
+// http://www.softwareishard.com/blog/har-12-spec/.
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+// Log represents the log of exported requests.
+type Log struct {
+	Version string   `json:"version"`           // Version of the format, "1.2".
+	Creator Creator  `json:"creator"`           // Application that created the log.
+	Browser *Creator `json:"browser,omitempty"` // Browser that created the log.
+	Entries []Entry  `json:"entries"`           // Exported requests, in the order they were issued.
+	Comment string   `json:"comment,omitempty"`
+}
+
+// Creator represents the creator application (or browser).
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// Entry represents an exported request.
+type Entry struct {
+	Pageref         string    `json:"pageref,omitempty"`
+	StartedDateTime time.Time `json:"startedDateTime"`           // Time the request was issued.
+	Time            float64   `json:"time"`                      // Total time of the request in milliseconds, the sum of Timings.
+	Request         Request   `json:"request"`                   // Request details.
+	Response        Response  `json:"response"`                  // Response details.
+	Cache           Cache     `json:"cache"`                     // Cache usage, not reported.
+	Timings         Timings   `json:"timings"`                   // Timings of the request phases.
+	ServerIPAddress string    `json:"serverIPAddress,omitempty"` // IP address of the server.
+	Connection      string    `json:"connection,omitempty"`      // Connection ID.
+	Comment         string    `json:"comment,omitempty"`
+
+	// Custom fields, as exported by Chrome DevTools.
+	ResourceType string `json:"_resourceType,omitempty"` // Resource type, e.g. "Document".
+}
+
+// Request represents a request.
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int         `json:"headersSize"` // Size of the headers, -1 if unknown.
+	BodySize    int         `json:"bodySize"`    // Size of the body, -1 if unknown.
+	Comment     string      `json:"comment,omitempty"`
+}
+
+// Response represents a response.
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"` // Size of the headers, -1 if unknown.
+	BodySize    int         `json:"bodySize"`    // Size of the body, -1 if unknown.
+	Comment     string      `json:"comment,omitempty"`
+
+	// Custom fields, as exported by Chrome DevTools.
+	TransferSize int    `json:"_transferSize"`    // Bytes received over the network, including headers.
+	Error        string `json:"_error,omitempty"` // Error text, set when loading failed.
+}
+
+// Cookie represents a cookie.
+type Cookie struct {
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Path     string     `json:"path,omitempty"`
+	Domain   string     `json:"domain,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	HTTPOnly bool       `json:"httpOnly,omitempty"`
+	Secure   bool       `json:"secure,omitempty"`
+	Comment  string     `json:"comment,omitempty"`
+}
+
+// NameValue represents a header or query string parameter.
+type NameValue struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// PostData represents the posted data of a request.
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// Content represents the response content.
+type Content struct {
+	Size        int    `json:"size"`                  // Decoded size of the body.
+	Compression int    `json:"compression,omitempty"` // Bytes saved by compression.
+	MimeType    string `json:"mimeType"`
+	Text        string `json:"text,omitempty"`     // The body, if recorded.
+	Encoding    string `json:"encoding,omitempty"` // Encoding of Text, e.g. "base64".
+	Comment     string `json:"comment,omitempty"`
+}
+
+// Cache represents the cache usage of a request.
+type Cache struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// Timings represents the time spent in each phase of a request, in
+// milliseconds. Phases that do not apply are -1. SSL is included in
+// Connect.
+type Timings struct {
+	Blocked float64 `json:"blocked"` // Time spent queueing.
+	DNS     float64 `json:"dns"`     // DNS resolution.
+	Connect float64 `json:"connect"` // Creating the connection.
+	Send    float64 `json:"send"`    // Sending the request.
+	Wait    float64 `json:"wait"`    // Waiting for the response (TTFB).
+	Receive float64 `json:"receive"` // Receiving the response.
+	SSL     float64 `json:"ssl"`     // TLS handshake.
+	Comment string  `json:"comment,omitempty"`
+}
+
+// total returns the total time of the timings, the sum of the
+// non-negative phases (excluding SSL).
+func (t Timings) total() float64 {
+	var sum float64
+	for _, v := range []float64{t.Blocked, t.DNS, t.Connect, t.Send, t.Wait, t.Receive} {
+		if v > 0 {
+			sum += v
+		}
+	}
+	return sum
+}
@@ -0,0 +1,589 @@
+package har
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// Option represents a function that sets a Recorder option.
+type Option func(*options)
+
+type options struct {
+	creator Creator
+	body    bool
+}
+
+// WithResponseBody returns an Option that records the response bodies
+// (Network.getResponseBody) when a request has finished loading.
+func WithResponseBody() Option {
+	return func(o *options) {
+		o.body = true
+	}
+}
+
+// WithCreator returns an Option that sets the creator of the log, the
+// default is "github.com/mafredri/cdp".
+func WithCreator(name, version string) Option {
+	return func(o *options) {
+		o.creator = Creator{Name: name, Version: version}
+	}
+}
+
+// Recorder records the requests of a target via the Network domain and
+// exports them as HAR.
+type Recorder struct {
+	c       *cdp.Client
+	opts    options
+	ctx     context.Context // Canceled by Stop.
+	cancel  context.CancelFunc
+	unsub   []func()
+	wg      sync.WaitGroup // Pending response bodies.
+	browser *Creator
+
+	mu       sync.Mutex // Protects following.
+	entries  []*entry
+	requests map[network.RequestID]*entry // Latest entry (redirect) by request.
+	reqExtra map[network.RequestID][]network.Headers
+	resExtra map[network.RequestID][]*network.ResponseReceivedExtraInfoReply
+	stopped  bool // No more pending response bodies are added.
+}
+
+// entry is a request in progress.
+type entry struct {
+	resourceType network.ResourceType
+	request      network.Request
+	wallTime     network.TimeSinceEpoch
+	issued       network.MonotonicTime
+	response     *network.Response
+	redirectURL  string
+
+	// Raw headers, from the ExtraInfo events.
+	requestHeaders      network.Headers
+	responseHeaders     network.Headers
+	responseHeadersText *string
+
+	dataLength        int
+	encodedDataLength float64
+	finished          network.MonotonicTime
+	done              bool
+	errorText         string
+
+	body    *network.GetResponseBodyReply
+	bodyErr error
+}
+
+// NewRecorder enables the Network domain and starts recording the
+// requests of c. The Network domain is left enabled by Stop.
+func NewRecorder(ctx context.Context, c *cdp.Client, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		c:        c,
+		opts:     options{creator: Creator{Name: "github.com/mafredri/cdp"}},
+		requests: make(map[network.RequestID]*entry),
+		reqExtra: make(map[network.RequestID][]network.Headers),
+		resExtra: make(map[network.RequestID][]*network.ResponseReceivedExtraInfoReply),
+	}
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+
+	// Handlers are called in the order the events arrived.
+	for _, on := range []func() (func(), error){
+		func() (func(), error) { return c.Network.OnRequestWillBeSent(r.requestWillBeSent) },
+		func() (func(), error) { return c.Network.OnRequestWillBeSentExtraInfo(r.requestWillBeSentExtraInfo) },
+		func() (func(), error) { return c.Network.OnResponseReceived(r.responseReceived) },
+		func() (func(), error) { return c.Network.OnResponseReceivedExtraInfo(r.responseReceivedExtraInfo) },
+		func() (func(), error) { return c.Network.OnDataReceived(r.dataReceived) },
+		func() (func(), error) { return c.Network.OnLoadingFinished(r.loadingFinished) },
+		func() (func(), error) { return c.Network.OnLoadingFailed(r.loadingFailed) },
+	} {
+		unsubscribe, err := on()
+		if err != nil {
+			r.Stop(ctx)
+			return nil, err
+		}
+		r.unsub = append(r.unsub, unsubscribe)
+	}
+
+	if err := c.Network.Enable(ctx, network.NewEnableArgs()); err != nil {
+		r.Stop(ctx)
+		return nil, errors.Wrapf(err, "har: enable network failed")
+	}
+
+	// The browser is optional in the log.
+	if v, err := c.Browser.GetVersion(ctx); err == nil {
+		name, version := v.Product, ""
+		if i := strings.IndexByte(name, '/'); i != -1 {
+			name, version = name[:i], name[i+1:]
+		}
+		r.browser = &Creator{Name: name, Version: version}
+	}
+
+	return r, nil
+}
+
+// Stop stops recording and waits for pending response bodies until
+// ctx is done, the remaining requests for response bodies are canceled
+// and ctx.Err() is returned. The recorded requests remain available via
+// HAR.
+func (r *Recorder) Stop(ctx context.Context) error {
+	for _, unsubscribe := range r.unsub {
+		unsubscribe()
+	}
+	r.unsub = nil
+
+	// Handlers may still be running, make sure they do not add to wg
+	// while we wait.
+	r.mu.Lock()
+	r.stopped = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	r.cancel()
+	<-done
+	return err
+}
+
+func (r *Recorder) requestWillBeSent(ev *network.RequestWillBeSentReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if prev, ok := r.requests[ev.RequestID]; ok && ev.RedirectResponse != nil {
+		// The previous request was redirected, the request ID is
+		// reused for the new request.
+		prev.response = ev.RedirectResponse
+		prev.redirectURL = ev.Request.URL
+		prev.encodedDataLength = ev.RedirectResponse.EncodedDataLength
+		prev.finished = ev.Timestamp
+		prev.done = true
+	}
+
+	e := &entry{
+		resourceType: ev.Type,
+		request:      ev.Request,
+		wallTime:     ev.WallTime,
+		issued:       ev.Timestamp,
+	}
+	if extra := r.reqExtra[ev.RequestID]; len(extra) > 0 {
+		e.requestHeaders = extra[0]
+		r.reqExtra[ev.RequestID] = extra[1:]
+	}
+	r.entries = append(r.entries, e)
+	r.requests[ev.RequestID] = e
+}
+
+func (r *Recorder) requestWillBeSentExtraInfo(ev *network.RequestWillBeSentExtraInfoReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The extra info can be received before or after the request.
+	if e, ok := r.requests[ev.RequestID]; ok && e.requestHeaders == nil && e.response == nil {
+		e.requestHeaders = ev.Headers
+		return
+	}
+	r.reqExtra[ev.RequestID] = append(r.reqExtra[ev.RequestID], ev.Headers)
+}
+
+func (r *Recorder) responseReceived(ev *network.ResponseReceivedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.requests[ev.RequestID]
+	if !ok {
+		return
+	}
+	e.response = &ev.Response
+	e.resourceType = ev.Type
+	if extra := r.resExtra[ev.RequestID]; len(extra) > 0 && e.responseHeaders == nil {
+		e.responseHeaders, e.responseHeadersText = extra[0].Headers, extra[0].HeadersText
+		r.resExtra[ev.RequestID] = extra[1:]
+	}
+}
+
+func (r *Recorder) responseReceivedExtraInfo(ev *network.ResponseReceivedExtraInfoReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.requests[ev.RequestID]; ok && e.responseHeaders == nil && !e.done {
+		e.responseHeaders, e.responseHeadersText = ev.Headers, ev.HeadersText
+		return
+	}
+	r.resExtra[ev.RequestID] = append(r.resExtra[ev.RequestID], ev)
+}
+
+func (r *Recorder) dataReceived(ev *network.DataReceivedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.requests[ev.RequestID]; ok {
+		e.dataLength += ev.DataLength
+	}
+}
+
+func (r *Recorder) loadingFinished(ev *network.LoadingFinishedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.requests[ev.RequestID]
+	if !ok {
+		return
+	}
+	r.finish(ev.RequestID)
+	e.encodedDataLength = ev.EncodedDataLength
+	e.finished = ev.Timestamp
+	e.done = true
+
+	if r.opts.body && !r.stopped {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			body, err := r.c.Network.GetResponseBody(r.ctx, network.NewGetResponseBodyArgs(ev.RequestID))
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			e.body, e.bodyErr = body, err
+		}()
+	}
+}
+
+func (r *Recorder) loadingFailed(ev *network.LoadingFailedReply) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.requests[ev.RequestID]
+	if !ok {
+		return
+	}
+	r.finish(ev.RequestID)
+	e.errorText = ev.ErrorText
+	e.finished = ev.Timestamp
+	e.done = true
+}
+
+// finish stops tracking the request, the caller must hold r.mu.
+func (r *Recorder) finish(id network.RequestID) {
+	delete(r.requests, id)
+	delete(r.reqExtra, id)
+	delete(r.resExtra, id)
+}
+
+// HAR returns the recorded requests as HAR. Requests that have not
+// finished loading are not included.
+func (r *Recorder) HAR() *HAR {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h := &HAR{Log: Log{
+		Version: "1.2",
+		Creator: r.opts.creator,
+		Browser: r.browser,
+		Entries: []Entry{},
+	}}
+	for _, e := range r.entries {
+		if e.done {
+			h.Log.Entries = append(h.Log.Entries, e.har())
+		}
+	}
+	return h
+}
+
+// WriteTo writes the recorded requests as HAR (JSON) to w.
+func (r *Recorder) WriteTo(w io.Writer) (n int64, err error) {
+	data, err := json.MarshalIndent(r.HAR(), "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	m, err := w.Write(append(data, '\n'))
+	return int64(m), err
+}
+
+var _ io.WriterTo = (*Recorder)(nil)
+
+// har converts the entry to HAR.
+func (e *entry) har() Entry {
+	req := e.request
+	reqURL := req.URL
+	if req.URLFragment != nil {
+		reqURL += *req.URLFragment
+	}
+	reqHeaders := req.Headers
+	if e.requestHeaders != nil {
+		reqHeaders = e.requestHeaders
+	}
+
+	he := Entry{
+		StartedDateTime: e.wallTime.Time().UTC(),
+		ResourceType:    string(e.resourceType),
+		Request: Request{
+			Method:      req.Method,
+			URL:         reqURL,
+			HTTPVersion: "HTTP/1.1",
+			Headers:     headers(reqHeaders),
+			QueryString: queryString(req.URL),
+			HeadersSize: -1,
+		},
+		Response: Response{
+			Cookies:     []Cookie{},
+			Headers:     []NameValue{},
+			RedirectURL: e.redirectURL,
+			HeadersSize: -1,
+			BodySize:    -1,
+			Error:       e.errorText,
+		},
+	}
+	he.Request.Cookies = cookies(header(he.Request.Headers, "Cookie"), false)
+	if req.PostData != nil {
+		he.Request.BodySize = len(*req.PostData)
+		he.Request.PostData = &PostData{
+			MimeType: header(he.Request.Headers, "Content-Type"),
+			Text:     *req.PostData,
+		}
+	}
+
+	var timing *network.ResourceTiming
+	if res := e.response; res != nil {
+		resHeaders := res.Headers
+		if e.responseHeaders != nil {
+			resHeaders = e.responseHeaders
+		}
+		if res.RequestHeaders != nil && e.requestHeaders == nil {
+			he.Request.Headers = headers(res.RequestHeaders)
+		}
+		if res.RequestHeadersText != nil {
+			he.Request.HeadersSize = len(*res.RequestHeadersText)
+		}
+
+		he.Request.HTTPVersion = httpVersion(res.Protocol)
+		he.Response.Status = res.Status
+		he.Response.StatusText = res.StatusText
+		he.Response.HTTPVersion = he.Request.HTTPVersion
+		he.Response.Headers = headers(resHeaders)
+		he.Response.Cookies = cookies(header(he.Response.Headers, "Set-Cookie"), true)
+		he.Response.Content.MimeType = res.MimeType
+		if he.Response.RedirectURL == "" {
+			he.Response.RedirectURL = header(he.Response.Headers, "Location")
+		}
+
+		headersText := res.HeadersText
+		if e.responseHeadersText != nil {
+			headersText = e.responseHeadersText
+		}
+		if headersText != nil {
+			he.Response.HeadersSize = len(*headersText)
+		}
+		if res.RemoteIPAddress != nil {
+			he.ServerIPAddress = *res.RemoteIPAddress
+		}
+		if res.ConnectionID != 0 {
+			he.Connection = strconv.FormatFloat(res.ConnectionID, 'f', -1, 64)
+		}
+		timing = res.Timing
+	}
+
+	he.Response.TransferSize = int(e.encodedDataLength)
+	he.Response.Content.Size = e.dataLength
+	if e.errorText == "" && he.Response.HeadersSize >= 0 {
+		he.Response.BodySize = he.Response.TransferSize - he.Response.HeadersSize
+		if c := he.Response.Content.Size - he.Response.BodySize; c > 0 {
+			he.Response.Content.Compression = c
+		}
+	}
+
+	if e.body != nil {
+		he.Response.Content.Text = e.body.Body
+		if e.body.Base64Encoded {
+			he.Response.Content.Encoding = "base64"
+		}
+	} else if e.bodyErr != nil {
+		he.Response.Content.Comment = "body unavailable: " + e.bodyErr.Error()
+	}
+
+	he.Timings = timings(timing, e.issued, e.finished)
+	he.Time = he.Timings.total()
+	return he
+}
+
+// timings converts the resource timing into HAR timings, issued is the
+// time the request was issued and finished the time it finished
+// loading.
+func timings(t *network.ResourceTiming, issued, finished network.MonotonicTime) Timings {
+	ms := func(d float64) float64 { return d * 1000 }
+
+	ht := Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1}
+	if t == nil {
+		// E.g. served from the memory cache.
+		ht.Receive = nonNegative(ms(float64(finished - issued)))
+		return ht
+	}
+
+	// Time queued before the request was started.
+	if queued := ms(t.RequestTime - float64(issued)); queued > 0 {
+		ht.Blocked = queued
+	}
+	// Time blocked after the request was started, before the
+	// first network activity.
+	blocked := -1.0
+	for _, v := range []float64{t.DNSStart, t.ConnectStart, t.SendStart} {
+		if v >= 0 && (blocked < 0 || v < blocked) {
+			blocked = v
+		}
+	}
+	if blocked >= 0 {
+		ht.Blocked = nonNegative(ht.Blocked) + blocked
+	}
+
+	if t.DNSStart >= 0 && t.DNSEnd >= 0 {
+		ht.DNS = t.DNSEnd - t.DNSStart
+	}
+	if t.ConnectStart >= 0 && t.ConnectEnd >= 0 {
+		ht.Connect = t.ConnectEnd - t.ConnectStart
+	}
+	if t.SSLStart >= 0 && t.SSLEnd >= 0 {
+		ht.SSL = t.SSLEnd - t.SSLStart
+	}
+	ht.Send = nonNegative(t.SendEnd - t.SendStart)
+	ht.Wait = nonNegative(t.ReceiveHeadersEnd - t.SendEnd)
+	if finished > 0 {
+		ht.Receive = nonNegative(ms(float64(finished)-t.RequestTime) - t.ReceiveHeadersEnd)
+	}
+	return ht
+}
+
+func nonNegative(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	return v
+}
+
+// headers converts the headers (JSON object) into name-value pairs,
+// sorted by name. Headers with multiple values are separated by
+// newlines and split into multiple pairs.
+func headers(h network.Headers) []NameValue {
+	nv := []NameValue{}
+	var m map[string]interface{}
+	if err := json.Unmarshal(h, &m); err != nil {
+		return nv
+	}
+	for name, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		for _, value := range strings.Split(s, "\n") {
+			nv = append(nv, NameValue{Name: name, Value: value})
+		}
+	}
+	sort.SliceStable(nv, func(i, j int) bool { return nv[i].Name < nv[j].Name })
+	return nv
+}
+
+// header returns the values of the header (case-insensitive), joined by
+// newlines.
+func header(nv []NameValue, name string) string {
+	var values []string
+	for _, h := range nv {
+		if strings.EqualFold(h.Name, name) {
+			values = append(values, h.Value)
+		}
+	}
+	return strings.Join(values, "\n")
+}
+
+// cookies parses the Cookie (or Set-Cookie if set is true) header.
+func cookies(h string, set bool) []Cookie {
+	c := []Cookie{}
+	if h == "" {
+		return c
+	}
+	var hc []*http.Cookie
+	if set {
+		hc = (&http.Response{Header: http.Header{"Set-Cookie": strings.Split(h, "\n")}}).Cookies()
+	} else {
+		hc = (&http.Request{Header: http.Header{"Cookie": {h}}}).Cookies()
+	}
+	for _, ck := range hc {
+		cookie := Cookie{
+			Name:     ck.Name,
+			Value:    ck.Value,
+			Path:     ck.Path,
+			Domain:   ck.Domain,
+			HTTPOnly: ck.HttpOnly,
+			Secure:   ck.Secure,
+		}
+		if !ck.Expires.IsZero() {
+			expires := ck.Expires.UTC()
+			cookie.Expires = &expires
+		}
+		c = append(c, cookie)
+	}
+	return c
+}
+
+// queryString returns the query parameters of rawURL, in order.
+func queryString(rawURL string) []NameValue {
+	nv := []NameValue{}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return nv
+	}
+	for _, kv := range strings.Split(u.RawQuery, "&") {
+		if kv == "" {
+			continue
+		}
+		var name, value string
+		if i := strings.IndexByte(kv, '='); i != -1 {
+			name, value = kv[:i], kv[i+1:]
+		} else {
+			name = kv
+		}
+		if s, err := url.QueryUnescape(name); err == nil {
+			name = s
+		}
+		if s, err := url.QueryUnescape(value); err == nil {
+			value = s
+		}
+		nv = append(nv, NameValue{Name: name, Value: value})
+	}
+	return nv
+}
+
+// httpVersion converts the protocol reported by the browser into an
+// HTTP version.
+func httpVersion(protocol *string) string {
+	if protocol == nil {
+		return "HTTP/1.1"
+	}
+	switch p := strings.ToLower(*protocol); p {
+	case "h2":
+		return "HTTP/2.0"
+	case "h3", "quic":
+		return "HTTP/3.0"
+	case "":
+		return "HTTP/1.1"
+	default:
+		return strings.ToUpper(p)
+	}
+}
@@ -0,0 +1,282 @@
+package har_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/har"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+type event struct {
+	method string
+	params string
+}
+
+var events = []event{
+	{"Network.requestWillBeSent", `{"requestId":"1","loaderId":"L1","documentURL":"http://example.com/","request":{"url":"http://example.com/?a=1&b=x%20y","method":"GET","headers":{"Accept":"*/*"},"initialPriority":"VeryHigh","referrerPolicy":"no-referrer"},"timestamp":100,"wallTime":1600000000,"initiator":{"type":"other"},"type":"Document"}`},
+	{"Network.requestWillBeSentExtraInfo", `{"requestId":"1","associatedCookies":[],"headers":{"Accept":"*/*","Cookie":"a=b; c=d","Host":"example.com"}}`},
+	{"Network.requestWillBeSent", `{"requestId":"1","loaderId":"L1","documentURL":"https://example.com/","request":{"url":"https://example.com/","method":"GET","headers":{"Accept":"*/*"},"initialPriority":"VeryHigh","referrerPolicy":"no-referrer"},"timestamp":100.5,"wallTime":1600000000.5,"initiator":{"type":"other"},"type":"Document","redirectResponse":{"url":"http://example.com/?a=1&b=x%20y","status":301,"statusText":"Moved Permanently","headers":{"Location":"https://example.com/"},"mimeType":"","connectionReused":false,"connectionId":1,"encodedDataLength":100,"securityState":"insecure"}}`},
+	{"Network.responseReceived", `{"requestId":"1","loaderId":"L1","timestamp":100.6,"type":"Document","response":{"url":"https://example.com/","status":200,"statusText":"","headers":{"Content-Type":"text/html","Set-Cookie":"x=y; Path=/; HttpOnly\nz=1; Secure"},"mimeType":"text/html","connectionReused":false,"connectionId":2,"remoteIPAddress":"93.184.216.34","encodedDataLength":50,"protocol":"h2","securityState":"secure","timing":{"requestTime":100.5,"proxyStart":-1,"proxyEnd":-1,"dnsStart":1,"dnsEnd":11,"connectStart":11,"connectEnd":41,"sslStart":21,"sslEnd":41,"workerStart":-1,"workerReady":-1,"workerFetchStart":-1,"workerRespondWithSettled":-1,"sendStart":41,"sendEnd":42,"pushStart":0,"pushEnd":0,"receiveHeadersEnd":92}}}`},
+	{"Network.dataReceived", `{"requestId":"1","timestamp":100.65,"dataLength":1000,"encodedDataLength":450}`},
+	{"Network.loadingFinished", `{"requestId":"1","timestamp":100.7,"encodedDataLength":500}`},
+	{"Network.requestWillBeSent", `{"requestId":"2","loaderId":"L1","documentURL":"https://example.com/","request":{"url":"https://example.com/api","method":"POST","headers":{"Content-Type":"application/json"},"postData":"{}","initialPriority":"High","referrerPolicy":"no-referrer"},"timestamp":101,"wallTime":1600000001,"initiator":{"type":"script"},"type":"Fetch"}`},
+	{"Network.loadingFailed", `{"requestId":"2","timestamp":101.2,"type":"Fetch","errorText":"net::ERR_FAILED"}`},
+	{"Network.requestWillBeSent", `{"requestId":"3","loaderId":"L1","documentURL":"https://example.com/","request":{"url":"https://example.com/pending","method":"GET","headers":{},"initialPriority":"Low","referrerPolicy":"no-referrer"},"timestamp":102,"wallTime":1600000002,"initiator":{"type":"other"},"type":"Image"}`},
+}
+
+func TestRecorder(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Network.enable", cdptest.Reply(nil))
+	srv.Handle("Browser.getVersion", cdptest.Reply(map[string]string{"product": "HeadlessChrome/85.0.4183.83"}))
+	srv.Handle("Network.getResponseBody", cdptest.Reply(map[string]interface{}{"body": "PGh0bWw+", "base64Encoded": true}))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	r, err := har.NewRecorder(ctx, cdp.NewClient(conn), har.WithResponseBody(), har.WithCreator("test", "1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ev := range events {
+		if err = srv.Event(ev.method, json.RawMessage(ev.params)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Wait for the events to be handled.
+	for len(r.HAR().Log.Entries) < 3 {
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for entries, got %d", len(r.HAR().Log.Entries))
+		}
+	}
+	if err = r.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err = r.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var h har.HAR
+	if err = json.Unmarshal(buf.Bytes(), &h); err != nil {
+		t.Fatal(err)
+	}
+
+	approx := cmpopts.EquateApprox(0, 1e-6)
+	want := har.Log{
+		Version: "1.2",
+		Creator: har.Creator{Name: "test", Version: "1.0"},
+		Browser: &har.Creator{Name: "HeadlessChrome", Version: "85.0.4183.83"},
+		Entries: []har.Entry{
+			{
+				StartedDateTime: time.Unix(1600000000, 0).UTC(),
+				Time:            500,
+				ResourceType:    "Document",
+				Request: har.Request{
+					Method:      "GET",
+					URL:         "http://example.com/?a=1&b=x%20y",
+					HTTPVersion: "HTTP/1.1",
+					Cookies:     []har.Cookie{{Name: "a", Value: "b"}, {Name: "c", Value: "d"}},
+					Headers: []har.NameValue{
+						{Name: "Accept", Value: "*/*"},
+						{Name: "Cookie", Value: "a=b; c=d"},
+						{Name: "Host", Value: "example.com"},
+					},
+					QueryString: []har.NameValue{{Name: "a", Value: "1"}, {Name: "b", Value: "x y"}},
+					HeadersSize: -1,
+				},
+				Response: har.Response{
+					Status:       301,
+					StatusText:   "Moved Permanently",
+					HTTPVersion:  "HTTP/1.1",
+					Cookies:      []har.Cookie{},
+					Headers:      []har.NameValue{{Name: "Location", Value: "https://example.com/"}},
+					RedirectURL:  "https://example.com/",
+					HeadersSize:  -1,
+					BodySize:     -1,
+					TransferSize: 100,
+				},
+				Connection: "1",
+				Timings:    har.Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Receive: 500},
+			},
+			{
+				StartedDateTime: time.Unix(1600000000, 5e8).UTC(),
+				Time:            200,
+				ResourceType:    "Document",
+				Request: har.Request{
+					Method:      "GET",
+					URL:         "https://example.com/",
+					HTTPVersion: "HTTP/2.0",
+					Cookies:     []har.Cookie{},
+					Headers:     []har.NameValue{{Name: "Accept", Value: "*/*"}},
+					QueryString: []har.NameValue{},
+					HeadersSize: -1,
+				},
+				Response: har.Response{
+					Status:      200,
+					HTTPVersion: "HTTP/2.0",
+					Cookies: []har.Cookie{
+						{Name: "x", Value: "y", Path: "/", HTTPOnly: true},
+						{Name: "z", Value: "1", Secure: true},
+					},
+					Headers: []har.NameValue{
+						{Name: "Content-Type", Value: "text/html"},
+						{Name: "Set-Cookie", Value: "x=y; Path=/; HttpOnly"},
+						{Name: "Set-Cookie", Value: "z=1; Secure"},
+					},
+					Content: har.Content{
+						Size:     1000,
+						MimeType: "text/html",
+						Text:     "PGh0bWw+",
+						Encoding: "base64",
+					},
+					HeadersSize:  -1,
+					BodySize:     -1,
+					TransferSize: 500,
+				},
+				ServerIPAddress: "93.184.216.34",
+				Connection:      "2",
+				Timings: har.Timings{
+					Blocked: 1,
+					DNS:     10,
+					Connect: 30,
+					SSL:     20,
+					Send:    1,
+					Wait:    50,
+					Receive: 108,
+				},
+			},
+			{
+				StartedDateTime: time.Unix(1600000001, 0).UTC(),
+				Time:            200,
+				ResourceType:    "Fetch",
+				Request: har.Request{
+					Method:      "POST",
+					URL:         "https://example.com/api",
+					HTTPVersion: "HTTP/1.1",
+					Cookies:     []har.Cookie{},
+					Headers:     []har.NameValue{{Name: "Content-Type", Value: "application/json"}},
+					QueryString: []har.NameValue{},
+					PostData:    &har.PostData{MimeType: "application/json", Text: "{}"},
+					HeadersSize: -1,
+					BodySize:    2,
+				},
+				Response: har.Response{
+					Cookies:     []har.Cookie{},
+					Headers:     []har.NameValue{},
+					HeadersSize: -1,
+					BodySize:    -1,
+					Error:       "net::ERR_FAILED",
+				},
+				Timings: har.Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Receive: 200},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, h.Log, approx, cmp.Comparer(func(a, b time.Time) bool {
+		return math.Abs(float64(a.Sub(b))) < float64(time.Millisecond)
+	})); diff != "" {
+		t.Errorf("HAR diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecorder_StopWhileRecording(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Network.enable", cdptest.Reply(nil))
+	srv.Handle("Browser.getVersion", cdptest.Reply(map[string]string{"product": "HeadlessChrome/85.0.4183.83"}))
+	srv.Handle("Network.getResponseBody", cdptest.Reply(map[string]interface{}{"body": ""}))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	r, err := har.NewRecorder(ctx, cdp.NewClient(conn), har.WithResponseBody())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stop races with requests that finish loading.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			for _, ev := range events[:6] {
+				if srv.Event(ev.method, json.RawMessage(ev.params)) != nil {
+					return
+				}
+			}
+		}
+	}()
+	time.Sleep(5 * time.Millisecond)
+	if err = r.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+}
+
+func TestRecorder_StopCancelsResponseBody(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	block := make(chan struct{})
+	defer close(block)
+	srv.Handle("Network.enable", cdptest.Reply(nil))
+	srv.Handle("Network.getResponseBody", func(*cdptest.Request) (interface{}, error) {
+		<-block // The browser never replies.
+		return nil, nil
+	})
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	r, err := har.NewRecorder(ctx, cdp.NewClient(conn), har.WithResponseBody())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ev := range events[:6] {
+		if err = srv.Event(ev.method, json.RawMessage(ev.params)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = srv.WaitCall(ctx, "Network.getResponseBody", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	stopCtx, stopCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer stopCancel()
+	if err = r.Stop(stopCtx); err != context.DeadlineExceeded {
+		t.Errorf("Stop() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
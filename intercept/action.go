@@ -0,0 +1,134 @@
+package intercept
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// Action resolves a paused request, it is returned by a Handler.
+type Action interface {
+	resolve(ctx context.Context, c *cdp.Client, req *Request) error
+}
+
+type actionFunc func(ctx context.Context, c *cdp.Client, req *Request) error
+
+func (fn actionFunc) resolve(ctx context.Context, c *cdp.Client, req *Request) error {
+	return fn(ctx, c, req)
+}
+
+// fulfillFunc is an Action that responds to the request, the request
+// is failed (not continued) if it cannot be resolved.
+type fulfillFunc func(ctx context.Context, c *cdp.Client, req *Request) error
+
+func (fn fulfillFunc) resolve(ctx context.Context, c *cdp.Client, req *Request) error {
+	return fn(ctx, c, req)
+}
+
+// Continue returns an Action that continues the request (or response)
+// unmodified.
+func Continue() Action {
+	return actionFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		return c.Fetch.ContinueRequest(ctx, fetch.NewContinueRequestArgs(req.RequestID))
+	})
+}
+
+// Override represents the modifications of a continued request. Zero
+// values are not modified.
+type Override struct {
+	URL      string      // Not observable by the page.
+	Method   string      // HTTP method.
+	PostData string      // Post data.
+	Header   http.Header // Replaces all headers.
+}
+
+// ContinueWith returns an Action that continues the request with the
+// modifications in o. Requests can only be modified in the request
+// stage.
+func ContinueWith(o Override) Action {
+	return actionFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		args := fetch.NewContinueRequestArgs(req.RequestID)
+		if o.URL != "" {
+			args.SetURL(o.URL)
+		}
+		if o.Method != "" {
+			args.SetMethod(o.Method)
+		}
+		if o.PostData != "" {
+			args.SetPostData(o.PostData)
+		}
+		if o.Header != nil {
+			args.SetHeaders(headerEntries(o.Header))
+		}
+		return c.Fetch.ContinueRequest(ctx, args)
+	})
+}
+
+// Fail returns an Action that fails the request with reason.
+func Fail(reason network.ErrorReason) Action {
+	return actionFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		return c.Fetch.FailRequest(ctx, fetch.NewFailRequestArgs(req.RequestID, reason))
+	})
+}
+
+// Fulfill returns an Action that responds to the request with the
+// status code, header and body instead of loading it. The request is
+// failed if the response does not fit in a message, see
+// Router.MaxMessageSize.
+func Fulfill(code int, header http.Header, body []byte) Action {
+	return fulfillFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		args := fetch.NewFulfillRequestArgs(req.RequestID, code).
+			SetResponseHeaders(headerEntries(header)).
+			SetBody(base64.StdEncoding.EncodeToString(body))
+		if err := req.checkSize(args, len(body)); err != nil {
+			return err
+		}
+		return c.Fetch.FulfillRequest(ctx, args)
+	})
+}
+
+// FulfillFile returns an Action that responds to the request with the
+// contents of the named file. The Content-Type is determined by the
+// file extension. If the file cannot be read, the response is 404 Not
+// Found.
+func FulfillFile(name string) Action {
+	return fulfillFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			code := http.StatusInternalServerError
+			if os.IsNotExist(err) {
+				code = http.StatusNotFound
+			}
+			return Fulfill(code, nil, []byte(http.StatusText(code))).resolve(ctx, c, req)
+		}
+		header := make(http.Header)
+		ctype := mime.TypeByExtension(filepath.Ext(name))
+		if ctype == "" {
+			ctype = http.DetectContentType(data)
+		}
+		header.Set("Content-Type", ctype)
+		return Fulfill(http.StatusOK, header, data).resolve(ctx, c, req)
+	})
+}
+
+// headerEntries converts the header into header entries, sorted by
+// name.
+func headerEntries(h http.Header) []fetch.HeaderEntry {
+	entries := []fetch.HeaderEntry{}
+	for name, values := range h {
+		for _, v := range values {
+			entries = append(entries, fetch.HeaderEntry{Name: name, Value: v})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
@@ -0,0 +1,47 @@
+/*
+
+Package intercept implements a Router for request interception via the Fetch
+domain. Paused requests are dispatched to the handler of the first matching
+route (URL glob or regexp, resource type and stage), which returns an Action
+that resolves the request.
+
+	r := intercept.NewRouter()
+
+	// Mock an API with an http.Handler.
+	r.Handle(intercept.Pattern{URL: "https://example.com/api/*"}, intercept.HandlerFunc(
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.FulfillHandler(apiMock)
+		}))
+
+	// Block images.
+	r.HandleFunc(intercept.Pattern{ResourceType: network.ResourceTypeImage},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.Fail(network.ErrorReasonBlockedByClient)
+		})
+
+	// Respond to authentication challenges.
+	r.HandleAuth(intercept.ProvideCredentials("user", "secret"))
+
+	go func() {
+		err := r.Serve(ctx, c) // Blocks until ctx is done.
+		// ...
+	}()
+
+Every paused request is resolved, requests that match no route, or whose
+handler returns nil, are continued unmodified. A handler that panics fails the
+request, as does a Fulfill action that cannot be resolved.
+
+An http.Handler can serve all requests to an origin, e.g. for hermetic tests
+where the backend runs in the test process. The response is sent in a single
+message, dial the connection with a write buffer that fits the largest
+(base64 encoded) response body.
+
+	conn, err := rpcc.DialContext(ctx, wsURL, rpcc.WithWriteBufferSize(16<<20))
+	// ...
+
+	r := intercept.NewRouter()
+	r.MaxMessageSize = 16 << 20 // Requests with larger responses are failed.
+	r.Handle(intercept.Pattern{URL: "https://app.test/*"}, intercept.HTTPHandler(mux))
+
+*/
+package intercept
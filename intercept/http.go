@@ -0,0 +1,174 @@
+package intercept
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// NewHTTPRequest returns the paused request ev as an incoming server
+// request, suitable for an http.Handler. Post data that was omitted
+// from the event (because it is too large) is not included, see
+// Request.HTTPRequest.
+func NewHTTPRequest(ctx context.Context, ev *fetch.RequestPausedReply) (*http.Request, error) {
+	var body string
+	if ev.Request.PostData != nil {
+		body = *ev.Request.PostData
+	}
+	return newHTTPRequest(ctx, &ev.Request, body)
+}
+
+func newHTTPRequest(ctx context.Context, r *network.Request, body string) (*http.Request, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "intercept: parse url")
+	}
+	var header map[string]string
+	if len(r.Headers) > 0 {
+		if err = json.Unmarshal(r.Headers, &header); err != nil {
+			return nil, errors.Wrapf(err, "intercept: decode headers")
+		}
+	}
+
+	hr, err := http.NewRequest(r.Method, r.URL, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	// Mimic a request received by http.Server.
+	hr.RequestURI = u.RequestURI()
+	hr.Host = u.Host
+	for name, value := range header {
+		// Multiple values are joined by newlines.
+		for _, v := range strings.Split(value, "\n") {
+			hr.Header.Add(name, v)
+		}
+	}
+	if host := hr.Header.Get("Host"); host != "" {
+		hr.Host = host
+		hr.Header.Del("Host")
+	}
+	hr.ContentLength = int64(len(body))
+	if body == "" {
+		hr.Body = http.NoBody
+	}
+	return hr.WithContext(ctx), nil
+}
+
+// HTTPRequest returns the paused request as an *http.Request, e.g. for
+// use with an http.Handler. Post data that was omitted from the event
+// is fetched via Network.getRequestPostData, this requires the Network
+// domain to be enabled.
+func (r *Request) HTTPRequest(ctx context.Context) (*http.Request, error) {
+	if r.Request.PostData != nil || r.Request.HasPostData == nil || !*r.Request.HasPostData || r.NetworkID == nil {
+		return NewHTTPRequest(ctx, r.RequestPausedReply)
+	}
+	reply, err := r.c.Network.GetRequestPostData(ctx, network.NewGetRequestPostDataArgs(network.RequestID(*r.NetworkID)))
+	if err != nil {
+		return nil, err
+	}
+	return newHTTPRequest(ctx, &r.Request, reply.PostData)
+}
+
+// HTTPHandler returns a Handler that responds to all requests with the
+// response of h (see FulfillHandler), e.g. for serving an application
+// from the test process:
+//
+//	r.Handle(intercept.Pattern{URL: "https://app.test/*"}, intercept.HTTPHandler(mux))
+func HTTPHandler(h http.Handler) Handler {
+	return HandlerFunc(func(context.Context, *Request) Action {
+		return FulfillHandler(h)
+	})
+}
+
+// FulfillHandler returns an Action that responds to the request with
+// the response of h, e.g. an http.FileServer or a mock API.
+//
+// The response is buffered and sent as a whole (Fetch.fulfillRequest),
+// the browser does not support streaming it. The connection must be
+// dialed with a write buffer that fits the base64 encoded body, see
+// rpcc.WithWriteBufferSize and Router.MaxMessageSize. Responses that
+// do not fit fail the request.
+func FulfillHandler(h http.Handler) Action {
+	return fulfillFunc(func(ctx context.Context, c *cdp.Client, req *Request) error {
+		hr, err := req.HTTPRequest(ctx)
+		if err != nil {
+			return err
+		}
+		w := newResponseWriter()
+		h.ServeHTTP(w, hr)
+		w.finish(hr.Method)
+
+		return Fulfill(w.code, w.sent, w.body.Bytes()).resolve(ctx, c, req)
+	})
+}
+
+// responseWriter records the response of an http.Handler.
+type responseWriter struct {
+	header      http.Header
+	code        int
+	wroteHeader bool
+	sent        http.Header // Snapshot of header at WriteHeader.
+	body        bytes.Buffer
+}
+
+func newResponseWriter() *responseWriter {
+	return &responseWriter{header: make(http.Header), code: http.StatusOK}
+}
+
+func (w *responseWriter) Header() http.Header { return w.header }
+
+func (w *responseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.code = code
+	// Changes to the header after WriteHeader are not sent.
+	w.sent = make(http.Header, len(w.header))
+	for k, v := range w.header {
+		w.sent[k] = append([]string(nil), v...)
+	}
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.body.Write(b)
+}
+
+// Flush implements http.Flusher, it is a no-op since the response is
+// sent as a whole.
+func (w *responseWriter) Flush() {}
+
+// finish sets the headers that http.Server would, Content-Type and
+// Content-Length, and discards the body of HEAD requests.
+func (w *responseWriter) finish(method string) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.code == http.StatusNoContent || w.code == http.StatusNotModified {
+		w.body.Reset()
+		return
+	}
+	if w.body.Len() > 0 {
+		if _, ok := w.sent["Content-Type"]; !ok {
+			w.sent.Set("Content-Type", http.DetectContentType(w.body.Bytes()))
+		}
+	}
+	if w.sent.Get("Content-Length") == "" && w.sent.Get("Transfer-Encoding") == "" {
+		w.sent.Set("Content-Length", strconv.Itoa(w.body.Len()))
+	}
+	if method == http.MethodHead {
+		w.body.Reset()
+	}
+}
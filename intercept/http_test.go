@@ -0,0 +1,194 @@
+package intercept_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/intercept"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func TestNewHTTPRequest(t *testing.T) {
+	body := `{"name":"gopher"}`
+	ev := &fetch.RequestPausedReply{RequestID: "R1"}
+	ev.Request.Method = "POST"
+	ev.Request.URL = "https://app.test/api/users?page=2"
+	ev.Request.Headers = []byte(`{"Content-Type":"application/json","X-Multi":"a\nb"}`)
+	ev.Request.PostData = &body
+
+	hr, err := intercept.NewHTTPRequest(context.Background(), ev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hr.Method != "POST" || hr.Host != "app.test" || hr.RequestURI != "/api/users?page=2" {
+		t.Errorf("got %s %s %s, want POST app.test /api/users?page=2", hr.Method, hr.Host, hr.RequestURI)
+	}
+	want := http.Header{
+		"Content-Type": {"application/json"},
+		"X-Multi":      {"a", "b"},
+	}
+	if diff := cmp.Diff(want, hr.Header); diff != "" {
+		t.Errorf("Header (-want +got):\n%s", diff)
+	}
+	if hr.ContentLength != int64(len(body)) {
+		t.Errorf("ContentLength = %d, want %d", hr.ContentLength, len(body))
+	}
+	b, err := ioutil.ReadAll(hr.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != body {
+		t.Errorf("Body = %q, want %q", b, body)
+	}
+}
+
+func TestHTTPHandler(t *testing.T) {
+	large := bytes.Repeat([]byte("0123456789"), 1000)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html>" + r.Host + "</html>"))
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Add("Set-Cookie", "a=1")
+		w.Header().Add("Set-Cookie", "b=2")
+		w.WriteHeader(http.StatusAccepted)
+		w.Header().Set("X-Ignored", "1") // After WriteHeader.
+		w.Write(b)
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(large)
+	})
+
+	r := intercept.NewRouter()
+	r.MaxMessageSize = 1 << 20
+	r.Handle(intercept.Pattern{URL: "https://app.test/*"}, intercept.HTTPHandler(mux))
+
+	// The base64 encoded body must fit in the write buffer.
+	st := newServeTest(t, r, rpcc.WithWriteBufferSize(r.MaxMessageSize))
+	defer st.stop()
+
+	echo := pausedEvent("R2", "POST", "https://app.test/echo", "XHR")
+	echo["request"].(map[string]interface{})["postData"] = "hello"
+	for _, ev := range []map[string]interface{}{
+		pausedEvent("R1", "GET", "https://app.test/", "Document"),
+		echo,
+		pausedEvent("R3", "HEAD", "https://app.test/", "Document"),
+		pausedEvent("R4", "GET", "https://app.test/large", "XHR"),
+	} {
+		if err := st.srv.Event("Fetch.requestPaused", ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	st.wait(t, "Fetch.fulfillRequest", 4)
+	got := make(map[fetch.RequestID]fetch.FulfillRequestArgs)
+	for _, req := range st.srv.CallsTo("Fetch.fulfillRequest") {
+		var args fetch.FulfillRequestArgs
+		if err := req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		got[args.RequestID] = args
+	}
+
+	body := func(s string) *string {
+		b := base64.StdEncoding.EncodeToString([]byte(s))
+		return &b
+	}
+	want := map[fetch.RequestID]fetch.FulfillRequestArgs{
+		"R1": {
+			RequestID:    "R1",
+			ResponseCode: http.StatusOK,
+			ResponseHeaders: []fetch.HeaderEntry{
+				{Name: "Content-Length", Value: "21"},
+				{Name: "Content-Type", Value: "text/html; charset=utf-8"},
+			},
+			Body: body("<html>app.test</html>"),
+		},
+		"R2": {
+			RequestID:    "R2",
+			ResponseCode: http.StatusAccepted,
+			ResponseHeaders: []fetch.HeaderEntry{
+				{Name: "Content-Length", Value: "5"},
+				{Name: "Content-Type", Value: "text/plain"},
+				{Name: "Set-Cookie", Value: "a=1"},
+				{Name: "Set-Cookie", Value: "b=2"},
+			},
+			Body: body("hello"),
+		},
+		"R3": {
+			RequestID:    "R3",
+			ResponseCode: http.StatusOK,
+			ResponseHeaders: []fetch.HeaderEntry{
+				{Name: "Content-Length", Value: "21"},
+				{Name: "Content-Type", Value: "text/html; charset=utf-8"},
+			},
+			Body: body(""),
+		},
+	}
+	if b, _ := base64.StdEncoding.DecodeString(*got["R4"].Body); !bytes.Equal(b, large) {
+		t.Errorf("R4: got body of %d bytes, want %d", len(b), len(large))
+	}
+	delete(got, "R4")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Fetch.fulfillRequest (-want +got):\n%s", diff)
+	}
+}
+
+func TestHTTPHandler_TooLarge(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bytes.Repeat([]byte("0123456789"), 1000))
+	})
+
+	var logBuf bytes.Buffer
+	r := intercept.NewRouter()
+	r.ErrorLog = log.New(&logBuf, "", 0)
+	r.Handle(intercept.Pattern{URL: "https://app.test/*"}, intercept.HTTPHandler(mux))
+
+	// Default write buffer size and MaxMessageSize.
+	st := newServeTest(t, r)
+	defer st.stop()
+
+	for _, ev := range []map[string]interface{}{
+		pausedEvent("R1", "GET", "https://app.test/large", "XHR"),
+		pausedEvent("R2", "GET", "https://app.test/", "Document"),
+	} {
+		if err := st.srv.Event("Fetch.requestPaused", ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The request does not reach the network and the connection
+	// remains usable.
+	var args fetch.FailRequestArgs
+	if err := st.wait(t, "Fetch.failRequest", 1).Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	if args.RequestID != "R1" || args.ErrorReason != network.ErrorReasonFailed {
+		t.Errorf("Fetch.failRequest = %s (%s), want R1 (Failed)", args.RequestID, args.ErrorReason)
+	}
+	st.wait(t, "Fetch.fulfillRequest", 1)
+	if st.srv.Called("Fetch.continueRequest") {
+		t.Error("Fetch.continueRequest was called")
+	}
+	st.stop()
+	if !strings.Contains(logBuf.String(), "increase Router.MaxMessageSize") {
+		t.Errorf("log = %q, want response too large", logBuf.String())
+	}
+}
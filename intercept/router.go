@@ -0,0 +1,453 @@
+package intercept
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+)
+
+// Timeout for resolving a paused request after the handler has
+// returned, or Serve has stopped.
+const resolveTimeout = 10 * time.Second
+
+// Request represents a paused request. In the response stage, the
+// response status code and headers are set.
+type Request struct {
+	*fetch.RequestPausedReply
+	Stage fetch.RequestStage // Stage the request was paused at.
+
+	c          *cdp.Client
+	maxMessage int // Router.MaxMessageSize.
+}
+
+var errResponseTooLarge = errors.New("response too large, increase Router.MaxMessageSize and rpcc.WithWriteBufferSize")
+
+const (
+	// The default write buffer size of rpcc.
+	defaultMaxMessageSize = 4096
+	// Room for the id, method and session ID of the message.
+	messageOverhead = 128
+)
+
+// checkSize returns an error if the request to fulfill the response
+// does not fit in a message.
+func (r *Request) checkSize(args *fetch.FulfillRequestArgs, bodySize int) error {
+	limit := r.maxMessage
+	if limit == 0 {
+		limit = defaultMaxMessageSize
+	}
+	if limit < 0 {
+		return nil
+	}
+	data, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	if n := len(data) + messageOverhead; n > limit {
+		return errors.Wrapf(errResponseTooLarge, "intercept: fulfill: body of %d bytes needs a message of ~%d bytes, MaxMessageSize is %d", bodySize, n, limit)
+	}
+	return nil
+}
+
+// ResponseBody returns the body of the response, it can only be called
+// in the response stage.
+func (r *Request) ResponseBody(ctx context.Context) ([]byte, error) {
+	reply, err := r.c.Fetch.GetResponseBody(ctx, fetch.NewGetResponseBodyArgs(r.RequestID))
+	if err != nil {
+		return nil, err
+	}
+	if reply.Base64Encoded {
+		return base64.StdEncoding.DecodeString(reply.Body)
+	}
+	return []byte(reply.Body), nil
+}
+
+// Handler handles a paused request and returns the Action that
+// resolves it. A nil Action continues the request.
+type Handler interface {
+	Intercept(ctx context.Context, req *Request) Action
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as
+// a Handler.
+type HandlerFunc func(ctx context.Context, req *Request) Action
+
+// Intercept calls fn(ctx, req).
+func (fn HandlerFunc) Intercept(ctx context.Context, req *Request) Action {
+	return fn(ctx, req)
+}
+
+// Pattern represents the requests matched by a route. All set fields
+// must match.
+type Pattern struct {
+	// URL is a glob pattern, '*' matches zero or more characters and
+	// '?' exactly one, backslash escapes. Empty matches all URLs.
+	URL string
+	// Regexp matches the URL, if set.
+	Regexp *regexp.Regexp
+	// ResourceType matches the resource type, e.g.
+	// network.ResourceTypeImage, if set.
+	ResourceType network.ResourceType
+	// Stage matches the stage, the default is the request stage.
+	Stage fetch.RequestStage
+}
+
+func (p Pattern) stage() fetch.RequestStage {
+	if p.Stage == fetch.RequestStageNotSet {
+		return fetch.RequestStageRequest
+	}
+	return p.Stage
+}
+
+func (p Pattern) match(req *Request) bool {
+	if p.stage() != req.Stage {
+		return false
+	}
+	if p.ResourceType != "" && p.ResourceType != req.ResourceType {
+		return false
+	}
+	url := req.Request.URL
+	if req.Request.URLFragment != nil {
+		url += *req.Request.URLFragment
+	}
+	if p.URL != "" && !matchGlob(p.URL, url) {
+		return false
+	}
+	if p.Regexp != nil && !p.Regexp.MatchString(url) {
+		return false
+	}
+	return true
+}
+
+// requestPattern returns the pattern that the browser uses for pausing
+// requests (Fetch.enable).
+func (p Pattern) requestPattern() fetch.RequestPattern {
+	rp := fetch.RequestPattern{RequestStage: p.stage()}
+	if p.URL != "" && p.Regexp == nil {
+		url := p.URL
+		rp.URLPattern = &url
+	}
+	if p.ResourceType != "" {
+		rt := p.ResourceType
+		rp.ResourceType = &rt
+	}
+	return rp
+}
+
+// AuthFunc responds to an authentication challenge, see
+// ProvideCredentials.
+type AuthFunc func(ctx context.Context, req *fetch.AuthRequiredReply) fetch.AuthChallengeResponse
+
+// ProvideCredentials returns an AuthFunc that responds to all
+// authentication challenges with the username and password.
+func ProvideCredentials(username, password string) AuthFunc {
+	return func(context.Context, *fetch.AuthRequiredReply) fetch.AuthChallengeResponse {
+		return fetch.AuthChallengeResponse{
+			Response: "ProvideCredentials",
+			Username: &username,
+			Password: &password,
+		}
+	}
+}
+
+type route struct {
+	p Pattern
+	h Handler
+}
+
+// Router dispatches paused requests (Fetch.requestPaused) to the
+// handler of the first matching route, in the order that routes were
+// registered. Requests that match no route are continued.
+//
+// Every paused request is resolved so that the page does not hang: if
+// the handler returns a nil Action the request is continued, if it
+// panics the request is failed. If the Action fails, the request is
+// continued, or failed if it should have been fulfilled.
+type Router struct {
+	// ErrorLog specifies an optional logger for errors in handlers
+	// and when resolving requests. If nil, the log package's
+	// standard logger is used.
+	ErrorLog *log.Logger
+	// MaxMessageSize is the write buffer size of the connection
+	// (rpcc.WithWriteBufferSize). Responses (Fulfill) that do not
+	// fit in a message fail the request instead of breaking the
+	// connection. If zero, the default write buffer size of rpcc
+	// (4096 bytes) is used, a negative value disables the check.
+	MaxMessageSize int
+
+	mu     sync.Mutex // Protects following.
+	routes []route
+	auth   AuthFunc
+}
+
+// NewRouter returns a new Router.
+func NewRouter() *Router {
+	return &Router{}
+}
+
+// Handle registers the handler for requests matching p.
+func (r *Router) Handle(p Pattern, h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes = append(r.routes, route{p: p, h: h})
+}
+
+// HandleFunc registers the handler function for requests matching p.
+func (r *Router) HandleFunc(p Pattern, fn func(ctx context.Context, req *Request) Action) {
+	r.Handle(p, HandlerFunc(fn))
+}
+
+// HandleAuth registers fn for responding to authentication challenges
+// (Fetch.authRequired, Fetch.continueWithAuth). Without an AuthFunc,
+// challenges are handled by the browser.
+func (r *Router) HandleAuth(fn AuthFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.auth = fn
+}
+
+// Intercept implements Handler, it dispatches req to the first
+// matching route.
+func (r *Router) Intercept(ctx context.Context, req *Request) Action {
+	r.mu.Lock()
+	var h Handler
+	for _, rt := range r.routes {
+		if rt.p.match(req) {
+			h = rt.h
+			break
+		}
+	}
+	r.mu.Unlock()
+
+	if h == nil {
+		return Continue()
+	}
+	return h.Intercept(ctx, req)
+}
+
+// Serve enables the Fetch domain on c for the registered routes and
+// handles paused requests until ctx is done or the connection is
+// closed. Routes must be registered before calling Serve. In-flight
+// requests are resolved and the Fetch domain is disabled before Serve
+// returns.
+func (r *Router) Serve(ctx context.Context, c *cdp.Client) error {
+	r.mu.Lock()
+	patterns := make([]fetch.RequestPattern, 0, len(r.routes))
+	for _, rt := range r.routes {
+		patterns = append(patterns, rt.p.requestPattern())
+	}
+	auth := r.auth
+	r.mu.Unlock()
+
+	if len(patterns) == 0 && auth == nil {
+		return errors.New("intercept: Serve: no routes")
+	}
+
+	// Paused requests are handled concurrently, the handlers must not
+	// block the dispatcher.
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		stopped bool
+	)
+	spawn := func(fn func()) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn()
+		}()
+	}
+	stop := func() {
+		mu.Lock()
+		stopped = true
+		mu.Unlock()
+		wg.Wait()
+	}
+	defer stop()
+
+	unsubPaused, err := c.Fetch.OnRequestPaused(func(ev *fetch.RequestPausedReply) {
+		spawn(func() { r.handle(ctx, c, ev) })
+	})
+	if err != nil {
+		return err
+	}
+	defer unsubPaused()
+
+	unsubAuth, err := c.Fetch.OnAuthRequired(func(ev *fetch.AuthRequiredReply) {
+		spawn(func() { r.handleAuth(ctx, c, auth, ev) })
+	})
+	if err != nil {
+		return err
+	}
+	defer unsubAuth()
+
+	if len(patterns) == 0 {
+		// Authentication challenges are only reported for paused
+		// requests, pause (and continue) all of them.
+		patterns = append(patterns, Pattern{}.requestPattern())
+	}
+	args := fetch.NewEnableArgs().
+		SetPatterns(patterns).
+		SetHandleAuthRequests(auth != nil)
+	if err = c.Fetch.Enable(ctx, args); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-c.Conn().Context().Done():
+		return errors.New("intercept: Serve: connection closed")
+	}
+
+	// Resolve in-flight requests before disabling, disabling the Fetch
+	// domain continues all paused requests.
+	unsubPaused()
+	unsubAuth()
+	stop()
+
+	dctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	if e := c.Fetch.Disable(dctx); e != nil {
+		r.logf("intercept: disable failed: %v", e)
+	}
+	return err
+}
+
+// handle resolves the paused request.
+func (r *Router) handle(ctx context.Context, c *cdp.Client, ev *fetch.RequestPausedReply) {
+	req := &Request{RequestPausedReply: ev, Stage: fetch.RequestStageRequest, c: c, maxMessage: r.MaxMessageSize}
+	if ev.ResponseStatusCode != nil || ev.ResponseErrorReason != nil {
+		req.Stage = fetch.RequestStageResponse
+	}
+
+	action := r.intercept(ctx, req)
+
+	// The request must be resolved even when ctx is done.
+	rctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	err := action.resolve(rctx, c, req)
+	if err != nil {
+		r.logf("intercept: resolve %s %s failed: %v", req.Request.Method, req.Request.URL, err)
+		// A request that should have been fulfilled must not
+		// reach the network.
+		fallback, name := Continue(), "continue"
+		if _, ok := action.(fulfillFunc); ok {
+			fallback, name = Fail(network.ErrorReasonFailed), "fail"
+		}
+		if err = fallback.resolve(rctx, c, req); err != nil {
+			r.logf("intercept: %s %s %s failed: %v", name, req.Request.Method, req.Request.URL, err)
+		}
+	}
+}
+
+// intercept calls the handler, recovering panics.
+func (r *Router) intercept(ctx context.Context, req *Request) (action Action) {
+	defer func() {
+		if e := recover(); e != nil {
+			r.logf("intercept: panic handling %s %s: %v", req.Request.Method, req.Request.URL, e)
+			action = Fail(network.ErrorReasonFailed)
+		}
+	}()
+	action = r.Intercept(ctx, req)
+	if action == nil {
+		action = Continue()
+	}
+	return action
+}
+
+// handleAuth responds to the authentication challenge.
+func (r *Router) handleAuth(ctx context.Context, c *cdp.Client, auth AuthFunc, ev *fetch.AuthRequiredReply) {
+	resp := fetch.AuthChallengeResponse{Response: "Default"}
+	if auth != nil {
+		func() {
+			defer func() {
+				if e := recover(); e != nil {
+					r.logf("intercept: panic handling auth for %s: %v", ev.Request.URL, e)
+					resp = fetch.AuthChallengeResponse{Response: "CancelAuth"}
+				}
+			}()
+			resp = auth(ctx, ev)
+		}()
+	}
+
+	rctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	defer cancel()
+	err := c.Fetch.ContinueWithAuth(rctx, fetch.NewContinueWithAuthArgs(ev.RequestID, resp))
+	if err != nil {
+		r.logf("intercept: continue with auth %s failed: %v", ev.Request.URL, err)
+	}
+}
+
+func (r *Router) logf(format string, args ...interface{}) {
+	if r.ErrorLog != nil {
+		r.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+var _ Handler = (*Router)(nil)
+
+// matchGlob reports whether s matches the glob pattern, '*' matches
+// zero or more characters and '?' exactly one, backslash escapes.
+func matchGlob(pattern, s string) bool {
+	// Position to backtrack to when the last '*' must consume
+	// another character.
+	star, next := -1, 0
+	p, i := 0, 0
+	for i < len(s) {
+		if p < len(pattern) {
+			switch c := pattern[p]; c {
+			case '*':
+				star, next = p, i
+				p++
+				continue
+			case '?':
+				p++
+				i++
+				continue
+			case '\\':
+				if p+1 < len(pattern) {
+					c = pattern[p+1]
+					if c == s[i] {
+						p += 2
+						i++
+						continue
+					}
+					break
+				}
+				fallthrough
+			default:
+				if c == s[i] {
+					p++
+					i++
+					continue
+				}
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		next++
+		p, i = star+1, next
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,341 @@
+package intercept_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/intercept"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func pausedEvent(id, method, url, resourceType string) map[string]interface{} {
+	return map[string]interface{}{
+		"requestId":    id,
+		"frameId":      "F1",
+		"resourceType": resourceType,
+		"request": map[string]interface{}{
+			"url":             url,
+			"method":          method,
+			"headers":         map[string]string{"Accept": "*/*"},
+			"initialPriority": "High",
+			"referrerPolicy":  "no-referrer",
+		},
+	}
+}
+
+type serveTest struct {
+	srv  *cdptest.Server
+	conn *rpcc.Conn
+	errC chan error
+	stop func()
+}
+
+func newServeTest(t *testing.T, r *intercept.Router, opts ...rpcc.DialOption) *serveTest {
+	srv := cdptest.NewServer()
+	for _, method := range []string{
+		"Fetch.enable",
+		"Fetch.disable",
+		"Fetch.continueRequest",
+		"Fetch.fulfillRequest",
+		"Fetch.failRequest",
+		"Fetch.continueWithAuth",
+	} {
+		srv.Handle(method, cdptest.Reply(nil))
+	}
+
+	conn, err := rpcc.Dial(srv.WebSocketURL, opts...)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() { errC <- r.Serve(ctx, cdp.NewClient(conn)) }()
+
+	wctx, wcancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer wcancel()
+	if _, err = srv.WaitCall(wctx, "Fetch.enable", 1); err != nil {
+		cancel()
+		conn.Close()
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	var once sync.Once
+	return &serveTest{
+		srv:  srv,
+		conn: conn,
+		errC: errC,
+		stop: func() {
+			once.Do(func() {
+				cancel()
+				<-errC
+				conn.Close()
+				srv.Close()
+			})
+		},
+	}
+}
+
+func (st *serveTest) wait(t *testing.T, method string, n int) *cdptest.Request {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := st.srv.WaitCall(ctx, method, n)
+	if err != nil {
+		t.Fatalf("WaitCall(%s, %d): %v", method, n, err)
+	}
+	return &req
+}
+
+func TestRouter_Serve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "intercept")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	styleFile := filepath.Join(dir, "style.css")
+	if err = ioutil.WriteFile(styleFile, []byte("body{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := intercept.NewRouter()
+	r.ErrorLog = log.New(ioutil.Discard, "", 0)
+	r.Handle(intercept.Pattern{URL: "https://example.com/api/*"}, intercept.HandlerFunc(
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.FulfillHandler(http.HandlerFunc(func(w http.ResponseWriter, hr *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"accept":"` + hr.Header.Get("Accept") + `"}`))
+			}))
+		}))
+	r.HandleFunc(intercept.Pattern{Regexp: regexp.MustCompile(`\.css$`)},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.FulfillFile(styleFile)
+		})
+	r.HandleFunc(intercept.Pattern{ResourceType: network.ResourceTypeImage},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.Fail(network.ErrorReasonBlockedByClient)
+		})
+	r.HandleFunc(intercept.Pattern{URL: "*/redirect"},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return intercept.ContinueWith(intercept.Override{
+				URL:    "https://example.com/target",
+				Header: http.Header{"X-Test": {"1"}},
+			})
+		})
+	r.HandleFunc(intercept.Pattern{URL: "*/panic"},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			panic("boom")
+		})
+	r.HandleFunc(intercept.Pattern{URL: "*/nil"},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			return nil
+		})
+
+	st := newServeTest(t, r)
+	defer st.stop()
+
+	var enable fetch.EnableArgs
+	if err = st.wait(t, "Fetch.enable", 1).Unmarshal(&enable); err != nil {
+		t.Fatal(err)
+	}
+	if len(enable.Patterns) != 6 {
+		t.Errorf("Fetch.enable: got %d patterns, want 6", len(enable.Patterns))
+	}
+
+	events := []map[string]interface{}{
+		pausedEvent("R1", "GET", "https://example.com/api/users", "XHR"),
+		pausedEvent("R2", "GET", "https://example.com/style.css", "Stylesheet"),
+		pausedEvent("R3", "GET", "https://example.com/logo.png", "Image"),
+		pausedEvent("R4", "POST", "https://example.com/redirect", "Document"),
+		pausedEvent("R5", "GET", "https://example.com/panic", "Document"),
+		pausedEvent("R6", "GET", "https://example.com/nil", "Document"),
+		pausedEvent("R7", "GET", "https://example.com/other", "Document"),
+	}
+	for _, ev := range events {
+		if err = st.srv.Event("Fetch.requestPaused", ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	st.wait(t, "Fetch.fulfillRequest", 2)
+	st.wait(t, "Fetch.failRequest", 2)
+	st.wait(t, "Fetch.continueRequest", 3)
+
+	fulfilled := make(map[fetch.RequestID]fetch.FulfillRequestArgs)
+	for _, req := range st.srv.CallsTo("Fetch.fulfillRequest") {
+		var args fetch.FulfillRequestArgs
+		if err = req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		fulfilled[args.RequestID] = args
+	}
+	api := fulfilled["R1"]
+	if api.ResponseCode != http.StatusCreated {
+		t.Errorf("R1: got code %d, want %d", api.ResponseCode, http.StatusCreated)
+	}
+	if body, _ := base64.StdEncoding.DecodeString(*api.Body); string(body) != `{"accept":"*/*"}` {
+		t.Errorf("R1: got body %q", body)
+	}
+	style := fulfilled["R2"]
+	wantHeaders := []fetch.HeaderEntry{{Name: "Content-Type", Value: "text/css; charset=utf-8"}}
+	if diff := cmp.Diff(wantHeaders, style.ResponseHeaders); diff != "" {
+		t.Errorf("R2: headers (-want +got):\n%s", diff)
+	}
+	if body, _ := base64.StdEncoding.DecodeString(*style.Body); string(body) != "body{}" {
+		t.Errorf("R2: got body %q", body)
+	}
+
+	failed := make(map[fetch.RequestID]network.ErrorReason)
+	for _, req := range st.srv.CallsTo("Fetch.failRequest") {
+		var args fetch.FailRequestArgs
+		if err = req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		failed[args.RequestID] = args.ErrorReason
+	}
+	wantFailed := map[fetch.RequestID]network.ErrorReason{
+		"R3": network.ErrorReasonBlockedByClient,
+		"R5": network.ErrorReasonFailed,
+	}
+	if diff := cmp.Diff(wantFailed, failed); diff != "" {
+		t.Errorf("Fetch.failRequest (-want +got):\n%s", diff)
+	}
+
+	continued := make(map[fetch.RequestID]fetch.ContinueRequestArgs)
+	for _, req := range st.srv.CallsTo("Fetch.continueRequest") {
+		var args fetch.ContinueRequestArgs
+		if err = req.Unmarshal(&args); err != nil {
+			t.Fatal(err)
+		}
+		continued[args.RequestID] = args
+	}
+	url := "https://example.com/target"
+	wantContinued := map[fetch.RequestID]fetch.ContinueRequestArgs{
+		"R4": {
+			RequestID: "R4",
+			URL:       &url,
+			Headers:   []fetch.HeaderEntry{{Name: "X-Test", Value: "1"}},
+		},
+		"R6": {RequestID: "R6"},
+		"R7": {RequestID: "R7"},
+	}
+	if diff := cmp.Diff(wantContinued, continued); diff != "" {
+		t.Errorf("Fetch.continueRequest (-want +got):\n%s", diff)
+	}
+
+	st.stop()
+	if !st.srv.Called("Fetch.disable") {
+		t.Error("Fetch.disable was not called")
+	}
+}
+
+func TestRouter_Stage(t *testing.T) {
+	r := intercept.NewRouter()
+	r.HandleFunc(intercept.Pattern{URL: "*", Stage: fetch.RequestStageResponse},
+		func(ctx context.Context, req *intercept.Request) intercept.Action {
+			if req.Stage != fetch.RequestStageResponse {
+				t.Errorf("got stage %s, want %s", req.Stage, fetch.RequestStageResponse)
+			}
+			return intercept.Fulfill(http.StatusOK, nil, []byte("replaced"))
+		})
+
+	st := newServeTest(t, r)
+	defer st.stop()
+
+	ev := pausedEvent("R1", "GET", "https://example.com/", "Document")
+	ev["responseStatusCode"] = 200
+	if err := st.srv.Event("Fetch.requestPaused", ev); err != nil {
+		t.Fatal(err)
+	}
+	// The request stage does not match, it is continued.
+	if err := st.srv.Event("Fetch.requestPaused", pausedEvent("R2", "GET", "https://example.com/", "Document")); err != nil {
+		t.Fatal(err)
+	}
+
+	var args fetch.FulfillRequestArgs
+	if err := st.wait(t, "Fetch.fulfillRequest", 1).Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	if args.RequestID != "R1" {
+		t.Errorf("Fetch.fulfillRequest: got %s, want R1", args.RequestID)
+	}
+	if err := st.wait(t, "Fetch.continueRequest", 1).Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	if args.RequestID != "R2" {
+		t.Errorf("Fetch.continueRequest: got %s, want R2", args.RequestID)
+	}
+}
+
+func TestRouter_HandleAuth(t *testing.T) {
+	r := intercept.NewRouter()
+	r.HandleAuth(intercept.ProvideCredentials("user", "secret"))
+
+	st := newServeTest(t, r)
+	defer st.stop()
+
+	var enable fetch.EnableArgs
+	if err := st.wait(t, "Fetch.enable", 1).Unmarshal(&enable); err != nil {
+		t.Fatal(err)
+	}
+	if enable.HandleAuthRequests == nil || !*enable.HandleAuthRequests {
+		t.Error("Fetch.enable: handleAuthRequests not set")
+	}
+
+	err := st.srv.Event("Fetch.authRequired", map[string]interface{}{
+		"requestId":    "R1",
+		"frameId":      "F1",
+		"resourceType": "Document",
+		"request":      pausedEvent("", "GET", "https://example.com/", "")["request"],
+		"authChallenge": map[string]string{
+			"origin": "https://example.com",
+			"scheme": "basic",
+			"realm":  "test",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var args fetch.ContinueWithAuthArgs
+	if err = st.wait(t, "Fetch.continueWithAuth", 1).Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	user, pass := "user", "secret"
+	want := fetch.ContinueWithAuthArgs{
+		RequestID: "R1",
+		AuthChallengeResponse: fetch.AuthChallengeResponse{
+			Response: "ProvideCredentials",
+			Username: &user,
+			Password: &pass,
+		},
+	}
+	if diff := cmp.Diff(want, args); diff != "" {
+		t.Errorf("Fetch.continueWithAuth (-want +got):\n%s", diff)
+	}
+}
+
+func TestRouter_Serve_NoRoutes(t *testing.T) {
+	r := intercept.NewRouter()
+	if err := r.Serve(context.Background(), nil); err == nil {
+		t.Error("Serve: want error, got nil")
+	}
+}
@@ -0,0 +1,347 @@
+// Package fastjson implements a minimal JSON scanner and encoder for
+// the hot paths of rpcc and the generated protocol types (cdpgen
+// -fast-json). Values that are not handled here are decoded and encoded
+// with encoding/json.
+package fastjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+var errUnexpectedEnd = errors.New("fastjson: unexpected end of JSON input")
+
+func syntaxError(data []byte, i int) error {
+	if i >= len(data) {
+		return errUnexpectedEnd
+	}
+	return fmt.Errorf("fastjson: invalid character %q at offset %d", data[i], i)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) && isSpace(data[i]) {
+		i++
+	}
+	return i
+}
+
+// Skip skips the whitespace and the value that starts at offset i and
+// returns the offset after the value. Strings, objects and arrays are
+// scanned for their end, their contents are not fully validated.
+func Skip(data []byte, i int) (int, error) {
+	i = skipSpace(data, i)
+	if i >= len(data) {
+		return i, errUnexpectedEnd
+	}
+	switch c := data[i]; c {
+	case '"':
+		return skipString(data, i)
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				end, err := skipString(data, i)
+				if err != nil {
+					return end, err
+				}
+				i = end
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1, nil
+				}
+			}
+			i++
+		}
+		return i, errUnexpectedEnd
+	default:
+		// Literals and numbers.
+		start := i
+		for i < len(data) {
+			c := data[i]
+			if c == ',' || c == '}' || c == ']' || isSpace(c) {
+				break
+			}
+			i++
+		}
+		if i == start {
+			return i, syntaxError(data, i)
+		}
+		return i, nil
+	}
+}
+
+// skipString returns the offset after the string that starts at i.
+func skipString(data []byte, i int) (int, error) {
+	i++ // Opening quote.
+	for i < len(data) {
+		switch data[i] {
+		case '\\':
+			i += 2
+			continue
+		case '"':
+			return i + 1, nil
+		}
+		i++
+	}
+	return i, errUnexpectedEnd
+}
+
+// ObjectEach calls fn for each key and (raw) value in the JSON object
+// data. Iteration stops when fn returns an error. A null object is
+// treated as an empty object.
+func ObjectEach(data []byte, fn func(key, value []byte) error) error {
+	i := skipSpace(data, 0)
+	end := len(data)
+	for end > i && isSpace(data[end-1]) {
+		end--
+	}
+	if IsNull(data[i:end]) {
+		return nil
+	}
+	if i >= len(data) || data[i] != '{' {
+		return syntaxError(data, i)
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return nil
+	}
+	for {
+		if i >= len(data) || data[i] != '"' {
+			return syntaxError(data, i)
+		}
+		end, err := skipString(data, i)
+		if err != nil {
+			return err
+		}
+		key := data[i+1 : end-1]
+		for _, c := range key {
+			if c == '\\' {
+				var s string
+				if err = json.Unmarshal(data[i:end], &s); err != nil {
+					return err
+				}
+				key = []byte(s)
+				break
+			}
+		}
+
+		i = skipSpace(data, end)
+		if i >= len(data) || data[i] != ':' {
+			return syntaxError(data, i)
+		}
+		start := skipSpace(data, i+1)
+		end, err = Skip(data, start)
+		if err != nil {
+			return err
+		}
+		if err = fn(key, data[start:end]); err != nil {
+			return err
+		}
+
+		i = skipSpace(data, end)
+		if i >= len(data) {
+			return errUnexpectedEnd
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case '}':
+			return nil
+		default:
+			return syntaxError(data, i)
+		}
+	}
+}
+
+// IsNull returns true if value is the JSON null literal.
+func IsNull(value []byte) bool {
+	return len(value) == 4 && string(value) == "null"
+}
+
+// String decodes a JSON string, null decodes as the empty string.
+func String(value []byte) (string, error) {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		if IsNull(value) {
+			return "", nil
+		}
+		return "", fmt.Errorf("fastjson: cannot decode %.20q as string", value)
+	}
+	s := value[1 : len(value)-1]
+	for _, c := range s {
+		if c == '\\' || c < ' ' || c >= utf8.RuneSelf {
+			// Slow path, escapes and UTF-8 validation.
+			var v string
+			err := json.Unmarshal(value, &v)
+			return v, err
+		}
+	}
+	return string(s), nil
+}
+
+// Int decodes a JSON number as an int, null decodes as zero.
+func Int(value []byte) (int, error) {
+	if IsNull(value) {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(string(value), 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("fastjson: cannot decode %.20q as int", value)
+	}
+	return int(n), nil
+}
+
+// Uint64 decodes a JSON number as an uint64, null decodes as zero.
+func Uint64(value []byte) (uint64, error) {
+	if IsNull(value) {
+		return 0, nil
+	}
+	var n uint64
+	if len(value) == 0 || len(value) > 20 {
+		return 0, fmt.Errorf("fastjson: cannot decode %.20q as uint64", value)
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("fastjson: cannot decode %.20q as uint64", value)
+		}
+		n = n*10 + uint64(c-'0')
+	}
+	return n, nil
+}
+
+// Float decodes a JSON number as a float64, null decodes as zero.
+func Float(value []byte) (float64, error) {
+	if IsNull(value) {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(string(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("fastjson: cannot decode %.20q as float64", value)
+	}
+	return f, nil
+}
+
+// Bool decodes a JSON boolean, null decodes as false.
+func Bool(value []byte) (bool, error) {
+	switch string(value) {
+	case "true":
+		return true, nil
+	case "false", "null":
+		return false, nil
+	}
+	return false, fmt.Errorf("fastjson: cannot decode %.20q as bool", value)
+}
+
+// AppendKey appends the object key (and separator) to dst, dst must
+// end with the opening brace or a previous value.
+func AppendKey(dst []byte, key string) []byte {
+	if len(dst) > 0 && dst[len(dst)-1] != '{' {
+		dst = append(dst, ',')
+	}
+	dst = AppendString(dst, key)
+	return append(dst, ':')
+}
+
+const hex = "0123456789abcdef"
+
+// AppendString appends s as a JSON string to dst, it is escaped the
+// same way as by encoding/json (including HTML characters).
+func AppendString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '\\', '"':
+				dst = append(dst, '\\', c)
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// AppendInt appends n as a JSON number to dst.
+func AppendInt(dst []byte, n int) []byte {
+	return strconv.AppendInt(dst, int64(n), 10)
+}
+
+// AppendFloat appends f as a JSON number to dst, it is formatted the
+// same way as by encoding/json.
+func AppendFloat(dst []byte, f float64) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return dst, fmt.Errorf("fastjson: unsupported value: %s", strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	dst = strconv.AppendFloat(dst, f, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst, nil
+}
+
+// AppendBool appends b as a JSON boolean to dst.
+func AppendBool(dst []byte, b bool) []byte {
+	return strconv.AppendBool(dst, b)
+}
+
+// AppendValue appends v encoded by encoding/json to dst.
+func AppendValue(dst []byte, v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, b...), nil
+}
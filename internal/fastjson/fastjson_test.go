@@ -0,0 +1,140 @@
+package fastjson
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjectEach(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"Empty", `{}`, map[string]string{}, false},
+		{"Null", `null`, map[string]string{}, false},
+		{"NullWhitespace", " \n null\t ", map[string]string{}, false},
+		{"Values", `{"a":1,"b":"x,}","c":{"d":[1,{"e":"]"}]},"f":null,"g":true}`, map[string]string{
+			"a": `1`,
+			"b": `"x,}"`,
+			"c": `{"d":[1,{"e":"]"}]}`,
+			"f": `null`,
+			"g": `true`,
+		}, false},
+		{"Whitespace", " {\n\t\"a\" : 1 ,\r\n \"b\":[ ] } ", map[string]string{
+			"a": `1`,
+			"b": `[ ]`,
+		}, false},
+		{"EscapedKey", `{"a\"b":"\"c\\"}`, map[string]string{`a"b`: `"\"c\\"`}, false},
+		{"NotObject", `[1]`, nil, true},
+		{"Truncated", `{"a":1`, nil, true},
+		{"TruncatedString", `{"a":"b}`, nil, true},
+		{"MissingColon", `{"a" 1}`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]string)
+			err := ObjectEach([]byte(tt.data), func(key, value []byte) error {
+				got[string(key)] = string(value)
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ObjectEach() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ObjectEach() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	s, err := String([]byte(`"a\nbå"`))
+	if err != nil || s != "a\nbå" {
+		t.Errorf("String() = %q, %v; want %q", s, err, "a\nbå")
+	}
+	if _, err = String([]byte(`1`)); err == nil {
+		t.Error("String(1) want error")
+	}
+	n, err := Int([]byte(`-42`))
+	if err != nil || n != -42 {
+		t.Errorf("Int() = %d, %v; want -42", n, err)
+	}
+	if _, err = Int([]byte(`1.5`)); err == nil {
+		t.Error("Int(1.5) want error")
+	}
+	u, err := Uint64([]byte(`18446744073709551615`))
+	if err != nil || u != math.MaxUint64 {
+		t.Errorf("Uint64() = %d, %v; want %d", u, err, uint64(math.MaxUint64))
+	}
+	f, err := Float([]byte(`1.5e3`))
+	if err != nil || f != 1500 {
+		t.Errorf("Float() = %g, %v; want 1500", f, err)
+	}
+	b, err := Bool([]byte(`true`))
+	if err != nil || !b {
+		t.Errorf("Bool() = %v, %v; want true", b, err)
+	}
+	if _, err = Bool([]byte(`1`)); err == nil {
+		t.Error("Bool(1) want error")
+	}
+}
+
+func TestAppendString(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"hello",
+		`"quoted" \back\slash`,
+		"\n\r\t\x00\x1f",
+		"<html> & </html>",
+		"åäö 日本語 😀",
+		"  ",
+		"invalid \xff utf-8",
+	} {
+		want, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := AppendString(nil, s); string(got) != string(want) {
+			t.Errorf("AppendString(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
+
+func TestAppendFloat(t *testing.T) {
+	for _, f := range []float64{0, 1, -1.5, 1e20, 1e21, 123456789.123, 1e-6, 1e-7, 2.5e-9, -1e300, math.MaxFloat64} {
+		want, err := json.Marshal(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := AppendFloat(nil, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("AppendFloat(%g) = %s, want %s", f, got, want)
+		}
+	}
+	if _, err := AppendFloat(nil, math.NaN()); err == nil {
+		t.Error("AppendFloat(NaN) want error")
+	}
+}
+
+func TestAppendKey(t *testing.T) {
+	b := []byte{'{'}
+	b = AppendKey(b, "a")
+	b = AppendInt(b, 1)
+	b = AppendKey(b, "b")
+	b = AppendBool(b, true)
+	b = append(b, '}')
+	if want := `{"a":1,"b":true}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+}
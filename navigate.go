@@ -0,0 +1,275 @@
+package cdp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/inspector"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// WaitCondition represents the lifecycle events that Navigate waits
+// for. Conditions can be combined, e.g. WaitLoad|WaitNetworkIdle, in
+// which case Navigate waits for all of them.
+type WaitCondition int
+
+// WaitCondition enums.
+const (
+	WaitLoad              WaitCondition = 1 << iota // The load event (lifecycle event "load").
+	WaitDOMContentLoaded                            // The DOMContentLoaded event.
+	WaitNetworkIdle                                 // No network activity for 500ms.
+	WaitNetworkAlmostIdle                           // At most two network requests for 500ms.
+)
+
+// lifecycleEvents maps the names of lifecycle events to conditions.
+var lifecycleEvents = map[string]WaitCondition{
+	"load":              WaitLoad,
+	"DOMContentLoaded":  WaitDOMContentLoaded,
+	"networkIdle":       WaitNetworkIdle,
+	"networkAlmostIdle": WaitNetworkAlmostIdle,
+}
+
+// ErrNavigationAborted indicates that the navigation was aborted, e.g.
+// because it was replaced by another navigation in the same frame. Use
+// errors.Is to check for ErrNavigationAborted.
+var ErrNavigationAborted = errors.New("cdp: navigation aborted")
+
+// ErrFrameDetached indicates that the frame was detached during the
+// navigation. Use errors.Is to check for ErrFrameDetached.
+var ErrFrameDetached = errors.New("cdp: frame detached")
+
+// ErrTargetCrashed indicates that the target crashed during the
+// navigation. Use errors.Is to check for ErrTargetCrashed.
+var ErrTargetCrashed = errors.New("cdp: target crashed")
+
+// NavigationError is returned by Navigate when the navigation failed.
+type NavigationError struct {
+	URL     string       // The URL that was navigated to.
+	FrameID page.FrameID // The frame that failed to navigate.
+	Text    string       // Error text, e.g. "net::ERR_NAME_NOT_RESOLVED".
+}
+
+func (e *NavigationError) Error() string {
+	return fmt.Sprintf("cdp: navigation to %s failed: %s", e.URL, e.Text)
+}
+
+// Is implements errors.Is for ErrNavigationAborted, ErrFrameDetached
+// and ErrTargetCrashed.
+func (e *NavigationError) Is(target error) bool {
+	switch target {
+	case ErrNavigationAborted:
+		return e.Text == "net::ERR_ABORTED" || e.Text == errTextSuperseded
+	case ErrFrameDetached:
+		return e.Text == errTextDetached
+	case ErrTargetCrashed:
+		return e.Text == errTextCrashed
+	}
+	return false
+}
+
+const (
+	errTextSuperseded = "superseded by another navigation"
+	errTextDetached   = "frame detached"
+	errTextCrashed    = "target crashed"
+)
+
+// NavigateOption represents a function that sets a Navigate option.
+type NavigateOption func(*navigateOptions)
+
+type navigateOptions struct {
+	waitFor  WaitCondition
+	referrer string
+}
+
+// WaitFor returns a NavigateOption that sets the conditions to wait
+// for, the default is WaitLoad.
+func WaitFor(cond WaitCondition) NavigateOption {
+	return func(o *navigateOptions) {
+		o.waitFor = cond
+	}
+}
+
+// WithReferrer returns a NavigateOption that sets the referrer URL for
+// the navigation.
+func WithReferrer(referrer string) NavigateOption {
+	return func(o *navigateOptions) {
+		o.referrer = referrer
+	}
+}
+
+// Navigate navigates the page to url and waits until the wait
+// conditions (WaitFor) are met for the new document. Lifecycle events
+// are correlated with the navigation by frame and loader ID. In case
+// lifecycle events are missed, Page.loadEventFired and
+// Page.frameStoppedLoading (for the frame) satisfy WaitLoad and
+// WaitDOMContentLoaded.
+//
+// Navigation failures are returned as *NavigationError, also when the
+// frame is detached (ErrFrameDetached) or the target crashes
+// (ErrTargetCrashed) before the conditions are met. Same-document
+// navigations (e.g. to a fragment) do not load a new document and
+// return as soon as the navigation is committed.
+//
+// Navigate enables the Inspector and Page domains and lifecycle events,
+// they are left enabled.
+func Navigate(ctx context.Context, c *Client, url string, opts ...NavigateOption) (*page.NavigateReply, error) {
+	o := navigateOptions{waitFor: WaitLoad}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	// Subscribe before navigating so that no events are missed.
+	ev, err := newNavigationEvents(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer ev.Close()
+
+	if err = c.Inspector.Enable(ctx); err != nil {
+		return nil, err
+	}
+	if err = c.Page.Enable(ctx); err != nil {
+		return nil, err
+	}
+	if err = c.Page.SetLifecycleEventsEnabled(ctx, page.NewSetLifecycleEventsEnabledArgs(true)); err != nil {
+		return nil, err
+	}
+
+	args := page.NewNavigateArgs(url)
+	if o.referrer != "" {
+		args.SetReferrer(o.referrer)
+	}
+	nav, err := c.Page.Navigate(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if nav.ErrorText != nil && *nav.ErrorText != "" {
+		return nil, &NavigationError{URL: url, FrameID: nav.FrameID, Text: *nav.ErrorText}
+	}
+	if nav.LoaderID == nil {
+		// Same-document navigation, no new document is loaded.
+		return nav, nil
+	}
+
+	err = ev.wait(ctx, nav.FrameID, *nav.LoaderID, o.waitFor, func(text string) error {
+		return &NavigationError{URL: url, FrameID: nav.FrameID, Text: text}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nav, nil
+}
+
+// navigationEvents are the events that Navigate waits for.
+type navigationEvents struct {
+	lifecycle page.LifecycleEventClient
+	load      page.LoadEventFiredClient
+	stopped   page.FrameStoppedLoadingClient
+	detached  page.FrameDetachedClient
+	crashed   inspector.TargetCrashedClient
+}
+
+func newNavigationEvents(ctx context.Context, c *Client) (ev *navigationEvents, err error) {
+	ev = new(navigationEvents)
+	defer func() {
+		if err != nil {
+			ev.Close()
+		}
+	}()
+
+	if ev.lifecycle, err = c.Page.LifecycleEvent(ctx); err != nil {
+		return nil, err
+	}
+	if ev.load, err = c.Page.LoadEventFired(ctx); err != nil {
+		return nil, err
+	}
+	if ev.stopped, err = c.Page.FrameStoppedLoading(ctx); err != nil {
+		return nil, err
+	}
+	if ev.detached, err = c.Page.FrameDetached(ctx); err != nil {
+		return nil, err
+	}
+	if ev.crashed, err = c.Inspector.TargetCrashed(ctx); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+func (ev *navigationEvents) Close() error {
+	for _, s := range []interface{ Close() error }{
+		ev.lifecycle, ev.load, ev.stopped, ev.detached, ev.crashed,
+	} {
+		if s != nil {
+			s.Close()
+		}
+	}
+	return nil
+}
+
+// wait waits until all conditions have been reported for the frame
+// and loader, by lifecycle events or by the load events.
+func (ev *navigationEvents) wait(ctx context.Context, frameID page.FrameID, loaderID network.LoaderID, cond WaitCondition, navErr func(text string) error) error {
+	const loaded = WaitLoad | WaitDOMContentLoaded
+
+	var got WaitCondition
+	for got&cond != cond {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ev.lifecycle.Ready():
+			e, err := ev.lifecycle.Recv()
+			if err != nil {
+				return err
+			}
+			if e.FrameID != frameID {
+				continue
+			}
+			if e.LoaderID != loaderID {
+				// Events for the previous document can be
+				// received before ours, but a new document
+				// (init) in the frame means that we were
+				// replaced.
+				if e.Name == "init" {
+					return navErr(errTextSuperseded)
+				}
+				continue
+			}
+			got |= lifecycleEvents[e.Name]
+
+		case <-ev.load.Ready():
+			// Navigate only navigates the main frame.
+			if _, err := ev.load.Recv(); err != nil {
+				return err
+			}
+			got |= loaded
+
+		case <-ev.stopped.Ready():
+			e, err := ev.stopped.Recv()
+			if err != nil {
+				return err
+			}
+			if e.FrameID == frameID {
+				got |= loaded
+			}
+
+		case <-ev.detached.Ready():
+			e, err := ev.detached.Recv()
+			if err != nil {
+				return err
+			}
+			if e.FrameID == frameID {
+				return navErr(errTextDetached)
+			}
+
+		case <-ev.crashed.Ready():
+			if _, err := ev.crashed.Recv(); err != nil {
+				return err
+			}
+			return navErr(errTextCrashed)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package cdp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+type lifecycleEvent struct {
+	FrameID  string `json:"frameId"`
+	LoaderID string `json:"loaderId"`
+	Name     string `json:"name"`
+}
+
+// pageEvent is an event other than Page.lifecycleEvent.
+type pageEvent struct {
+	method string
+	params interface{}
+}
+
+// newNavigateTest returns a server that replies to Page.navigate with
+// reply, after emitting the events (lifecycleEvent or pageEvent).
+func newNavigateTest(t *testing.T, reply map[string]string, events ...interface{}) (*cdptest.Server, *cdp.Client, func()) {
+	srv := cdptest.NewServer()
+	srv.Handle("Inspector.enable", cdptest.Reply(nil))
+	srv.Handle("Page.enable", cdptest.Reply(nil))
+	srv.Handle("Page.setLifecycleEventsEnabled", cdptest.Reply(nil))
+	srv.Handle("Page.navigate", func(req *cdptest.Request) (interface{}, error) {
+		for _, ev := range events {
+			method, params := "Page.lifecycleEvent", ev
+			if pe, ok := ev.(pageEvent); ok {
+				method, params = pe.method, pe.params
+			}
+			if err := req.Emit(method, params); err != nil {
+				return nil, err
+			}
+		}
+		return reply, nil
+	})
+
+	conn, err := rpcc.Dial(srv.WebSocketURL)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return srv, cdp.NewClient(conn), func() {
+		conn.Close()
+		srv.Close()
+	}
+}
+
+func TestNavigate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, c, done := newNavigateTest(t, map[string]string{"frameId": "F1", "loaderId": "L1"},
+		lifecycleEvent{"F1", "L0", "load"}, // Previous document.
+		lifecycleEvent{"F2", "L1", "load"}, // Other frame.
+		lifecycleEvent{"F1", "L1", "init"},
+		lifecycleEvent{"F1", "L1", "DOMContentLoaded"},
+		lifecycleEvent{"F1", "L1", "load"},
+	)
+	defer done()
+
+	nav, err := cdp.Navigate(ctx, c, "https://example.com", cdp.WaitFor(cdp.WaitLoad|cdp.WaitDOMContentLoaded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nav.FrameID != "F1" {
+		t.Errorf("Navigate() FrameID = %s, want F1", nav.FrameID)
+	}
+}
+
+func TestNavigate_WaitsForConditions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv, c, done := newNavigateTest(t, map[string]string{"frameId": "F1", "loaderId": "L1"},
+		lifecycleEvent{"F1", "L1", "load"},
+		lifecycleEvent{"F1", "L0", "networkIdle"},
+	)
+	defer done()
+
+	errC := make(chan error, 1)
+	go func() {
+		_, err := cdp.Navigate(ctx, c, "https://example.com", cdp.WaitFor(cdp.WaitNetworkIdle))
+		errC <- err
+	}()
+
+	if _, err := srv.WaitCall(ctx, "Page.navigate", 1); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errC:
+		t.Fatalf("Navigate() returned before networkIdle: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := srv.Event("Page.lifecycleEvent", lifecycleEvent{"F1", "L1", "networkIdle"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errC; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNavigate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		reply    map[string]string
+		events   []interface{}
+		wantText string
+		wantErr  error // ErrNavigationAborted, ErrFrameDetached or ErrTargetCrashed.
+	}{
+		{
+			name:     "Failed",
+			reply:    map[string]string{"frameId": "F1", "loaderId": "L1", "errorText": "net::ERR_NAME_NOT_RESOLVED"},
+			wantText: "net::ERR_NAME_NOT_RESOLVED",
+		},
+		{
+			name:     "Aborted",
+			reply:    map[string]string{"frameId": "F1", "loaderId": "L1", "errorText": "net::ERR_ABORTED"},
+			wantText: "net::ERR_ABORTED",
+			wantErr:  cdp.ErrNavigationAborted,
+		},
+		{
+			name:  "Superseded",
+			reply: map[string]string{"frameId": "F1", "loaderId": "L1"},
+			events: []interface{}{
+				lifecycleEvent{"F1", "L1", "init"},
+				lifecycleEvent{"F1", "L2", "init"},
+			},
+			wantText: "superseded by another navigation",
+			wantErr:  cdp.ErrNavigationAborted,
+		},
+		{
+			name:  "Superseded before init",
+			reply: map[string]string{"frameId": "F1", "loaderId": "L1"},
+			events: []interface{}{
+				lifecycleEvent{"F1", "L0", "load"}, // Previous document.
+				lifecycleEvent{"F1", "L2", "init"},
+			},
+			wantText: "superseded by another navigation",
+			wantErr:  cdp.ErrNavigationAborted,
+		},
+		{
+			name:  "Detached",
+			reply: map[string]string{"frameId": "F1", "loaderId": "L1"},
+			events: []interface{}{
+				pageEvent{"Page.frameDetached", map[string]string{"frameId": "F2"}}, // Other frame.
+				pageEvent{"Page.frameDetached", map[string]string{"frameId": "F1"}},
+			},
+			wantText: "frame detached",
+			wantErr:  cdp.ErrFrameDetached,
+		},
+		{
+			name:     "Crashed",
+			reply:    map[string]string{"frameId": "F1", "loaderId": "L1"},
+			events:   []interface{}{pageEvent{"Inspector.targetCrashed", nil}},
+			wantText: "target crashed",
+			wantErr:  cdp.ErrTargetCrashed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, c, done := newNavigateTest(t, tt.reply, tt.events...)
+			defer done()
+			_, err := cdp.Navigate(ctx, c, "https://example.com")
+
+			var navErr *cdp.NavigationError
+			if !errors.As(err, &navErr) {
+				t.Fatalf("Navigate() error = %v, want *NavigationError", err)
+			}
+			if navErr.Text != tt.wantText || navErr.FrameID != "F1" {
+				t.Errorf("Navigate() error = %+v, want Text %q in frame F1", navErr, tt.wantText)
+			}
+			for _, target := range []error{cdp.ErrNavigationAborted, cdp.ErrFrameDetached, cdp.ErrTargetCrashed} {
+				if got, want := errors.Is(err, target), target == tt.wantErr; got != want {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", target, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNavigate_LoadEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		cond   cdp.WaitCondition
+		events []interface{}
+	}{
+		{
+			name:   "LoadEventFired",
+			cond:   cdp.WaitLoad,
+			events: []interface{}{pageEvent{"Page.loadEventFired", map[string]float64{"timestamp": 1}}},
+		},
+		{
+			name: "FrameStoppedLoading",
+			cond: cdp.WaitLoad | cdp.WaitDOMContentLoaded,
+			events: []interface{}{
+				pageEvent{"Page.frameStoppedLoading", map[string]string{"frameId": "F2"}}, // Other frame.
+				pageEvent{"Page.frameStoppedLoading", map[string]string{"frameId": "F1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			// No lifecycle events.
+			_, c, done := newNavigateTest(t, map[string]string{"frameId": "F1", "loaderId": "L1"}, tt.events...)
+			defer done()
+			if _, err := cdp.Navigate(ctx, c, "https://example.com", cdp.WaitFor(tt.cond)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNavigate_SameDocument(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// No loaderId, no lifecycle events.
+	_, c, done := newNavigateTest(t, map[string]string{"frameId": "F1"})
+	defer done()
+	nav, err := cdp.Navigate(ctx, c, "https://example.com/#fragment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nav.LoaderID != nil {
+		t.Errorf("Navigate() LoaderID = %v, want nil", *nav.LoaderID)
+	}
+}
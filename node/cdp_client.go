@@ -0,0 +1,55 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package node
+
+import (
+	"github.com/mafredri/cdp/node/protocol/console"
+	"github.com/mafredri/cdp/node/protocol/debugger"
+	"github.com/mafredri/cdp/node/protocol/heapprofiler"
+	"github.com/mafredri/cdp/node/protocol/noderuntime"
+	"github.com/mafredri/cdp/node/protocol/nodetracing"
+	"github.com/mafredri/cdp/node/protocol/nodeworker"
+	"github.com/mafredri/cdp/node/protocol/profiler"
+	"github.com/mafredri/cdp/node/protocol/runtime"
+	"github.com/mafredri/cdp/node/protocol/schema"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Client represents a Node.js Inspector Protocol client that can be used to
+// invoke methods or listen to events in every CDP domain. The Client consumes
+// a rpcc connection, used to invoke the methods.
+type Client struct {
+	Console      Console
+	Debugger     Debugger
+	HeapProfiler HeapProfiler
+	NodeRuntime  NodeRuntime
+	NodeTracing  NodeTracing
+	NodeWorker   NodeWorker
+	Profiler     Profiler
+	Runtime      Runtime
+	Schema       Schema
+
+	conn *rpcc.Conn
+}
+
+// NewClient returns a new Client that uses conn
+// for communication with the debugging target.
+func NewClient(conn *rpcc.Conn) *Client {
+	return &Client{
+		Console:      console.NewClient(conn),
+		Debugger:     debugger.NewClient(conn),
+		HeapProfiler: heapprofiler.NewClient(conn),
+		NodeRuntime:  noderuntime.NewClient(conn),
+		NodeTracing:  nodetracing.NewClient(conn),
+		NodeWorker:   nodeworker.NewClient(conn),
+		Profiler:     profiler.NewClient(conn),
+		Runtime:      runtime.NewClient(conn),
+		Schema:       schema.NewClient(conn),
+
+		conn: conn,
+	}
+}
+
+// Conn returns the connection used by the Client, it is nil
+// if the Client was not created by NewClient.
+func (c *Client) Conn() *rpcc.Conn { return c.conn }
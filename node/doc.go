@@ -0,0 +1,22 @@
+/*
+Package node provides type-safe bindings for the Node.js Inspector
+Protocol, i.e. the V8 domains along with the Node specific NodeTracing,
+NodeWorker and NodeRuntime domains.
+
+The node Client requires an rpcc connection to the inspector of a Node
+process (started with --inspect):
+
+	conn, err := rpcc.DialContext(ctx, "ws://127.0.0.1:9229/0f2c936f-b1cd-4ac9-aab3-f63b0f33d55e")
+	if err != nil {
+		// Handle error.
+	}
+	defer conn.Close()
+
+	c := node.NewClient(conn)
+	categories, err := c.NodeTracing.GetCategories(ctx)
+	// ...
+
+The packages are generated by cdpgen (-profile node) and live in their
+own tree (node/protocol), they can be used alongside package cdp.
+*/
+package node
@@ -0,0 +1,605 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package node
+
+import (
+	"context"
+
+	"github.com/mafredri/cdp/node/protocol/console"
+	"github.com/mafredri/cdp/node/protocol/debugger"
+	"github.com/mafredri/cdp/node/protocol/heapprofiler"
+	"github.com/mafredri/cdp/node/protocol/noderuntime"
+	"github.com/mafredri/cdp/node/protocol/nodetracing"
+	"github.com/mafredri/cdp/node/protocol/nodeworker"
+	"github.com/mafredri/cdp/node/protocol/profiler"
+	"github.com/mafredri/cdp/node/protocol/runtime"
+	"github.com/mafredri/cdp/node/protocol/schema"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// The Console domain.
+//
+// Deprecated: This domain is deprecated - use Runtime or Log instead.
+type Console interface {
+	// Command Enable
+	//
+	// Enables console domain, sends the messages collected so far to the
+	// client by means of the messageAdded notification.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables console domain, prevents further console messages from
+	// being reported to the client.
+	Disable(context.Context) error
+
+	// Command ClearMessages
+	//
+	// Does nothing.
+	ClearMessages(context.Context) error
+
+	// Event MessageAdded
+	//
+	// Issued when new console message is added.
+	MessageAdded(context.Context, ...rpcc.StreamOption) (console.MessageAddedClient, error)
+
+	// OnMessageAdded registers a handler for MessageAdded events.
+	OnMessageAdded(fn func(*console.MessageAddedReply)) (unsubscribe func(), err error)
+}
+
+// The Debugger domain. Debugger domain exposes JavaScript debugging
+// capabilities. It allows setting and removing breakpoints, stepping through
+// execution, exploring stack traces, etc.
+type Debugger interface {
+	// Command Enable
+	//
+	// Enables debugger for the given page. Clients should not assume that
+	// the debugging has been enabled until the result for this command is
+	// received.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables debugger for given page.
+	Disable(context.Context) error
+
+	// Command SetBreakpointsActive
+	//
+	// Activates / deactivates all breakpoints on the page.
+	SetBreakpointsActive(context.Context, *debugger.SetBreakpointsActiveArgs) error
+
+	// Command SetSkipAllPauses
+	//
+	// Makes page not interrupt on any pauses (breakpoint, exception, dom
+	// exception etc).
+	SetSkipAllPauses(context.Context, *debugger.SetSkipAllPausesArgs) error
+
+	// Command SetBreakpointByURL
+	//
+	// Sets JavaScript breakpoint at given location specified either by
+	// URL or URL regex. Once this command is issued, all existing parsed
+	// scripts will have breakpoints resolved and returned in locations
+	// property. Further matching script parsing will result in subsequent
+	// breakpointResolved events issued. This logical breakpoint will
+	// survive page reloads.
+	SetBreakpointByURL(context.Context, *debugger.SetBreakpointByURLArgs) (*debugger.SetBreakpointByURLReply, error)
+
+	// Command SetBreakpoint
+	//
+	// Sets JavaScript breakpoint at a given location.
+	SetBreakpoint(context.Context, *debugger.SetBreakpointArgs) (*debugger.SetBreakpointReply, error)
+
+	// Command RemoveBreakpoint
+	//
+	// Removes JavaScript breakpoint.
+	RemoveBreakpoint(context.Context, *debugger.RemoveBreakpointArgs) error
+
+	// Command ContinueToLocation
+	//
+	// Continues execution until specific location is reached.
+	ContinueToLocation(context.Context, *debugger.ContinueToLocationArgs) error
+
+	// Command StepOver
+	//
+	// Steps over the statement.
+	StepOver(context.Context) error
+
+	// Command StepInto
+	//
+	// Steps into the function call.
+	StepInto(context.Context) error
+
+	// Command StepOut
+	//
+	// Steps out of the function call.
+	StepOut(context.Context) error
+
+	// Command Pause
+	//
+	// Stops on the next JavaScript statement.
+	Pause(context.Context) error
+
+	// Command Resume
+	//
+	// Resumes JavaScript execution.
+	Resume(context.Context) error
+
+	// Command SearchInContent
+	//
+	// Searches for given string in script content.
+	//
+	// Note: This command is experimental.
+	SearchInContent(context.Context, *debugger.SearchInContentArgs) (*debugger.SearchInContentReply, error)
+
+	// Command SetScriptSource
+	//
+	// Edits JavaScript source live.
+	SetScriptSource(context.Context, *debugger.SetScriptSourceArgs) (*debugger.SetScriptSourceReply, error)
+
+	// Command RestartFrame
+	//
+	// Restarts particular call frame from the beginning.
+	RestartFrame(context.Context, *debugger.RestartFrameArgs) (*debugger.RestartFrameReply, error)
+
+	// Command GetScriptSource
+	//
+	// Returns source for the script with given id.
+	GetScriptSource(context.Context, *debugger.GetScriptSourceArgs) (*debugger.GetScriptSourceReply, error)
+
+	// Command SetPauseOnExceptions
+	//
+	// Defines pause on exceptions state. Can be set to stop on all
+	// exceptions, uncaught exceptions or no exceptions. Initial pause on
+	// exceptions state is none.
+	SetPauseOnExceptions(context.Context, *debugger.SetPauseOnExceptionsArgs) error
+
+	// Command EvaluateOnCallFrame
+	//
+	// Evaluates expression on a given call frame.
+	EvaluateOnCallFrame(context.Context, *debugger.EvaluateOnCallFrameArgs) (*debugger.EvaluateOnCallFrameReply, error)
+
+	// Command SetVariableValue
+	//
+	// Changes value of variable in a callframe. Object-based scopes are
+	// not supported and must be mutated manually.
+	SetVariableValue(context.Context, *debugger.SetVariableValueArgs) error
+
+	// Command SetAsyncCallStackDepth
+	//
+	// Enables or disables async call stacks tracking.
+	SetAsyncCallStackDepth(context.Context, *debugger.SetAsyncCallStackDepthArgs) error
+
+	// Command SetBlackboxPatterns
+	//
+	// Replace previous blackbox patterns with passed ones. Forces backend
+	// to skip stepping/pausing in scripts with url matching one of the
+	// patterns. VM will try to leave blackboxed script by performing 'step
+	// in' several times, finally resorting to 'step out' if unsuccessful.
+	//
+	// Note: This command is experimental.
+	SetBlackboxPatterns(context.Context, *debugger.SetBlackboxPatternsArgs) error
+
+	// Command SetBlackboxedRanges
+	//
+	// Makes backend skip steps in the script in blackboxed ranges. VM
+	// will try leave blacklisted scripts by performing 'step in' several
+	// times, finally resorting to 'step out' if unsuccessful. Positions
+	// array contains positions where blackbox state is changed. First
+	// interval isn't blackboxed. Array should be sorted.
+	//
+	// Note: This command is experimental.
+	SetBlackboxedRanges(context.Context, *debugger.SetBlackboxedRangesArgs) error
+
+	// Event ScriptParsed
+	//
+	// Fired when virtual machine parses script. This event is also fired
+	// for all known and uncollected scripts upon enabling debugger.
+	ScriptParsed(context.Context, ...rpcc.StreamOption) (debugger.ScriptParsedClient, error)
+
+	// OnScriptParsed registers a handler for ScriptParsed events.
+	OnScriptParsed(fn func(*debugger.ScriptParsedReply)) (unsubscribe func(), err error)
+
+	// Event ScriptFailedToParse
+	//
+	// Fired when virtual machine fails to parse the script.
+	ScriptFailedToParse(context.Context, ...rpcc.StreamOption) (debugger.ScriptFailedToParseClient, error)
+
+	// OnScriptFailedToParse registers a handler for ScriptFailedToParse events.
+	OnScriptFailedToParse(fn func(*debugger.ScriptFailedToParseReply)) (unsubscribe func(), err error)
+
+	// Event BreakpointResolved
+	//
+	// Fired when breakpoint is resolved to an actual script and location.
+	BreakpointResolved(context.Context, ...rpcc.StreamOption) (debugger.BreakpointResolvedClient, error)
+
+	// OnBreakpointResolved registers a handler for BreakpointResolved events.
+	OnBreakpointResolved(fn func(*debugger.BreakpointResolvedReply)) (unsubscribe func(), err error)
+
+	// Event Paused
+	//
+	// Fired when the virtual machine stopped on breakpoint or exception
+	// or any other stop criteria.
+	Paused(context.Context, ...rpcc.StreamOption) (debugger.PausedClient, error)
+
+	// OnPaused registers a handler for Paused events.
+	OnPaused(fn func(*debugger.PausedReply)) (unsubscribe func(), err error)
+
+	// Event Resumed
+	//
+	// Fired when the virtual machine resumed execution.
+	Resumed(context.Context, ...rpcc.StreamOption) (debugger.ResumedClient, error)
+
+	// OnResumed registers a handler for Resumed events.
+	OnResumed(fn func(*debugger.ResumedReply)) (unsubscribe func(), err error)
+}
+
+// The HeapProfiler domain.
+//
+// Note: This domain is experimental.
+type HeapProfiler interface {
+	// Command Enable
+	Enable(context.Context) error
+
+	// Command Disable
+	Disable(context.Context) error
+
+	// Command StartTrackingHeapObjects
+	StartTrackingHeapObjects(context.Context, *heapprofiler.StartTrackingHeapObjectsArgs) error
+
+	// Command StopTrackingHeapObjects
+	StopTrackingHeapObjects(context.Context, *heapprofiler.StopTrackingHeapObjectsArgs) error
+
+	// Command TakeHeapSnapshot
+	TakeHeapSnapshot(context.Context, *heapprofiler.TakeHeapSnapshotArgs) error
+
+	// Command CollectGarbage
+	CollectGarbage(context.Context) error
+
+	// Command GetObjectByHeapObjectID
+	GetObjectByHeapObjectID(context.Context, *heapprofiler.GetObjectByHeapObjectIDArgs) (*heapprofiler.GetObjectByHeapObjectIDReply, error)
+
+	// Command AddInspectedHeapObject
+	//
+	// Enables console to refer to the node with given id via $x (see
+	// Command Line API for more details $x functions).
+	AddInspectedHeapObject(context.Context, *heapprofiler.AddInspectedHeapObjectArgs) error
+
+	// Command GetHeapObjectID
+	GetHeapObjectID(context.Context, *heapprofiler.GetHeapObjectIDArgs) (*heapprofiler.GetHeapObjectIDReply, error)
+
+	// Command StartSampling
+	StartSampling(context.Context, *heapprofiler.StartSamplingArgs) error
+
+	// Command StopSampling
+	StopSampling(context.Context) (*heapprofiler.StopSamplingReply, error)
+
+	// Event AddHeapSnapshotChunk
+	AddHeapSnapshotChunk(context.Context, ...rpcc.StreamOption) (heapprofiler.AddHeapSnapshotChunkClient, error)
+
+	// OnAddHeapSnapshotChunk registers a handler for AddHeapSnapshotChunk events.
+	OnAddHeapSnapshotChunk(fn func(*heapprofiler.AddHeapSnapshotChunkReply)) (unsubscribe func(), err error)
+
+	// Event ResetProfiles
+	ResetProfiles(context.Context, ...rpcc.StreamOption) (heapprofiler.ResetProfilesClient, error)
+
+	// OnResetProfiles registers a handler for ResetProfiles events.
+	OnResetProfiles(fn func(*heapprofiler.ResetProfilesReply)) (unsubscribe func(), err error)
+
+	// Event ReportHeapSnapshotProgress
+	ReportHeapSnapshotProgress(context.Context, ...rpcc.StreamOption) (heapprofiler.ReportHeapSnapshotProgressClient, error)
+
+	// OnReportHeapSnapshotProgress registers a handler for ReportHeapSnapshotProgress events.
+	OnReportHeapSnapshotProgress(fn func(*heapprofiler.ReportHeapSnapshotProgressReply)) (unsubscribe func(), err error)
+
+	// Event LastSeenObjectID
+	//
+	// If heap objects tracking has been started then backend regularly
+	// sends a current value for last seen object id and corresponding
+	// timestamp. If the were changes in the heap since last event then one
+	// or more heapStatsUpdate events will be sent before a new
+	// lastSeenObjectId event.
+	LastSeenObjectID(context.Context, ...rpcc.StreamOption) (heapprofiler.LastSeenObjectIDClient, error)
+
+	// OnLastSeenObjectID registers a handler for LastSeenObjectID events.
+	OnLastSeenObjectID(fn func(*heapprofiler.LastSeenObjectIDReply)) (unsubscribe func(), err error)
+
+	// Event HeapStatsUpdate
+	//
+	// If heap objects tracking has been started then backend may send
+	// update for one or more fragments
+	HeapStatsUpdate(context.Context, ...rpcc.StreamOption) (heapprofiler.HeapStatsUpdateClient, error)
+
+	// OnHeapStatsUpdate registers a handler for HeapStatsUpdate events.
+	OnHeapStatsUpdate(fn func(*heapprofiler.HeapStatsUpdateReply)) (unsubscribe func(), err error)
+}
+
+// The NodeRuntime domain. Support for inspecting node process state.
+//
+// Note: This domain is experimental.
+type NodeRuntime interface {
+	// Command NotifyWhenWaitingForDisconnect
+	//
+	// Enable the `NodeRuntime.waitingForDisconnect`.
+	NotifyWhenWaitingForDisconnect(context.Context, *noderuntime.NotifyWhenWaitingForDisconnectArgs) error
+
+	// Event WaitingForDisconnect
+	//
+	// This event is fired instead of `Runtime.executionContextDestroyed`
+	// when enabled. It is fired when the Node process finished all code
+	// execution and is waiting for all frontends to disconnect.
+	WaitingForDisconnect(context.Context, ...rpcc.StreamOption) (noderuntime.WaitingForDisconnectClient, error)
+
+	// OnWaitingForDisconnect registers a handler for WaitingForDisconnect events.
+	OnWaitingForDisconnect(fn func(*noderuntime.WaitingForDisconnectReply)) (unsubscribe func(), err error)
+}
+
+// The NodeTracing domain.
+//
+// Note: This domain is experimental.
+type NodeTracing interface {
+	// Command GetCategories
+	//
+	// Gets supported tracing categories.
+	GetCategories(context.Context) (*nodetracing.GetCategoriesReply, error)
+
+	// Command Start
+	//
+	// Start trace events collection.
+	Start(context.Context, *nodetracing.StartArgs) error
+
+	// Command Stop
+	//
+	// Stop trace events collection. Remaining collected events will be
+	// sent as a sequence of dataCollected events followed by
+	// tracingComplete event.
+	Stop(context.Context) error
+
+	// Event DataCollected
+	//
+	// Contains an bucket of collected trace events.
+	DataCollected(context.Context, ...rpcc.StreamOption) (nodetracing.DataCollectedClient, error)
+
+	// OnDataCollected registers a handler for DataCollected events.
+	OnDataCollected(fn func(*nodetracing.DataCollectedReply)) (unsubscribe func(), err error)
+
+	// Event TracingComplete
+	//
+	// Signals that tracing is stopped and there is no trace buffers
+	// pending flush, all data were delivered via dataCollected events.
+	TracingComplete(context.Context, ...rpcc.StreamOption) (nodetracing.TracingCompleteClient, error)
+
+	// OnTracingComplete registers a handler for TracingComplete events.
+	OnTracingComplete(fn func(*nodetracing.TracingCompleteReply)) (unsubscribe func(), err error)
+}
+
+// The NodeWorker domain. Support for sending messages to Node worker
+// Inspector instances.
+//
+// Note: This domain is experimental.
+type NodeWorker interface {
+	// Command SendMessageToWorker
+	//
+	// Sends protocol message over session with given id.
+	SendMessageToWorker(context.Context, *nodeworker.SendMessageToWorkerArgs) error
+
+	// Command Enable
+	//
+	// Instructs the inspector to attach to running workers. Will also
+	// attach to new workers as they start
+	Enable(context.Context, *nodeworker.EnableArgs) error
+
+	// Command Disable
+	//
+	// Detaches from all running workers and disables attaching to new
+	// workers as they are started.
+	Disable(context.Context) error
+
+	// Command Detach
+	//
+	// Detached from the worker with given sessionId.
+	Detach(context.Context, *nodeworker.DetachArgs) error
+
+	// Event AttachedToWorker
+	//
+	// Issued when attached to a worker.
+	AttachedToWorker(context.Context, ...rpcc.StreamOption) (nodeworker.AttachedToWorkerClient, error)
+
+	// OnAttachedToWorker registers a handler for AttachedToWorker events.
+	OnAttachedToWorker(fn func(*nodeworker.AttachedToWorkerReply)) (unsubscribe func(), err error)
+
+	// Event DetachedFromWorker
+	//
+	// Issued when detached from the worker.
+	DetachedFromWorker(context.Context, ...rpcc.StreamOption) (nodeworker.DetachedFromWorkerClient, error)
+
+	// OnDetachedFromWorker registers a handler for DetachedFromWorker events.
+	OnDetachedFromWorker(fn func(*nodeworker.DetachedFromWorkerReply)) (unsubscribe func(), err error)
+
+	// Event ReceivedMessageFromWorker
+	//
+	// Notifies about a new protocol message received from the session
+	// (session ID is provided in attachedToWorker notification).
+	ReceivedMessageFromWorker(context.Context, ...rpcc.StreamOption) (nodeworker.ReceivedMessageFromWorkerClient, error)
+
+	// OnReceivedMessageFromWorker registers a handler for ReceivedMessageFromWorker events.
+	OnReceivedMessageFromWorker(fn func(*nodeworker.ReceivedMessageFromWorkerReply)) (unsubscribe func(), err error)
+}
+
+// The Profiler domain.
+type Profiler interface {
+	// Command Enable
+	Enable(context.Context) error
+
+	// Command Disable
+	Disable(context.Context) error
+
+	// Command SetSamplingInterval
+	//
+	// Changes CPU profiler sampling interval. Must be called before CPU
+	// profiles recording started.
+	SetSamplingInterval(context.Context, *profiler.SetSamplingIntervalArgs) error
+
+	// Command Start
+	Start(context.Context) error
+
+	// Command Stop
+	Stop(context.Context) (*profiler.StopReply, error)
+
+	// Event ConsoleProfileStarted
+	//
+	// Sent when new profile recodring is started using console.profile()
+	// call.
+	ConsoleProfileStarted(context.Context, ...rpcc.StreamOption) (profiler.ConsoleProfileStartedClient, error)
+
+	// OnConsoleProfileStarted registers a handler for ConsoleProfileStarted events.
+	OnConsoleProfileStarted(fn func(*profiler.ConsoleProfileStartedReply)) (unsubscribe func(), err error)
+
+	// Event ConsoleProfileFinished
+	ConsoleProfileFinished(context.Context, ...rpcc.StreamOption) (profiler.ConsoleProfileFinishedClient, error)
+
+	// OnConsoleProfileFinished registers a handler for ConsoleProfileFinished events.
+	OnConsoleProfileFinished(fn func(*profiler.ConsoleProfileFinishedReply)) (unsubscribe func(), err error)
+}
+
+// The Runtime domain. Runtime domain exposes JavaScript runtime by means of
+// remote evaluation and mirror objects. Evaluation results are returned as
+// mirror object that expose object type, string representation and unique
+// identifier that can be used for further object reference. Original objects
+// are maintained in memory unless they are either explicitly released or are
+// released along with the other objects in their object group.
+type Runtime interface {
+	// Command Evaluate
+	//
+	// Evaluates expression on global object.
+	Evaluate(context.Context, *runtime.EvaluateArgs) (*runtime.EvaluateReply, error)
+
+	// Command AwaitPromise
+	//
+	// Add handler to promise with given promise object id.
+	AwaitPromise(context.Context, *runtime.AwaitPromiseArgs) (*runtime.AwaitPromiseReply, error)
+
+	// Command CallFunctionOn
+	//
+	// Calls function with given declaration on the given object. Object
+	// group of the result is inherited from the target object.
+	CallFunctionOn(context.Context, *runtime.CallFunctionOnArgs) (*runtime.CallFunctionOnReply, error)
+
+	// Command GetProperties
+	//
+	// Returns properties of a given object. Object group of the result is
+	// inherited from the target object.
+	GetProperties(context.Context, *runtime.GetPropertiesArgs) (*runtime.GetPropertiesReply, error)
+
+	// Command ReleaseObject
+	//
+	// Releases remote object with given id.
+	ReleaseObject(context.Context, *runtime.ReleaseObjectArgs) error
+
+	// Command ReleaseObjectGroup
+	//
+	// Releases all remote objects that belong to a given group.
+	ReleaseObjectGroup(context.Context, *runtime.ReleaseObjectGroupArgs) error
+
+	// Command RunIfWaitingForDebugger
+	//
+	// Tells inspected instance to run if it was waiting for debugger to
+	// attach.
+	RunIfWaitingForDebugger(context.Context) error
+
+	// Command Enable
+	//
+	// Enables reporting of execution contexts creation by means of
+	// executionContextCreated event. When the reporting gets enabled the
+	// event will be sent immediately for each existing execution context.
+	Enable(context.Context) error
+
+	// Command Disable
+	//
+	// Disables reporting of execution contexts creation.
+	Disable(context.Context) error
+
+	// Command DiscardConsoleEntries
+	//
+	// Discards collected exceptions and console API calls.
+	DiscardConsoleEntries(context.Context) error
+
+	// Command SetCustomObjectFormatterEnabled
+	//
+	// Note: This command is experimental.
+	SetCustomObjectFormatterEnabled(context.Context, *runtime.SetCustomObjectFormatterEnabledArgs) error
+
+	// Command CompileScript
+	//
+	// Compiles expression.
+	CompileScript(context.Context, *runtime.CompileScriptArgs) (*runtime.CompileScriptReply, error)
+
+	// Command RunScript
+	//
+	// Runs script with given id in a given context.
+	RunScript(context.Context, *runtime.RunScriptArgs) (*runtime.RunScriptReply, error)
+
+	// Event ExecutionContextCreated
+	//
+	// Issued when new execution context is created.
+	ExecutionContextCreated(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextCreatedClient, error)
+
+	// OnExecutionContextCreated registers a handler for ExecutionContextCreated events.
+	OnExecutionContextCreated(fn func(*runtime.ExecutionContextCreatedReply)) (unsubscribe func(), err error)
+
+	// Event ExecutionContextDestroyed
+	//
+	// Issued when execution context is destroyed.
+	ExecutionContextDestroyed(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextDestroyedClient, error)
+
+	// OnExecutionContextDestroyed registers a handler for ExecutionContextDestroyed events.
+	OnExecutionContextDestroyed(fn func(*runtime.ExecutionContextDestroyedReply)) (unsubscribe func(), err error)
+
+	// Event ExecutionContextsCleared
+	//
+	// Issued when all executionContexts were cleared in browser
+	ExecutionContextsCleared(context.Context, ...rpcc.StreamOption) (runtime.ExecutionContextsClearedClient, error)
+
+	// OnExecutionContextsCleared registers a handler for ExecutionContextsCleared events.
+	OnExecutionContextsCleared(fn func(*runtime.ExecutionContextsClearedReply)) (unsubscribe func(), err error)
+
+	// Event ExceptionThrown
+	//
+	// Issued when exception was thrown and unhandled.
+	ExceptionThrown(context.Context, ...rpcc.StreamOption) (runtime.ExceptionThrownClient, error)
+
+	// OnExceptionThrown registers a handler for ExceptionThrown events.
+	OnExceptionThrown(fn func(*runtime.ExceptionThrownReply)) (unsubscribe func(), err error)
+
+	// Event ExceptionRevoked
+	//
+	// Issued when unhandled exception was revoked.
+	ExceptionRevoked(context.Context, ...rpcc.StreamOption) (runtime.ExceptionRevokedClient, error)
+
+	// OnExceptionRevoked registers a handler for ExceptionRevoked events.
+	OnExceptionRevoked(fn func(*runtime.ExceptionRevokedReply)) (unsubscribe func(), err error)
+
+	// Event ConsoleAPICalled
+	//
+	// Issued when console API was called.
+	ConsoleAPICalled(context.Context, ...rpcc.StreamOption) (runtime.ConsoleAPICalledClient, error)
+
+	// OnConsoleAPICalled registers a handler for ConsoleAPICalled events.
+	OnConsoleAPICalled(fn func(*runtime.ConsoleAPICalledReply)) (unsubscribe func(), err error)
+
+	// Event InspectRequested
+	//
+	// Issued when object should be inspected (for example, as a result of
+	// inspect() command line API call).
+	InspectRequested(context.Context, ...rpcc.StreamOption) (runtime.InspectRequestedClient, error)
+
+	// OnInspectRequested registers a handler for InspectRequested events.
+	OnInspectRequested(fn func(*runtime.InspectRequestedReply)) (unsubscribe func(), err error)
+}
+
+// The Schema domain. Provides information about the protocol schema.
+type Schema interface {
+	// Command GetDomains
+	//
+	// Returns supported domains.
+	GetDomains(context.Context) (*schema.GetDomainsReply, error)
+}
@@ -0,0 +1,40 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/node"
+	"github.com/mafredri/cdp/node/protocol/nodetracing"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+func TestClient(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	want := []string{"node", "v8"}
+	srv.Handle("NodeTracing.getCategories", cdptest.Reply(&nodetracing.GetCategoriesReply{Categories: want}))
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := node.NewClient(conn)
+
+	reply, err := c.NodeTracing.GetCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, reply.Categories); diff != "" {
+		t.Errorf("GetCategories: (-want +got)\n%s", diff)
+	}
+}
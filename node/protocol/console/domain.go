@@ -0,0 +1,88 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package console implements the Console domain. This domain is deprecated -
+// use Runtime or Log instead.
+package console
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/node/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the Console domain. This domain is deprecated
+// - use Runtime or Log instead.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Console domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the Console method. Enables console domain, sends the
+// messages collected so far to the client by means of the messageAdded
+// notification.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Console.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Console", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Console method. Disables console domain, prevents
+// further console messages from being reported to the client.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Console.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Console", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// ClearMessages invokes the Console method. Does nothing.
+func (d *domainClient) ClearMessages(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Console.clearMessages", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Console", Op: "ClearMessages", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) MessageAdded(ctx context.Context, opts ...rpcc.StreamOption) (MessageAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Console.messageAdded", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &messageAddedClient{Stream: s}, nil
+}
+
+// OnMessageAdded registers fn to be called for each
+// MessageAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnMessageAdded(fn func(*MessageAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Console.messageAdded", d.conn, func(args []byte) error {
+		ev := new(MessageAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type messageAddedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *messageAddedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *messageAddedClient) Recv() (*MessageAddedReply, error) {
+	event := new(MessageAddedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Console", Op: "MessageAdded Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,332 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package heapprofiler implements the HeapProfiler domain.
+package heapprofiler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/node/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the HeapProfiler domain.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the HeapProfiler domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the HeapProfiler method.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "HeapProfiler.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the HeapProfiler method.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "HeapProfiler.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// StartTrackingHeapObjects invokes the HeapProfiler method.
+func (d *domainClient) StartTrackingHeapObjects(ctx context.Context, args *StartTrackingHeapObjectsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.startTrackingHeapObjects", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.startTrackingHeapObjects", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "StartTrackingHeapObjects", Err: err}
+	}
+	return
+}
+
+// StopTrackingHeapObjects invokes the HeapProfiler method.
+func (d *domainClient) StopTrackingHeapObjects(ctx context.Context, args *StopTrackingHeapObjectsArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.stopTrackingHeapObjects", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.stopTrackingHeapObjects", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "StopTrackingHeapObjects", Err: err}
+	}
+	return
+}
+
+// TakeHeapSnapshot invokes the HeapProfiler method.
+func (d *domainClient) TakeHeapSnapshot(ctx context.Context, args *TakeHeapSnapshotArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.takeHeapSnapshot", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.takeHeapSnapshot", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "TakeHeapSnapshot", Err: err}
+	}
+	return
+}
+
+// CollectGarbage invokes the HeapProfiler method.
+func (d *domainClient) CollectGarbage(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "HeapProfiler.collectGarbage", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "CollectGarbage", Err: err}
+	}
+	return
+}
+
+// GetObjectByHeapObjectID invokes the HeapProfiler method.
+func (d *domainClient) GetObjectByHeapObjectID(ctx context.Context, args *GetObjectByHeapObjectIDArgs) (reply *GetObjectByHeapObjectIDReply, err error) {
+	reply = new(GetObjectByHeapObjectIDReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.getObjectByHeapObjectId", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.getObjectByHeapObjectId", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "GetObjectByHeapObjectID", Err: err}
+	}
+	return
+}
+
+// AddInspectedHeapObject invokes the HeapProfiler method. Enables console to
+// refer to the node with given id via $x (see Command Line API for more
+// details $x functions).
+func (d *domainClient) AddInspectedHeapObject(ctx context.Context, args *AddInspectedHeapObjectArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.addInspectedHeapObject", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.addInspectedHeapObject", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "AddInspectedHeapObject", Err: err}
+	}
+	return
+}
+
+// GetHeapObjectID invokes the HeapProfiler method.
+func (d *domainClient) GetHeapObjectID(ctx context.Context, args *GetHeapObjectIDArgs) (reply *GetHeapObjectIDReply, err error) {
+	reply = new(GetHeapObjectIDReply)
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.getHeapObjectId", args, reply, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.getHeapObjectId", nil, reply, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "GetHeapObjectID", Err: err}
+	}
+	return
+}
+
+// StartSampling invokes the HeapProfiler method.
+func (d *domainClient) StartSampling(ctx context.Context, args *StartSamplingArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "HeapProfiler.startSampling", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "HeapProfiler.startSampling", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "StartSampling", Err: err}
+	}
+	return
+}
+
+// StopSampling invokes the HeapProfiler method.
+func (d *domainClient) StopSampling(ctx context.Context) (reply *StopSamplingReply, err error) {
+	reply = new(StopSamplingReply)
+	err = rpcc.Invoke(ctx, "HeapProfiler.stopSampling", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "HeapProfiler", Op: "StopSampling", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) AddHeapSnapshotChunk(ctx context.Context, opts ...rpcc.StreamOption) (AddHeapSnapshotChunkClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.addHeapSnapshotChunk", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &addHeapSnapshotChunkClient{Stream: s}, nil
+}
+
+// OnAddHeapSnapshotChunk registers fn to be called for each
+// AddHeapSnapshotChunk event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAddHeapSnapshotChunk(fn func(*AddHeapSnapshotChunkReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.addHeapSnapshotChunk", d.conn, func(args []byte) error {
+		ev := new(AddHeapSnapshotChunkReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type addHeapSnapshotChunkClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *addHeapSnapshotChunkClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *addHeapSnapshotChunkClient) Recv() (*AddHeapSnapshotChunkReply, error) {
+	event := new(AddHeapSnapshotChunkReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "HeapProfiler", Op: "AddHeapSnapshotChunk Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ResetProfiles(ctx context.Context, opts ...rpcc.StreamOption) (ResetProfilesClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.resetProfiles", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resetProfilesClient{Stream: s}, nil
+}
+
+// OnResetProfiles registers fn to be called for each
+// ResetProfiles event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResetProfiles(fn func(*ResetProfilesReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.resetProfiles", d.conn, func(args []byte) error {
+		ev := new(ResetProfilesReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type resetProfilesClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *resetProfilesClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *resetProfilesClient) Recv() (*ResetProfilesReply, error) {
+	event := new(ResetProfilesReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "HeapProfiler", Op: "ResetProfiles Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReportHeapSnapshotProgress(ctx context.Context, opts ...rpcc.StreamOption) (ReportHeapSnapshotProgressClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.reportHeapSnapshotProgress", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &reportHeapSnapshotProgressClient{Stream: s}, nil
+}
+
+// OnReportHeapSnapshotProgress registers fn to be called for each
+// ReportHeapSnapshotProgress event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnReportHeapSnapshotProgress(fn func(*ReportHeapSnapshotProgressReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.reportHeapSnapshotProgress", d.conn, func(args []byte) error {
+		ev := new(ReportHeapSnapshotProgressReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type reportHeapSnapshotProgressClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *reportHeapSnapshotProgressClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *reportHeapSnapshotProgressClient) Recv() (*ReportHeapSnapshotProgressReply, error) {
+	event := new(ReportHeapSnapshotProgressReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "HeapProfiler", Op: "ReportHeapSnapshotProgress Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) LastSeenObjectID(ctx context.Context, opts ...rpcc.StreamOption) (LastSeenObjectIDClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.lastSeenObjectId", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &lastSeenObjectIDClient{Stream: s}, nil
+}
+
+// OnLastSeenObjectID registers fn to be called for each
+// LastSeenObjectID event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLastSeenObjectID(fn func(*LastSeenObjectIDReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.lastSeenObjectId", d.conn, func(args []byte) error {
+		ev := new(LastSeenObjectIDReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type lastSeenObjectIDClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *lastSeenObjectIDClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *lastSeenObjectIDClient) Recv() (*LastSeenObjectIDReply, error) {
+	event := new(LastSeenObjectIDReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "HeapProfiler", Op: "LastSeenObjectID Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) HeapStatsUpdate(ctx context.Context, opts ...rpcc.StreamOption) (HeapStatsUpdateClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.heapStatsUpdate", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &heapStatsUpdateClient{Stream: s}, nil
+}
+
+// OnHeapStatsUpdate registers fn to be called for each
+// HeapStatsUpdate event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnHeapStatsUpdate(fn func(*HeapStatsUpdateReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.heapStatsUpdate", d.conn, func(args []byte) error {
+		ev := new(HeapStatsUpdateReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type heapStatsUpdateClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *heapStatsUpdateClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *heapStatsUpdateClient) Recv() (*HeapStatsUpdateReply, error) {
+	event := new(HeapStatsUpdateReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "HeapProfiler", Op: "HeapStatsUpdate Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,15 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package noderuntime
+
+// NotifyWhenWaitingForDisconnectArgs represents the arguments for NotifyWhenWaitingForDisconnect in the NodeRuntime domain.
+type NotifyWhenWaitingForDisconnectArgs struct {
+	Enabled bool `json:"enabled"` // No description.
+}
+
+// NewNotifyWhenWaitingForDisconnectArgs initializes NotifyWhenWaitingForDisconnectArgs with the required arguments.
+func NewNotifyWhenWaitingForDisconnectArgs(enabled bool) *NotifyWhenWaitingForDisconnectArgs {
+	args := new(NotifyWhenWaitingForDisconnectArgs)
+	args.Enabled = enabled
+	return args
+}
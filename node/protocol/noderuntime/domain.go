@@ -0,0 +1,72 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package noderuntime implements the NodeRuntime domain. Support for
+// inspecting node process state.
+package noderuntime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/node/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the NodeRuntime domain. Support for inspecting
+// node process state.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the NodeRuntime domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// NotifyWhenWaitingForDisconnect invokes the NodeRuntime method. Enable the
+// `NodeRuntime.waitingForDisconnect`.
+func (d *domainClient) NotifyWhenWaitingForDisconnect(ctx context.Context, args *NotifyWhenWaitingForDisconnectArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeRuntime.notifyWhenWaitingForDisconnect", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeRuntime.notifyWhenWaitingForDisconnect", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeRuntime", Op: "NotifyWhenWaitingForDisconnect", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) WaitingForDisconnect(ctx context.Context, opts ...rpcc.StreamOption) (WaitingForDisconnectClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeRuntime.waitingForDisconnect", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &waitingForDisconnectClient{Stream: s}, nil
+}
+
+// OnWaitingForDisconnect registers fn to be called for each
+// WaitingForDisconnect event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWaitingForDisconnect(fn func(*WaitingForDisconnectReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("NodeRuntime.waitingForDisconnect", d.conn, func(args []byte) error {
+		ev := new(WaitingForDisconnectReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type waitingForDisconnectClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *waitingForDisconnectClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *waitingForDisconnectClient) Recv() (*WaitingForDisconnectReply, error) {
+	event := new(WaitingForDisconnectReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeRuntime", Op: "WaitingForDisconnect Recv", Err: err}
+	}
+	return event, nil
+}
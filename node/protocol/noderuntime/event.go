@@ -0,0 +1,22 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package noderuntime
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// WaitingForDisconnectClient is a client for WaitingForDisconnect events.
+// This event is fired instead of `Runtime.executionContextDestroyed` when
+// enabled. It is fired when the Node process finished all code execution and
+// is waiting for all frontends to disconnect.
+type WaitingForDisconnectClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*WaitingForDisconnectReply, error)
+	rpcc.Stream
+}
+
+// WaitingForDisconnectReply is the reply for WaitingForDisconnect events.
+type WaitingForDisconnectReply struct {
+}
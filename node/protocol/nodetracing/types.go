@@ -0,0 +1,12 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodetracing
+
+// TraceConfig
+type TraceConfig struct {
+	// RecordMode Controls how the trace buffer stores data.
+	//
+	// Values: "recordUntilFull", "recordContinuously", "recordAsMuchAsPossible".
+	RecordMode         *string  `json:"recordMode,omitempty"`
+	IncludedCategories []string `json:"includedCategories"` // Included category filters.
+}
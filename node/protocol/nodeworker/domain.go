@@ -0,0 +1,182 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package nodeworker implements the NodeWorker domain. Support for sending
+// messages to Node worker Inspector instances.
+package nodeworker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/node/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the NodeWorker domain. Support for sending
+// messages to Node worker Inspector instances.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the NodeWorker domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// SendMessageToWorker invokes the NodeWorker method. Sends protocol message
+// over session with given id.
+func (d *domainClient) SendMessageToWorker(ctx context.Context, args *SendMessageToWorkerArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.sendMessageToWorker", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.sendMessageToWorker", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "SendMessageToWorker", Err: err}
+	}
+	return
+}
+
+// Enable invokes the NodeWorker method. Instructs the inspector to attach to
+// running workers. Will also attach to new workers as they start
+func (d *domainClient) Enable(ctx context.Context, args *EnableArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.enable", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.enable", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the NodeWorker method. Detaches from all running workers
+// and disables attaching to new workers as they are started.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "NodeWorker.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// Detach invokes the NodeWorker method. Detached from the worker with given
+// sessionId.
+func (d *domainClient) Detach(ctx context.Context, args *DetachArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "NodeWorker.detach", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "NodeWorker.detach", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "NodeWorker", Op: "Detach", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) AttachedToWorker(ctx context.Context, opts ...rpcc.StreamOption) (AttachedToWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.attachedToWorker", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &attachedToWorkerClient{Stream: s}, nil
+}
+
+// OnAttachedToWorker registers fn to be called for each
+// AttachedToWorker event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttachedToWorker(fn func(*AttachedToWorkerReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("NodeWorker.attachedToWorker", d.conn, func(args []byte) error {
+		ev := new(AttachedToWorkerReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type attachedToWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *attachedToWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *attachedToWorkerClient) Recv() (*AttachedToWorkerReply, error) {
+	event := new(AttachedToWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "AttachedToWorker Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) DetachedFromWorker(ctx context.Context, opts ...rpcc.StreamOption) (DetachedFromWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.detachedFromWorker", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &detachedFromWorkerClient{Stream: s}, nil
+}
+
+// OnDetachedFromWorker registers fn to be called for each
+// DetachedFromWorker event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDetachedFromWorker(fn func(*DetachedFromWorkerReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("NodeWorker.detachedFromWorker", d.conn, func(args []byte) error {
+		ev := new(DetachedFromWorkerReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type detachedFromWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *detachedFromWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *detachedFromWorkerClient) Recv() (*DetachedFromWorkerReply, error) {
+	event := new(DetachedFromWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "DetachedFromWorker Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ReceivedMessageFromWorker(ctx context.Context, opts ...rpcc.StreamOption) (ReceivedMessageFromWorkerClient, error) {
+	s, err := rpcc.NewStream(ctx, "NodeWorker.receivedMessageFromWorker", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &receivedMessageFromWorkerClient{Stream: s}, nil
+}
+
+// OnReceivedMessageFromWorker registers fn to be called for each
+// ReceivedMessageFromWorker event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnReceivedMessageFromWorker(fn func(*ReceivedMessageFromWorkerReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("NodeWorker.receivedMessageFromWorker", d.conn, func(args []byte) error {
+		ev := new(ReceivedMessageFromWorkerReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type receivedMessageFromWorkerClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *receivedMessageFromWorkerClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *receivedMessageFromWorkerClient) Recv() (*ReceivedMessageFromWorkerReply, error) {
+	event := new(ReceivedMessageFromWorkerReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "NodeWorker", Op: "ReceivedMessageFromWorker Recv", Err: err}
+	}
+	return event, nil
+}
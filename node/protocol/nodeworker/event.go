@@ -0,0 +1,53 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package nodeworker
+
+import (
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// AttachedToWorkerClient is a client for AttachedToWorker events. Issued when
+// attached to a worker.
+type AttachedToWorkerClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*AttachedToWorkerReply, error)
+	rpcc.Stream
+}
+
+// AttachedToWorkerReply is the reply for AttachedToWorker events.
+type AttachedToWorkerReply struct {
+	SessionID          SessionID  `json:"sessionId"`          // Identifier assigned to the session used to send/receive messages.
+	WorkerInfo         WorkerInfo `json:"workerInfo"`         // No description.
+	WaitingForDebugger bool       `json:"waitingForDebugger"` // No description.
+}
+
+// DetachedFromWorkerClient is a client for DetachedFromWorker events. Issued
+// when detached from the worker.
+type DetachedFromWorkerClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*DetachedFromWorkerReply, error)
+	rpcc.Stream
+}
+
+// DetachedFromWorkerReply is the reply for DetachedFromWorker events.
+type DetachedFromWorkerReply struct {
+	SessionID SessionID `json:"sessionId"` // Detached session identifier.
+}
+
+// ReceivedMessageFromWorkerClient is a client for ReceivedMessageFromWorker events.
+// Notifies about a new protocol message received from the session (session ID
+// is provided in attachedToWorker notification).
+type ReceivedMessageFromWorkerClient interface {
+	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
+	// triggered, context canceled or connection closed.
+	Recv() (*ReceivedMessageFromWorkerReply, error)
+	rpcc.Stream
+}
+
+// ReceivedMessageFromWorkerReply is the reply for ReceivedMessageFromWorker events.
+type ReceivedMessageFromWorkerReply struct {
+	SessionID SessionID `json:"sessionId"` // Identifier of a session which sends a message.
+	Message   string    `json:"message"`   // No description.
+}
@@ -0,0 +1,20 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package profiler
+
+// SetSamplingIntervalArgs represents the arguments for SetSamplingInterval in the Profiler domain.
+type SetSamplingIntervalArgs struct {
+	Interval int `json:"interval"` // New sampling interval in microseconds.
+}
+
+// NewSetSamplingIntervalArgs initializes SetSamplingIntervalArgs with the required arguments.
+func NewSetSamplingIntervalArgs(interval int) *SetSamplingIntervalArgs {
+	args := new(SetSamplingIntervalArgs)
+	args.Interval = interval
+	return args
+}
+
+// StopReply represents the return values for Stop in the Profiler domain.
+type StopReply struct {
+	Profile Profile `json:"profile"` // Recorded profile.
+}
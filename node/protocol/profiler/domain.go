@@ -0,0 +1,143 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+// Package profiler implements the Profiler domain.
+package profiler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mafredri/cdp/node/protocol/internal"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// domainClient is a client for the Profiler domain.
+type domainClient struct{ conn *rpcc.Conn }
+
+// NewClient returns a client for the Profiler domain with the connection set to conn.
+func NewClient(conn *rpcc.Conn) *domainClient {
+	return &domainClient{conn: conn}
+}
+
+// Enable invokes the Profiler method.
+func (d *domainClient) Enable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Profiler.enable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Profiler", Op: "Enable", Err: err}
+	}
+	return
+}
+
+// Disable invokes the Profiler method.
+func (d *domainClient) Disable(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Profiler.disable", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Profiler", Op: "Disable", Err: err}
+	}
+	return
+}
+
+// SetSamplingInterval invokes the Profiler method. Changes CPU profiler
+// sampling interval. Must be called before CPU profiles recording started.
+func (d *domainClient) SetSamplingInterval(ctx context.Context, args *SetSamplingIntervalArgs) (err error) {
+	if args != nil {
+		err = rpcc.Invoke(ctx, "Profiler.setSamplingInterval", args, nil, d.conn)
+	} else {
+		err = rpcc.Invoke(ctx, "Profiler.setSamplingInterval", nil, nil, d.conn)
+	}
+	if err != nil {
+		err = &internal.OpError{Domain: "Profiler", Op: "SetSamplingInterval", Err: err}
+	}
+	return
+}
+
+// Start invokes the Profiler method.
+func (d *domainClient) Start(ctx context.Context) (err error) {
+	err = rpcc.Invoke(ctx, "Profiler.start", nil, nil, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Profiler", Op: "Start", Err: err}
+	}
+	return
+}
+
+// Stop invokes the Profiler method.
+func (d *domainClient) Stop(ctx context.Context) (reply *StopReply, err error) {
+	reply = new(StopReply)
+	err = rpcc.Invoke(ctx, "Profiler.stop", nil, reply, d.conn)
+	if err != nil {
+		err = &internal.OpError{Domain: "Profiler", Op: "Stop", Err: err}
+	}
+	return
+}
+
+func (d *domainClient) ConsoleProfileStarted(ctx context.Context, opts ...rpcc.StreamOption) (ConsoleProfileStartedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileStarted", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &consoleProfileStartedClient{Stream: s}, nil
+}
+
+// OnConsoleProfileStarted registers fn to be called for each
+// ConsoleProfileStarted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnConsoleProfileStarted(fn func(*ConsoleProfileStartedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Profiler.consoleProfileStarted", d.conn, func(args []byte) error {
+		ev := new(ConsoleProfileStartedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type consoleProfileStartedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *consoleProfileStartedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *consoleProfileStartedClient) Recv() (*ConsoleProfileStartedReply, error) {
+	event := new(ConsoleProfileStartedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Profiler", Op: "ConsoleProfileStarted Recv", Err: err}
+	}
+	return event, nil
+}
+
+func (d *domainClient) ConsoleProfileFinished(ctx context.Context, opts ...rpcc.StreamOption) (ConsoleProfileFinishedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileFinished", d.conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &consoleProfileFinishedClient{Stream: s}, nil
+}
+
+// OnConsoleProfileFinished registers fn to be called for each
+// ConsoleProfileFinished event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnConsoleProfileFinished(fn func(*ConsoleProfileFinishedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Profiler.consoleProfileFinished", d.conn, func(args []byte) error {
+		ev := new(ConsoleProfileFinishedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
+type consoleProfileFinishedClient struct{ rpcc.Stream }
+
+// GetStream returns the original Stream for use with cdp.Sync.
+func (c *consoleProfileFinishedClient) GetStream() rpcc.Stream { return c.Stream }
+
+func (c *consoleProfileFinishedClient) Recv() (*ConsoleProfileFinishedReply, error) {
+	event := new(ConsoleProfileFinishedReply)
+	if err := c.RecvMsg(event); err != nil {
+		return nil, &internal.OpError{Domain: "Profiler", Op: "ConsoleProfileFinished Recv", Err: err}
+	}
+	return event, nil
+}
@@ -0,0 +1,43 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package profiler
+
+import (
+	"github.com/mafredri/cdp/node/protocol/runtime"
+)
+
+// ProfileNode Profile node. Holds callsite information, execution statistics
+// and child nodes.
+type ProfileNode struct {
+	ID        int               `json:"id"`        // Unique id of the node.
+	CallFrame runtime.CallFrame `json:"callFrame"` // Function location.
+	// HitCount Number of samples where this node was on top of the call
+	// stack.
+	//
+	// Note: This property is experimental.
+	HitCount    *int    `json:"hitCount,omitempty"`
+	Children    []int   `json:"children,omitempty"`    // Child node ids.
+	DeoptReason *string `json:"deoptReason,omitempty"` // The reason of being not optimized. The function may be deoptimized or marked as don't optimize.
+	// PositionTicks An array of source position ticks.
+	//
+	// Note: This property is experimental.
+	PositionTicks []PositionTickInfo `json:"positionTicks,omitempty"`
+}
+
+// Profile Profile.
+type Profile struct {
+	Nodes      []ProfileNode `json:"nodes"`                // The list of profile nodes. First item is the root node.
+	StartTime  float64       `json:"startTime"`            // Profiling start timestamp in microseconds.
+	EndTime    float64       `json:"endTime"`              // Profiling end timestamp in microseconds.
+	Samples    []int         `json:"samples,omitempty"`    // Ids of samples top nodes.
+	TimeDeltas []int         `json:"timeDeltas,omitempty"` // Time intervals between adjacent samples in microseconds. The first delta is relative to the profile startTime.
+}
+
+// PositionTickInfo Specifies a number of samples attributed to a certain
+// source position.
+//
+// Note: This type is experimental.
+type PositionTickInfo struct {
+	Line  int `json:"line"`  // Source line number (1-based).
+	Ticks int `json:"ticks"` // Number of samples attributed to the source line.
+}
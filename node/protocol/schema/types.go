@@ -0,0 +1,9 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package schema
+
+// Domain Description of the protocol domain.
+type Domain struct {
+	Name    string `json:"name"`    // Domain name.
+	Version string `json:"version"` // Domain version.
+}
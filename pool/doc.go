@@ -0,0 +1,47 @@
+/*
+
+Package pool implements a Pool of warm targets (pages) that are handed out
+as leases. Targets are created via the Target domain and connected to via a
+session Manager.
+
+Initialize a new Pool with four targets, each in its own browser context.
+
+	c := cdp.NewClient(conn) // cdp.Client with websocket connection.
+
+	m, err := session.NewManager(c)
+	if err != nil {
+		// Handle error.
+	}
+	defer m.Close()
+
+	p, err := pool.New(context.TODO(), c, m, pool.WithSize(4), pool.WithIncognito())
+	if err != nil {
+		// Handle error.
+	}
+	defer p.Close()
+
+Acquire a lease and use the session connection to the target.
+
+	l, err := p.Acquire(context.TODO())
+	if err != nil {
+		// Handle error.
+	}
+
+	pageClient := cdp.NewClient(l.Conn)
+	// ...
+
+Release the target back to the Pool, it is navigated to about:blank and the
+cookies and storage of the visited origins are cleared. The session connection
+is closed, domains enabled during the lease are disabled by the browser and the
+next lease uses a new connection. Targets that have crashed, or fail to reset, are replaced by new targets. Use WithIncognito to
+isolate the cookies of the targets, they share the cookie jar otherwise.
+
+	err = l.Release(context.TODO())
+
+The health of the Pool can be inspected via Stats.
+
+	s := p.Stats()
+	log.Printf("idle: %d, leased: %d, crashed: %d", s.Idle, s.Leased, s.Crashed)
+
+*/
+package pool
@@ -0,0 +1,590 @@
+package pool
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/browser"
+	"github.com/mafredri/cdp/protocol/inspector"
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/protocol/storage"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+// ErrClosed is returned by Acquire when the Pool is closed.
+var ErrClosed = errors.New("pool: closed")
+
+const (
+	defaultSize = 4
+
+	// Timeout for creating (or destroying) a target in the
+	// background, e.g. when replacing a crashed target.
+	backgroundTimeout = 30 * time.Second
+	// Delay before retrying a failed attempt at creating a target.
+	retryDelay = time.Second
+)
+
+// Option represents a function that sets a Pool option.
+type Option func(*options)
+
+type options struct {
+	size      int
+	incognito bool
+	url       string
+}
+
+// WithSize returns an Option that sets the number of targets kept by
+// the Pool, the default is 4.
+func WithSize(n int) Option {
+	return func(o *options) {
+		o.size = n
+	}
+}
+
+// WithIncognito returns an Option that creates each target in its own
+// browser context (Target.createBrowserContext). Cookies and storage
+// are not shared between the targets.
+//
+// Without incognito the targets share the cookie jar, Release only
+// clears the cookies of the origins that the target visited. Cookies
+// set by one lease can be seen by other leases meanwhile.
+func WithIncognito() Option {
+	return func(o *options) {
+		o.incognito = true
+	}
+}
+
+// WithURL returns an Option that sets the URL that targets are created
+// with and reset to on Release, the default is "about:blank".
+func WithURL(url string) Option {
+	return func(o *options) {
+		o.url = url
+	}
+}
+
+// Stats represents the health of the Pool.
+type Stats struct {
+	Size         int // Number of targets kept by the Pool.
+	Idle         int // Targets ready to be leased.
+	Leased       int // Targets currently leased.
+	Leases       int // Total number of leases handed out.
+	Created      int // Total number of targets created.
+	Recycled     int // Targets replaced after a crash, failed reset or Discard.
+	Crashed      int // Targets that crashed or were detached.
+	ResetErrors  int // Releases that failed to reset the target.
+	CreateErrors int // Failed attempts at creating a target.
+}
+
+// pooledTarget is a target kept by the Pool.
+type pooledTarget struct {
+	id        target.ID
+	contextID browser.ContextID // Set in incognito mode.
+	gone      chan struct{}     // Closed when the target is destroyed.
+
+	// Protected by Pool.mu.
+	conn    *rpcc.Conn // Session connection, replaced on Release.
+	unwatch func()
+	leased  bool
+	dead    bool
+}
+
+// Pool keeps a number of warm targets (pages) and hands them out as
+// leases. Targets are created via the Target domain of the cdp.Client
+// and connected to via the session.Manager.
+type Pool struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	c      *cdp.Client
+	m      *session.Manager
+	opts   options
+	wg     sync.WaitGroup // Background replacements.
+
+	mu      sync.Mutex // Protects following.
+	targets map[*pooledTarget]struct{}
+	idle    []*pooledTarget
+	wait    chan struct{} // Closed and replaced when a target is put back.
+	stats   Stats
+	closed  bool
+}
+
+// New creates the targets and returns a new Pool. The cdp.Client is
+// used for the Target domain and m for establishing the session
+// connections, neither is closed by the Pool.
+func New(ctx context.Context, c *cdp.Client, m *session.Manager, opts ...Option) (*Pool, error) {
+	o := options{size: defaultSize, url: "about:blank"}
+	for _, fn := range opts {
+		fn(&o)
+	}
+	if o.size < 1 {
+		o.size = 1
+	}
+
+	p := &Pool{
+		c:       c,
+		m:       m,
+		opts:    o,
+		targets: make(map[*pooledTarget]struct{}),
+		wait:    make(chan struct{}),
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.stats.Size = o.size
+
+	type result struct {
+		t   *pooledTarget
+		err error
+	}
+	resC := make(chan result, o.size)
+	for i := 0; i < o.size; i++ {
+		go func() {
+			t, err := p.create(ctx)
+			resC <- result{t, err}
+		}()
+	}
+	var err []error
+	for i := 0; i < o.size; i++ {
+		res := <-resC
+		if res.err != nil {
+			err = append(err, res.err)
+			continue
+		}
+		p.put(res.t)
+	}
+	if len(err) > 0 {
+		p.Close()
+		return nil, errors.Wrapf(errors.Merge(err...), "pool: New: create targets failed")
+	}
+	return p, nil
+}
+
+// create creates a target and connects to it.
+func (p *Pool) create(ctx context.Context) (t *pooledTarget, err error) {
+	t = &pooledTarget{gone: make(chan struct{})}
+	defer func() {
+		p.mu.Lock()
+		if err != nil {
+			p.stats.CreateErrors++
+		} else {
+			p.stats.Created++
+		}
+		p.mu.Unlock()
+		if err != nil {
+			p.destroy(t)
+		}
+	}()
+
+	args := target.NewCreateTargetArgs(p.opts.url)
+	if p.opts.incognito {
+		bc, err := p.c.Target.CreateBrowserContext(ctx, target.NewCreateBrowserContextArgs())
+		if err != nil {
+			return nil, err
+		}
+		t.contextID = bc.BrowserContextID
+		args.SetBrowserContextID(t.contextID)
+	}
+	reply, err := p.c.Target.CreateTarget(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	t.id = reply.TargetID
+
+	if err = p.attach(ctx, t); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.targets[t] = struct{}{}
+	p.mu.Unlock()
+	return t, nil
+}
+
+// attach connects to the target with a new session connection and
+// watches it for crashes and detach.
+func (p *Pool) attach(ctx context.Context, t *pooledTarget) error {
+	conn, err := p.m.Dial(ctx, t.id)
+	if err != nil {
+		return err
+	}
+
+	// Watch for crashes (requires the Inspector domain) and detach.
+	ic := inspector.NewClient(conn)
+	unwatchCrash, err := ic.OnTargetCrashed(func(*inspector.TargetCrashedReply) {
+		p.markDead(t, true)
+	})
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if err = ic.Enable(ctx); err != nil {
+		unwatchCrash()
+		conn.Close()
+		return err
+	}
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-conn.Context().Done():
+			p.markDead(t, true)
+		case <-stop:
+		case <-t.gone:
+		}
+	}()
+	unwatch := func() {
+		unwatchCrash()
+		close(stop)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-t.gone:
+		unwatch()
+		conn.Close()
+		return ErrClosed
+	default:
+	}
+	t.conn, t.unwatch = conn, unwatch
+	return nil
+}
+
+// reattach replaces the session connection of the target. Closing the
+// connection detaches the session, the browser disables the domains
+// (e.g. Fetch) that were enabled by the previous lease and its streams
+// and handlers are closed.
+func (p *Pool) reattach(ctx context.Context, t *pooledTarget) error {
+	p.mu.Lock()
+	conn, unwatch := t.conn, t.unwatch
+	t.conn, t.unwatch = nil, nil
+	p.mu.Unlock()
+
+	unwatch()
+	if err := conn.Close(); err != nil {
+		return err
+	}
+	return p.attach(ctx, t)
+}
+
+// destroy closes the target and its browser context, errors are
+// returned but the target is gone regardless.
+func (p *Pool) destroy(t *pooledTarget) error {
+	p.mu.Lock()
+	delete(p.targets, t)
+	select {
+	case <-t.gone:
+		p.mu.Unlock()
+		return nil // Already destroyed.
+	default:
+		close(t.gone)
+	}
+	conn, unwatch := t.conn, t.unwatch
+	p.mu.Unlock()
+
+	if unwatch != nil {
+		unwatch()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), backgroundTimeout)
+	defer cancel()
+
+	var err []error
+	if conn != nil {
+		err = append(err, conn.Close())
+	}
+	if t.id != "" {
+		_, e := p.c.Target.CloseTarget(ctx, target.NewCloseTargetArgs(t.id))
+		err = append(err, e)
+	}
+	if t.contextID != "" {
+		err = append(err, p.c.Target.DisposeBrowserContext(ctx, target.NewDisposeBrowserContextArgs(t.contextID)))
+	}
+	return errors.Merge(err...)
+}
+
+// markDead marks the target as dead, an idle target is replaced
+// immediately and a leased target when it is released.
+func (p *Pool) markDead(t *pooledTarget, crashed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t.dead || p.closed {
+		return
+	}
+	select {
+	case <-t.gone:
+		return // Destroyed by the Pool.
+	default:
+	}
+	t.dead = true
+	if crashed {
+		p.stats.Crashed++
+	}
+	if !t.leased {
+		for i, it := range p.idle {
+			if it == t {
+				p.idle = append(p.idle[:i], p.idle[i+1:]...)
+				break
+			}
+		}
+		p.recycleLocked(t)
+	}
+}
+
+// recycleLocked replaces t with a new target in the background. The
+// caller must hold p.mu.
+func (p *Pool) recycleLocked(t *pooledTarget) {
+	if p.closed {
+		go p.destroy(t)
+		return
+	}
+	p.stats.Recycled++
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.destroy(t)
+
+		delay := retryDelay
+		for {
+			ctx, cancel := context.WithTimeout(p.ctx, backgroundTimeout)
+			nt, err := p.create(ctx)
+			cancel()
+			if err == nil {
+				p.put(nt)
+				return
+			}
+
+			select {
+			case <-time.After(delay):
+				if delay < backgroundTimeout {
+					delay *= 2
+				}
+			case <-p.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// put returns t to the idle targets.
+func (p *Pool) put(t *pooledTarget) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		go p.destroy(t)
+		return
+	}
+	t.leased = false
+	p.idle = append(p.idle, t)
+	close(p.wait)
+	p.wait = make(chan struct{})
+}
+
+// Acquire returns a lease for an idle target, it blocks until a target
+// is available, ctx is done or the Pool is closed.
+func (p *Pool) Acquire(ctx context.Context) (*Lease, error) {
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return nil, ErrClosed
+		}
+		if n := len(p.idle); n > 0 {
+			t := p.idle[0]
+			p.idle = append(p.idle[:0], p.idle[1:]...)
+			t.leased = true
+			p.stats.Leases++
+			p.mu.Unlock()
+			return &Lease{
+				Conn:             t.conn,
+				TargetID:         t.id,
+				BrowserContextID: t.contextID,
+				p:                p,
+				t:                t,
+			}, nil
+		}
+		wait := p.wait
+		p.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Stats returns the current health of the Pool.
+func (p *Pool) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.stats
+	s.Idle = len(p.idle)
+	for t := range p.targets {
+		if t.leased {
+			s.Leased++
+		}
+	}
+	return s
+}
+
+// Close closes the Pool and all targets, including leased ones.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.wait) // Wake up Acquire.
+	p.idle = nil
+	p.mu.Unlock()
+
+	p.cancel()
+	p.wg.Wait()
+
+	p.mu.Lock()
+	var targets []*pooledTarget
+	for t := range p.targets {
+		targets = append(targets, t)
+	}
+	p.mu.Unlock()
+
+	errC := make(chan error, len(targets))
+	for _, t := range targets {
+		go func(t *pooledTarget) { errC <- p.destroy(t) }(t)
+	}
+	var err []error
+	for range targets {
+		err = append(err, <-errC)
+	}
+	return errors.Wrapf(errors.Merge(err...), "pool: Close failed")
+}
+
+// reset restores the target to its initial state. The cookies of the
+// current page (and its frames) and the storage, including cookies, for
+// the origins in the navigation history are cleared. Other cookies are
+// kept, they may belong to other targets that share the cookie jar.
+func (p *Pool) reset(ctx context.Context, t *pooledTarget) error {
+	c := cdp.NewClient(t.conn)
+
+	hist, err := c.Page.GetNavigationHistory(ctx)
+	if err != nil {
+		return err
+	}
+	cookies, err := c.Network.GetCookies(ctx, nil)
+	if err != nil {
+		return err
+	}
+	origins := make(map[string]bool)
+	for _, e := range hist.Entries {
+		u, err := url.Parse(e.URL)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		origins[u.Scheme+"://"+u.Host] = true
+	}
+
+	if _, err = c.Page.Navigate(ctx, page.NewNavigateArgs(p.opts.url)); err != nil {
+		return err
+	}
+	if err = c.Page.ResetNavigationHistory(ctx); err != nil {
+		return err
+	}
+	for _, ck := range cookies.Cookies {
+		args := network.NewDeleteCookiesArgs(ck.Name).SetDomain(ck.Domain).SetPath(ck.Path)
+		if err = c.Network.DeleteCookies(ctx, args); err != nil {
+			return err
+		}
+	}
+	for origin := range origins {
+		err = c.Storage.ClearDataForOrigin(ctx, storage.NewClearDataForOriginArgs(origin, "all"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lease represents a leased target. The lease must be ended with
+// Release or Discard.
+type Lease struct {
+	Conn             *rpcc.Conn        // Session connection to the target.
+	TargetID         target.ID         // ID of the target.
+	BrowserContextID browser.ContextID // Browser context of the target, set in incognito mode.
+
+	p    *Pool
+	t    *pooledTarget
+	once sync.Once
+}
+
+var errLeaseEnded = errors.New("pool: lease already ended")
+
+// Release resets the target (navigates to the initial URL, clears the
+// cookies and storage of the visited origins) and returns it to the
+// Pool. If the target crashed or the reset fails, it is replaced with a
+// new target.
+//
+// Conn is closed by Release, the session is detached so that domains
+// enabled during the lease (e.g. Fetch) are disabled. The next lease
+// of the target uses a new session connection.
+func (l *Lease) Release(ctx context.Context) error {
+	err := errLeaseEnded
+	l.once.Do(func() {
+		err = nil
+		p, t := l.p, l.t
+
+		p.mu.Lock()
+		dead := t.dead
+		p.mu.Unlock()
+		if !dead {
+			err = p.reset(ctx, t)
+			if err == nil {
+				err = p.reattach(ctx, t)
+			}
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if err != nil {
+			p.stats.ResetErrors++
+			err = errors.Wrapf(err, "pool: Release: reset failed")
+		}
+		if t.dead || err != nil {
+			t.dead = true
+			p.recycleLocked(t)
+			return
+		}
+		if p.closed {
+			go p.destroy(t)
+			return
+		}
+		t.leased = false
+		p.idle = append(p.idle, t)
+		close(p.wait)
+		p.wait = make(chan struct{})
+	})
+	return err
+}
+
+// Discard closes the target and replaces it with a new one in the
+// Pool, e.g. when the state of the target is unknown.
+func (l *Lease) Discard() error {
+	err := errLeaseEnded
+	l.once.Do(func() {
+		err = nil
+		p, t := l.p, l.t
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		t.dead = true
+		p.recycleLocked(t)
+	})
+	return err
+}
@@ -0,0 +1,330 @@
+package pool_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/pool"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+type testPool struct {
+	srv  *cdptest.Server
+	conn *rpcc.Conn
+	m    *session.Manager
+	p    *pool.Pool
+}
+
+func newTestPool(t *testing.T, opts ...pool.Option) *testPool {
+	srv := cdptest.NewServer()
+
+	var mu sync.Mutex
+	var targets, contexts int
+	srv.Handle("Target.createTarget", func(*cdptest.Request) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		targets++
+		return map[string]string{"targetId": fmt.Sprintf("T%d", targets)}, nil
+	})
+	srv.Handle("Target.createBrowserContext", func(*cdptest.Request) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		contexts++
+		return map[string]string{"browserContextId": fmt.Sprintf("C%d", contexts)}, nil
+	})
+	srv.Handle("Page.getNavigationHistory", cdptest.Reply(map[string]interface{}{
+		"currentIndex": 1,
+		"entries": []map[string]interface{}{
+			{"id": 1, "url": "about:blank", "userTypedURL": "about:blank", "title": "", "transitionType": "typed"},
+			{"id": 2, "url": "https://example.com/a", "userTypedURL": "https://example.com/a", "title": "", "transitionType": "typed"},
+		},
+	}))
+	srv.Handle("Page.navigate", cdptest.Reply(map[string]string{"frameId": "F1"}))
+	srv.Handle("Network.getCookies", cdptest.Reply(map[string]interface{}{
+		"cookies": []map[string]interface{}{
+			{"name": "id", "value": "1", "domain": ".example.com", "path": "/"},
+		},
+	}))
+	for _, method := range []string{
+		"Target.closeTarget",
+		"Target.disposeBrowserContext",
+		"Inspector.enable",
+		"Fetch.enable",
+		"Page.resetNavigationHistory",
+		"Network.deleteCookies",
+		"Storage.clearDataForOrigin",
+	} {
+		srv.Handle(method, cdptest.Reply(nil))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	m, err := session.NewFlatManager(conn)
+	if err != nil {
+		conn.Close()
+		srv.Close()
+		t.Fatal(err)
+	}
+	p, err := pool.New(ctx, cdp.NewClient(conn), m, opts...)
+	if err != nil {
+		m.Close()
+		conn.Close()
+		srv.Close()
+		t.Fatal(err)
+	}
+	return &testPool{srv: srv, conn: conn, m: m, p: p}
+}
+
+func (tp *testPool) Close() {
+	tp.p.Close()
+	tp.m.Close()
+	tp.conn.Close()
+	tp.srv.Close()
+}
+
+// waitStats polls the pool stats until cond is true.
+func waitStats(t *testing.T, p *pool.Pool, cond func(pool.Stats) bool) pool.Stats {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s := p.Stats()
+		if cond(s) {
+			return s
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for stats, got %+v", s)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPool_AcquireRelease(t *testing.T) {
+	tp := newTestPool(t, pool.WithSize(2), pool.WithIncognito())
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if got := len(tp.srv.CallsTo("Target.createBrowserContext")); got != 2 {
+		t.Errorf("createBrowserContext called %d times, want 2", got)
+	}
+
+	l1, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l2, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l1.TargetID == l2.TargetID {
+		t.Errorf("leases share target %s", l1.TargetID)
+	}
+	if l1.BrowserContextID == "" || l1.BrowserContextID == l2.BrowserContextID {
+		t.Errorf("leases browser contexts = %q, %q, want unique", l1.BrowserContextID, l2.BrowserContextID)
+	}
+
+	// The pool is exhausted.
+	shortCtx, shortCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer shortCancel()
+	if _, err = tp.p.Acquire(shortCtx); err != context.DeadlineExceeded {
+		t.Errorf("Acquire() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if err = l1.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = l1.Release(ctx); err == nil {
+		t.Error("second Release() error = nil, want error")
+	}
+	req := tp.srv.CallsTo("Storage.clearDataForOrigin")
+	if len(req) != 1 {
+		t.Fatalf("clearDataForOrigin called %d times, want 1", len(req))
+	}
+	var args struct{ Origin string }
+	if err = req[0].Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	if args.Origin != "https://example.com" {
+		t.Errorf("clearDataForOrigin origin = %q, want %q", args.Origin, "https://example.com")
+	}
+	for _, method := range []string{"Page.navigate", "Page.resetNavigationHistory"} {
+		if !tp.srv.Called(method) {
+			t.Errorf("%s was not called on Release", method)
+		}
+	}
+	// Only the cookies of the target are deleted.
+	req = tp.srv.CallsTo("Network.deleteCookies")
+	if len(req) != 1 {
+		t.Fatalf("deleteCookies called %d times, want 1", len(req))
+	}
+	var cookie struct{ Name, Domain, Path string }
+	if err = req[0].Unmarshal(&cookie); err != nil {
+		t.Fatal(err)
+	}
+	if want := (struct{ Name, Domain, Path string }{"id", ".example.com", "/"}); cookie != want {
+		t.Errorf("deleteCookies args = %+v, want %+v", cookie, want)
+	}
+
+	l3, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l3.TargetID != l1.TargetID {
+		t.Errorf("Acquire() target = %s, want released target %s", l3.TargetID, l1.TargetID)
+	}
+	if l3.Conn == l1.Conn {
+		t.Error("Acquire() reused the session connection of the released lease")
+	}
+
+	s := tp.p.Stats()
+	want := pool.Stats{Size: 2, Leased: 2, Leases: 3, Created: 2}
+	if s != want {
+		t.Errorf("Stats() = %+v, want %+v", s, want)
+	}
+
+	if err = tp.p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(tp.srv.CallsTo("Target.disposeBrowserContext")); got != 2 {
+		t.Errorf("disposeBrowserContext called %d times, want 2", got)
+	}
+	if _, err = tp.p.Acquire(ctx); err != pool.ErrClosed {
+		t.Errorf("Acquire() error = %v, want %v", err, pool.ErrClosed)
+	}
+}
+
+func TestPool_Crash(t *testing.T) {
+	tp := newTestPool(t, pool.WithSize(1))
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	l, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sid := range tp.srv.Sessions() {
+		if err = tp.srv.SessionEvent(sid, "Inspector.targetCrashed", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitStats(t, tp.p, func(s pool.Stats) bool { return s.Crashed == 1 })
+
+	// The crashed target is not reset but replaced.
+	if err = l.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if tp.srv.Called("Page.navigate") {
+		t.Error("crashed target was reset")
+	}
+
+	l, err = tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.TargetID != "T2" {
+		t.Errorf("Acquire() target = %s, want T2", l.TargetID)
+	}
+	s := tp.p.Stats()
+	if s.Created != 2 || s.Recycled != 1 {
+		t.Errorf("Stats() = %+v, want Created: 2, Recycled: 1", s)
+	}
+
+	// Discard replaces the target as well.
+	if err = l.Discard(); err != nil {
+		t.Fatal(err)
+	}
+	waitStats(t, tp.p, func(s pool.Stats) bool { return s.Idle == 1 && s.Created == 3 })
+	if _, err = tp.srv.WaitCall(ctx, "Target.closeTarget", 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPool_ResetError(t *testing.T) {
+	tp := newTestPool(t, pool.WithSize(1))
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tp.srv.Handle("Page.navigate", func(*cdptest.Request) (interface{}, error) {
+		return nil, fmt.Errorf("navigate failed")
+	})
+
+	l, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = l.Release(ctx); err == nil {
+		t.Error("Release() error = nil, want error")
+	}
+	s := waitStats(t, tp.p, func(s pool.Stats) bool { return s.Idle == 1 })
+	if s.ResetErrors != 1 || s.Recycled != 1 || s.Created != 2 {
+		t.Errorf("Stats() = %+v, want ResetErrors: 1, Recycled: 1, Created: 2", s)
+	}
+}
+
+func TestPool_ReleaseDetachesSession(t *testing.T) {
+	tp := newTestPool(t, pool.WithSize(1))
+	defer tp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	l, err := tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The lease leaves request interception enabled.
+	if err = cdp.NewClient(l.Conn).Fetch.Enable(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	sessionID := tp.srv.CallsTo("Fetch.enable")[0].SessionID
+
+	if err = l.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if l.Conn.Context().Err() == nil {
+		t.Error("Release() did not close the session connection")
+	}
+	req := tp.srv.CallsTo("Target.detachFromTarget")
+	if len(req) != 1 {
+		t.Fatalf("detachFromTarget called %d times, want 1", len(req))
+	}
+	var args struct{ SessionID string }
+	if err = req[0].Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	if args.SessionID != sessionID {
+		t.Errorf("detachFromTarget session = %q, want %q", args.SessionID, sessionID)
+	}
+
+	l, err = tp.p.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cdp.NewClient(l.Conn).Page.Navigate(ctx, page.NewNavigateArgs("https://example.com")); err != nil {
+		t.Fatal(err)
+	}
+	req = tp.srv.CallsTo("Page.navigate")
+	if got := req[len(req)-1].SessionID; got == sessionID || got == "" {
+		t.Errorf("Page.navigate session = %q, want a new session", got)
+	}
+	if s := tp.p.Stats(); s.Created != 1 || s.Crashed != 0 || s.Recycled != 0 {
+		t.Errorf("Stats() = %+v, want Created: 1, Crashed: 0, Recycled: 0", s)
+	}
+}
@@ -3,6 +3,9 @@
 package accessibility
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
@@ -22,6 +25,35 @@ func NewGetPartialAXTreeArgs() *GetPartialAXTreeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetPartialAXTreeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	if a.FetchRelatives != nil {
+		b = fastjson.AppendKey(b, "fetchRelatives")
+		b = fastjson.AppendBool(b, *a.FetchRelatives)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the node
 // to get the partial accessibility tree for.
 func (a *GetPartialAXTreeArgs) SetNodeID(nodeID dom.NodeID) *GetPartialAXTreeArgs {
@@ -57,7 +89,31 @@ type GetPartialAXTreeReply struct {
 	Nodes []AXNode `json:"nodes"` // The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and children, if requested.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetPartialAXTreeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodes":
+			err = json.Unmarshal(value, &r.Nodes)
+		}
+		return err
+	})
+}
+
 // GetFullAXTreeReply represents the return values for GetFullAXTree in the Accessibility domain.
 type GetFullAXTreeReply struct {
 	Nodes []AXNode `json:"nodes"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFullAXTreeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodes":
+			err = json.Unmarshal(value, &r.Nodes)
+		}
+		return err
+	})
+}
@@ -3,6 +3,9 @@
 package animation
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -18,16 +21,55 @@ func NewGetCurrentTimeArgs(id string) *GetCurrentTimeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCurrentTimeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "id")
+	b = fastjson.AppendString(b, a.ID)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCurrentTimeReply represents the return values for GetCurrentTime in the Animation domain.
 type GetCurrentTimeReply struct {
 	CurrentTime float64 `json:"currentTime"` // Current time of the page.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCurrentTimeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "currentTime":
+			r.CurrentTime, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // GetPlaybackRateReply represents the return values for GetPlaybackRate in the Animation domain.
 type GetPlaybackRateReply struct {
 	PlaybackRate float64 `json:"playbackRate"` // Playback rate for animations on page.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetPlaybackRateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "playbackRate":
+			r.PlaybackRate, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // ReleaseAnimationsArgs represents the arguments for ReleaseAnimations in the Animation domain.
 type ReleaseAnimationsArgs struct {
 	Animations []string `json:"animations"` // List of animation ids to seek.
@@ -40,6 +82,23 @@ func NewReleaseAnimationsArgs(animations []string) *ReleaseAnimationsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReleaseAnimationsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "animations")
+	if b, err = fastjson.AppendValue(b, a.Animations); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // ResolveAnimationArgs represents the arguments for ResolveAnimation in the Animation domain.
 type ResolveAnimationArgs struct {
 	AnimationID string `json:"animationId"` // Animation id.
@@ -52,11 +111,38 @@ func NewResolveAnimationArgs(animationID string) *ResolveAnimationArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ResolveAnimationArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "animationId")
+	b = fastjson.AppendString(b, a.AnimationID)
+	b = append(b, '}')
+	return b, err
+}
+
 // ResolveAnimationReply represents the return values for ResolveAnimation in the Animation domain.
 type ResolveAnimationReply struct {
 	RemoteObject runtime.RemoteObject `json:"remoteObject"` // Corresponding remote object.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResolveAnimationReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "remoteObject":
+			err = json.Unmarshal(value, &r.RemoteObject)
+		}
+		return err
+	})
+}
+
 // SeekAnimationsArgs represents the arguments for SeekAnimations in the Animation domain.
 type SeekAnimationsArgs struct {
 	Animations  []string `json:"animations"`  // List of animation ids to seek.
@@ -71,6 +157,27 @@ func NewSeekAnimationsArgs(animations []string, currentTime float64) *SeekAnimat
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SeekAnimationsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "animations")
+	if b, err = fastjson.AppendValue(b, a.Animations); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "currentTime")
+	if b, err = fastjson.AppendFloat(b, a.CurrentTime); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPausedArgs represents the arguments for SetPaused in the Animation domain.
 type SetPausedArgs struct {
 	Animations []string `json:"animations"` // Animations to set the pause state of.
@@ -85,6 +192,25 @@ func NewSetPausedArgs(animations []string, paused bool) *SetPausedArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPausedArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "animations")
+	if b, err = fastjson.AppendValue(b, a.Animations); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "paused")
+	b = fastjson.AppendBool(b, a.Paused)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPlaybackRateArgs represents the arguments for SetPlaybackRate in the Animation domain.
 type SetPlaybackRateArgs struct {
 	PlaybackRate float64 `json:"playbackRate"` // Playback rate for animations on page
@@ -97,6 +223,23 @@ func NewSetPlaybackRateArgs(playbackRate float64) *SetPlaybackRateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPlaybackRateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "playbackRate")
+	if b, err = fastjson.AppendFloat(b, a.PlaybackRate); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTimingArgs represents the arguments for SetTiming in the Animation domain.
 type SetTimingArgs struct {
 	AnimationID string  `json:"animationId"` // Animation id.
@@ -112,3 +255,26 @@ func NewSetTimingArgs(animationID string, duration float64, delay float64) *SetT
 	args.Delay = delay
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetTimingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "animationId")
+	b = fastjson.AppendString(b, a.AnimationID)
+	b = fastjson.AppendKey(b, "duration")
+	if b, err = fastjson.AppendFloat(b, a.Duration); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "delay")
+	if b, err = fastjson.AppendFloat(b, a.Delay); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
@@ -5,6 +5,7 @@ package animation
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -149,14 +150,29 @@ func (d *domainClient) SetTiming(ctx context.Context, args *SetTimingArgs) (err
 	return
 }
 
-func (d *domainClient) AnimationCanceled(ctx context.Context) (CanceledClient, error) {
-	s, err := rpcc.NewStream(ctx, "Animation.animationCanceled", d.conn)
+func (d *domainClient) AnimationCanceled(ctx context.Context, opts ...rpcc.StreamOption) (CanceledClient, error) {
+	s, err := rpcc.NewStream(ctx, "Animation.animationCanceled", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &canceledClient{Stream: s}, nil
 }
 
+// OnAnimationCanceled registers fn to be called for each
+// AnimationCanceled event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAnimationCanceled(fn func(*CanceledReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Animation.animationCanceled", d.conn, func(args []byte) error {
+		ev := new(CanceledReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type canceledClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -170,14 +186,29 @@ func (c *canceledClient) Recv() (*CanceledReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AnimationCreated(ctx context.Context) (CreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Animation.animationCreated", d.conn)
+func (d *domainClient) AnimationCreated(ctx context.Context, opts ...rpcc.StreamOption) (CreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Animation.animationCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &createdClient{Stream: s}, nil
 }
 
+// OnAnimationCreated registers fn to be called for each
+// AnimationCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAnimationCreated(fn func(*CreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Animation.animationCreated", d.conn, func(args []byte) error {
+		ev := new(CreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type createdClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -191,14 +222,29 @@ func (c *createdClient) Recv() (*CreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AnimationStarted(ctx context.Context) (StartedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Animation.animationStarted", d.conn)
+func (d *domainClient) AnimationStarted(ctx context.Context, opts ...rpcc.StreamOption) (StartedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Animation.animationStarted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &startedClient{Stream: s}, nil
 }
 
+// OnAnimationStarted registers fn to be called for each
+// AnimationStarted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAnimationStarted(fn func(*StartedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Animation.animationStarted", d.conn, func(args []byte) error {
+		ev := new(StartedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type startedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
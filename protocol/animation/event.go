@@ -3,6 +3,9 @@
 package animation
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -20,6 +23,18 @@ type CanceledReply struct {
 	ID string `json:"id"` // Id of the animation that was canceled.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CanceledReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // CreatedClient is a client for AnimationCreated events. Event for each
 // animation that has been created.
 type CreatedClient interface {
@@ -34,6 +49,18 @@ type CreatedReply struct {
 	ID string `json:"id"` // Id of the animation that was created.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // StartedClient is a client for AnimationStarted events. Event for animation
 // that has been started.
 type StartedClient interface {
@@ -47,3 +74,15 @@ type StartedClient interface {
 type StartedReply struct {
 	Animation Animation `json:"animation"` // Animation that was started.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StartedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "animation":
+			err = json.Unmarshal(value, &r.Animation)
+		}
+		return err
+	})
+}
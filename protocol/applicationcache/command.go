@@ -3,6 +3,9 @@
 package applicationcache
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/page"
 )
 
@@ -18,16 +21,55 @@ func NewGetApplicationCacheForFrameArgs(frameID page.FrameID) *GetApplicationCac
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetApplicationCacheForFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetApplicationCacheForFrameReply represents the return values for GetApplicationCacheForFrame in the ApplicationCache domain.
 type GetApplicationCacheForFrameReply struct {
 	ApplicationCache ApplicationCache `json:"applicationCache"` // Relevant application cache data for the document in given frame.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetApplicationCacheForFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "applicationCache":
+			err = json.Unmarshal(value, &r.ApplicationCache)
+		}
+		return err
+	})
+}
+
 // GetFramesWithManifestsReply represents the return values for GetFramesWithManifests in the ApplicationCache domain.
 type GetFramesWithManifestsReply struct {
 	FrameIDs []FrameWithManifest `json:"frameIds"` // Array of frame identifiers with manifest urls for each frame containing a document associated with some application cache.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFramesWithManifestsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameIds":
+			err = json.Unmarshal(value, &r.FrameIDs)
+		}
+		return err
+	})
+}
+
 // GetManifestForFrameArgs represents the arguments for GetManifestForFrame in the ApplicationCache domain.
 type GetManifestForFrameArgs struct {
 	FrameID page.FrameID `json:"frameId"` // Identifier of the frame containing document whose manifest is retrieved.
@@ -40,7 +82,34 @@ func NewGetManifestForFrameArgs(frameID page.FrameID) *GetManifestForFrameArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetManifestForFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetManifestForFrameReply represents the return values for GetManifestForFrame in the ApplicationCache domain.
 type GetManifestForFrameReply struct {
 	ManifestURL string `json:"manifestURL"` // Manifest URL for document in the given frame.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetManifestForFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "manifestURL":
+			r.ManifestURL, err = fastjson.String(value)
+		}
+		return err
+	})
+}
@@ -5,6 +5,7 @@ package applicationcache
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -70,14 +71,29 @@ func (d *domainClient) GetManifestForFrame(ctx context.Context, args *GetManifes
 	return
 }
 
-func (d *domainClient) ApplicationCacheStatusUpdated(ctx context.Context) (StatusUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "ApplicationCache.applicationCacheStatusUpdated", d.conn)
+func (d *domainClient) ApplicationCacheStatusUpdated(ctx context.Context, opts ...rpcc.StreamOption) (StatusUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "ApplicationCache.applicationCacheStatusUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &statusUpdatedClient{Stream: s}, nil
 }
 
+// OnApplicationCacheStatusUpdated registers fn to be called for each
+// ApplicationCacheStatusUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnApplicationCacheStatusUpdated(fn func(*StatusUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("ApplicationCache.applicationCacheStatusUpdated", d.conn, func(args []byte) error {
+		ev := new(StatusUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type statusUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -91,14 +107,29 @@ func (c *statusUpdatedClient) Recv() (*StatusUpdatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) NetworkStateUpdated(ctx context.Context) (NetworkStateUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "ApplicationCache.networkStateUpdated", d.conn)
+func (d *domainClient) NetworkStateUpdated(ctx context.Context, opts ...rpcc.StreamOption) (NetworkStateUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "ApplicationCache.networkStateUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &networkStateUpdatedClient{Stream: s}, nil
 }
 
+// OnNetworkStateUpdated registers fn to be called for each
+// NetworkStateUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNetworkStateUpdated(fn func(*NetworkStateUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("ApplicationCache.networkStateUpdated", d.conn, func(args []byte) error {
+		ev := new(NetworkStateUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type networkStateUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,7 @@
 package applicationcache
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -22,6 +23,24 @@ type StatusUpdatedReply struct {
 	Status      int          `json:"status"`      // Updated application cache status.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StatusUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = page.FrameID(v)
+		case "manifestURL":
+			r.ManifestURL, err = fastjson.String(value)
+		case "status":
+			r.Status, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // NetworkStateUpdatedClient is a client for NetworkStateUpdated events.
 type NetworkStateUpdatedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -34,3 +53,15 @@ type NetworkStateUpdatedClient interface {
 type NetworkStateUpdatedReply struct {
 	IsNowOnline bool `json:"isNowOnline"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NetworkStateUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "isNowOnline":
+			r.IsNowOnline, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
@@ -3,6 +3,7 @@
 package audits
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/network"
 )
 
@@ -25,6 +26,33 @@ func NewGetEncodedResponseArgs(requestID network.RequestID, encoding string) *Ge
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetEncodedResponseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = fastjson.AppendKey(b, "encoding")
+	b = fastjson.AppendString(b, a.Encoding)
+	if a.Quality != nil {
+		b = fastjson.AppendKey(b, "quality")
+		if b, err = fastjson.AppendFloat(b, *a.Quality); err != nil {
+			return nil, err
+		}
+	}
+	if a.SizeOnly != nil {
+		b = fastjson.AppendKey(b, "sizeOnly")
+		b = fastjson.AppendBool(b, *a.SizeOnly)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetQuality sets the Quality optional argument. The quality of the
 // encoding (0-1). (defaults to 1)
 func (a *GetEncodedResponseArgs) SetQuality(quality float64) *GetEncodedResponseArgs {
@@ -45,3 +73,25 @@ type GetEncodedResponseReply struct {
 	OriginalSize int     `json:"originalSize"`   // Size before re-encoding.
 	EncodedSize  int     `json:"encodedSize"`    // Size after re-encoding.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetEncodedResponseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "body":
+			if fastjson.IsNull(value) {
+				r.Body = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Body = &v
+		case "originalSize":
+			r.OriginalSize, err = fastjson.Int(value)
+		case "encodedSize":
+			r.EncodedSize, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
@@ -6,6 +6,7 @@ package audits
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -56,14 +57,29 @@ func (d *domainClient) Enable(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) IssueAdded(ctx context.Context) (IssueAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Audits.issueAdded", d.conn)
+func (d *domainClient) IssueAdded(ctx context.Context, opts ...rpcc.StreamOption) (IssueAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Audits.issueAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &issueAddedClient{Stream: s}, nil
 }
 
+// OnIssueAdded registers fn to be called for each
+// IssueAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnIssueAdded(fn func(*IssueAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Audits.issueAdded", d.conn, func(args []byte) error {
+		ev := new(IssueAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type issueAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
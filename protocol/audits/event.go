@@ -3,6 +3,9 @@
 package audits
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -18,3 +21,15 @@ type IssueAddedClient interface {
 type IssueAddedReply struct {
 	Issue InspectorIssue `json:"issue"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *IssueAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "issue":
+			err = json.Unmarshal(value, &r.Issue)
+		}
+		return err
+	})
+}
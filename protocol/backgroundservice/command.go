@@ -2,6 +2,10 @@
 
 package backgroundservice
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // StartObservingArgs represents the arguments for StartObserving in the BackgroundService domain.
 type StartObservingArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -14,6 +18,21 @@ func NewStartObservingArgs(service ServiceName) *StartObservingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartObservingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "service")
+	b = fastjson.AppendString(b, string(a.Service))
+	b = append(b, '}')
+	return b, err
+}
+
 // StopObservingArgs represents the arguments for StopObserving in the BackgroundService domain.
 type StopObservingArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -26,6 +45,21 @@ func NewStopObservingArgs(service ServiceName) *StopObservingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StopObservingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "service")
+	b = fastjson.AppendString(b, string(a.Service))
+	b = append(b, '}')
+	return b, err
+}
+
 // SetRecordingArgs represents the arguments for SetRecording in the BackgroundService domain.
 type SetRecordingArgs struct {
 	ShouldRecord bool        `json:"shouldRecord"` // No description.
@@ -40,6 +74,23 @@ func NewSetRecordingArgs(shouldRecord bool, service ServiceName) *SetRecordingAr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetRecordingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "shouldRecord")
+	b = fastjson.AppendBool(b, a.ShouldRecord)
+	b = fastjson.AppendKey(b, "service")
+	b = fastjson.AppendString(b, string(a.Service))
+	b = append(b, '}')
+	return b, err
+}
+
 // ClearEventsArgs represents the arguments for ClearEvents in the BackgroundService domain.
 type ClearEventsArgs struct {
 	Service ServiceName `json:"service"` // No description.
@@ -51,3 +102,18 @@ func NewClearEventsArgs(service ServiceName) *ClearEventsArgs {
 	args.Service = service
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearEventsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "service")
+	b = fastjson.AppendString(b, string(a.Service))
+	b = append(b, '}')
+	return b, err
+}
@@ -6,6 +6,7 @@ package backgroundservice
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -76,14 +77,29 @@ func (d *domainClient) ClearEvents(ctx context.Context, args *ClearEventsArgs) (
 	return
 }
 
-func (d *domainClient) RecordingStateChanged(ctx context.Context) (RecordingStateChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "BackgroundService.recordingStateChanged", d.conn)
+func (d *domainClient) RecordingStateChanged(ctx context.Context, opts ...rpcc.StreamOption) (RecordingStateChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "BackgroundService.recordingStateChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &recordingStateChangedClient{Stream: s}, nil
 }
 
+// OnRecordingStateChanged registers fn to be called for each
+// RecordingStateChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRecordingStateChanged(fn func(*RecordingStateChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("BackgroundService.recordingStateChanged", d.conn, func(args []byte) error {
+		ev := new(RecordingStateChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type recordingStateChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -97,14 +113,29 @@ func (c *recordingStateChangedClient) Recv() (*RecordingStateChangedReply, error
 	return event, nil
 }
 
-func (d *domainClient) BackgroundServiceEventReceived(ctx context.Context) (EventReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "BackgroundService.backgroundServiceEventReceived", d.conn)
+func (d *domainClient) BackgroundServiceEventReceived(ctx context.Context, opts ...rpcc.StreamOption) (EventReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "BackgroundService.backgroundServiceEventReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &eventReceivedClient{Stream: s}, nil
 }
 
+// OnBackgroundServiceEventReceived registers fn to be called for each
+// BackgroundServiceEventReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnBackgroundServiceEventReceived(fn func(*EventReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("BackgroundService.backgroundServiceEventReceived", d.conn, func(args []byte) error {
+		ev := new(EventReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type eventReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
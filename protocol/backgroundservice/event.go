@@ -3,6 +3,9 @@
 package backgroundservice
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,6 +24,22 @@ type RecordingStateChangedReply struct {
 	Service     ServiceName `json:"service"`     // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RecordingStateChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "isRecording":
+			r.IsRecording, err = fastjson.Bool(value)
+		case "service":
+			var v string
+			v, err = fastjson.String(value)
+			r.Service = ServiceName(v)
+		}
+		return err
+	})
+}
+
 // EventReceivedClient is a client for BackgroundServiceEventReceived events.
 // Called with all existing backgroundServiceEvents when enabled, and all new
 // events afterwards if enabled and recording.
@@ -35,3 +54,15 @@ type EventReceivedClient interface {
 type EventReceivedReply struct {
 	BackgroundServiceEvent Event `json:"backgroundServiceEvent"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EventReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "backgroundServiceEvent":
+			err = json.Unmarshal(value, &r.BackgroundServiceEvent)
+		}
+		return err
+	})
+}
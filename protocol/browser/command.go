@@ -3,6 +3,9 @@
 package browser
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/target"
 )
 
@@ -22,6 +25,33 @@ func NewSetPermissionArgs(permission PermissionDescriptor, setting PermissionSet
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPermissionArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "permission")
+	if b, err = fastjson.AppendValue(b, a.Permission); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "setting")
+	b = fastjson.AppendString(b, string(a.Setting))
+	if a.Origin != nil {
+		b = fastjson.AppendKey(b, "origin")
+		b = fastjson.AppendString(b, *a.Origin)
+	}
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOrigin sets the Origin optional argument. Origin the permission
 // applies to, all origins if not specified.
 func (a *SetPermissionArgs) SetOrigin(origin string) *SetPermissionArgs {
@@ -50,6 +80,31 @@ func NewGrantPermissionsArgs(permissions []PermissionType) *GrantPermissionsArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GrantPermissionsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "permissions")
+	if b, err = fastjson.AppendValue(b, a.Permissions); err != nil {
+		return nil, err
+	}
+	if a.Origin != nil {
+		b = fastjson.AppendKey(b, "origin")
+		b = fastjson.AppendString(b, *a.Origin)
+	}
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOrigin sets the Origin optional argument. Origin the permission
 // applies to, all origins if not specified.
 func (a *GrantPermissionsArgs) SetOrigin(origin string) *GrantPermissionsArgs {
@@ -77,6 +132,23 @@ func NewResetPermissionsArgs() *ResetPermissionsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ResetPermissionsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBrowserContextID sets the BrowserContextID optional argument.
 // BrowserContext to reset permissions. When omitted, default browser
 // context is used.
@@ -105,6 +177,29 @@ func NewSetDownloadBehaviorArgs(behavior string) *SetDownloadBehaviorArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDownloadBehaviorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "behavior")
+	b = fastjson.AppendString(b, a.Behavior)
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	if a.DownloadPath != nil {
+		b = fastjson.AppendKey(b, "downloadPath")
+		b = fastjson.AppendString(b, *a.DownloadPath)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBrowserContextID sets the BrowserContextID optional argument.
 // BrowserContext to set download behavior. When omitted, default
 // browser context is used.
@@ -130,11 +225,43 @@ type GetVersionReply struct {
 	JsVersion       string `json:"jsVersion"`       // V8 version.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetVersionReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "protocolVersion":
+			r.ProtocolVersion, err = fastjson.String(value)
+		case "product":
+			r.Product, err = fastjson.String(value)
+		case "revision":
+			r.Revision, err = fastjson.String(value)
+		case "userAgent":
+			r.UserAgent, err = fastjson.String(value)
+		case "jsVersion":
+			r.JsVersion, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetBrowserCommandLineReply represents the return values for GetBrowserCommandLine in the Browser domain.
 type GetBrowserCommandLineReply struct {
 	Arguments []string `json:"arguments"` // Commandline parameters
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBrowserCommandLineReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "arguments":
+			err = json.Unmarshal(value, &r.Arguments)
+		}
+		return err
+	})
+}
+
 // GetHistogramsArgs represents the arguments for GetHistograms in the Browser domain.
 type GetHistogramsArgs struct {
 	Query *string `json:"query,omitempty"` // Requested substring in name. Only histograms which have query as a substring in their name are extracted. An empty or absent query returns all histograms.
@@ -148,6 +275,27 @@ func NewGetHistogramsArgs() *GetHistogramsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetHistogramsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Query != nil {
+		b = fastjson.AppendKey(b, "query")
+		b = fastjson.AppendString(b, *a.Query)
+	}
+	if a.Delta != nil {
+		b = fastjson.AppendKey(b, "delta")
+		b = fastjson.AppendBool(b, *a.Delta)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetQuery sets the Query optional argument. Requested substring in
 // name. Only histograms which have query as a substring in their name
 // are extracted. An empty or absent query returns all histograms.
@@ -168,6 +316,18 @@ type GetHistogramsReply struct {
 	Histograms []Histogram `json:"histograms"` // Histograms.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetHistogramsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "histograms":
+			err = json.Unmarshal(value, &r.Histograms)
+		}
+		return err
+	})
+}
+
 // GetHistogramArgs represents the arguments for GetHistogram in the Browser domain.
 type GetHistogramArgs struct {
 	Name  string `json:"name"`            // Requested histogram name.
@@ -181,6 +341,25 @@ func NewGetHistogramArgs(name string) *GetHistogramArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetHistogramArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	if a.Delta != nil {
+		b = fastjson.AppendKey(b, "delta")
+		b = fastjson.AppendBool(b, *a.Delta)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDelta sets the Delta optional argument. If true, retrieve delta
 // since last call.
 func (a *GetHistogramArgs) SetDelta(delta bool) *GetHistogramArgs {
@@ -193,6 +372,18 @@ type GetHistogramReply struct {
 	Histogram Histogram `json:"histogram"` // Histogram.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetHistogramReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "histogram":
+			err = json.Unmarshal(value, &r.Histogram)
+		}
+		return err
+	})
+}
+
 // GetWindowBoundsArgs represents the arguments for GetWindowBounds in the Browser domain.
 type GetWindowBoundsArgs struct {
 	WindowID WindowID `json:"windowId"` // Browser window id.
@@ -205,11 +396,38 @@ func NewGetWindowBoundsArgs(windowID WindowID) *GetWindowBoundsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetWindowBoundsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "windowId")
+	b = fastjson.AppendInt(b, int(a.WindowID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetWindowBoundsReply represents the return values for GetWindowBounds in the Browser domain.
 type GetWindowBoundsReply struct {
 	Bounds Bounds `json:"bounds"` // Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetWindowBoundsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "bounds":
+			err = json.Unmarshal(value, &r.Bounds)
+		}
+		return err
+	})
+}
+
 // GetWindowForTargetArgs represents the arguments for GetWindowForTarget in the Browser domain.
 type GetWindowForTargetArgs struct {
 	TargetID *target.ID `json:"targetId,omitempty"` // Devtools agent host id. If called as a part of the session, associated targetId is used.
@@ -222,6 +440,23 @@ func NewGetWindowForTargetArgs() *GetWindowForTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetWindowForTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.TargetID != nil {
+		b = fastjson.AppendKey(b, "targetId")
+		b = fastjson.AppendString(b, string(*a.TargetID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTargetID sets the TargetID optional argument. Devtools agent
 // host id. If called as a part of the session, associated targetId is
 // used.
@@ -236,6 +471,22 @@ type GetWindowForTargetReply struct {
 	Bounds   Bounds   `json:"bounds"`   // Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetWindowForTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "windowId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.WindowID = WindowID(v)
+		case "bounds":
+			err = json.Unmarshal(value, &r.Bounds)
+		}
+		return err
+	})
+}
+
 // SetWindowBoundsArgs represents the arguments for SetWindowBounds in the Browser domain.
 type SetWindowBoundsArgs struct {
 	WindowID WindowID `json:"windowId"` // Browser window id.
@@ -250,6 +501,25 @@ func NewSetWindowBoundsArgs(windowID WindowID, bounds Bounds) *SetWindowBoundsAr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetWindowBoundsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "windowId")
+	b = fastjson.AppendInt(b, int(a.WindowID))
+	b = fastjson.AppendKey(b, "bounds")
+	if b, err = fastjson.AppendValue(b, a.Bounds); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDockTileArgs represents the arguments for SetDockTile in the Browser domain.
 type SetDockTileArgs struct {
 	BadgeLabel *string `json:"badgeLabel,omitempty"` // No description.
@@ -263,6 +533,27 @@ func NewSetDockTileArgs() *SetDockTileArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDockTileArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.BadgeLabel != nil {
+		b = fastjson.AppendKey(b, "badgeLabel")
+		b = fastjson.AppendString(b, *a.BadgeLabel)
+	}
+	if a.Image != nil {
+		b = fastjson.AppendKey(b, "image")
+		b = fastjson.AppendString(b, *a.Image)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBadgeLabel sets the BadgeLabel optional argument.
 func (a *SetDockTileArgs) SetBadgeLabel(badgeLabel string) *SetDockTileArgs {
 	a.BadgeLabel = &badgeLabel
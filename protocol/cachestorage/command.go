@@ -2,6 +2,12 @@
 
 package cachestorage
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // DeleteCacheArgs represents the arguments for DeleteCache in the CacheStorage domain.
 type DeleteCacheArgs struct {
 	CacheID CacheID `json:"cacheId"` // Id of cache for deletion.
@@ -14,6 +20,21 @@ func NewDeleteCacheArgs(cacheID CacheID) *DeleteCacheArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeleteCacheArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cacheId")
+	b = fastjson.AppendString(b, string(a.CacheID))
+	b = append(b, '}')
+	return b, err
+}
+
 // DeleteEntryArgs represents the arguments for DeleteEntry in the CacheStorage domain.
 type DeleteEntryArgs struct {
 	CacheID CacheID `json:"cacheId"` // Id of cache where the entry will be deleted.
@@ -28,6 +49,23 @@ func NewDeleteEntryArgs(cacheID CacheID, request string) *DeleteEntryArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeleteEntryArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cacheId")
+	b = fastjson.AppendString(b, string(a.CacheID))
+	b = fastjson.AppendKey(b, "request")
+	b = fastjson.AppendString(b, a.Request)
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestCacheNamesArgs represents the arguments for RequestCacheNames in the CacheStorage domain.
 type RequestCacheNamesArgs struct {
 	SecurityOrigin string `json:"securityOrigin"` // Security origin.
@@ -40,11 +78,38 @@ func NewRequestCacheNamesArgs(securityOrigin string) *RequestCacheNamesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestCacheNamesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestCacheNamesReply represents the return values for RequestCacheNames in the CacheStorage domain.
 type RequestCacheNamesReply struct {
 	Caches []Cache `json:"caches"` // Caches for the security origin.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestCacheNamesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "caches":
+			err = json.Unmarshal(value, &r.Caches)
+		}
+		return err
+	})
+}
+
 // RequestCachedResponseArgs represents the arguments for RequestCachedResponse in the CacheStorage domain.
 type RequestCachedResponseArgs struct {
 	CacheID        CacheID  `json:"cacheId"`        // Id of cache that contains the entry.
@@ -61,11 +126,44 @@ func NewRequestCachedResponseArgs(cacheID CacheID, requestURL string, requestHea
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestCachedResponseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cacheId")
+	b = fastjson.AppendString(b, string(a.CacheID))
+	b = fastjson.AppendKey(b, "requestURL")
+	b = fastjson.AppendString(b, a.RequestURL)
+	b = fastjson.AppendKey(b, "requestHeaders")
+	if b, err = fastjson.AppendValue(b, a.RequestHeaders); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestCachedResponseReply represents the return values for RequestCachedResponse in the CacheStorage domain.
 type RequestCachedResponseReply struct {
 	Response CachedResponse `json:"response"` // Response read from the cache.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestCachedResponseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "response":
+			err = json.Unmarshal(value, &r.Response)
+		}
+		return err
+	})
+}
+
 // RequestEntriesArgs represents the arguments for RequestEntries in the CacheStorage domain.
 type RequestEntriesArgs struct {
 	CacheID    CacheID `json:"cacheId"`              // ID of cache to get entries from.
@@ -81,6 +179,33 @@ func NewRequestEntriesArgs(cacheID CacheID) *RequestEntriesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestEntriesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cacheId")
+	b = fastjson.AppendString(b, string(a.CacheID))
+	if a.SkipCount != nil {
+		b = fastjson.AppendKey(b, "skipCount")
+		b = fastjson.AppendInt(b, *a.SkipCount)
+	}
+	if a.PageSize != nil {
+		b = fastjson.AppendKey(b, "pageSize")
+		b = fastjson.AppendInt(b, *a.PageSize)
+	}
+	if a.PathFilter != nil {
+		b = fastjson.AppendKey(b, "pathFilter")
+		b = fastjson.AppendString(b, *a.PathFilter)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetSkipCount sets the SkipCount optional argument. Number of
 // records to skip.
 func (a *RequestEntriesArgs) SetSkipCount(skipCount int) *RequestEntriesArgs {
@@ -107,3 +232,17 @@ type RequestEntriesReply struct {
 	CacheDataEntries []DataEntry `json:"cacheDataEntries"` // Array of object store data entries.
 	ReturnCount      float64     `json:"returnCount"`      // Count of returned entries from this storage. If pathFilter is empty, it is the count of all entries from this storage.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestEntriesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "cacheDataEntries":
+			err = json.Unmarshal(value, &r.CacheDataEntries)
+		case "returnCount":
+			r.ReturnCount, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
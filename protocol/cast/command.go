@@ -2,6 +2,10 @@
 
 package cast
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // EnableArgs represents the arguments for Enable in the Cast domain.
 type EnableArgs struct {
 	PresentationURL *string `json:"presentationUrl,omitempty"` // No description.
@@ -14,6 +18,23 @@ func NewEnableArgs() *EnableArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EnableArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.PresentationURL != nil {
+		b = fastjson.AppendKey(b, "presentationUrl")
+		b = fastjson.AppendString(b, *a.PresentationURL)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPresentationURL sets the PresentationURL optional argument.
 func (a *EnableArgs) SetPresentationURL(presentationURL string) *EnableArgs {
 	a.PresentationURL = &presentationURL
@@ -32,6 +53,21 @@ func NewSetSinkToUseArgs(sinkName string) *SetSinkToUseArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetSinkToUseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "sinkName")
+	b = fastjson.AppendString(b, a.SinkName)
+	b = append(b, '}')
+	return b, err
+}
+
 // StartTabMirroringArgs represents the arguments for StartTabMirroring in the Cast domain.
 type StartTabMirroringArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -44,6 +80,21 @@ func NewStartTabMirroringArgs(sinkName string) *StartTabMirroringArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartTabMirroringArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "sinkName")
+	b = fastjson.AppendString(b, a.SinkName)
+	b = append(b, '}')
+	return b, err
+}
+
 // StopCastingArgs represents the arguments for StopCasting in the Cast domain.
 type StopCastingArgs struct {
 	SinkName string `json:"sinkName"` // No description.
@@ -55,3 +106,18 @@ func NewStopCastingArgs(sinkName string) *StopCastingArgs {
 	args.SinkName = sinkName
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StopCastingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "sinkName")
+	b = fastjson.AppendString(b, a.SinkName)
+	b = append(b, '}')
+	return b, err
+}
@@ -6,6 +6,7 @@ package cast
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -89,14 +90,29 @@ func (d *domainClient) StopCasting(ctx context.Context, args *StopCastingArgs) (
 	return
 }
 
-func (d *domainClient) SinksUpdated(ctx context.Context) (SinksUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Cast.sinksUpdated", d.conn)
+func (d *domainClient) SinksUpdated(ctx context.Context, opts ...rpcc.StreamOption) (SinksUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Cast.sinksUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &sinksUpdatedClient{Stream: s}, nil
 }
 
+// OnSinksUpdated registers fn to be called for each
+// SinksUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnSinksUpdated(fn func(*SinksUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Cast.sinksUpdated", d.conn, func(args []byte) error {
+		ev := new(SinksUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type sinksUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -110,14 +126,29 @@ func (c *sinksUpdatedClient) Recv() (*SinksUpdatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) IssueUpdated(ctx context.Context) (IssueUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Cast.issueUpdated", d.conn)
+func (d *domainClient) IssueUpdated(ctx context.Context, opts ...rpcc.StreamOption) (IssueUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Cast.issueUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &issueUpdatedClient{Stream: s}, nil
 }
 
+// OnIssueUpdated registers fn to be called for each
+// IssueUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnIssueUpdated(fn func(*IssueUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Cast.issueUpdated", d.conn, func(args []byte) error {
+		ev := new(IssueUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type issueUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package cast
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,6 +24,18 @@ type SinksUpdatedReply struct {
 	Sinks []Sink `json:"sinks"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SinksUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sinks":
+			err = json.Unmarshal(value, &r.Sinks)
+		}
+		return err
+	})
+}
+
 // IssueUpdatedClient is a client for IssueUpdated events. This is fired
 // whenever the outstanding issue/error message changes. |issueMessage| is
 // empty if there is no issue.
@@ -35,3 +50,15 @@ type IssueUpdatedClient interface {
 type IssueUpdatedReply struct {
 	IssueMessage string `json:"issueMessage"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *IssueUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "issueMessage":
+			r.IssueMessage, err = fastjson.String(value)
+		}
+		return err
+	})
+}
@@ -6,6 +6,7 @@ package console
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -50,14 +51,29 @@ func (d *domainClient) Enable(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) MessageAdded(ctx context.Context) (MessageAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Console.messageAdded", d.conn)
+func (d *domainClient) MessageAdded(ctx context.Context, opts ...rpcc.StreamOption) (MessageAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Console.messageAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &messageAddedClient{Stream: s}, nil
 }
 
+// OnMessageAdded registers fn to be called for each
+// MessageAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnMessageAdded(fn func(*MessageAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Console.messageAdded", d.conn, func(args []byte) error {
+		ev := new(MessageAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type messageAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
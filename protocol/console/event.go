@@ -3,6 +3,9 @@
 package console
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -19,3 +22,15 @@ type MessageAddedClient interface {
 type MessageAddedReply struct {
 	Message Message `json:"message"` // Console message that has been added.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *MessageAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "message":
+			err = json.Unmarshal(value, &r.Message)
+		}
+		return err
+	})
+}
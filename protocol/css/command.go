@@ -3,6 +3,9 @@
 package css
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
 )
@@ -23,11 +26,44 @@ func NewAddRuleArgs(styleSheetID StyleSheetID, ruleText string, location SourceR
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddRuleArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = fastjson.AppendKey(b, "ruleText")
+	b = fastjson.AppendString(b, a.RuleText)
+	b = fastjson.AppendKey(b, "location")
+	if b, err = fastjson.AppendValue(b, a.Location); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // AddRuleReply represents the return values for AddRule in the CSS domain.
 type AddRuleReply struct {
 	Rule Rule `json:"rule"` // The newly created rule.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddRuleReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "rule":
+			err = json.Unmarshal(value, &r.Rule)
+		}
+		return err
+	})
+}
+
 // CollectClassNamesArgs represents the arguments for CollectClassNames in the CSS domain.
 type CollectClassNamesArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -40,11 +76,38 @@ func NewCollectClassNamesArgs(styleSheetID StyleSheetID) *CollectClassNamesArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CollectClassNamesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = append(b, '}')
+	return b, err
+}
+
 // CollectClassNamesReply represents the return values for CollectClassNames in the CSS domain.
 type CollectClassNamesReply struct {
 	ClassNames []string `json:"classNames"` // Class name list.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CollectClassNamesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "classNames":
+			err = json.Unmarshal(value, &r.ClassNames)
+		}
+		return err
+	})
+}
+
 // CreateStyleSheetArgs represents the arguments for CreateStyleSheet in the CSS domain.
 type CreateStyleSheetArgs struct {
 	FrameID page.FrameID `json:"frameId"` // Identifier of the frame where "via-inspector" stylesheet should be created.
@@ -57,11 +120,40 @@ func NewCreateStyleSheetArgs(frameID page.FrameID) *CreateStyleSheetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CreateStyleSheetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // CreateStyleSheetReply represents the return values for CreateStyleSheet in the CSS domain.
 type CreateStyleSheetReply struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // Identifier of the created "via-inspector" stylesheet.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreateStyleSheetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "styleSheetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.StyleSheetID = StyleSheetID(v)
+		}
+		return err
+	})
+}
+
 // ForcePseudoStateArgs represents the arguments for ForcePseudoState in the CSS domain.
 type ForcePseudoStateArgs struct {
 	NodeID              dom.NodeID `json:"nodeId"`              // The element id for which to force the pseudo state.
@@ -76,6 +168,25 @@ func NewForcePseudoStateArgs(nodeID dom.NodeID, forcedPseudoClasses []string) *F
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ForcePseudoStateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "forcedPseudoClasses")
+	if b, err = fastjson.AppendValue(b, a.ForcedPseudoClasses); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetBackgroundColorsArgs represents the arguments for GetBackgroundColors in the CSS domain.
 type GetBackgroundColorsArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // Id of the node to get background colors for.
@@ -88,6 +199,21 @@ func NewGetBackgroundColorsArgs(nodeID dom.NodeID) *GetBackgroundColorsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetBackgroundColorsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetBackgroundColorsReply represents the return values for GetBackgroundColors in the CSS domain.
 type GetBackgroundColorsReply struct {
 	BackgroundColors   []string `json:"backgroundColors,omitempty"`   // The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load).
@@ -95,6 +221,34 @@ type GetBackgroundColorsReply struct {
 	ComputedFontWeight *string  `json:"computedFontWeight,omitempty"` // The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or '100').
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBackgroundColorsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "backgroundColors":
+			err = json.Unmarshal(value, &r.BackgroundColors)
+		case "computedFontSize":
+			if fastjson.IsNull(value) {
+				r.ComputedFontSize = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.ComputedFontSize = &v
+		case "computedFontWeight":
+			if fastjson.IsNull(value) {
+				r.ComputedFontWeight = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.ComputedFontWeight = &v
+		}
+		return err
+	})
+}
+
 // GetComputedStyleForNodeArgs represents the arguments for GetComputedStyleForNode in the CSS domain.
 type GetComputedStyleForNodeArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // No description.
@@ -107,11 +261,38 @@ func NewGetComputedStyleForNodeArgs(nodeID dom.NodeID) *GetComputedStyleForNodeA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetComputedStyleForNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetComputedStyleForNodeReply represents the return values for GetComputedStyleForNode in the CSS domain.
 type GetComputedStyleForNodeReply struct {
 	ComputedStyle []ComputedStyleProperty `json:"computedStyle"` // Computed style for the specified DOM node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetComputedStyleForNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "computedStyle":
+			err = json.Unmarshal(value, &r.ComputedStyle)
+		}
+		return err
+	})
+}
+
 // GetInlineStylesForNodeArgs represents the arguments for GetInlineStylesForNode in the CSS domain.
 type GetInlineStylesForNodeArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // No description.
@@ -124,12 +305,41 @@ func NewGetInlineStylesForNodeArgs(nodeID dom.NodeID) *GetInlineStylesForNodeArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetInlineStylesForNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetInlineStylesForNodeReply represents the return values for GetInlineStylesForNode in the CSS domain.
 type GetInlineStylesForNodeReply struct {
 	InlineStyle     *Style `json:"inlineStyle,omitempty"`     // Inline style for the specified DOM node.
 	AttributesStyle *Style `json:"attributesStyle,omitempty"` // Attribute-defined element style (e.g. resulting from "width=20 height=100%").
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetInlineStylesForNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "inlineStyle":
+			err = json.Unmarshal(value, &r.InlineStyle)
+		case "attributesStyle":
+			err = json.Unmarshal(value, &r.AttributesStyle)
+		}
+		return err
+	})
+}
+
 // GetMatchedStylesForNodeArgs represents the arguments for GetMatchedStylesForNode in the CSS domain.
 type GetMatchedStylesForNodeArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // No description.
@@ -142,6 +352,21 @@ func NewGetMatchedStylesForNodeArgs(nodeID dom.NodeID) *GetMatchedStylesForNodeA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetMatchedStylesForNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetMatchedStylesForNodeReply represents the return values for GetMatchedStylesForNode in the CSS domain.
 type GetMatchedStylesForNodeReply struct {
 	InlineStyle       *Style                 `json:"inlineStyle,omitempty"`       // Inline style for the specified DOM node.
@@ -152,11 +377,45 @@ type GetMatchedStylesForNodeReply struct {
 	CSSKeyframesRules []KeyframesRule        `json:"cssKeyframesRules,omitempty"` // A list of CSS keyframed animations matching this node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetMatchedStylesForNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "inlineStyle":
+			err = json.Unmarshal(value, &r.InlineStyle)
+		case "attributesStyle":
+			err = json.Unmarshal(value, &r.AttributesStyle)
+		case "matchedCSSRules":
+			err = json.Unmarshal(value, &r.MatchedCSSRules)
+		case "pseudoElements":
+			err = json.Unmarshal(value, &r.PseudoElements)
+		case "inherited":
+			err = json.Unmarshal(value, &r.Inherited)
+		case "cssKeyframesRules":
+			err = json.Unmarshal(value, &r.CSSKeyframesRules)
+		}
+		return err
+	})
+}
+
 // GetMediaQueriesReply represents the return values for GetMediaQueries in the CSS domain.
 type GetMediaQueriesReply struct {
 	Medias []Media `json:"medias"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetMediaQueriesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "medias":
+			err = json.Unmarshal(value, &r.Medias)
+		}
+		return err
+	})
+}
+
 // GetPlatformFontsForNodeArgs represents the arguments for GetPlatformFontsForNode in the CSS domain.
 type GetPlatformFontsForNodeArgs struct {
 	NodeID dom.NodeID `json:"nodeId"` // No description.
@@ -169,11 +428,38 @@ func NewGetPlatformFontsForNodeArgs(nodeID dom.NodeID) *GetPlatformFontsForNodeA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetPlatformFontsForNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetPlatformFontsForNodeReply represents the return values for GetPlatformFontsForNode in the CSS domain.
 type GetPlatformFontsForNodeReply struct {
 	Fonts []PlatformFontUsage `json:"fonts"` // Usage statistics for every employed platform font.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetPlatformFontsForNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "fonts":
+			err = json.Unmarshal(value, &r.Fonts)
+		}
+		return err
+	})
+}
+
 // GetStyleSheetTextArgs represents the arguments for GetStyleSheetText in the CSS domain.
 type GetStyleSheetTextArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -186,11 +472,38 @@ func NewGetStyleSheetTextArgs(styleSheetID StyleSheetID) *GetStyleSheetTextArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetStyleSheetTextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetStyleSheetTextReply represents the return values for GetStyleSheetText in the CSS domain.
 type GetStyleSheetTextReply struct {
 	Text string `json:"text"` // The stylesheet text.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetStyleSheetTextReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "text":
+			r.Text, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // SetEffectivePropertyValueForNodeArgs represents the arguments for SetEffectivePropertyValueForNode in the CSS domain.
 type SetEffectivePropertyValueForNodeArgs struct {
 	NodeID       dom.NodeID `json:"nodeId"`       // The element id for which to set property.
@@ -207,6 +520,25 @@ func NewSetEffectivePropertyValueForNodeArgs(nodeID dom.NodeID, propertyName str
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetEffectivePropertyValueForNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "propertyName")
+	b = fastjson.AppendString(b, a.PropertyName)
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendString(b, a.Value)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetKeyframeKeyArgs represents the arguments for SetKeyframeKey in the CSS domain.
 type SetKeyframeKeyArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -223,11 +555,44 @@ func NewSetKeyframeKeyArgs(styleSheetID StyleSheetID, rang SourceRange, keyText
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetKeyframeKeyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = fastjson.AppendKey(b, "range")
+	if b, err = fastjson.AppendValue(b, a.Range); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "keyText")
+	b = fastjson.AppendString(b, a.KeyText)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetKeyframeKeyReply represents the return values for SetKeyframeKey in the CSS domain.
 type SetKeyframeKeyReply struct {
 	KeyText Value `json:"keyText"` // The resulting key text after modification.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetKeyframeKeyReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "keyText":
+			err = json.Unmarshal(value, &r.KeyText)
+		}
+		return err
+	})
+}
+
 // SetMediaTextArgs represents the arguments for SetMediaText in the CSS domain.
 type SetMediaTextArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -244,11 +609,44 @@ func NewSetMediaTextArgs(styleSheetID StyleSheetID, rang SourceRange, text strin
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetMediaTextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = fastjson.AppendKey(b, "range")
+	if b, err = fastjson.AppendValue(b, a.Range); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "text")
+	b = fastjson.AppendString(b, a.Text)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMediaTextReply represents the return values for SetMediaText in the CSS domain.
 type SetMediaTextReply struct {
 	Media Media `json:"media"` // The resulting CSS media rule after modification.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetMediaTextReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "media":
+			err = json.Unmarshal(value, &r.Media)
+		}
+		return err
+	})
+}
+
 // SetRuleSelectorArgs represents the arguments for SetRuleSelector in the CSS domain.
 type SetRuleSelectorArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -265,11 +663,44 @@ func NewSetRuleSelectorArgs(styleSheetID StyleSheetID, rang SourceRange, selecto
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetRuleSelectorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = fastjson.AppendKey(b, "range")
+	if b, err = fastjson.AppendValue(b, a.Range); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "selector")
+	b = fastjson.AppendString(b, a.Selector)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetRuleSelectorReply represents the return values for SetRuleSelector in the CSS domain.
 type SetRuleSelectorReply struct {
 	SelectorList SelectorList `json:"selectorList"` // The resulting selector list after modification.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetRuleSelectorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "selectorList":
+			err = json.Unmarshal(value, &r.SelectorList)
+		}
+		return err
+	})
+}
+
 // SetStyleSheetTextArgs represents the arguments for SetStyleSheetText in the CSS domain.
 type SetStyleSheetTextArgs struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
@@ -284,11 +715,46 @@ func NewSetStyleSheetTextArgs(styleSheetID StyleSheetID, text string) *SetStyleS
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetStyleSheetTextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "styleSheetId")
+	b = fastjson.AppendString(b, string(a.StyleSheetID))
+	b = fastjson.AppendKey(b, "text")
+	b = fastjson.AppendString(b, a.Text)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetStyleSheetTextReply represents the return values for SetStyleSheetText in the CSS domain.
 type SetStyleSheetTextReply struct {
 	SourceMapURL *string `json:"sourceMapURL,omitempty"` // URL of source map associated with script (if any).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetStyleSheetTextReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sourceMapURL":
+			if fastjson.IsNull(value) {
+				r.SourceMapURL = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceMapURL = &v
+		}
+		return err
+	})
+}
+
 // SetStyleTextsArgs represents the arguments for SetStyleTexts in the CSS domain.
 type SetStyleTextsArgs struct {
 	Edits []StyleDeclarationEdit `json:"edits"` // No description.
@@ -301,18 +767,73 @@ func NewSetStyleTextsArgs(edits []StyleDeclarationEdit) *SetStyleTextsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetStyleTextsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "edits")
+	if b, err = fastjson.AppendValue(b, a.Edits); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetStyleTextsReply represents the return values for SetStyleTexts in the CSS domain.
 type SetStyleTextsReply struct {
 	Styles []Style `json:"styles"` // The resulting styles after modification.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetStyleTextsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "styles":
+			err = json.Unmarshal(value, &r.Styles)
+		}
+		return err
+	})
+}
+
 // StopRuleUsageTrackingReply represents the return values for StopRuleUsageTracking in the CSS domain.
 type StopRuleUsageTrackingReply struct {
 	RuleUsage []RuleUsage `json:"ruleUsage"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StopRuleUsageTrackingReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "ruleUsage":
+			err = json.Unmarshal(value, &r.RuleUsage)
+		}
+		return err
+	})
+}
+
 // TakeCoverageDeltaReply represents the return values for TakeCoverageDelta in the CSS domain.
 type TakeCoverageDeltaReply struct {
 	Coverage  []RuleUsage `json:"coverage"`  // No description.
 	Timestamp float64     `json:"timestamp"` // Monotonically increasing time, in seconds.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *TakeCoverageDeltaReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "coverage":
+			err = json.Unmarshal(value, &r.Coverage)
+		case "timestamp":
+			r.Timestamp, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
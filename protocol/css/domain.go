@@ -13,6 +13,7 @@ package css
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -335,14 +336,29 @@ func (d *domainClient) TakeCoverageDelta(ctx context.Context) (reply *TakeCovera
 	return
 }
 
-func (d *domainClient) FontsUpdated(ctx context.Context) (FontsUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "CSS.fontsUpdated", d.conn)
+func (d *domainClient) FontsUpdated(ctx context.Context, opts ...rpcc.StreamOption) (FontsUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.fontsUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &fontsUpdatedClient{Stream: s}, nil
 }
 
+// OnFontsUpdated registers fn to be called for each
+// FontsUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFontsUpdated(fn func(*FontsUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.fontsUpdated", d.conn, func(args []byte) error {
+		ev := new(FontsUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type fontsUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -356,14 +372,29 @@ func (c *fontsUpdatedClient) Recv() (*FontsUpdatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) MediaQueryResultChanged(ctx context.Context) (MediaQueryResultChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "CSS.mediaQueryResultChanged", d.conn)
+func (d *domainClient) MediaQueryResultChanged(ctx context.Context, opts ...rpcc.StreamOption) (MediaQueryResultChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.mediaQueryResultChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &mediaQueryResultChangedClient{Stream: s}, nil
 }
 
+// OnMediaQueryResultChanged registers fn to be called for each
+// MediaQueryResultChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnMediaQueryResultChanged(fn func(*MediaQueryResultChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.mediaQueryResultChanged", d.conn, func(args []byte) error {
+		ev := new(MediaQueryResultChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type mediaQueryResultChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -377,14 +408,29 @@ func (c *mediaQueryResultChangedClient) Recv() (*MediaQueryResultChangedReply, e
 	return event, nil
 }
 
-func (d *domainClient) StyleSheetAdded(ctx context.Context) (StyleSheetAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "CSS.styleSheetAdded", d.conn)
+func (d *domainClient) StyleSheetAdded(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &styleSheetAddedClient{Stream: s}, nil
 }
 
+// OnStyleSheetAdded registers fn to be called for each
+// StyleSheetAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetAdded(fn func(*StyleSheetAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetAdded", d.conn, func(args []byte) error {
+		ev := new(StyleSheetAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type styleSheetAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -398,14 +444,29 @@ func (c *styleSheetAddedClient) Recv() (*StyleSheetAddedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) StyleSheetChanged(ctx context.Context) (StyleSheetChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "CSS.styleSheetChanged", d.conn)
+func (d *domainClient) StyleSheetChanged(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &styleSheetChangedClient{Stream: s}, nil
 }
 
+// OnStyleSheetChanged registers fn to be called for each
+// StyleSheetChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetChanged(fn func(*StyleSheetChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetChanged", d.conn, func(args []byte) error {
+		ev := new(StyleSheetChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type styleSheetChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -419,14 +480,29 @@ func (c *styleSheetChangedClient) Recv() (*StyleSheetChangedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) StyleSheetRemoved(ctx context.Context) (StyleSheetRemovedClient, error) {
-	s, err := rpcc.NewStream(ctx, "CSS.styleSheetRemoved", d.conn)
+func (d *domainClient) StyleSheetRemoved(ctx context.Context, opts ...rpcc.StreamOption) (StyleSheetRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "CSS.styleSheetRemoved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &styleSheetRemovedClient{Stream: s}, nil
 }
 
+// OnStyleSheetRemoved registers fn to be called for each
+// StyleSheetRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnStyleSheetRemoved(fn func(*StyleSheetRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("CSS.styleSheetRemoved", d.conn, func(args []byte) error {
+		ev := new(StyleSheetRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type styleSheetRemovedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
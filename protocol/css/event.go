@@ -3,6 +3,9 @@
 package css
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,6 +24,18 @@ type FontsUpdatedReply struct {
 	Font *FontFace `json:"font,omitempty"` // The web font that has loaded.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FontsUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "font":
+			err = json.Unmarshal(value, &r.Font)
+		}
+		return err
+	})
+}
+
 // MediaQueryResultChangedClient is a client for MediaQueryResultChanged events.
 // Fires whenever a MediaQuery result changes (for example, after a browser
 // window has been resized.) The current implementation considers only
@@ -50,6 +65,18 @@ type StyleSheetAddedReply struct {
 	Header StyleSheetHeader `json:"header"` // Added stylesheet metainfo.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StyleSheetAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "header":
+			err = json.Unmarshal(value, &r.Header)
+		}
+		return err
+	})
+}
+
 // StyleSheetChangedClient is a client for StyleSheetChanged events. Fired
 // whenever a stylesheet is changed as a result of the client operation.
 type StyleSheetChangedClient interface {
@@ -64,6 +91,20 @@ type StyleSheetChangedReply struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StyleSheetChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "styleSheetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.StyleSheetID = StyleSheetID(v)
+		}
+		return err
+	})
+}
+
 // StyleSheetRemovedClient is a client for StyleSheetRemoved events. Fired
 // whenever an active document stylesheet is removed.
 type StyleSheetRemovedClient interface {
@@ -77,3 +118,17 @@ type StyleSheetRemovedClient interface {
 type StyleSheetRemovedReply struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"` // Identifier of the removed stylesheet.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StyleSheetRemovedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "styleSheetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.StyleSheetID = StyleSheetID(v)
+		}
+		return err
+	})
+}
@@ -4,6 +4,8 @@ package database
 
 import (
 	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 )
 
 // ExecuteSQLArgs represents the arguments for ExecuteSQL in the Database domain.
@@ -20,6 +22,23 @@ func NewExecuteSQLArgs(databaseID ID, query string) *ExecuteSQLArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ExecuteSQLArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "databaseId")
+	b = fastjson.AppendString(b, string(a.DatabaseID))
+	b = fastjson.AppendKey(b, "query")
+	b = fastjson.AppendString(b, a.Query)
+	b = append(b, '}')
+	return b, err
+}
+
 // ExecuteSQLReply represents the return values for ExecuteSQL in the Database domain.
 type ExecuteSQLReply struct {
 	ColumnNames []string          `json:"columnNames,omitempty"` // No description.
@@ -27,6 +46,22 @@ type ExecuteSQLReply struct {
 	SQLError    *Error            `json:"sqlError,omitempty"`    // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExecuteSQLReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "columnNames":
+			err = json.Unmarshal(value, &r.ColumnNames)
+		case "values":
+			err = json.Unmarshal(value, &r.Values)
+		case "sqlError":
+			err = json.Unmarshal(value, &r.SQLError)
+		}
+		return err
+	})
+}
+
 // GetDatabaseTableNamesArgs represents the arguments for GetDatabaseTableNames in the Database domain.
 type GetDatabaseTableNamesArgs struct {
 	DatabaseID ID `json:"databaseId"` // No description.
@@ -39,7 +74,34 @@ func NewGetDatabaseTableNamesArgs(databaseID ID) *GetDatabaseTableNamesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetDatabaseTableNamesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "databaseId")
+	b = fastjson.AppendString(b, string(a.DatabaseID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetDatabaseTableNamesReply represents the return values for GetDatabaseTableNames in the Database domain.
 type GetDatabaseTableNamesReply struct {
 	TableNames []string `json:"tableNames"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetDatabaseTableNamesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "tableNames":
+			err = json.Unmarshal(value, &r.TableNames)
+		}
+		return err
+	})
+}
@@ -5,6 +5,7 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -66,14 +67,29 @@ func (d *domainClient) GetDatabaseTableNames(ctx context.Context, args *GetDatab
 	return
 }
 
-func (d *domainClient) AddDatabase(ctx context.Context) (AddDatabaseClient, error) {
-	s, err := rpcc.NewStream(ctx, "Database.addDatabase", d.conn)
+func (d *domainClient) AddDatabase(ctx context.Context, opts ...rpcc.StreamOption) (AddDatabaseClient, error) {
+	s, err := rpcc.NewStream(ctx, "Database.addDatabase", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &addDatabaseClient{Stream: s}, nil
 }
 
+// OnAddDatabase registers fn to be called for each
+// AddDatabase event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAddDatabase(fn func(*AddDatabaseReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Database.addDatabase", d.conn, func(args []byte) error {
+		ev := new(AddDatabaseReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type addDatabaseClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
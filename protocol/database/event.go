@@ -3,6 +3,9 @@
 package database
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -18,3 +21,15 @@ type AddDatabaseClient interface {
 type AddDatabaseReply struct {
 	Database Database `json:"database"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddDatabaseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "database":
+			err = json.Unmarshal(value, &r.Database)
+		}
+		return err
+	})
+}
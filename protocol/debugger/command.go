@@ -3,6 +3,9 @@
 package debugger
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -22,6 +25,27 @@ func NewContinueToLocationArgs(location Location) *ContinueToLocationArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ContinueToLocationArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "location")
+	if b, err = fastjson.AppendValue(b, a.Location); err != nil {
+		return nil, err
+	}
+	if a.TargetCallFrames != nil {
+		b = fastjson.AppendKey(b, "targetCallFrames")
+		b = fastjson.AppendString(b, *a.TargetCallFrames)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTargetCallFrames sets the TargetCallFrames optional argument.
 //
 // Values: "any", "current".
@@ -47,6 +71,25 @@ func NewEnableArgs() *EnableArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EnableArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.MaxScriptsCacheSize != nil {
+		b = fastjson.AppendKey(b, "maxScriptsCacheSize")
+		if b, err = fastjson.AppendFloat(b, *a.MaxScriptsCacheSize); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMaxScriptsCacheSize sets the MaxScriptsCacheSize optional argument.
 // The maximum size in bytes of collected scripts (not referenced by
 // other heap objects) the debugger can hold. Puts no limit if
@@ -66,6 +109,20 @@ type EnableReply struct {
 	DebuggerID runtime.UniqueDebuggerID `json:"debuggerId"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EnableReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "debuggerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.DebuggerID = runtime.UniqueDebuggerID(v)
+		}
+		return err
+	})
+}
+
 // EvaluateOnCallFrameArgs represents the arguments for EvaluateOnCallFrame in the Debugger domain.
 type EvaluateOnCallFrameArgs struct {
 	CallFrameID           CallFrameID `json:"callFrameId"`                     // Call frame identifier to evaluate on.
@@ -94,6 +151,53 @@ func NewEvaluateOnCallFrameArgs(callFrameID CallFrameID, expression string) *Eva
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EvaluateOnCallFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "callFrameId")
+	b = fastjson.AppendString(b, string(a.CallFrameID))
+	b = fastjson.AppendKey(b, "expression")
+	b = fastjson.AppendString(b, a.Expression)
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	if a.IncludeCommandLineAPI != nil {
+		b = fastjson.AppendKey(b, "includeCommandLineAPI")
+		b = fastjson.AppendBool(b, *a.IncludeCommandLineAPI)
+	}
+	if a.Silent != nil {
+		b = fastjson.AppendKey(b, "silent")
+		b = fastjson.AppendBool(b, *a.Silent)
+	}
+	if a.ReturnByValue != nil {
+		b = fastjson.AppendKey(b, "returnByValue")
+		b = fastjson.AppendBool(b, *a.ReturnByValue)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	if a.ThrowOnSideEffect != nil {
+		b = fastjson.AppendKey(b, "throwOnSideEffect")
+		b = fastjson.AppendBool(b, *a.ThrowOnSideEffect)
+	}
+	if a.Timeout != nil {
+		b = fastjson.AppendKey(b, "timeout")
+		if b, err = fastjson.AppendFloat(b, float64(*a.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetObjectGroup sets the ObjectGroup optional argument. String
 // object group name to put result into (allows rapid releasing
 // resulting object handles using `releaseObjectGroup`).
@@ -158,6 +262,20 @@ type EvaluateOnCallFrameReply struct {
 	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EvaluateOnCallFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // ExecuteWasmEvaluatorArgs represents the arguments for ExecuteWasmEvaluator in the Debugger domain.
 type ExecuteWasmEvaluatorArgs struct {
 	CallFrameID CallFrameID `json:"callFrameId"` // WebAssembly call frame identifier to evaluate on.
@@ -177,6 +295,29 @@ func NewExecuteWasmEvaluatorArgs(callFrameID CallFrameID, evaluator string) *Exe
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ExecuteWasmEvaluatorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "callFrameId")
+	b = fastjson.AppendString(b, string(a.CallFrameID))
+	b = fastjson.AppendKey(b, "evaluator")
+	b = fastjson.AppendString(b, a.Evaluator)
+	if a.Timeout != nil {
+		b = fastjson.AppendKey(b, "timeout")
+		if b, err = fastjson.AppendFloat(b, float64(*a.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTimeout sets the Timeout optional argument. Terminate execution
 // after timing out (number of milliseconds).
 //
@@ -192,6 +333,20 @@ type ExecuteWasmEvaluatorReply struct {
 	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExecuteWasmEvaluatorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // GetPossibleBreakpointsArgs represents the arguments for GetPossibleBreakpoints in the Debugger domain.
 type GetPossibleBreakpointsArgs struct {
 	Start              Location  `json:"start"`                        // Start of range to search possible breakpoint locations in.
@@ -206,6 +361,33 @@ func NewGetPossibleBreakpointsArgs(start Location) *GetPossibleBreakpointsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetPossibleBreakpointsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "start")
+	if b, err = fastjson.AppendValue(b, a.Start); err != nil {
+		return nil, err
+	}
+	if a.End != nil {
+		b = fastjson.AppendKey(b, "end")
+		if b, err = fastjson.AppendValue(b, a.End); err != nil {
+			return nil, err
+		}
+	}
+	if a.RestrictToFunction != nil {
+		b = fastjson.AppendKey(b, "restrictToFunction")
+		b = fastjson.AppendBool(b, *a.RestrictToFunction)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetEnd sets the End optional argument. End of range to search
 // possible breakpoint locations in (excluding). When not specified,
 // end of scripts is used as end of range.
@@ -227,6 +409,18 @@ type GetPossibleBreakpointsReply struct {
 	Locations []BreakLocation `json:"locations"` // List of the possible breakpoint locations.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetPossibleBreakpointsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "locations":
+			err = json.Unmarshal(value, &r.Locations)
+		}
+		return err
+	})
+}
+
 // GetScriptSourceArgs represents the arguments for GetScriptSource in the Debugger domain.
 type GetScriptSourceArgs struct {
 	ScriptID runtime.ScriptID `json:"scriptId"` // Id of the script to get source for.
@@ -239,12 +433,47 @@ func NewGetScriptSourceArgs(scriptID runtime.ScriptID) *GetScriptSourceArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetScriptSourceArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetScriptSourceReply represents the return values for GetScriptSource in the Debugger domain.
 type GetScriptSourceReply struct {
 	ScriptSource string  `json:"scriptSource"`       // Script source (empty in case of Wasm bytecode).
 	Bytecode     *string `json:"bytecode,omitempty"` // Wasm bytecode.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetScriptSourceReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "scriptSource":
+			r.ScriptSource, err = fastjson.String(value)
+		case "bytecode":
+			if fastjson.IsNull(value) {
+				r.Bytecode = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Bytecode = &v
+		}
+		return err
+	})
+}
+
 // GetWasmBytecodeArgs represents the arguments for GetWasmBytecode in the Debugger domain.
 type GetWasmBytecodeArgs struct {
 	ScriptID runtime.ScriptID `json:"scriptId"` // Id of the Wasm script to get source for.
@@ -257,11 +486,38 @@ func NewGetWasmBytecodeArgs(scriptID runtime.ScriptID) *GetWasmBytecodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetWasmBytecodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetWasmBytecodeReply represents the return values for GetWasmBytecode in the Debugger domain.
 type GetWasmBytecodeReply struct {
 	Bytecode string `json:"bytecode"` // Script source.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetWasmBytecodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "bytecode":
+			r.Bytecode, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetStackTraceArgs represents the arguments for GetStackTrace in the Debugger domain.
 type GetStackTraceArgs struct {
 	StackTraceID runtime.StackTraceID `json:"stackTraceId"` // No description.
@@ -274,11 +530,40 @@ func NewGetStackTraceArgs(stackTraceID runtime.StackTraceID) *GetStackTraceArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetStackTraceArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "stackTraceId")
+	if b, err = fastjson.AppendValue(b, a.StackTraceID); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetStackTraceReply represents the return values for GetStackTrace in the Debugger domain.
 type GetStackTraceReply struct {
 	StackTrace runtime.StackTrace `json:"stackTrace"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetStackTraceReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "stackTrace":
+			err = json.Unmarshal(value, &r.StackTrace)
+		}
+		return err
+	})
+}
+
 // PauseOnAsyncCallArgs represents the arguments for PauseOnAsyncCall in the Debugger domain.
 type PauseOnAsyncCallArgs struct {
 	ParentStackTraceID runtime.StackTraceID `json:"parentStackTraceId"` // Debugger will pause when async call with given stack trace is started.
@@ -291,6 +576,23 @@ func NewPauseOnAsyncCallArgs(parentStackTraceID runtime.StackTraceID) *PauseOnAs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *PauseOnAsyncCallArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "parentStackTraceId")
+	if b, err = fastjson.AppendValue(b, a.ParentStackTraceID); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // RemoveBreakpointArgs represents the arguments for RemoveBreakpoint in the Debugger domain.
 type RemoveBreakpointArgs struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // No description.
@@ -303,6 +605,21 @@ func NewRemoveBreakpointArgs(breakpointID BreakpointID) *RemoveBreakpointArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "breakpointId")
+	b = fastjson.AppendString(b, string(a.BreakpointID))
+	b = append(b, '}')
+	return b, err
+}
+
 // RestartFrameArgs represents the arguments for RestartFrame in the Debugger domain.
 type RestartFrameArgs struct {
 	CallFrameID CallFrameID `json:"callFrameId"` // Call frame identifier to evaluate on.
@@ -315,6 +632,21 @@ func NewRestartFrameArgs(callFrameID CallFrameID) *RestartFrameArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RestartFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "callFrameId")
+	b = fastjson.AppendString(b, string(a.CallFrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // RestartFrameReply represents the return values for RestartFrame in the Debugger domain.
 type RestartFrameReply struct {
 	CallFrames      []CallFrame         `json:"callFrames"`                // New stack trace.
@@ -325,6 +657,22 @@ type RestartFrameReply struct {
 	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RestartFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "callFrames":
+			err = json.Unmarshal(value, &r.CallFrames)
+		case "asyncStackTrace":
+			err = json.Unmarshal(value, &r.AsyncStackTrace)
+		case "asyncStackTraceId":
+			err = json.Unmarshal(value, &r.AsyncStackTraceID)
+		}
+		return err
+	})
+}
+
 // ResumeArgs represents the arguments for Resume in the Debugger domain.
 type ResumeArgs struct {
 	TerminateOnResume *bool `json:"terminateOnResume,omitempty"` // Set to true to terminate execution upon resuming execution. In contrast to Runtime.terminateExecution, this will allows to execute further JavaScript (i.e. via evaluation) until execution of the paused code is actually resumed, at which point termination is triggered. If execution is currently not paused, this parameter has no effect.
@@ -337,6 +685,23 @@ func NewResumeArgs() *ResumeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ResumeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.TerminateOnResume != nil {
+		b = fastjson.AppendKey(b, "terminateOnResume")
+		b = fastjson.AppendBool(b, *a.TerminateOnResume)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTerminateOnResume sets the TerminateOnResume optional argument.
 // Set to true to terminate execution upon resuming execution. In
 // contrast to Runtime.terminateExecution, this will allows to execute
@@ -365,6 +730,31 @@ func NewSearchInContentArgs(scriptID runtime.ScriptID, query string) *SearchInCo
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SearchInContentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	b = fastjson.AppendKey(b, "query")
+	b = fastjson.AppendString(b, a.Query)
+	if a.CaseSensitive != nil {
+		b = fastjson.AppendKey(b, "caseSensitive")
+		b = fastjson.AppendBool(b, *a.CaseSensitive)
+	}
+	if a.IsRegex != nil {
+		b = fastjson.AppendKey(b, "isRegex")
+		b = fastjson.AppendBool(b, *a.IsRegex)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCaseSensitive sets the CaseSensitive optional argument. If true,
 // search is case sensitive.
 func (a *SearchInContentArgs) SetCaseSensitive(caseSensitive bool) *SearchInContentArgs {
@@ -384,6 +774,18 @@ type SearchInContentReply struct {
 	Result []SearchMatch `json:"result"` // List of search matches.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SearchInContentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // SetAsyncCallStackDepthArgs represents the arguments for SetAsyncCallStackDepth in the Debugger domain.
 type SetAsyncCallStackDepthArgs struct {
 	MaxDepth int `json:"maxDepth"` // Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async call stacks (default).
@@ -396,6 +798,21 @@ func NewSetAsyncCallStackDepthArgs(maxDepth int) *SetAsyncCallStackDepthArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetAsyncCallStackDepthArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "maxDepth")
+	b = fastjson.AppendInt(b, a.MaxDepth)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBlackboxPatternsArgs represents the arguments for SetBlackboxPatterns in the Debugger domain.
 type SetBlackboxPatternsArgs struct {
 	Patterns []string `json:"patterns"` // Array of regexps that will be used to check script url for blackbox state.
@@ -408,6 +825,23 @@ func NewSetBlackboxPatternsArgs(patterns []string) *SetBlackboxPatternsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBlackboxPatternsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "patterns")
+	if b, err = fastjson.AppendValue(b, a.Patterns); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBlackboxedRangesArgs represents the arguments for SetBlackboxedRanges in the Debugger domain.
 type SetBlackboxedRangesArgs struct {
 	ScriptID  runtime.ScriptID `json:"scriptId"`  // Id of the script.
@@ -422,6 +856,25 @@ func NewSetBlackboxedRangesArgs(scriptID runtime.ScriptID, positions []ScriptPos
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBlackboxedRangesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	b = fastjson.AppendKey(b, "positions")
+	if b, err = fastjson.AppendValue(b, a.Positions); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBreakpointArgs represents the arguments for SetBreakpoint in the Debugger domain.
 type SetBreakpointArgs struct {
 	Location  Location `json:"location"`            // Location to set breakpoint in.
@@ -435,6 +888,27 @@ func NewSetBreakpointArgs(location Location) *SetBreakpointArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "location")
+	if b, err = fastjson.AppendValue(b, a.Location); err != nil {
+		return nil, err
+	}
+	if a.Condition != nil {
+		b = fastjson.AppendKey(b, "condition")
+		b = fastjson.AppendString(b, *a.Condition)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCondition sets the Condition optional argument. Expression to
 // use as a breakpoint condition. When specified, debugger will only
 // stop on the breakpoint if this expression evaluates to true.
@@ -449,6 +923,22 @@ type SetBreakpointReply struct {
 	ActualLocation Location     `json:"actualLocation"` // Location this breakpoint resolved into.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetBreakpointReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "breakpointId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BreakpointID = BreakpointID(v)
+		case "actualLocation":
+			err = json.Unmarshal(value, &r.ActualLocation)
+		}
+		return err
+	})
+}
+
 // SetInstrumentationBreakpointArgs represents the arguments for SetInstrumentationBreakpoint in the Debugger domain.
 type SetInstrumentationBreakpointArgs struct {
 	// Instrumentation Instrumentation name.
@@ -464,11 +954,40 @@ func NewSetInstrumentationBreakpointArgs(instrumentation string) *SetInstrumenta
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetInstrumentationBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "instrumentation")
+	b = fastjson.AppendString(b, a.Instrumentation)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetInstrumentationBreakpointReply represents the return values for SetInstrumentationBreakpoint in the Debugger domain.
 type SetInstrumentationBreakpointReply struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetInstrumentationBreakpointReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "breakpointId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BreakpointID = BreakpointID(v)
+		}
+		return err
+	})
+}
+
 // SetBreakpointByURLArgs represents the arguments for SetBreakpointByURL in the Debugger domain.
 type SetBreakpointByURLArgs struct {
 	LineNumber   int     `json:"lineNumber"`             // Line number to set breakpoint at.
@@ -486,6 +1005,41 @@ func NewSetBreakpointByURLArgs(lineNumber int) *SetBreakpointByURLArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBreakpointByURLArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "lineNumber")
+	b = fastjson.AppendInt(b, a.LineNumber)
+	if a.URL != nil {
+		b = fastjson.AppendKey(b, "url")
+		b = fastjson.AppendString(b, *a.URL)
+	}
+	if a.URLRegex != nil {
+		b = fastjson.AppendKey(b, "urlRegex")
+		b = fastjson.AppendString(b, *a.URLRegex)
+	}
+	if a.ScriptHash != nil {
+		b = fastjson.AppendKey(b, "scriptHash")
+		b = fastjson.AppendString(b, *a.ScriptHash)
+	}
+	if a.ColumnNumber != nil {
+		b = fastjson.AppendKey(b, "columnNumber")
+		b = fastjson.AppendInt(b, *a.ColumnNumber)
+	}
+	if a.Condition != nil {
+		b = fastjson.AppendKey(b, "condition")
+		b = fastjson.AppendString(b, *a.Condition)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetURL sets the URL optional argument. URL of the resources to set
 // breakpoint on.
 func (a *SetBreakpointByURLArgs) SetURL(url string) *SetBreakpointByURLArgs {
@@ -529,6 +1083,22 @@ type SetBreakpointByURLReply struct {
 	Locations    []Location   `json:"locations"`    // List of the locations this breakpoint resolved into upon addition.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetBreakpointByURLReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "breakpointId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BreakpointID = BreakpointID(v)
+		case "locations":
+			err = json.Unmarshal(value, &r.Locations)
+		}
+		return err
+	})
+}
+
 // SetBreakpointOnFunctionCallArgs represents the arguments for SetBreakpointOnFunctionCall in the Debugger domain.
 type SetBreakpointOnFunctionCallArgs struct {
 	ObjectID  runtime.RemoteObjectID `json:"objectId"`            // Function object id.
@@ -542,6 +1112,25 @@ func NewSetBreakpointOnFunctionCallArgs(objectID runtime.RemoteObjectID) *SetBre
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBreakpointOnFunctionCallArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	if a.Condition != nil {
+		b = fastjson.AppendKey(b, "condition")
+		b = fastjson.AppendString(b, *a.Condition)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCondition sets the Condition optional argument. Expression to
 // use as a breakpoint condition. When specified, debugger will stop on
 // the breakpoint if this expression evaluates to true.
@@ -555,6 +1144,20 @@ type SetBreakpointOnFunctionCallReply struct {
 	BreakpointID BreakpointID `json:"breakpointId"` // Id of the created breakpoint for further reference.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetBreakpointOnFunctionCallReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "breakpointId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BreakpointID = BreakpointID(v)
+		}
+		return err
+	})
+}
+
 // SetBreakpointsActiveArgs represents the arguments for SetBreakpointsActive in the Debugger domain.
 type SetBreakpointsActiveArgs struct {
 	Active bool `json:"active"` // New value for breakpoints active state.
@@ -567,6 +1170,21 @@ func NewSetBreakpointsActiveArgs(active bool) *SetBreakpointsActiveArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBreakpointsActiveArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "active")
+	b = fastjson.AppendBool(b, a.Active)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPauseOnExceptionsArgs represents the arguments for SetPauseOnExceptions in the Debugger domain.
 type SetPauseOnExceptionsArgs struct {
 	// State Pause on exceptions mode.
@@ -582,6 +1200,21 @@ func NewSetPauseOnExceptionsArgs(state string) *SetPauseOnExceptionsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPauseOnExceptionsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "state")
+	b = fastjson.AppendString(b, a.State)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetReturnValueArgs represents the arguments for SetReturnValue in the Debugger domain.
 type SetReturnValueArgs struct {
 	NewValue runtime.CallArgument `json:"newValue"` // New return value.
@@ -594,6 +1227,23 @@ func NewSetReturnValueArgs(newValue runtime.CallArgument) *SetReturnValueArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetReturnValueArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "newValue")
+	if b, err = fastjson.AppendValue(b, a.NewValue); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetScriptSourceArgs represents the arguments for SetScriptSource in the Debugger domain.
 type SetScriptSourceArgs struct {
 	ScriptID     runtime.ScriptID `json:"scriptId"`         // Id of the script to edit.
@@ -609,6 +1259,27 @@ func NewSetScriptSourceArgs(scriptID runtime.ScriptID, scriptSource string) *Set
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetScriptSourceArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	b = fastjson.AppendKey(b, "scriptSource")
+	b = fastjson.AppendString(b, a.ScriptSource)
+	if a.DryRun != nil {
+		b = fastjson.AppendKey(b, "dryRun")
+		b = fastjson.AppendBool(b, *a.DryRun)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDryRun sets the DryRun optional argument. If true the change
 // will not actually be applied. Dry run may be used to get result
 // description without actually modifying the code.
@@ -629,6 +1300,32 @@ type SetScriptSourceReply struct {
 	ExceptionDetails  *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details if any.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetScriptSourceReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "callFrames":
+			err = json.Unmarshal(value, &r.CallFrames)
+		case "stackChanged":
+			if fastjson.IsNull(value) {
+				r.StackChanged = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.StackChanged = &v
+		case "asyncStackTrace":
+			err = json.Unmarshal(value, &r.AsyncStackTrace)
+		case "asyncStackTraceId":
+			err = json.Unmarshal(value, &r.AsyncStackTraceID)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // SetSkipAllPausesArgs represents the arguments for SetSkipAllPauses in the Debugger domain.
 type SetSkipAllPausesArgs struct {
 	Skip bool `json:"skip"` // New value for skip pauses state.
@@ -641,6 +1338,21 @@ func NewSetSkipAllPausesArgs(skip bool) *SetSkipAllPausesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetSkipAllPausesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "skip")
+	b = fastjson.AppendBool(b, a.Skip)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetVariableValueArgs represents the arguments for SetVariableValue in the Debugger domain.
 type SetVariableValueArgs struct {
 	ScopeNumber  int                  `json:"scopeNumber"`  // 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.
@@ -659,6 +1371,29 @@ func NewSetVariableValueArgs(scopeNumber int, variableName string, newValue runt
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetVariableValueArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scopeNumber")
+	b = fastjson.AppendInt(b, a.ScopeNumber)
+	b = fastjson.AppendKey(b, "variableName")
+	b = fastjson.AppendString(b, a.VariableName)
+	b = fastjson.AppendKey(b, "newValue")
+	if b, err = fastjson.AppendValue(b, a.NewValue); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "callFrameId")
+	b = fastjson.AppendString(b, string(a.CallFrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // StepIntoArgs represents the arguments for StepInto in the Debugger domain.
 type StepIntoArgs struct {
 	// BreakOnAsyncCall Debugger will pause on the execution of the first
@@ -675,6 +1410,23 @@ func NewStepIntoArgs() *StepIntoArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StepIntoArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.BreakOnAsyncCall != nil {
+		b = fastjson.AppendKey(b, "breakOnAsyncCall")
+		b = fastjson.AppendBool(b, *a.BreakOnAsyncCall)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBreakOnAsyncCall sets the BreakOnAsyncCall optional argument.
 // Debugger will pause on the execution of the first async task which
 // was scheduled before next pause.
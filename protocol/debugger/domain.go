@@ -7,6 +7,7 @@ package debugger
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -462,14 +463,29 @@ func (d *domainClient) StepOver(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) BreakpointResolved(ctx context.Context) (BreakpointResolvedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Debugger.breakpointResolved", d.conn)
+func (d *domainClient) BreakpointResolved(ctx context.Context, opts ...rpcc.StreamOption) (BreakpointResolvedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.breakpointResolved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &breakpointResolvedClient{Stream: s}, nil
 }
 
+// OnBreakpointResolved registers fn to be called for each
+// BreakpointResolved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnBreakpointResolved(fn func(*BreakpointResolvedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.breakpointResolved", d.conn, func(args []byte) error {
+		ev := new(BreakpointResolvedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type breakpointResolvedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -483,14 +499,29 @@ func (c *breakpointResolvedClient) Recv() (*BreakpointResolvedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) Paused(ctx context.Context) (PausedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Debugger.paused", d.conn)
+func (d *domainClient) Paused(ctx context.Context, opts ...rpcc.StreamOption) (PausedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.paused", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &pausedClient{Stream: s}, nil
 }
 
+// OnPaused registers fn to be called for each
+// Paused event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPaused(fn func(*PausedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.paused", d.conn, func(args []byte) error {
+		ev := new(PausedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type pausedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -504,14 +535,29 @@ func (c *pausedClient) Recv() (*PausedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) Resumed(ctx context.Context) (ResumedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Debugger.resumed", d.conn)
+func (d *domainClient) Resumed(ctx context.Context, opts ...rpcc.StreamOption) (ResumedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.resumed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &resumedClient{Stream: s}, nil
 }
 
+// OnResumed registers fn to be called for each
+// Resumed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResumed(fn func(*ResumedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.resumed", d.conn, func(args []byte) error {
+		ev := new(ResumedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type resumedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -525,14 +571,29 @@ func (c *resumedClient) Recv() (*ResumedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ScriptFailedToParse(ctx context.Context) (ScriptFailedToParseClient, error) {
-	s, err := rpcc.NewStream(ctx, "Debugger.scriptFailedToParse", d.conn)
+func (d *domainClient) ScriptFailedToParse(ctx context.Context, opts ...rpcc.StreamOption) (ScriptFailedToParseClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.scriptFailedToParse", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &scriptFailedToParseClient{Stream: s}, nil
 }
 
+// OnScriptFailedToParse registers fn to be called for each
+// ScriptFailedToParse event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScriptFailedToParse(fn func(*ScriptFailedToParseReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.scriptFailedToParse", d.conn, func(args []byte) error {
+		ev := new(ScriptFailedToParseReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type scriptFailedToParseClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -546,14 +607,29 @@ func (c *scriptFailedToParseClient) Recv() (*ScriptFailedToParseReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ScriptParsed(ctx context.Context) (ScriptParsedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Debugger.scriptParsed", d.conn)
+func (d *domainClient) ScriptParsed(ctx context.Context, opts ...rpcc.StreamOption) (ScriptParsedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Debugger.scriptParsed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &scriptParsedClient{Stream: s}, nil
 }
 
+// OnScriptParsed registers fn to be called for each
+// ScriptParsed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScriptParsed(fn func(*ScriptParsedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Debugger.scriptParsed", d.conn, func(args []byte) error {
+		ev := new(ScriptParsedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type scriptParsedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
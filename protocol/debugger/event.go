@@ -5,6 +5,7 @@ package debugger
 import (
 	"encoding/json"
 
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/runtime"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -24,6 +25,22 @@ type BreakpointResolvedReply struct {
 	Location     Location     `json:"location"`     // Actual breakpoint location.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *BreakpointResolvedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "breakpointId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BreakpointID = BreakpointID(v)
+		case "location":
+			err = json.Unmarshal(value, &r.Location)
+		}
+		return err
+	})
+}
+
 // PausedClient is a client for Paused events. Fired when the virtual machine
 // stopped on breakpoint or exception or any other stop criteria.
 type PausedClient interface {
@@ -55,6 +72,30 @@ type PausedReply struct {
 	AsyncCallStackTraceID *runtime.StackTraceID `json:"asyncCallStackTraceId,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PausedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "callFrames":
+			err = json.Unmarshal(value, &r.CallFrames)
+		case "reason":
+			r.Reason, err = fastjson.String(value)
+		case "data":
+			err = json.Unmarshal(value, &r.Data)
+		case "hitBreakpoints":
+			err = json.Unmarshal(value, &r.HitBreakpoints)
+		case "asyncStackTrace":
+			err = json.Unmarshal(value, &r.AsyncStackTrace)
+		case "asyncStackTraceId":
+			err = json.Unmarshal(value, &r.AsyncStackTraceID)
+		case "asyncCallStackTraceId":
+			err = json.Unmarshal(value, &r.AsyncCallStackTraceID)
+		}
+		return err
+	})
+}
+
 // ResumedClient is a client for Resumed events. Fired when the virtual
 // machine resumed execution.
 type ResumedClient interface {
@@ -108,6 +149,84 @@ type ScriptFailedToParseReply struct {
 	ScriptLanguage ScriptLanguage `json:"scriptLanguage,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ScriptFailedToParseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "scriptId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ScriptID = runtime.ScriptID(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "startLine":
+			r.StartLine, err = fastjson.Int(value)
+		case "startColumn":
+			r.StartColumn, err = fastjson.Int(value)
+		case "endLine":
+			r.EndLine, err = fastjson.Int(value)
+		case "endColumn":
+			r.EndColumn, err = fastjson.Int(value)
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = runtime.ExecutionContextID(v)
+		case "hash":
+			r.Hash, err = fastjson.String(value)
+		case "executionContextAuxData":
+			err = json.Unmarshal(value, &r.ExecutionContextAuxData)
+		case "sourceMapURL":
+			if fastjson.IsNull(value) {
+				r.SourceMapURL = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceMapURL = &v
+		case "hasSourceURL":
+			if fastjson.IsNull(value) {
+				r.HasSourceURL = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.HasSourceURL = &v
+		case "isModule":
+			if fastjson.IsNull(value) {
+				r.IsModule = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.IsModule = &v
+		case "length":
+			if fastjson.IsNull(value) {
+				r.Length = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.Length = &v
+		case "stackTrace":
+			err = json.Unmarshal(value, &r.StackTrace)
+		case "codeOffset":
+			if fastjson.IsNull(value) {
+				r.CodeOffset = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.CodeOffset = &v
+		case "scriptLanguage":
+			var v string
+			v, err = fastjson.String(value)
+			r.ScriptLanguage = ScriptLanguage(v)
+		}
+		return err
+	})
+}
+
 // ScriptParsedClient is a client for ScriptParsed events. Fired when virtual
 // machine parses script. This event is also fired for all known and
 // uncollected scripts upon enabling debugger.
@@ -158,3 +277,91 @@ type ScriptParsedReply struct {
 	// Note: This property is experimental.
 	DebugSymbols *DebugSymbols `json:"debugSymbols,omitempty"`
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ScriptParsedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "scriptId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ScriptID = runtime.ScriptID(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "startLine":
+			r.StartLine, err = fastjson.Int(value)
+		case "startColumn":
+			r.StartColumn, err = fastjson.Int(value)
+		case "endLine":
+			r.EndLine, err = fastjson.Int(value)
+		case "endColumn":
+			r.EndColumn, err = fastjson.Int(value)
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = runtime.ExecutionContextID(v)
+		case "hash":
+			r.Hash, err = fastjson.String(value)
+		case "executionContextAuxData":
+			err = json.Unmarshal(value, &r.ExecutionContextAuxData)
+		case "isLiveEdit":
+			if fastjson.IsNull(value) {
+				r.IsLiveEdit = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.IsLiveEdit = &v
+		case "sourceMapURL":
+			if fastjson.IsNull(value) {
+				r.SourceMapURL = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceMapURL = &v
+		case "hasSourceURL":
+			if fastjson.IsNull(value) {
+				r.HasSourceURL = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.HasSourceURL = &v
+		case "isModule":
+			if fastjson.IsNull(value) {
+				r.IsModule = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.IsModule = &v
+		case "length":
+			if fastjson.IsNull(value) {
+				r.Length = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.Length = &v
+		case "stackTrace":
+			err = json.Unmarshal(value, &r.StackTrace)
+		case "codeOffset":
+			if fastjson.IsNull(value) {
+				r.CodeOffset = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.CodeOffset = &v
+		case "scriptLanguage":
+			var v string
+			v, err = fastjson.String(value)
+			r.ScriptLanguage = ScriptLanguage(v)
+		case "debugSymbols":
+			err = json.Unmarshal(value, &r.DebugSymbols)
+		}
+		return err
+	})
+}
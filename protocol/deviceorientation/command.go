@@ -2,6 +2,10 @@
 
 package deviceorientation
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // SetDeviceOrientationOverrideArgs represents the arguments for SetDeviceOrientationOverride in the DeviceOrientation domain.
 type SetDeviceOrientationOverrideArgs struct {
 	Alpha float64 `json:"alpha"` // Mock alpha
@@ -17,3 +21,28 @@ func NewSetDeviceOrientationOverrideArgs(alpha float64, beta float64, gamma floa
 	args.Gamma = gamma
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDeviceOrientationOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "alpha")
+	if b, err = fastjson.AppendFloat(b, a.Alpha); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "beta")
+	if b, err = fastjson.AppendFloat(b, a.Beta); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "gamma")
+	if b, err = fastjson.AppendFloat(b, a.Gamma); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
@@ -3,6 +3,9 @@
 package dom
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
@@ -19,11 +22,38 @@ func NewCollectClassNamesFromSubtreeArgs(nodeID NodeID) *CollectClassNamesFromSu
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CollectClassNamesFromSubtreeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // CollectClassNamesFromSubtreeReply represents the return values for CollectClassNamesFromSubtree in the DOM domain.
 type CollectClassNamesFromSubtreeReply struct {
 	ClassNames []string `json:"classNames"` // Class name list.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CollectClassNamesFromSubtreeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "classNames":
+			err = json.Unmarshal(value, &r.ClassNames)
+		}
+		return err
+	})
+}
+
 // CopyToArgs represents the arguments for CopyTo in the DOM domain.
 type CopyToArgs struct {
 	NodeID             NodeID  `json:"nodeId"`                       // Id of the node to copy.
@@ -39,6 +69,27 @@ func NewCopyToArgs(nodeID NodeID, targetNodeID NodeID) *CopyToArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CopyToArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "targetNodeId")
+	b = fastjson.AppendInt(b, int(a.TargetNodeID))
+	if a.InsertBeforeNodeID != nil {
+		b = fastjson.AppendKey(b, "insertBeforeNodeId")
+		b = fastjson.AppendInt(b, int(*a.InsertBeforeNodeID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetInsertBeforeNodeID sets the InsertBeforeNodeID optional argument.
 // Drop the copy before this node (if absent, the copy becomes the last
 // child of `targetNodeId`).
@@ -52,6 +103,20 @@ type CopyToReply struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node clone.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CopyToReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // DescribeNodeArgs represents the arguments for DescribeNode in the DOM domain.
 type DescribeNodeArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -68,6 +133,39 @@ func NewDescribeNodeArgs() *DescribeNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DescribeNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	if a.Depth != nil {
+		b = fastjson.AppendKey(b, "depth")
+		b = fastjson.AppendInt(b, *a.Depth)
+	}
+	if a.Pierce != nil {
+		b = fastjson.AppendKey(b, "pierce")
+		b = fastjson.AppendBool(b, *a.Pierce)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *DescribeNodeArgs) SetNodeID(nodeID NodeID) *DescribeNodeArgs {
@@ -110,6 +208,18 @@ type DescribeNodeReply struct {
 	Node Node `json:"node"` // Node description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DescribeNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "node":
+			err = json.Unmarshal(value, &r.Node)
+		}
+		return err
+	})
+}
+
 // ScrollIntoViewIfNeededArgs represents the arguments for ScrollIntoViewIfNeeded in the DOM domain.
 type ScrollIntoViewIfNeededArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -125,6 +235,37 @@ func NewScrollIntoViewIfNeededArgs() *ScrollIntoViewIfNeededArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ScrollIntoViewIfNeededArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	if a.Rect != nil {
+		b = fastjson.AppendKey(b, "rect")
+		if b, err = fastjson.AppendValue(b, a.Rect); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *ScrollIntoViewIfNeededArgs) SetNodeID(nodeID NodeID) *ScrollIntoViewIfNeededArgs {
@@ -167,6 +308,21 @@ func NewDiscardSearchResultsArgs(searchID string) *DiscardSearchResultsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DiscardSearchResultsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "searchId")
+	b = fastjson.AppendString(b, a.SearchID)
+	b = append(b, '}')
+	return b, err
+}
+
 // FocusArgs represents the arguments for Focus in the DOM domain.
 type FocusArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -181,6 +337,31 @@ func NewFocusArgs() *FocusArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *FocusArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *FocusArgs) SetNodeID(nodeID NodeID) *FocusArgs {
@@ -214,11 +395,38 @@ func NewGetAttributesArgs(nodeID NodeID) *GetAttributesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetAttributesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetAttributesReply represents the return values for GetAttributes in the DOM domain.
 type GetAttributesReply struct {
 	Attributes []string `json:"attributes"` // An interleaved array of node attribute names and values.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetAttributesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "attributes":
+			err = json.Unmarshal(value, &r.Attributes)
+		}
+		return err
+	})
+}
+
 // GetBoxModelArgs represents the arguments for GetBoxModel in the DOM domain.
 type GetBoxModelArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -233,6 +441,31 @@ func NewGetBoxModelArgs() *GetBoxModelArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetBoxModelArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *GetBoxModelArgs) SetNodeID(nodeID NodeID) *GetBoxModelArgs {
@@ -259,6 +492,18 @@ type GetBoxModelReply struct {
 	Model BoxModel `json:"model"` // Box model for the node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBoxModelReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "model":
+			err = json.Unmarshal(value, &r.Model)
+		}
+		return err
+	})
+}
+
 // GetContentQuadsArgs represents the arguments for GetContentQuads in the DOM domain.
 type GetContentQuadsArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -273,6 +518,31 @@ func NewGetContentQuadsArgs() *GetContentQuadsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetContentQuadsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *GetContentQuadsArgs) SetNodeID(nodeID NodeID) *GetContentQuadsArgs {
@@ -299,6 +569,18 @@ type GetContentQuadsReply struct {
 	Quads []Quad `json:"quads"` // Quads that describe node layout relative to viewport.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetContentQuadsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "quads":
+			err = json.Unmarshal(value, &r.Quads)
+		}
+		return err
+	})
+}
+
 // GetDocumentArgs represents the arguments for GetDocument in the DOM domain.
 type GetDocumentArgs struct {
 	Depth  *int  `json:"depth,omitempty"`  // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.
@@ -312,6 +594,27 @@ func NewGetDocumentArgs() *GetDocumentArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetDocumentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Depth != nil {
+		b = fastjson.AppendKey(b, "depth")
+		b = fastjson.AppendInt(b, *a.Depth)
+	}
+	if a.Pierce != nil {
+		b = fastjson.AppendKey(b, "pierce")
+		b = fastjson.AppendBool(b, *a.Pierce)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDepth sets the Depth optional argument. The maximum depth at
 // which children should be retrieved, defaults to 1. Use -1 for the
 // entire subtree or provide an integer larger than 0.
@@ -333,6 +636,18 @@ type GetDocumentReply struct {
 	Root Node `json:"root"` // Resulting node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetDocumentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "root":
+			err = json.Unmarshal(value, &r.Root)
+		}
+		return err
+	})
+}
+
 // GetFlattenedDocumentArgs represents the arguments for GetFlattenedDocument in the DOM domain.
 type GetFlattenedDocumentArgs struct {
 	Depth  *int  `json:"depth,omitempty"`  // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.
@@ -346,6 +661,27 @@ func NewGetFlattenedDocumentArgs() *GetFlattenedDocumentArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetFlattenedDocumentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Depth != nil {
+		b = fastjson.AppendKey(b, "depth")
+		b = fastjson.AppendInt(b, *a.Depth)
+	}
+	if a.Pierce != nil {
+		b = fastjson.AppendKey(b, "pierce")
+		b = fastjson.AppendBool(b, *a.Pierce)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDepth sets the Depth optional argument. The maximum depth at
 // which children should be retrieved, defaults to 1. Use -1 for the
 // entire subtree or provide an integer larger than 0.
@@ -367,6 +703,18 @@ type GetFlattenedDocumentReply struct {
 	Nodes []Node `json:"nodes"` // Resulting node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFlattenedDocumentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodes":
+			err = json.Unmarshal(value, &r.Nodes)
+		}
+		return err
+	})
+}
+
 // GetNodeForLocationArgs represents the arguments for GetNodeForLocation in the DOM domain.
 type GetNodeForLocationArgs struct {
 	X                         int   `json:"x"`                                   // X coordinate.
@@ -383,6 +731,31 @@ func NewGetNodeForLocationArgs(x int, y int) *GetNodeForLocationArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetNodeForLocationArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "x")
+	b = fastjson.AppendInt(b, a.X)
+	b = fastjson.AppendKey(b, "y")
+	b = fastjson.AppendInt(b, a.Y)
+	if a.IncludeUserAgentShadowDOM != nil {
+		b = fastjson.AppendKey(b, "includeUserAgentShadowDOM")
+		b = fastjson.AppendBool(b, *a.IncludeUserAgentShadowDOM)
+	}
+	if a.IgnorePointerEventsNone != nil {
+		b = fastjson.AppendKey(b, "ignorePointerEventsNone")
+		b = fastjson.AppendBool(b, *a.IgnorePointerEventsNone)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIncludeUserAgentShadowDOM sets the IncludeUserAgentShadowDOM optional argument.
 // False to skip to the nearest non-UA shadow root ancestor (default:
 // false).
@@ -406,6 +779,33 @@ type GetNodeForLocationReply struct {
 	NodeID        *NodeID              `json:"nodeId,omitempty"` // Id of the node at given coordinates, only when enabled and requested document.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetNodeForLocationReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "backendNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.BackendNodeID = BackendNodeID(v)
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = internal.PageFrameID(v)
+		case "nodeId":
+			if fastjson.IsNull(value) {
+				r.NodeID = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			c := NodeID(v)
+			r.NodeID = &c
+		}
+		return err
+	})
+}
+
 // GetOuterHTMLArgs represents the arguments for GetOuterHTML in the DOM domain.
 type GetOuterHTMLArgs struct {
 	NodeID        *NodeID                 `json:"nodeId,omitempty"`        // Identifier of the node.
@@ -420,6 +820,31 @@ func NewGetOuterHTMLArgs() *GetOuterHTMLArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetOuterHTMLArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *GetOuterHTMLArgs) SetNodeID(nodeID NodeID) *GetOuterHTMLArgs {
@@ -446,6 +871,18 @@ type GetOuterHTMLReply struct {
 	OuterHTML string `json:"outerHTML"` // Outer HTML markup.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetOuterHTMLReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "outerHTML":
+			r.OuterHTML, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetRelayoutBoundaryArgs represents the arguments for GetRelayoutBoundary in the DOM domain.
 type GetRelayoutBoundaryArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node.
@@ -458,11 +895,40 @@ func NewGetRelayoutBoundaryArgs(nodeID NodeID) *GetRelayoutBoundaryArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetRelayoutBoundaryArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetRelayoutBoundaryReply represents the return values for GetRelayoutBoundary in the DOM domain.
 type GetRelayoutBoundaryReply struct {
 	NodeID NodeID `json:"nodeId"` // Relayout boundary node id for the given node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetRelayoutBoundaryReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // GetSearchResultsArgs represents the arguments for GetSearchResults in the DOM domain.
 type GetSearchResultsArgs struct {
 	SearchID  string `json:"searchId"`  // Unique search session identifier.
@@ -479,11 +945,42 @@ func NewGetSearchResultsArgs(searchID string, fromIndex int, toIndex int) *GetSe
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetSearchResultsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "searchId")
+	b = fastjson.AppendString(b, a.SearchID)
+	b = fastjson.AppendKey(b, "fromIndex")
+	b = fastjson.AppendInt(b, a.FromIndex)
+	b = fastjson.AppendKey(b, "toIndex")
+	b = fastjson.AppendInt(b, a.ToIndex)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetSearchResultsReply represents the return values for GetSearchResults in the DOM domain.
 type GetSearchResultsReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Ids of the search result nodes.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetSearchResultsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeIds":
+			err = json.Unmarshal(value, &r.NodeIDs)
+		}
+		return err
+	})
+}
+
 // MoveToArgs represents the arguments for MoveTo in the DOM domain.
 type MoveToArgs struct {
 	NodeID             NodeID  `json:"nodeId"`                       // Id of the node to move.
@@ -499,6 +996,27 @@ func NewMoveToArgs(nodeID NodeID, targetNodeID NodeID) *MoveToArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *MoveToArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "targetNodeId")
+	b = fastjson.AppendInt(b, int(a.TargetNodeID))
+	if a.InsertBeforeNodeID != nil {
+		b = fastjson.AppendKey(b, "insertBeforeNodeId")
+		b = fastjson.AppendInt(b, int(*a.InsertBeforeNodeID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetInsertBeforeNodeID sets the InsertBeforeNodeID optional argument.
 // Drop node before this one (if absent, the moved node becomes the
 // last child of `targetNodeId`).
@@ -512,6 +1030,20 @@ type MoveToReply struct {
 	NodeID NodeID `json:"nodeId"` // New id of the moved node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *MoveToReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // PerformSearchArgs represents the arguments for PerformSearch in the DOM domain.
 type PerformSearchArgs struct {
 	Query                     string `json:"query"`                               // Plain text or query selector or XPath search query.
@@ -525,6 +1057,25 @@ func NewPerformSearchArgs(query string) *PerformSearchArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *PerformSearchArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "query")
+	b = fastjson.AppendString(b, a.Query)
+	if a.IncludeUserAgentShadowDOM != nil {
+		b = fastjson.AppendKey(b, "includeUserAgentShadowDOM")
+		b = fastjson.AppendBool(b, *a.IncludeUserAgentShadowDOM)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIncludeUserAgentShadowDOM sets the IncludeUserAgentShadowDOM optional argument.
 // True to search in user agent shadow DOM.
 func (a *PerformSearchArgs) SetIncludeUserAgentShadowDOM(includeUserAgentShadowDOM bool) *PerformSearchArgs {
@@ -538,6 +1089,20 @@ type PerformSearchReply struct {
 	ResultCount int    `json:"resultCount"` // Number of search results.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PerformSearchReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "searchId":
+			r.SearchID, err = fastjson.String(value)
+		case "resultCount":
+			r.ResultCount, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // PushNodeByPathToFrontendArgs represents the arguments for PushNodeByPathToFrontend in the DOM domain.
 type PushNodeByPathToFrontendArgs struct {
 	Path string `json:"path"` // Path to node in the proprietary format.
@@ -550,11 +1115,40 @@ func NewPushNodeByPathToFrontendArgs(path string) *PushNodeByPathToFrontendArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *PushNodeByPathToFrontendArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "path")
+	b = fastjson.AppendString(b, a.Path)
+	b = append(b, '}')
+	return b, err
+}
+
 // PushNodeByPathToFrontendReply represents the return values for PushNodeByPathToFrontend in the DOM domain.
 type PushNodeByPathToFrontendReply struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node for given path.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PushNodeByPathToFrontendReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // PushNodesByBackendIDsToFrontendArgs represents the arguments for PushNodesByBackendIDsToFrontend in the DOM domain.
 type PushNodesByBackendIDsToFrontendArgs struct {
 	BackendNodeIDs []BackendNodeID `json:"backendNodeIds"` // The array of backend node ids.
@@ -567,11 +1161,40 @@ func NewPushNodesByBackendIDsToFrontendArgs(backendNodeIDs []BackendNodeID) *Pus
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *PushNodesByBackendIDsToFrontendArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "backendNodeIds")
+	if b, err = fastjson.AppendValue(b, a.BackendNodeIDs); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // PushNodesByBackendIDsToFrontendReply represents the return values for PushNodesByBackendIDsToFrontend in the DOM domain.
 type PushNodesByBackendIDsToFrontendReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PushNodesByBackendIDsToFrontendReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeIds":
+			err = json.Unmarshal(value, &r.NodeIDs)
+		}
+		return err
+	})
+}
+
 // QuerySelectorArgs represents the arguments for QuerySelector in the DOM domain.
 type QuerySelectorArgs struct {
 	NodeID   NodeID `json:"nodeId"`   // Id of the node to query upon.
@@ -586,11 +1209,42 @@ func NewQuerySelectorArgs(nodeID NodeID, selector string) *QuerySelectorArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *QuerySelectorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "selector")
+	b = fastjson.AppendString(b, a.Selector)
+	b = append(b, '}')
+	return b, err
+}
+
 // QuerySelectorReply represents the return values for QuerySelector in the DOM domain.
 type QuerySelectorReply struct {
 	NodeID NodeID `json:"nodeId"` // Query selector result.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *QuerySelectorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // QuerySelectorAllArgs represents the arguments for QuerySelectorAll in the DOM domain.
 type QuerySelectorAllArgs struct {
 	NodeID   NodeID `json:"nodeId"`   // Id of the node to query upon.
@@ -605,11 +1259,40 @@ func NewQuerySelectorAllArgs(nodeID NodeID, selector string) *QuerySelectorAllAr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *QuerySelectorAllArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "selector")
+	b = fastjson.AppendString(b, a.Selector)
+	b = append(b, '}')
+	return b, err
+}
+
 // QuerySelectorAllReply represents the return values for QuerySelectorAll in the DOM domain.
 type QuerySelectorAllReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Query selector result.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *QuerySelectorAllReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeIds":
+			err = json.Unmarshal(value, &r.NodeIDs)
+		}
+		return err
+	})
+}
+
 // RemoveAttributeArgs represents the arguments for RemoveAttribute in the DOM domain.
 type RemoveAttributeArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the element to remove attribute from.
@@ -624,6 +1307,23 @@ func NewRemoveAttributeArgs(nodeID NodeID, name string) *RemoveAttributeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveAttributeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	b = append(b, '}')
+	return b, err
+}
+
 // RemoveNodeArgs represents the arguments for RemoveNode in the DOM domain.
 type RemoveNodeArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to remove.
@@ -636,6 +1336,21 @@ func NewRemoveNodeArgs(nodeID NodeID) *RemoveNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestChildNodesArgs represents the arguments for RequestChildNodes in the DOM domain.
 type RequestChildNodesArgs struct {
 	NodeID NodeID `json:"nodeId"`           // Id of the node to get children for.
@@ -650,6 +1365,29 @@ func NewRequestChildNodesArgs(nodeID NodeID) *RequestChildNodesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestChildNodesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	if a.Depth != nil {
+		b = fastjson.AppendKey(b, "depth")
+		b = fastjson.AppendInt(b, *a.Depth)
+	}
+	if a.Pierce != nil {
+		b = fastjson.AppendKey(b, "pierce")
+		b = fastjson.AppendBool(b, *a.Pierce)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDepth sets the Depth optional argument. The maximum depth at
 // which children should be retrieved, defaults to 1. Use -1 for the
 // entire subtree or provide an integer larger than 0.
@@ -678,11 +1416,40 @@ func NewRequestNodeArgs(objectID runtime.RemoteObjectID) *RequestNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestNodeReply represents the return values for RequestNode in the DOM domain.
 type RequestNodeReply struct {
 	NodeID NodeID `json:"nodeId"` // Node id for given object.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // ResolveNodeArgs represents the arguments for ResolveNode in the DOM domain.
 type ResolveNodeArgs struct {
 	NodeID             *NodeID                     `json:"nodeId,omitempty"`             // Id of the node to resolve.
@@ -698,6 +1465,35 @@ func NewResolveNodeArgs() *ResolveNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ResolveNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Id of the node to
 // resolve.
 func (a *ResolveNodeArgs) SetNodeID(nodeID NodeID) *ResolveNodeArgs {
@@ -731,6 +1527,18 @@ type ResolveNodeReply struct {
 	Object runtime.RemoteObject `json:"object"` // JavaScript object wrapper for given node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResolveNodeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "object":
+			err = json.Unmarshal(value, &r.Object)
+		}
+		return err
+	})
+}
+
 // SetAttributeValueArgs represents the arguments for SetAttributeValue in the DOM domain.
 type SetAttributeValueArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the element to set attribute for.
@@ -747,6 +1555,25 @@ func NewSetAttributeValueArgs(nodeID NodeID, name string, value string) *SetAttr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetAttributeValueArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendString(b, a.Value)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetAttributesAsTextArgs represents the arguments for SetAttributesAsText in the DOM domain.
 type SetAttributesAsTextArgs struct {
 	NodeID NodeID  `json:"nodeId"`         // Id of the element to set attributes for.
@@ -762,6 +1589,27 @@ func NewSetAttributesAsTextArgs(nodeID NodeID, text string) *SetAttributesAsText
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetAttributesAsTextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "text")
+	b = fastjson.AppendString(b, a.Text)
+	if a.Name != nil {
+		b = fastjson.AppendKey(b, "name")
+		b = fastjson.AppendString(b, *a.Name)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetName sets the Name optional argument. Attribute name to replace
 // with new attributes derived from text in case text parsed
 // successfully.
@@ -785,6 +1633,35 @@ func NewSetFileInputFilesArgs(files []string) *SetFileInputFilesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetFileInputFilesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "files")
+	if b, err = fastjson.AppendValue(b, a.Files); err != nil {
+		return nil, err
+	}
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the
 // node.
 func (a *SetFileInputFilesArgs) SetNodeID(nodeID NodeID) *SetFileInputFilesArgs {
@@ -818,6 +1695,21 @@ func NewSetNodeStackTracesEnabledArgs(enable bool) *SetNodeStackTracesEnabledArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetNodeStackTracesEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enable")
+	b = fastjson.AppendBool(b, a.Enable)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetNodeStackTracesArgs represents the arguments for GetNodeStackTraces in the DOM domain.
 type GetNodeStackTracesArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to get stack traces for.
@@ -830,11 +1722,38 @@ func NewGetNodeStackTracesArgs(nodeID NodeID) *GetNodeStackTracesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetNodeStackTracesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetNodeStackTracesReply represents the return values for GetNodeStackTraces in the DOM domain.
 type GetNodeStackTracesReply struct {
 	Creation *runtime.StackTrace `json:"creation,omitempty"` // Creation stack trace, if available.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetNodeStackTracesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "creation":
+			err = json.Unmarshal(value, &r.Creation)
+		}
+		return err
+	})
+}
+
 // GetFileInfoArgs represents the arguments for GetFileInfo in the DOM domain.
 type GetFileInfoArgs struct {
 	ObjectID runtime.RemoteObjectID `json:"objectId"` // JavaScript object id of the node wrapper.
@@ -847,11 +1766,38 @@ func NewGetFileInfoArgs(objectID runtime.RemoteObjectID) *GetFileInfoArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetFileInfoArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetFileInfoReply represents the return values for GetFileInfo in the DOM domain.
 type GetFileInfoReply struct {
 	Path string `json:"path"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFileInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "path":
+			r.Path, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // SetInspectedNodeArgs represents the arguments for SetInspectedNode in the DOM domain.
 type SetInspectedNodeArgs struct {
 	NodeID NodeID `json:"nodeId"` // DOM node id to be accessible by means of $x command line API.
@@ -864,6 +1810,21 @@ func NewSetInspectedNodeArgs(nodeID NodeID) *SetInspectedNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetInspectedNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeNameArgs represents the arguments for SetNodeName in the DOM domain.
 type SetNodeNameArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to set name for.
@@ -878,11 +1839,42 @@ func NewSetNodeNameArgs(nodeID NodeID, name string) *SetNodeNameArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetNodeNameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeNameReply represents the return values for SetNodeName in the DOM domain.
 type SetNodeNameReply struct {
 	NodeID NodeID `json:"nodeId"` // New node's id.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetNodeNameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // SetNodeValueArgs represents the arguments for SetNodeValue in the DOM domain.
 type SetNodeValueArgs struct {
 	NodeID NodeID `json:"nodeId"` // Id of the node to set value for.
@@ -897,6 +1889,23 @@ func NewSetNodeValueArgs(nodeID NodeID, value string) *SetNodeValueArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetNodeValueArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendString(b, a.Value)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOuterHTMLArgs represents the arguments for SetOuterHTML in the DOM domain.
 type SetOuterHTMLArgs struct {
 	NodeID    NodeID `json:"nodeId"`    // Id of the node to set markup for.
@@ -911,6 +1920,23 @@ func NewSetOuterHTMLArgs(nodeID NodeID, outerHTML string) *SetOuterHTMLArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetOuterHTMLArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "outerHTML")
+	b = fastjson.AppendString(b, a.OuterHTML)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetFrameOwnerArgs represents the arguments for GetFrameOwner in the DOM domain.
 type GetFrameOwnerArgs struct {
 	FrameID internal.PageFrameID `json:"frameId"` // No description.
@@ -923,8 +1949,46 @@ func NewGetFrameOwnerArgs(frameID internal.PageFrameID) *GetFrameOwnerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetFrameOwnerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetFrameOwnerReply represents the return values for GetFrameOwner in the DOM domain.
 type GetFrameOwnerReply struct {
 	BackendNodeID BackendNodeID `json:"backendNodeId"`    // Resulting node.
 	NodeID        *NodeID       `json:"nodeId,omitempty"` // Id of the node at given coordinates, only when enabled and requested document.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFrameOwnerReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "backendNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.BackendNodeID = BackendNodeID(v)
+		case "nodeId":
+			if fastjson.IsNull(value) {
+				r.NodeID = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			c := NodeID(v)
+			r.NodeID = &c
+		}
+		return err
+	})
+}
@@ -15,6 +15,7 @@ package dom
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -635,14 +636,29 @@ func (d *domainClient) GetFrameOwner(ctx context.Context, args *GetFrameOwnerArg
 	return
 }
 
-func (d *domainClient) AttributeModified(ctx context.Context) (AttributeModifiedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.attributeModified", d.conn)
+func (d *domainClient) AttributeModified(ctx context.Context, opts ...rpcc.StreamOption) (AttributeModifiedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.attributeModified", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &attributeModifiedClient{Stream: s}, nil
 }
 
+// OnAttributeModified registers fn to be called for each
+// AttributeModified event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttributeModified(fn func(*AttributeModifiedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.attributeModified", d.conn, func(args []byte) error {
+		ev := new(AttributeModifiedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type attributeModifiedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -656,14 +672,29 @@ func (c *attributeModifiedClient) Recv() (*AttributeModifiedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AttributeRemoved(ctx context.Context) (AttributeRemovedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.attributeRemoved", d.conn)
+func (d *domainClient) AttributeRemoved(ctx context.Context, opts ...rpcc.StreamOption) (AttributeRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.attributeRemoved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &attributeRemovedClient{Stream: s}, nil
 }
 
+// OnAttributeRemoved registers fn to be called for each
+// AttributeRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttributeRemoved(fn func(*AttributeRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.attributeRemoved", d.conn, func(args []byte) error {
+		ev := new(AttributeRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type attributeRemovedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -677,14 +708,29 @@ func (c *attributeRemovedClient) Recv() (*AttributeRemovedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) CharacterDataModified(ctx context.Context) (CharacterDataModifiedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.characterDataModified", d.conn)
+func (d *domainClient) CharacterDataModified(ctx context.Context, opts ...rpcc.StreamOption) (CharacterDataModifiedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.characterDataModified", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &characterDataModifiedClient{Stream: s}, nil
 }
 
+// OnCharacterDataModified registers fn to be called for each
+// CharacterDataModified event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCharacterDataModified(fn func(*CharacterDataModifiedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.characterDataModified", d.conn, func(args []byte) error {
+		ev := new(CharacterDataModifiedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type characterDataModifiedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -698,14 +744,29 @@ func (c *characterDataModifiedClient) Recv() (*CharacterDataModifiedReply, error
 	return event, nil
 }
 
-func (d *domainClient) ChildNodeCountUpdated(ctx context.Context) (ChildNodeCountUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.childNodeCountUpdated", d.conn)
+func (d *domainClient) ChildNodeCountUpdated(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeCountUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeCountUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &childNodeCountUpdatedClient{Stream: s}, nil
 }
 
+// OnChildNodeCountUpdated registers fn to be called for each
+// ChildNodeCountUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeCountUpdated(fn func(*ChildNodeCountUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeCountUpdated", d.conn, func(args []byte) error {
+		ev := new(ChildNodeCountUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type childNodeCountUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -719,14 +780,29 @@ func (c *childNodeCountUpdatedClient) Recv() (*ChildNodeCountUpdatedReply, error
 	return event, nil
 }
 
-func (d *domainClient) ChildNodeInserted(ctx context.Context) (ChildNodeInsertedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.childNodeInserted", d.conn)
+func (d *domainClient) ChildNodeInserted(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeInsertedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeInserted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &childNodeInsertedClient{Stream: s}, nil
 }
 
+// OnChildNodeInserted registers fn to be called for each
+// ChildNodeInserted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeInserted(fn func(*ChildNodeInsertedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeInserted", d.conn, func(args []byte) error {
+		ev := new(ChildNodeInsertedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type childNodeInsertedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -740,14 +816,29 @@ func (c *childNodeInsertedClient) Recv() (*ChildNodeInsertedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ChildNodeRemoved(ctx context.Context) (ChildNodeRemovedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.childNodeRemoved", d.conn)
+func (d *domainClient) ChildNodeRemoved(ctx context.Context, opts ...rpcc.StreamOption) (ChildNodeRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.childNodeRemoved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &childNodeRemovedClient{Stream: s}, nil
 }
 
+// OnChildNodeRemoved registers fn to be called for each
+// ChildNodeRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnChildNodeRemoved(fn func(*ChildNodeRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.childNodeRemoved", d.conn, func(args []byte) error {
+		ev := new(ChildNodeRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type childNodeRemovedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -761,14 +852,29 @@ func (c *childNodeRemovedClient) Recv() (*ChildNodeRemovedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DistributedNodesUpdated(ctx context.Context) (DistributedNodesUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.distributedNodesUpdated", d.conn)
+func (d *domainClient) DistributedNodesUpdated(ctx context.Context, opts ...rpcc.StreamOption) (DistributedNodesUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.distributedNodesUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &distributedNodesUpdatedClient{Stream: s}, nil
 }
 
+// OnDistributedNodesUpdated registers fn to be called for each
+// DistributedNodesUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDistributedNodesUpdated(fn func(*DistributedNodesUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.distributedNodesUpdated", d.conn, func(args []byte) error {
+		ev := new(DistributedNodesUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type distributedNodesUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -782,14 +888,29 @@ func (c *distributedNodesUpdatedClient) Recv() (*DistributedNodesUpdatedReply, e
 	return event, nil
 }
 
-func (d *domainClient) DocumentUpdated(ctx context.Context) (DocumentUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.documentUpdated", d.conn)
+func (d *domainClient) DocumentUpdated(ctx context.Context, opts ...rpcc.StreamOption) (DocumentUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.documentUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &documentUpdatedClient{Stream: s}, nil
 }
 
+// OnDocumentUpdated registers fn to be called for each
+// DocumentUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDocumentUpdated(fn func(*DocumentUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.documentUpdated", d.conn, func(args []byte) error {
+		ev := new(DocumentUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type documentUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -803,14 +924,29 @@ func (c *documentUpdatedClient) Recv() (*DocumentUpdatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) InlineStyleInvalidated(ctx context.Context) (InlineStyleInvalidatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.inlineStyleInvalidated", d.conn)
+func (d *domainClient) InlineStyleInvalidated(ctx context.Context, opts ...rpcc.StreamOption) (InlineStyleInvalidatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.inlineStyleInvalidated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &inlineStyleInvalidatedClient{Stream: s}, nil
 }
 
+// OnInlineStyleInvalidated registers fn to be called for each
+// InlineStyleInvalidated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInlineStyleInvalidated(fn func(*InlineStyleInvalidatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.inlineStyleInvalidated", d.conn, func(args []byte) error {
+		ev := new(InlineStyleInvalidatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type inlineStyleInvalidatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -824,14 +960,29 @@ func (c *inlineStyleInvalidatedClient) Recv() (*InlineStyleInvalidatedReply, err
 	return event, nil
 }
 
-func (d *domainClient) PseudoElementAdded(ctx context.Context) (PseudoElementAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementAdded", d.conn)
+func (d *domainClient) PseudoElementAdded(ctx context.Context, opts ...rpcc.StreamOption) (PseudoElementAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &pseudoElementAddedClient{Stream: s}, nil
 }
 
+// OnPseudoElementAdded registers fn to be called for each
+// PseudoElementAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPseudoElementAdded(fn func(*PseudoElementAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.pseudoElementAdded", d.conn, func(args []byte) error {
+		ev := new(PseudoElementAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type pseudoElementAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -845,14 +996,29 @@ func (c *pseudoElementAddedClient) Recv() (*PseudoElementAddedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) PseudoElementRemoved(ctx context.Context) (PseudoElementRemovedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementRemoved", d.conn)
+func (d *domainClient) PseudoElementRemoved(ctx context.Context, opts ...rpcc.StreamOption) (PseudoElementRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.pseudoElementRemoved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &pseudoElementRemovedClient{Stream: s}, nil
 }
 
+// OnPseudoElementRemoved registers fn to be called for each
+// PseudoElementRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPseudoElementRemoved(fn func(*PseudoElementRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.pseudoElementRemoved", d.conn, func(args []byte) error {
+		ev := new(PseudoElementRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type pseudoElementRemovedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -866,14 +1032,29 @@ func (c *pseudoElementRemovedClient) Recv() (*PseudoElementRemovedReply, error)
 	return event, nil
 }
 
-func (d *domainClient) SetChildNodes(ctx context.Context) (SetChildNodesClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.setChildNodes", d.conn)
+func (d *domainClient) SetChildNodes(ctx context.Context, opts ...rpcc.StreamOption) (SetChildNodesClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.setChildNodes", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &setChildNodesClient{Stream: s}, nil
 }
 
+// OnSetChildNodes registers fn to be called for each
+// SetChildNodes event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnSetChildNodes(fn func(*SetChildNodesReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.setChildNodes", d.conn, func(args []byte) error {
+		ev := new(SetChildNodesReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type setChildNodesClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -887,14 +1068,29 @@ func (c *setChildNodesClient) Recv() (*SetChildNodesReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ShadowRootPopped(ctx context.Context) (ShadowRootPoppedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPopped", d.conn)
+func (d *domainClient) ShadowRootPopped(ctx context.Context, opts ...rpcc.StreamOption) (ShadowRootPoppedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPopped", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &shadowRootPoppedClient{Stream: s}, nil
 }
 
+// OnShadowRootPopped registers fn to be called for each
+// ShadowRootPopped event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnShadowRootPopped(fn func(*ShadowRootPoppedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.shadowRootPopped", d.conn, func(args []byte) error {
+		ev := new(ShadowRootPoppedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type shadowRootPoppedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -908,14 +1104,29 @@ func (c *shadowRootPoppedClient) Recv() (*ShadowRootPoppedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ShadowRootPushed(ctx context.Context) (ShadowRootPushedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPushed", d.conn)
+func (d *domainClient) ShadowRootPushed(ctx context.Context, opts ...rpcc.StreamOption) (ShadowRootPushedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOM.shadowRootPushed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &shadowRootPushedClient{Stream: s}, nil
 }
 
+// OnShadowRootPushed registers fn to be called for each
+// ShadowRootPushed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnShadowRootPushed(fn func(*ShadowRootPushedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOM.shadowRootPushed", d.conn, func(args []byte) error {
+		ev := new(ShadowRootPushedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type shadowRootPushedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package dom
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -22,6 +25,24 @@ type AttributeModifiedReply struct {
 	Value  string `json:"value"`  // Attribute value.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AttributeModifiedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		case "name":
+			r.Name, err = fastjson.String(value)
+		case "value":
+			r.Value, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // AttributeRemovedClient is a client for AttributeRemoved events. Fired when
 // `Element`'s attribute is removed.
 type AttributeRemovedClient interface {
@@ -37,6 +58,22 @@ type AttributeRemovedReply struct {
 	Name   string `json:"name"`   // A ttribute name.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AttributeRemovedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		case "name":
+			r.Name, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // CharacterDataModifiedClient is a client for CharacterDataModified events.
 // Mirrors `DOMCharacterDataModified` event.
 type CharacterDataModifiedClient interface {
@@ -52,6 +89,22 @@ type CharacterDataModifiedReply struct {
 	CharacterData string `json:"characterData"` // New text value.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CharacterDataModifiedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		case "characterData":
+			r.CharacterData, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // ChildNodeCountUpdatedClient is a client for ChildNodeCountUpdated events.
 // Fired when `Container`'s child node count has changed.
 type ChildNodeCountUpdatedClient interface {
@@ -67,6 +120,22 @@ type ChildNodeCountUpdatedReply struct {
 	ChildNodeCount int    `json:"childNodeCount"` // New node count.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ChildNodeCountUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		case "childNodeCount":
+			r.ChildNodeCount, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // ChildNodeInsertedClient is a client for ChildNodeInserted events. Mirrors
 // `DOMNodeInserted` event.
 type ChildNodeInsertedClient interface {
@@ -83,6 +152,26 @@ type ChildNodeInsertedReply struct {
 	Node           Node   `json:"node"`           // Inserted node data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ChildNodeInsertedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "parentNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ParentNodeID = NodeID(v)
+		case "previousNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.PreviousNodeID = NodeID(v)
+		case "node":
+			err = json.Unmarshal(value, &r.Node)
+		}
+		return err
+	})
+}
+
 // ChildNodeRemovedClient is a client for ChildNodeRemoved events. Mirrors
 // `DOMNodeRemoved` event.
 type ChildNodeRemovedClient interface {
@@ -98,6 +187,24 @@ type ChildNodeRemovedReply struct {
 	NodeID       NodeID `json:"nodeId"`       // Id of the node that has been removed.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ChildNodeRemovedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "parentNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ParentNodeID = NodeID(v)
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // DistributedNodesUpdatedClient is a client for DistributedNodesUpdated events.
 // Called when distribution is changed.
 type DistributedNodesUpdatedClient interface {
@@ -113,6 +220,22 @@ type DistributedNodesUpdatedReply struct {
 	DistributedNodes []BackendNode `json:"distributedNodes"` // Distributed nodes for given insertion point.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DistributedNodesUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "insertionPointId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.InsertionPointID = NodeID(v)
+		case "distributedNodes":
+			err = json.Unmarshal(value, &r.DistributedNodes)
+		}
+		return err
+	})
+}
+
 // DocumentUpdatedClient is a client for DocumentUpdated events. Fired when
 // `Document` has been totally updated. Node ids are no longer valid.
 type DocumentUpdatedClient interface {
@@ -141,6 +264,18 @@ type InlineStyleInvalidatedReply struct {
 	NodeIDs []NodeID `json:"nodeIds"` // Ids of the nodes for which the inline styles have been invalidated.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *InlineStyleInvalidatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeIds":
+			err = json.Unmarshal(value, &r.NodeIDs)
+		}
+		return err
+	})
+}
+
 // PseudoElementAddedClient is a client for PseudoElementAdded events. Called
 // when a pseudo element is added to an element.
 type PseudoElementAddedClient interface {
@@ -156,6 +291,22 @@ type PseudoElementAddedReply struct {
 	PseudoElement Node   `json:"pseudoElement"` // The added pseudo element.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PseudoElementAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "parentId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ParentID = NodeID(v)
+		case "pseudoElement":
+			err = json.Unmarshal(value, &r.PseudoElement)
+		}
+		return err
+	})
+}
+
 // PseudoElementRemovedClient is a client for PseudoElementRemoved events.
 // Called when a pseudo element is removed from an element.
 type PseudoElementRemovedClient interface {
@@ -171,6 +322,24 @@ type PseudoElementRemovedReply struct {
 	PseudoElementID NodeID `json:"pseudoElementId"` // The removed pseudo element id.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PseudoElementRemovedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "parentId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ParentID = NodeID(v)
+		case "pseudoElementId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.PseudoElementID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // SetChildNodesClient is a client for SetChildNodes events. Fired when
 // backend wants to provide client with the missing DOM structure. This happens
 // upon most of the calls requesting node ids.
@@ -187,6 +356,22 @@ type SetChildNodesReply struct {
 	Nodes    []Node `json:"nodes"`    // Child nodes array.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetChildNodesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "parentId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ParentID = NodeID(v)
+		case "nodes":
+			err = json.Unmarshal(value, &r.Nodes)
+		}
+		return err
+	})
+}
+
 // ShadowRootPoppedClient is a client for ShadowRootPopped events. Called when
 // shadow root is popped from the element.
 type ShadowRootPoppedClient interface {
@@ -202,6 +387,24 @@ type ShadowRootPoppedReply struct {
 	RootID NodeID `json:"rootId"` // Shadow root id.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ShadowRootPoppedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "hostId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.HostID = NodeID(v)
+		case "rootId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.RootID = NodeID(v)
+		}
+		return err
+	})
+}
+
 // ShadowRootPushedClient is a client for ShadowRootPushed events. Called when
 // shadow root is pushed into the element.
 type ShadowRootPushedClient interface {
@@ -216,3 +419,19 @@ type ShadowRootPushedReply struct {
 	HostID NodeID `json:"hostId"` // Host element id.
 	Root   Node   `json:"root"`   // Shadow root.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ShadowRootPushedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "hostId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.HostID = NodeID(v)
+		case "root":
+			err = json.Unmarshal(value, &r.Root)
+		}
+		return err
+	})
+}
@@ -3,6 +3,9 @@
 package domdebugger
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
@@ -21,6 +24,29 @@ func NewGetEventListenersArgs(objectID runtime.RemoteObjectID) *GetEventListener
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetEventListenersArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	if a.Depth != nil {
+		b = fastjson.AppendKey(b, "depth")
+		b = fastjson.AppendInt(b, *a.Depth)
+	}
+	if a.Pierce != nil {
+		b = fastjson.AppendKey(b, "pierce")
+		b = fastjson.AppendBool(b, *a.Pierce)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDepth sets the Depth optional argument. The maximum depth at
 // which Node children should be retrieved, defaults to 1. Use -1 for
 // the entire subtree or provide an integer larger than 0.
@@ -43,6 +69,18 @@ type GetEventListenersReply struct {
 	Listeners []EventListener `json:"listeners"` // Array of relevant listeners.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetEventListenersReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "listeners":
+			err = json.Unmarshal(value, &r.Listeners)
+		}
+		return err
+	})
+}
+
 // RemoveDOMBreakpointArgs represents the arguments for RemoveDOMBreakpoint in the DOMDebugger domain.
 type RemoveDOMBreakpointArgs struct {
 	NodeID dom.NodeID        `json:"nodeId"` // Identifier of the node to remove breakpoint from.
@@ -57,6 +95,23 @@ func NewRemoveDOMBreakpointArgs(nodeID dom.NodeID, typ DOMBreakpointType) *Remov
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveDOMBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, string(a.Type))
+	b = append(b, '}')
+	return b, err
+}
+
 // RemoveEventListenerBreakpointArgs represents the arguments for RemoveEventListenerBreakpoint in the DOMDebugger domain.
 type RemoveEventListenerBreakpointArgs struct {
 	EventName string `json:"eventName"` // Event name.
@@ -73,6 +128,25 @@ func NewRemoveEventListenerBreakpointArgs(eventName string) *RemoveEventListener
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveEventListenerBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "eventName")
+	b = fastjson.AppendString(b, a.EventName)
+	if a.TargetName != nil {
+		b = fastjson.AppendKey(b, "targetName")
+		b = fastjson.AppendString(b, *a.TargetName)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTargetName sets the TargetName optional argument. EventTarget
 // interface name.
 //
@@ -94,6 +168,21 @@ func NewRemoveInstrumentationBreakpointArgs(eventName string) *RemoveInstrumenta
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveInstrumentationBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "eventName")
+	b = fastjson.AppendString(b, a.EventName)
+	b = append(b, '}')
+	return b, err
+}
+
 // RemoveXHRBreakpointArgs represents the arguments for RemoveXHRBreakpoint in the DOMDebugger domain.
 type RemoveXHRBreakpointArgs struct {
 	URL string `json:"url"` // Resource URL substring.
@@ -106,6 +195,21 @@ func NewRemoveXHRBreakpointArgs(url string) *RemoveXHRBreakpointArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveXHRBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDOMBreakpointArgs represents the arguments for SetDOMBreakpoint in the DOMDebugger domain.
 type SetDOMBreakpointArgs struct {
 	NodeID dom.NodeID        `json:"nodeId"` // Identifier of the node to set breakpoint on.
@@ -120,6 +224,23 @@ func NewSetDOMBreakpointArgs(nodeID dom.NodeID, typ DOMBreakpointType) *SetDOMBr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDOMBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, string(a.Type))
+	b = append(b, '}')
+	return b, err
+}
+
 // SetEventListenerBreakpointArgs represents the arguments for SetEventListenerBreakpoint in the DOMDebugger domain.
 type SetEventListenerBreakpointArgs struct {
 	EventName string `json:"eventName"` // DOM Event name to stop on (any DOM event will do).
@@ -137,6 +258,25 @@ func NewSetEventListenerBreakpointArgs(eventName string) *SetEventListenerBreakp
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetEventListenerBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "eventName")
+	b = fastjson.AppendString(b, a.EventName)
+	if a.TargetName != nil {
+		b = fastjson.AppendKey(b, "targetName")
+		b = fastjson.AppendString(b, *a.TargetName)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTargetName sets the TargetName optional argument. EventTarget
 // interface name to stop on. If equal to `"*"` or not provided, will
 // stop on any EventTarget.
@@ -159,6 +299,21 @@ func NewSetInstrumentationBreakpointArgs(eventName string) *SetInstrumentationBr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetInstrumentationBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "eventName")
+	b = fastjson.AppendString(b, a.EventName)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetXHRBreakpointArgs represents the arguments for SetXHRBreakpoint in the DOMDebugger domain.
 type SetXHRBreakpointArgs struct {
 	URL string `json:"url"` // Resource URL substring. All XHRs having this substring in the URL will get stopped upon.
@@ -170,3 +325,18 @@ func NewSetXHRBreakpointArgs(url string) *SetXHRBreakpointArgs {
 	args.URL = url
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetXHRBreakpointArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	b = append(b, '}')
+	return b, err
+}
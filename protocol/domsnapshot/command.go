@@ -2,6 +2,12 @@
 
 package domsnapshot
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetSnapshotArgs represents the arguments for GetSnapshot in the DOMSnapshot domain.
 type GetSnapshotArgs struct {
 	ComputedStyleWhitelist     []string `json:"computedStyleWhitelist"`               // Whitelist of computed styles to return.
@@ -17,6 +23,35 @@ func NewGetSnapshotArgs(computedStyleWhitelist []string) *GetSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "computedStyleWhitelist")
+	if b, err = fastjson.AppendValue(b, a.ComputedStyleWhitelist); err != nil {
+		return nil, err
+	}
+	if a.IncludeEventListeners != nil {
+		b = fastjson.AppendKey(b, "includeEventListeners")
+		b = fastjson.AppendBool(b, *a.IncludeEventListeners)
+	}
+	if a.IncludePaintOrder != nil {
+		b = fastjson.AppendKey(b, "includePaintOrder")
+		b = fastjson.AppendBool(b, *a.IncludePaintOrder)
+	}
+	if a.IncludeUserAgentShadowTree != nil {
+		b = fastjson.AppendKey(b, "includeUserAgentShadowTree")
+		b = fastjson.AppendBool(b, *a.IncludeUserAgentShadowTree)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIncludeEventListeners sets the IncludeEventListeners optional argument.
 // Whether or not to retrieve details of DOM listeners (default false).
 func (a *GetSnapshotArgs) SetIncludeEventListeners(includeEventListeners bool) *GetSnapshotArgs {
@@ -46,6 +81,22 @@ type GetSnapshotReply struct {
 	ComputedStyles  []ComputedStyle  `json:"computedStyles"`  // Whitelisted ComputedStyle properties for each node in the layout tree.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "domNodes":
+			err = json.Unmarshal(value, &r.DOMNodes)
+		case "layoutTreeNodes":
+			err = json.Unmarshal(value, &r.LayoutTreeNodes)
+		case "computedStyles":
+			err = json.Unmarshal(value, &r.ComputedStyles)
+		}
+		return err
+	})
+}
+
 // CaptureSnapshotArgs represents the arguments for CaptureSnapshot in the DOMSnapshot domain.
 type CaptureSnapshotArgs struct {
 	ComputedStyles    []string `json:"computedStyles"`              // Whitelist of computed styles to return.
@@ -60,6 +111,31 @@ func NewCaptureSnapshotArgs(computedStyles []string) *CaptureSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CaptureSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "computedStyles")
+	if b, err = fastjson.AppendValue(b, a.ComputedStyles); err != nil {
+		return nil, err
+	}
+	if a.IncludePaintOrder != nil {
+		b = fastjson.AppendKey(b, "includePaintOrder")
+		b = fastjson.AppendBool(b, *a.IncludePaintOrder)
+	}
+	if a.IncludeDOMRects != nil {
+		b = fastjson.AppendKey(b, "includeDOMRects")
+		b = fastjson.AppendBool(b, *a.IncludeDOMRects)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIncludePaintOrder sets the IncludePaintOrder optional argument.
 // Whether to include layout object paint orders into the snapshot.
 func (a *CaptureSnapshotArgs) SetIncludePaintOrder(includePaintOrder bool) *CaptureSnapshotArgs {
@@ -80,3 +156,17 @@ type CaptureSnapshotReply struct {
 	Documents []DocumentSnapshot `json:"documents"` // The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.
 	Strings   []string           `json:"strings"`   // Shared string table that all string properties refer to with indexes.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CaptureSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "documents":
+			err = json.Unmarshal(value, &r.Documents)
+		case "strings":
+			err = json.Unmarshal(value, &r.Strings)
+		}
+		return err
+	})
+}
@@ -2,6 +2,12 @@
 
 package domstorage
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // ClearArgs represents the arguments for Clear in the DOMStorage domain.
 type ClearArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -14,6 +20,23 @@ func NewClearArgs(storageID StorageID) *ClearArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "storageId")
+	if b, err = fastjson.AppendValue(b, a.StorageID); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetDOMStorageItemsArgs represents the arguments for GetDOMStorageItems in the DOMStorage domain.
 type GetDOMStorageItemsArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -26,11 +49,40 @@ func NewGetDOMStorageItemsArgs(storageID StorageID) *GetDOMStorageItemsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetDOMStorageItemsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "storageId")
+	if b, err = fastjson.AppendValue(b, a.StorageID); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetDOMStorageItemsReply represents the return values for GetDOMStorageItems in the DOMStorage domain.
 type GetDOMStorageItemsReply struct {
 	Entries []Item `json:"entries"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetDOMStorageItemsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "entries":
+			err = json.Unmarshal(value, &r.Entries)
+		}
+		return err
+	})
+}
+
 // RemoveDOMStorageItemArgs represents the arguments for RemoveDOMStorageItem in the DOMStorage domain.
 type RemoveDOMStorageItemArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -45,6 +97,25 @@ func NewRemoveDOMStorageItemArgs(storageID StorageID, key string) *RemoveDOMStor
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveDOMStorageItemArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "storageId")
+	if b, err = fastjson.AppendValue(b, a.StorageID); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "key")
+	b = fastjson.AppendString(b, a.Key)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDOMStorageItemArgs represents the arguments for SetDOMStorageItem in the DOMStorage domain.
 type SetDOMStorageItemArgs struct {
 	StorageID StorageID `json:"storageId"` // No description.
@@ -60,3 +131,24 @@ func NewSetDOMStorageItemArgs(storageID StorageID, key string, value string) *Se
 	args.Value = value
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDOMStorageItemArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "storageId")
+	if b, err = fastjson.AppendValue(b, a.StorageID); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "key")
+	b = fastjson.AppendString(b, a.Key)
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendString(b, a.Value)
+	b = append(b, '}')
+	return b, err
+}
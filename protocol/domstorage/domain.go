@@ -6,6 +6,7 @@ package domstorage
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -93,14 +94,29 @@ func (d *domainClient) SetDOMStorageItem(ctx context.Context, args *SetDOMStorag
 	return
 }
 
-func (d *domainClient) DOMStorageItemAdded(ctx context.Context) (ItemAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemAdded", d.conn)
+func (d *domainClient) DOMStorageItemAdded(ctx context.Context, opts ...rpcc.StreamOption) (ItemAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &itemAddedClient{Stream: s}, nil
 }
 
+// OnDOMStorageItemAdded registers fn to be called for each
+// DOMStorageItemAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMStorageItemAdded(fn func(*ItemAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOMStorage.domStorageItemAdded", d.conn, func(args []byte) error {
+		ev := new(ItemAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type itemAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -114,14 +130,29 @@ func (c *itemAddedClient) Recv() (*ItemAddedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DOMStorageItemRemoved(ctx context.Context) (ItemRemovedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemRemoved", d.conn)
+func (d *domainClient) DOMStorageItemRemoved(ctx context.Context, opts ...rpcc.StreamOption) (ItemRemovedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemRemoved", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &itemRemovedClient{Stream: s}, nil
 }
 
+// OnDOMStorageItemRemoved registers fn to be called for each
+// DOMStorageItemRemoved event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMStorageItemRemoved(fn func(*ItemRemovedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOMStorage.domStorageItemRemoved", d.conn, func(args []byte) error {
+		ev := new(ItemRemovedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type itemRemovedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -135,14 +166,29 @@ func (c *itemRemovedClient) Recv() (*ItemRemovedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DOMStorageItemUpdated(ctx context.Context) (ItemUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemUpdated", d.conn)
+func (d *domainClient) DOMStorageItemUpdated(ctx context.Context, opts ...rpcc.StreamOption) (ItemUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &itemUpdatedClient{Stream: s}, nil
 }
 
+// OnDOMStorageItemUpdated registers fn to be called for each
+// DOMStorageItemUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMStorageItemUpdated(fn func(*ItemUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOMStorage.domStorageItemUpdated", d.conn, func(args []byte) error {
+		ev := new(ItemUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type itemUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -156,14 +202,29 @@ func (c *itemUpdatedClient) Recv() (*ItemUpdatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DOMStorageItemsCleared(ctx context.Context) (ItemsClearedClient, error) {
-	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemsCleared", d.conn)
+func (d *domainClient) DOMStorageItemsCleared(ctx context.Context, opts ...rpcc.StreamOption) (ItemsClearedClient, error) {
+	s, err := rpcc.NewStream(ctx, "DOMStorage.domStorageItemsCleared", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &itemsClearedClient{Stream: s}, nil
 }
 
+// OnDOMStorageItemsCleared registers fn to be called for each
+// DOMStorageItemsCleared event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMStorageItemsCleared(fn func(*ItemsClearedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("DOMStorage.domStorageItemsCleared", d.conn, func(args []byte) error {
+		ev := new(ItemsClearedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type itemsClearedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
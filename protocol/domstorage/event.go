@@ -3,6 +3,9 @@
 package domstorage
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,6 +24,22 @@ type ItemAddedReply struct {
 	NewValue  string    `json:"newValue"`  // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ItemAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "storageId":
+			err = json.Unmarshal(value, &r.StorageID)
+		case "key":
+			r.Key, err = fastjson.String(value)
+		case "newValue":
+			r.NewValue, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // ItemRemovedClient is a client for DOMStorageItemRemoved events.
 type ItemRemovedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -35,6 +54,20 @@ type ItemRemovedReply struct {
 	Key       string    `json:"key"`       // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ItemRemovedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "storageId":
+			err = json.Unmarshal(value, &r.StorageID)
+		case "key":
+			r.Key, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // ItemUpdatedClient is a client for DOMStorageItemUpdated events.
 type ItemUpdatedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -51,6 +84,24 @@ type ItemUpdatedReply struct {
 	NewValue  string    `json:"newValue"`  // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ItemUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "storageId":
+			err = json.Unmarshal(value, &r.StorageID)
+		case "key":
+			r.Key, err = fastjson.String(value)
+		case "oldValue":
+			r.OldValue, err = fastjson.String(value)
+		case "newValue":
+			r.NewValue, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // ItemsClearedClient is a client for DOMStorageItemsCleared events.
 type ItemsClearedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -63,3 +114,15 @@ type ItemsClearedClient interface {
 type ItemsClearedReply struct {
 	StorageID StorageID `json:"storageId"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ItemsClearedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "storageId":
+			err = json.Unmarshal(value, &r.StorageID)
+		}
+		return err
+	})
+}
@@ -3,6 +3,7 @@
 package emulation
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/page"
@@ -13,6 +14,18 @@ type CanEmulateReply struct {
 	Result bool `json:"result"` // True if emulation is supported.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CanEmulateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			r.Result, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // SetFocusEmulationEnabledArgs represents the arguments for SetFocusEmulationEnabled in the Emulation domain.
 type SetFocusEmulationEnabledArgs struct {
 	Enabled bool `json:"enabled"` // Whether to enable to disable focus emulation.
@@ -25,6 +38,21 @@ func NewSetFocusEmulationEnabledArgs(enabled bool) *SetFocusEmulationEnabledArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetFocusEmulationEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCPUThrottlingRateArgs represents the arguments for SetCPUThrottlingRate in the Emulation domain.
 type SetCPUThrottlingRateArgs struct {
 	Rate float64 `json:"rate"` // Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
@@ -37,6 +65,23 @@ func NewSetCPUThrottlingRateArgs(rate float64) *SetCPUThrottlingRateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCPUThrottlingRateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "rate")
+	if b, err = fastjson.AppendFloat(b, a.Rate); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDefaultBackgroundColorOverrideArgs represents the arguments for SetDefaultBackgroundColorOverride in the Emulation domain.
 type SetDefaultBackgroundColorOverrideArgs struct {
 	Color *dom.RGBA `json:"color,omitempty"` // RGBA of the default background color. If not specified, any existing override will be cleared.
@@ -49,6 +94,25 @@ func NewSetDefaultBackgroundColorOverrideArgs() *SetDefaultBackgroundColorOverri
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDefaultBackgroundColorOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Color != nil {
+		b = fastjson.AppendKey(b, "color")
+		if b, err = fastjson.AppendValue(b, a.Color); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetColor sets the Color optional argument. RGBA of the default
 // background color. If not specified, any existing override will be
 // cleared.
@@ -111,6 +175,67 @@ func NewSetDeviceMetricsOverrideArgs(width int, height int, deviceScaleFactor fl
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDeviceMetricsOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "width")
+	b = fastjson.AppendInt(b, a.Width)
+	b = fastjson.AppendKey(b, "height")
+	b = fastjson.AppendInt(b, a.Height)
+	b = fastjson.AppendKey(b, "deviceScaleFactor")
+	if b, err = fastjson.AppendFloat(b, a.DeviceScaleFactor); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "mobile")
+	b = fastjson.AppendBool(b, a.Mobile)
+	if a.Scale != nil {
+		b = fastjson.AppendKey(b, "scale")
+		if b, err = fastjson.AppendFloat(b, *a.Scale); err != nil {
+			return nil, err
+		}
+	}
+	if a.ScreenWidth != nil {
+		b = fastjson.AppendKey(b, "screenWidth")
+		b = fastjson.AppendInt(b, *a.ScreenWidth)
+	}
+	if a.ScreenHeight != nil {
+		b = fastjson.AppendKey(b, "screenHeight")
+		b = fastjson.AppendInt(b, *a.ScreenHeight)
+	}
+	if a.PositionX != nil {
+		b = fastjson.AppendKey(b, "positionX")
+		b = fastjson.AppendInt(b, *a.PositionX)
+	}
+	if a.PositionY != nil {
+		b = fastjson.AppendKey(b, "positionY")
+		b = fastjson.AppendInt(b, *a.PositionY)
+	}
+	if a.DontSetVisibleSize != nil {
+		b = fastjson.AppendKey(b, "dontSetVisibleSize")
+		b = fastjson.AppendBool(b, *a.DontSetVisibleSize)
+	}
+	if a.ScreenOrientation != nil {
+		b = fastjson.AppendKey(b, "screenOrientation")
+		if b, err = fastjson.AppendValue(b, a.ScreenOrientation); err != nil {
+			return nil, err
+		}
+	}
+	if a.Viewport != nil {
+		b = fastjson.AppendKey(b, "viewport")
+		if b, err = fastjson.AppendValue(b, a.Viewport); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetScale sets the Scale optional argument. Scale to apply to
 // resulting view image.
 //
@@ -196,6 +321,21 @@ func NewSetScrollbarsHiddenArgs(hidden bool) *SetScrollbarsHiddenArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetScrollbarsHiddenArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "hidden")
+	b = fastjson.AppendBool(b, a.Hidden)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDocumentCookieDisabledArgs represents the arguments for SetDocumentCookieDisabled in the Emulation domain.
 type SetDocumentCookieDisabledArgs struct {
 	Disabled bool `json:"disabled"` // Whether document.coookie API should be disabled.
@@ -208,6 +348,21 @@ func NewSetDocumentCookieDisabledArgs(disabled bool) *SetDocumentCookieDisabledA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDocumentCookieDisabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "disabled")
+	b = fastjson.AppendBool(b, a.Disabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetEmitTouchEventsForMouseArgs represents the arguments for SetEmitTouchEventsForMouse in the Emulation domain.
 type SetEmitTouchEventsForMouseArgs struct {
 	Enabled bool `json:"enabled"` // Whether touch emulation based on mouse input should be enabled.
@@ -225,6 +380,25 @@ func NewSetEmitTouchEventsForMouseArgs(enabled bool) *SetEmitTouchEventsForMouse
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetEmitTouchEventsForMouseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	if a.Configuration != nil {
+		b = fastjson.AppendKey(b, "configuration")
+		b = fastjson.AppendString(b, *a.Configuration)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetConfiguration sets the Configuration optional argument.
 // Touch/gesture events configuration. Default: current platform.
 //
@@ -247,6 +421,29 @@ func NewSetEmulatedMediaArgs() *SetEmulatedMediaArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetEmulatedMediaArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Media != nil {
+		b = fastjson.AppendKey(b, "media")
+		b = fastjson.AppendString(b, *a.Media)
+	}
+	if len(a.Features) > 0 {
+		b = fastjson.AppendKey(b, "features")
+		if b, err = fastjson.AppendValue(b, a.Features); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMedia sets the Media optional argument. Media type to emulate.
 // Empty string disables the override.
 func (a *SetEmulatedMediaArgs) SetMedia(media string) *SetEmulatedMediaArgs {
@@ -276,6 +473,21 @@ func NewSetEmulatedVisionDeficiencyArgs(typ string) *SetEmulatedVisionDeficiency
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetEmulatedVisionDeficiencyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, a.Type)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetGeolocationOverrideArgs represents the arguments for SetGeolocationOverride in the Emulation domain.
 type SetGeolocationOverrideArgs struct {
 	Latitude  *float64 `json:"latitude,omitempty"`  // Mock latitude
@@ -290,6 +502,37 @@ func NewSetGeolocationOverrideArgs() *SetGeolocationOverrideArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetGeolocationOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Latitude != nil {
+		b = fastjson.AppendKey(b, "latitude")
+		if b, err = fastjson.AppendFloat(b, *a.Latitude); err != nil {
+			return nil, err
+		}
+	}
+	if a.Longitude != nil {
+		b = fastjson.AppendKey(b, "longitude")
+		if b, err = fastjson.AppendFloat(b, *a.Longitude); err != nil {
+			return nil, err
+		}
+	}
+	if a.Accuracy != nil {
+		b = fastjson.AppendKey(b, "accuracy")
+		if b, err = fastjson.AppendFloat(b, *a.Accuracy); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetLatitude sets the Latitude optional argument. Mock latitude
 func (a *SetGeolocationOverrideArgs) SetLatitude(latitude float64) *SetGeolocationOverrideArgs {
 	a.Latitude = &latitude
@@ -320,6 +563,21 @@ func NewSetNavigatorOverridesArgs(platform string) *SetNavigatorOverridesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetNavigatorOverridesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "platform")
+	b = fastjson.AppendString(b, a.Platform)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPageScaleFactorArgs represents the arguments for SetPageScaleFactor in the Emulation domain.
 type SetPageScaleFactorArgs struct {
 	PageScaleFactor float64 `json:"pageScaleFactor"` // Page scale factor.
@@ -332,6 +590,23 @@ func NewSetPageScaleFactorArgs(pageScaleFactor float64) *SetPageScaleFactorArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPageScaleFactorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "pageScaleFactor")
+	if b, err = fastjson.AppendFloat(b, a.PageScaleFactor); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetScriptExecutionDisabledArgs represents the arguments for SetScriptExecutionDisabled in the Emulation domain.
 type SetScriptExecutionDisabledArgs struct {
 	Value bool `json:"value"` // Whether script execution should be disabled in the page.
@@ -344,6 +619,21 @@ func NewSetScriptExecutionDisabledArgs(value bool) *SetScriptExecutionDisabledAr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetScriptExecutionDisabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendBool(b, a.Value)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTouchEmulationEnabledArgs represents the arguments for SetTouchEmulationEnabled in the Emulation domain.
 type SetTouchEmulationEnabledArgs struct {
 	Enabled        bool `json:"enabled"`                  // Whether the touch event emulation should be enabled.
@@ -357,6 +647,25 @@ func NewSetTouchEmulationEnabledArgs(enabled bool) *SetTouchEmulationEnabledArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetTouchEmulationEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	if a.MaxTouchPoints != nil {
+		b = fastjson.AppendKey(b, "maxTouchPoints")
+		b = fastjson.AppendInt(b, *a.MaxTouchPoints)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMaxTouchPoints sets the MaxTouchPoints optional argument.
 // Maximum touch points supported. Defaults to one.
 func (a *SetTouchEmulationEnabledArgs) SetMaxTouchPoints(maxTouchPoints int) *SetTouchEmulationEnabledArgs {
@@ -380,6 +689,41 @@ func NewSetVirtualTimePolicyArgs(policy VirtualTimePolicy) *SetVirtualTimePolicy
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetVirtualTimePolicyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "policy")
+	b = fastjson.AppendString(b, string(a.Policy))
+	if a.Budget != nil {
+		b = fastjson.AppendKey(b, "budget")
+		if b, err = fastjson.AppendFloat(b, *a.Budget); err != nil {
+			return nil, err
+		}
+	}
+	if a.MaxVirtualTimeTaskStarvationCount != nil {
+		b = fastjson.AppendKey(b, "maxVirtualTimeTaskStarvationCount")
+		b = fastjson.AppendInt(b, *a.MaxVirtualTimeTaskStarvationCount)
+	}
+	if a.WaitForNavigation != nil {
+		b = fastjson.AppendKey(b, "waitForNavigation")
+		b = fastjson.AppendBool(b, *a.WaitForNavigation)
+	}
+	if a.InitialVirtualTime != 0 {
+		b = fastjson.AppendKey(b, "initialVirtualTime")
+		if b, err = fastjson.AppendValue(b, a.InitialVirtualTime); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBudget sets the Budget optional argument. If set, after this
 // many virtual milliseconds have elapsed virtual time will be paused
 // and a virtualTimeBudgetExpired event is sent.
@@ -418,6 +762,18 @@ type SetVirtualTimePolicyReply struct {
 	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"` // Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetVirtualTimePolicyReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "virtualTimeTicksBase":
+			r.VirtualTimeTicksBase, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // SetLocaleOverrideArgs represents the arguments for SetLocaleOverride in the Emulation domain.
 type SetLocaleOverrideArgs struct {
 	Locale *string `json:"locale,omitempty"` // ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and restores default host system locale.
@@ -430,6 +786,23 @@ func NewSetLocaleOverrideArgs() *SetLocaleOverrideArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetLocaleOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Locale != nil {
+		b = fastjson.AppendKey(b, "locale")
+		b = fastjson.AppendString(b, *a.Locale)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetLocale sets the Locale optional argument. ICU style C locale
 // (e.g. "en_US"). If not specified or empty, disables the override and
 // restores default host system locale.
@@ -450,6 +823,21 @@ func NewSetTimezoneOverrideArgs(timezoneID string) *SetTimezoneOverrideArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetTimezoneOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "timezoneId")
+	b = fastjson.AppendString(b, a.TimezoneID)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetVisibleSizeArgs represents the arguments for SetVisibleSize in the Emulation domain.
 type SetVisibleSizeArgs struct {
 	Width  int `json:"width"`  // Frame width (DIP).
@@ -464,6 +852,23 @@ func NewSetVisibleSizeArgs(width int, height int) *SetVisibleSizeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetVisibleSizeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "width")
+	b = fastjson.AppendInt(b, a.Width)
+	b = fastjson.AppendKey(b, "height")
+	b = fastjson.AppendInt(b, a.Height)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetUserAgentOverrideArgs represents the arguments for SetUserAgentOverride in the Emulation domain.
 type SetUserAgentOverrideArgs struct {
 	UserAgent      string  `json:"userAgent"`                // User agent to use.
@@ -483,6 +888,35 @@ func NewSetUserAgentOverrideArgs(userAgent string) *SetUserAgentOverrideArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetUserAgentOverrideArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "userAgent")
+	b = fastjson.AppendString(b, a.UserAgent)
+	if a.AcceptLanguage != nil {
+		b = fastjson.AppendKey(b, "acceptLanguage")
+		b = fastjson.AppendString(b, *a.AcceptLanguage)
+	}
+	if a.Platform != nil {
+		b = fastjson.AppendKey(b, "platform")
+		b = fastjson.AppendString(b, *a.Platform)
+	}
+	if a.UserAgentMetadata != nil {
+		b = fastjson.AppendKey(b, "userAgentMetadata")
+		if b, err = fastjson.AppendValue(b, a.UserAgentMetadata); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetAcceptLanguage sets the AcceptLanguage optional argument.
 // Browser langugage to emulate.
 func (a *SetUserAgentOverrideArgs) SetAcceptLanguage(acceptLanguage string) *SetUserAgentOverrideArgs {
@@ -6,6 +6,7 @@ package emulation
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -333,14 +334,29 @@ func (d *domainClient) SetUserAgentOverride(ctx context.Context, args *SetUserAg
 	return
 }
 
-func (d *domainClient) VirtualTimeBudgetExpired(ctx context.Context) (VirtualTimeBudgetExpiredClient, error) {
-	s, err := rpcc.NewStream(ctx, "Emulation.virtualTimeBudgetExpired", d.conn)
+func (d *domainClient) VirtualTimeBudgetExpired(ctx context.Context, opts ...rpcc.StreamOption) (VirtualTimeBudgetExpiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Emulation.virtualTimeBudgetExpired", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &virtualTimeBudgetExpiredClient{Stream: s}, nil
 }
 
+// OnVirtualTimeBudgetExpired registers fn to be called for each
+// VirtualTimeBudgetExpired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnVirtualTimeBudgetExpired(fn func(*VirtualTimeBudgetExpiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Emulation.virtualTimeBudgetExpired", d.conn, func(args []byte) error {
+		ev := new(VirtualTimeBudgetExpiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type virtualTimeBudgetExpiredClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
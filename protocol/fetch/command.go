@@ -3,6 +3,7 @@
 package fetch
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/io"
 	"github.com/mafredri/cdp/protocol/network"
 )
@@ -20,6 +21,29 @@ func NewEnableArgs() *EnableArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EnableArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if len(a.Patterns) > 0 {
+		b = fastjson.AppendKey(b, "patterns")
+		if b, err = fastjson.AppendValue(b, a.Patterns); err != nil {
+			return nil, err
+		}
+	}
+	if a.HandleAuthRequests != nil {
+		b = fastjson.AppendKey(b, "handleAuthRequests")
+		b = fastjson.AppendBool(b, *a.HandleAuthRequests)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPatterns sets the Patterns optional argument. If specified, only
 // requests matching any of these patterns will produce fetchRequested
 // event and will be paused until clients response. If not set, all
@@ -51,6 +75,23 @@ func NewFailRequestArgs(requestID RequestID, errorReason network.ErrorReason) *F
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *FailRequestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = fastjson.AppendKey(b, "errorReason")
+	b = fastjson.AppendString(b, string(a.ErrorReason))
+	b = append(b, '}')
+	return b, err
+}
+
 // FulfillRequestArgs represents the arguments for FulfillRequest in the Fetch domain.
 type FulfillRequestArgs struct {
 	RequestID             RequestID     `json:"requestId"`                       // An id the client received in requestPaused event.
@@ -69,6 +110,41 @@ func NewFulfillRequestArgs(requestID RequestID, responseCode int) *FulfillReques
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *FulfillRequestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = fastjson.AppendKey(b, "responseCode")
+	b = fastjson.AppendInt(b, a.ResponseCode)
+	if len(a.ResponseHeaders) > 0 {
+		b = fastjson.AppendKey(b, "responseHeaders")
+		if b, err = fastjson.AppendValue(b, a.ResponseHeaders); err != nil {
+			return nil, err
+		}
+	}
+	if a.BinaryResponseHeaders != nil {
+		b = fastjson.AppendKey(b, "binaryResponseHeaders")
+		b = fastjson.AppendString(b, *a.BinaryResponseHeaders)
+	}
+	if a.Body != nil {
+		b = fastjson.AppendKey(b, "body")
+		b = fastjson.AppendString(b, *a.Body)
+	}
+	if a.ResponsePhrase != nil {
+		b = fastjson.AppendKey(b, "responsePhrase")
+		b = fastjson.AppendString(b, *a.ResponsePhrase)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetResponseHeaders sets the ResponseHeaders optional argument.
 // Response headers.
 func (a *FulfillRequestArgs) SetResponseHeaders(responseHeaders []HeaderEntry) *FulfillRequestArgs {
@@ -116,6 +192,39 @@ func NewContinueRequestArgs(requestID RequestID) *ContinueRequestArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ContinueRequestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	if a.URL != nil {
+		b = fastjson.AppendKey(b, "url")
+		b = fastjson.AppendString(b, *a.URL)
+	}
+	if a.Method != nil {
+		b = fastjson.AppendKey(b, "method")
+		b = fastjson.AppendString(b, *a.Method)
+	}
+	if a.PostData != nil {
+		b = fastjson.AppendKey(b, "postData")
+		b = fastjson.AppendString(b, *a.PostData)
+	}
+	if len(a.Headers) > 0 {
+		b = fastjson.AppendKey(b, "headers")
+		if b, err = fastjson.AppendValue(b, a.Headers); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetURL sets the URL optional argument. If set, the request url will
 // be modified in a way that's not observable by page.
 func (a *ContinueRequestArgs) SetURL(url string) *ContinueRequestArgs {
@@ -158,6 +267,25 @@ func NewContinueWithAuthArgs(requestID RequestID, authChallengeResponse AuthChal
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ContinueWithAuthArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = fastjson.AppendKey(b, "authChallengeResponse")
+	if b, err = fastjson.AppendValue(b, a.AuthChallengeResponse); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetResponseBodyArgs represents the arguments for GetResponseBody in the Fetch domain.
 type GetResponseBodyArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier for the intercepted request to get body for.
@@ -170,12 +298,41 @@ func NewGetResponseBodyArgs(requestID RequestID) *GetResponseBodyArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetResponseBodyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetResponseBodyReply represents the return values for GetResponseBody in the Fetch domain.
 type GetResponseBodyReply struct {
 	Body          string `json:"body"`          // Response body.
 	Base64Encoded bool   `json:"base64Encoded"` // True, if content was sent as base64.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetResponseBodyReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "body":
+			r.Body, err = fastjson.String(value)
+		case "base64Encoded":
+			r.Base64Encoded, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // TakeResponseBodyAsStreamArgs represents the arguments for TakeResponseBodyAsStream in the Fetch domain.
 type TakeResponseBodyAsStreamArgs struct {
 	RequestID RequestID `json:"requestId"` // No description.
@@ -188,7 +345,36 @@ func NewTakeResponseBodyAsStreamArgs(requestID RequestID) *TakeResponseBodyAsStr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *TakeResponseBodyAsStreamArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = append(b, '}')
+	return b, err
+}
+
 // TakeResponseBodyAsStreamReply represents the return values for TakeResponseBodyAsStream in the Fetch domain.
 type TakeResponseBodyAsStreamReply struct {
 	Stream io.StreamHandle `json:"stream"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *TakeResponseBodyAsStreamReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "stream":
+			var v string
+			v, err = fastjson.String(value)
+			r.Stream = io.StreamHandle(v)
+		}
+		return err
+	})
+}
@@ -6,6 +6,7 @@ package fetch
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -139,14 +140,29 @@ func (d *domainClient) TakeResponseBodyAsStream(ctx context.Context, args *TakeR
 	return
 }
 
-func (d *domainClient) RequestPaused(ctx context.Context) (RequestPausedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Fetch.requestPaused", d.conn)
+func (d *domainClient) RequestPaused(ctx context.Context, opts ...rpcc.StreamOption) (RequestPausedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Fetch.requestPaused", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &requestPausedClient{Stream: s}, nil
 }
 
+// OnRequestPaused registers fn to be called for each
+// RequestPaused event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRequestPaused(fn func(*RequestPausedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Fetch.requestPaused", d.conn, func(args []byte) error {
+		ev := new(RequestPausedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type requestPausedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -160,14 +176,29 @@ func (c *requestPausedClient) Recv() (*RequestPausedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AuthRequired(ctx context.Context) (AuthRequiredClient, error) {
-	s, err := rpcc.NewStream(ctx, "Fetch.authRequired", d.conn)
+func (d *domainClient) AuthRequired(ctx context.Context, opts ...rpcc.StreamOption) (AuthRequiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Fetch.authRequired", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &authRequiredClient{Stream: s}, nil
 }
 
+// OnAuthRequired registers fn to be called for each
+// AuthRequired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAuthRequired(fn func(*AuthRequiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Fetch.authRequired", d.conn, func(args []byte) error {
+		ev := new(AuthRequiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type authRequiredClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package fetch
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/rpcc"
@@ -34,6 +37,58 @@ type RequestPausedReply struct {
 	NetworkID           *RequestID           `json:"networkId,omitempty"`           // If the intercepted request had a corresponding Network.requestWillBeSent event fired for it, then this networkId will be the same as the requestId present in the requestWillBeSent event.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestPausedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "request":
+			err = json.Unmarshal(value, &r.Request)
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = page.FrameID(v)
+		case "resourceType":
+			var v string
+			v, err = fastjson.String(value)
+			r.ResourceType = network.ResourceType(v)
+		case "responseErrorReason":
+			if fastjson.IsNull(value) {
+				r.ResponseErrorReason = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := network.ErrorReason(v)
+			r.ResponseErrorReason = &c
+		case "responseStatusCode":
+			if fastjson.IsNull(value) {
+				r.ResponseStatusCode = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.ResponseStatusCode = &v
+		case "responseHeaders":
+			err = json.Unmarshal(value, &r.ResponseHeaders)
+		case "networkId":
+			if fastjson.IsNull(value) {
+				r.NetworkID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := RequestID(v)
+			r.NetworkID = &c
+		}
+		return err
+	})
+}
+
 // AuthRequiredClient is a client for AuthRequired events. Issued when the
 // domain is enabled with handleAuthRequests set to true. The request is paused
 // until client responds with continueWithAuth.
@@ -52,3 +107,29 @@ type AuthRequiredReply struct {
 	ResourceType  network.ResourceType `json:"resourceType"`  // How the requested resource will be used.
 	AuthChallenge AuthChallenge        `json:"authChallenge"` // Details of the Authorization Challenge encountered. If this is set, client should respond with continueRequest that contains AuthChallengeResponse.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AuthRequiredReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "request":
+			err = json.Unmarshal(value, &r.Request)
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = page.FrameID(v)
+		case "resourceType":
+			var v string
+			v, err = fastjson.String(value)
+			r.ResourceType = network.ResourceType(v)
+		case "authChallenge":
+			err = json.Unmarshal(value, &r.AuthChallenge)
+		}
+		return err
+	})
+}
@@ -2,6 +2,12 @@
 
 package headlessexperimental
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // BeginFrameArgs represents the arguments for BeginFrame in the HeadlessExperimental domain.
 type BeginFrameArgs struct {
 	FrameTimeTicks   *float64          `json:"frameTimeTicks,omitempty"`   // Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of uptime). If not set, the current time will be used.
@@ -17,6 +23,41 @@ func NewBeginFrameArgs() *BeginFrameArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *BeginFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.FrameTimeTicks != nil {
+		b = fastjson.AppendKey(b, "frameTimeTicks")
+		if b, err = fastjson.AppendFloat(b, *a.FrameTimeTicks); err != nil {
+			return nil, err
+		}
+	}
+	if a.Interval != nil {
+		b = fastjson.AppendKey(b, "interval")
+		if b, err = fastjson.AppendFloat(b, *a.Interval); err != nil {
+			return nil, err
+		}
+	}
+	if a.NoDisplayUpdates != nil {
+		b = fastjson.AppendKey(b, "noDisplayUpdates")
+		b = fastjson.AppendBool(b, *a.NoDisplayUpdates)
+	}
+	if a.Screenshot != nil {
+		b = fastjson.AppendKey(b, "screenshot")
+		if b, err = fastjson.AppendValue(b, a.Screenshot); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFrameTimeTicks sets the FrameTimeTicks optional argument.
 // Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of
 // uptime). If not set, the current time will be used.
@@ -59,3 +100,17 @@ type BeginFrameReply struct {
 	HasDamage      bool   `json:"hasDamage"`                // Whether the BeginFrame resulted in damage and, thus, a new frame was committed to the display. Reported for diagnostic uses, may be removed in the future.
 	ScreenshotData []byte `json:"screenshotData,omitempty"` // Base64-encoded image data of the screenshot, if one was requested and successfully taken.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *BeginFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "hasDamage":
+			r.HasDamage, err = fastjson.Bool(value)
+		case "screenshotData":
+			err = json.Unmarshal(value, &r.ScreenshotData)
+		}
+		return err
+	})
+}
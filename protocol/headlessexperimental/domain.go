@@ -6,6 +6,7 @@ package headlessexperimental
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -59,14 +60,29 @@ func (d *domainClient) Enable(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) NeedsBeginFramesChanged(ctx context.Context) (NeedsBeginFramesChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeadlessExperimental.needsBeginFramesChanged", d.conn)
+func (d *domainClient) NeedsBeginFramesChanged(ctx context.Context, opts ...rpcc.StreamOption) (NeedsBeginFramesChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeadlessExperimental.needsBeginFramesChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &needsBeginFramesChangedClient{Stream: s}, nil
 }
 
+// OnNeedsBeginFramesChanged registers fn to be called for each
+// NeedsBeginFramesChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNeedsBeginFramesChanged(fn func(*NeedsBeginFramesChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeadlessExperimental.needsBeginFramesChanged", d.conn, func(args []byte) error {
+		ev := new(NeedsBeginFramesChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type needsBeginFramesChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,7 @@
 package headlessexperimental
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,3 +22,15 @@ type NeedsBeginFramesChangedClient interface {
 type NeedsBeginFramesChangedReply struct {
 	NeedsBeginFrames bool `json:"needsBeginFrames"` // True if BeginFrames are needed, false otherwise.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NeedsBeginFramesChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "needsBeginFrames":
+			r.NeedsBeginFrames, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
@@ -3,6 +3,9 @@
 package heapprofiler
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -18,6 +21,21 @@ func NewAddInspectedHeapObjectArgs(heapObjectID HeapSnapshotObjectID) *AddInspec
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddInspectedHeapObjectArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "heapObjectId")
+	b = fastjson.AppendString(b, string(a.HeapObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetHeapObjectIDArgs represents the arguments for GetHeapObjectID in the HeapProfiler domain.
 type GetHeapObjectIDArgs struct {
 	ObjectID runtime.RemoteObjectID `json:"objectId"` // Identifier of the object to get heap object id for.
@@ -30,11 +48,40 @@ func NewGetHeapObjectIDArgs(objectID runtime.RemoteObjectID) *GetHeapObjectIDArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetHeapObjectIDArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetHeapObjectIDReply represents the return values for GetHeapObjectID in the HeapProfiler domain.
 type GetHeapObjectIDReply struct {
 	HeapSnapshotObjectID HeapSnapshotObjectID `json:"heapSnapshotObjectId"` // Id of the heap snapshot object corresponding to the passed remote object id.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetHeapObjectIDReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "heapSnapshotObjectId":
+			var v string
+			v, err = fastjson.String(value)
+			r.HeapSnapshotObjectID = HeapSnapshotObjectID(v)
+		}
+		return err
+	})
+}
+
 // GetObjectByHeapObjectIDArgs represents the arguments for GetObjectByHeapObjectID in the HeapProfiler domain.
 type GetObjectByHeapObjectIDArgs struct {
 	ObjectID    HeapSnapshotObjectID `json:"objectId"`              // No description.
@@ -48,6 +95,25 @@ func NewGetObjectByHeapObjectIDArgs(objectID HeapSnapshotObjectID) *GetObjectByH
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetObjectByHeapObjectIDArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetObjectGroup sets the ObjectGroup optional argument. Symbolic
 // group name that can be used to release multiple objects.
 func (a *GetObjectByHeapObjectIDArgs) SetObjectGroup(objectGroup string) *GetObjectByHeapObjectIDArgs {
@@ -60,11 +126,35 @@ type GetObjectByHeapObjectIDReply struct {
 	Result runtime.RemoteObject `json:"result"` // Evaluation result.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetObjectByHeapObjectIDReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // GetSamplingProfileReply represents the return values for GetSamplingProfile in the HeapProfiler domain.
 type GetSamplingProfileReply struct {
 	Profile SamplingHeapProfile `json:"profile"` // Return the sampling profile being collected.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetSamplingProfileReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
+
 // StartSamplingArgs represents the arguments for StartSampling in the HeapProfiler domain.
 type StartSamplingArgs struct {
 	SamplingInterval *float64 `json:"samplingInterval,omitempty"` // Average sample interval in bytes. Poisson distribution is used for the intervals. The default value is 32768 bytes.
@@ -77,6 +167,25 @@ func NewStartSamplingArgs() *StartSamplingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartSamplingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.SamplingInterval != nil {
+		b = fastjson.AppendKey(b, "samplingInterval")
+		if b, err = fastjson.AppendFloat(b, *a.SamplingInterval); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetSamplingInterval sets the SamplingInterval optional argument.
 // Average sample interval in bytes. Poisson distribution is used for
 // the intervals. The default value is 32768 bytes.
@@ -97,6 +206,23 @@ func NewStartTrackingHeapObjectsArgs() *StartTrackingHeapObjectsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartTrackingHeapObjectsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.TrackAllocations != nil {
+		b = fastjson.AppendKey(b, "trackAllocations")
+		b = fastjson.AppendBool(b, *a.TrackAllocations)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTrackAllocations sets the TrackAllocations optional argument.
 func (a *StartTrackingHeapObjectsArgs) SetTrackAllocations(trackAllocations bool) *StartTrackingHeapObjectsArgs {
 	a.TrackAllocations = &trackAllocations
@@ -108,6 +234,18 @@ type StopSamplingReply struct {
 	Profile SamplingHeapProfile `json:"profile"` // Recorded sampling heap profile.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StopSamplingReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
+
 // StopTrackingHeapObjectsArgs represents the arguments for StopTrackingHeapObjects in the HeapProfiler domain.
 type StopTrackingHeapObjectsArgs struct {
 	ReportProgress            *bool `json:"reportProgress,omitempty"`            // If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken when the tracking is stopped.
@@ -121,6 +259,27 @@ func NewStopTrackingHeapObjectsArgs() *StopTrackingHeapObjectsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StopTrackingHeapObjectsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.ReportProgress != nil {
+		b = fastjson.AppendKey(b, "reportProgress")
+		b = fastjson.AppendBool(b, *a.ReportProgress)
+	}
+	if a.TreatGlobalObjectsAsRoots != nil {
+		b = fastjson.AppendKey(b, "treatGlobalObjectsAsRoots")
+		b = fastjson.AppendBool(b, *a.TreatGlobalObjectsAsRoots)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetReportProgress sets the ReportProgress optional argument. If
 // true 'reportHeapSnapshotProgress' events will be generated while
 // snapshot is being taken when the tracking is stopped.
@@ -148,6 +307,27 @@ func NewTakeHeapSnapshotArgs() *TakeHeapSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *TakeHeapSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.ReportProgress != nil {
+		b = fastjson.AppendKey(b, "reportProgress")
+		b = fastjson.AppendBool(b, *a.ReportProgress)
+	}
+	if a.TreatGlobalObjectsAsRoots != nil {
+		b = fastjson.AppendKey(b, "treatGlobalObjectsAsRoots")
+		b = fastjson.AppendBool(b, *a.TreatGlobalObjectsAsRoots)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetReportProgress sets the ReportProgress optional argument. If
 // true 'reportHeapSnapshotProgress' events will be generated while
 // snapshot is being taken.
@@ -5,6 +5,7 @@ package heapprofiler
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -160,14 +161,29 @@ func (d *domainClient) TakeHeapSnapshot(ctx context.Context, args *TakeHeapSnaps
 	return
 }
 
-func (d *domainClient) AddHeapSnapshotChunk(ctx context.Context) (AddHeapSnapshotChunkClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeapProfiler.addHeapSnapshotChunk", d.conn)
+func (d *domainClient) AddHeapSnapshotChunk(ctx context.Context, opts ...rpcc.StreamOption) (AddHeapSnapshotChunkClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.addHeapSnapshotChunk", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &addHeapSnapshotChunkClient{Stream: s}, nil
 }
 
+// OnAddHeapSnapshotChunk registers fn to be called for each
+// AddHeapSnapshotChunk event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAddHeapSnapshotChunk(fn func(*AddHeapSnapshotChunkReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.addHeapSnapshotChunk", d.conn, func(args []byte) error {
+		ev := new(AddHeapSnapshotChunkReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type addHeapSnapshotChunkClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -181,14 +197,29 @@ func (c *addHeapSnapshotChunkClient) Recv() (*AddHeapSnapshotChunkReply, error)
 	return event, nil
 }
 
-func (d *domainClient) HeapStatsUpdate(ctx context.Context) (HeapStatsUpdateClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeapProfiler.heapStatsUpdate", d.conn)
+func (d *domainClient) HeapStatsUpdate(ctx context.Context, opts ...rpcc.StreamOption) (HeapStatsUpdateClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.heapStatsUpdate", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &heapStatsUpdateClient{Stream: s}, nil
 }
 
+// OnHeapStatsUpdate registers fn to be called for each
+// HeapStatsUpdate event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnHeapStatsUpdate(fn func(*HeapStatsUpdateReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.heapStatsUpdate", d.conn, func(args []byte) error {
+		ev := new(HeapStatsUpdateReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type heapStatsUpdateClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -202,14 +233,29 @@ func (c *heapStatsUpdateClient) Recv() (*HeapStatsUpdateReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) LastSeenObjectID(ctx context.Context) (LastSeenObjectIDClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeapProfiler.lastSeenObjectId", d.conn)
+func (d *domainClient) LastSeenObjectID(ctx context.Context, opts ...rpcc.StreamOption) (LastSeenObjectIDClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.lastSeenObjectId", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &lastSeenObjectIDClient{Stream: s}, nil
 }
 
+// OnLastSeenObjectID registers fn to be called for each
+// LastSeenObjectID event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLastSeenObjectID(fn func(*LastSeenObjectIDReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.lastSeenObjectId", d.conn, func(args []byte) error {
+		ev := new(LastSeenObjectIDReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type lastSeenObjectIDClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -223,14 +269,29 @@ func (c *lastSeenObjectIDClient) Recv() (*LastSeenObjectIDReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ReportHeapSnapshotProgress(ctx context.Context) (ReportHeapSnapshotProgressClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeapProfiler.reportHeapSnapshotProgress", d.conn)
+func (d *domainClient) ReportHeapSnapshotProgress(ctx context.Context, opts ...rpcc.StreamOption) (ReportHeapSnapshotProgressClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.reportHeapSnapshotProgress", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &reportHeapSnapshotProgressClient{Stream: s}, nil
 }
 
+// OnReportHeapSnapshotProgress registers fn to be called for each
+// ReportHeapSnapshotProgress event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnReportHeapSnapshotProgress(fn func(*ReportHeapSnapshotProgressReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.reportHeapSnapshotProgress", d.conn, func(args []byte) error {
+		ev := new(ReportHeapSnapshotProgressReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type reportHeapSnapshotProgressClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -244,14 +305,29 @@ func (c *reportHeapSnapshotProgressClient) Recv() (*ReportHeapSnapshotProgressRe
 	return event, nil
 }
 
-func (d *domainClient) ResetProfiles(ctx context.Context) (ResetProfilesClient, error) {
-	s, err := rpcc.NewStream(ctx, "HeapProfiler.resetProfiles", d.conn)
+func (d *domainClient) ResetProfiles(ctx context.Context, opts ...rpcc.StreamOption) (ResetProfilesClient, error) {
+	s, err := rpcc.NewStream(ctx, "HeapProfiler.resetProfiles", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &resetProfilesClient{Stream: s}, nil
 }
 
+// OnResetProfiles registers fn to be called for each
+// ResetProfiles event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResetProfiles(fn func(*ResetProfilesReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("HeapProfiler.resetProfiles", d.conn, func(args []byte) error {
+		ev := new(ResetProfilesReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type resetProfilesClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
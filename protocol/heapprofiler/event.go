@@ -3,6 +3,9 @@
 package heapprofiler
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -19,6 +22,18 @@ type AddHeapSnapshotChunkReply struct {
 	Chunk string `json:"chunk"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddHeapSnapshotChunkReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "chunk":
+			r.Chunk, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // HeapStatsUpdateClient is a client for HeapStatsUpdate events. If heap
 // objects tracking has been started then backend may send update for one or
 // more fragments
@@ -34,6 +49,18 @@ type HeapStatsUpdateReply struct {
 	StatsUpdate []int `json:"statsUpdate"` // An array of triplets. Each triplet describes a fragment. The first integer is the fragment index, the second integer is a total count of objects for the fragment, the third integer is a total size of the objects for the fragment.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *HeapStatsUpdateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "statsUpdate":
+			err = json.Unmarshal(value, &r.StatsUpdate)
+		}
+		return err
+	})
+}
+
 // LastSeenObjectIDClient is a client for LastSeenObjectID events. If heap
 // objects tracking has been started then backend regularly sends a current
 // value for last seen object id and corresponding timestamp. If the were
@@ -52,6 +79,20 @@ type LastSeenObjectIDReply struct {
 	Timestamp        float64 `json:"timestamp"`        // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LastSeenObjectIDReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "lastSeenObjectId":
+			r.LastSeenObjectID, err = fastjson.Int(value)
+		case "timestamp":
+			r.Timestamp, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // ReportHeapSnapshotProgressClient is a client for ReportHeapSnapshotProgress events.
 type ReportHeapSnapshotProgressClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -67,6 +108,28 @@ type ReportHeapSnapshotProgressReply struct {
 	Finished *bool `json:"finished,omitempty"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ReportHeapSnapshotProgressReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "done":
+			r.Done, err = fastjson.Int(value)
+		case "total":
+			r.Total, err = fastjson.Int(value)
+		case "finished":
+			if fastjson.IsNull(value) {
+				r.Finished = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.Finished = &v
+		}
+		return err
+	})
+}
+
 // ResetProfilesClient is a client for ResetProfiles events.
 type ResetProfilesClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -2,6 +2,12 @@
 
 package indexeddb
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // ClearObjectStoreArgs represents the arguments for ClearObjectStore in the IndexedDB domain.
 type ClearObjectStoreArgs struct {
 	SecurityOrigin  string `json:"securityOrigin"`  // Security origin.
@@ -18,6 +24,25 @@ func NewClearObjectStoreArgs(securityOrigin string, databaseName string, objectS
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearObjectStoreArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = fastjson.AppendKey(b, "objectStoreName")
+	b = fastjson.AppendString(b, a.ObjectStoreName)
+	b = append(b, '}')
+	return b, err
+}
+
 // DeleteDatabaseArgs represents the arguments for DeleteDatabase in the IndexedDB domain.
 type DeleteDatabaseArgs struct {
 	SecurityOrigin string `json:"securityOrigin"` // Security origin.
@@ -32,6 +57,23 @@ func NewDeleteDatabaseArgs(securityOrigin string, databaseName string) *DeleteDa
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeleteDatabaseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = append(b, '}')
+	return b, err
+}
+
 // DeleteObjectStoreEntriesArgs represents the arguments for DeleteObjectStoreEntries in the IndexedDB domain.
 type DeleteObjectStoreEntriesArgs struct {
 	SecurityOrigin  string   `json:"securityOrigin"`  // No description.
@@ -50,6 +92,29 @@ func NewDeleteObjectStoreEntriesArgs(securityOrigin string, databaseName string,
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeleteObjectStoreEntriesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = fastjson.AppendKey(b, "objectStoreName")
+	b = fastjson.AppendString(b, a.ObjectStoreName)
+	b = fastjson.AppendKey(b, "keyRange")
+	if b, err = fastjson.AppendValue(b, a.KeyRange); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestDataArgs represents the arguments for RequestData in the IndexedDB domain.
 type RequestDataArgs struct {
 	SecurityOrigin  string    `json:"securityOrigin"`     // Security origin.
@@ -73,6 +138,37 @@ func NewRequestDataArgs(securityOrigin string, databaseName string, objectStoreN
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestDataArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = fastjson.AppendKey(b, "objectStoreName")
+	b = fastjson.AppendString(b, a.ObjectStoreName)
+	b = fastjson.AppendKey(b, "indexName")
+	b = fastjson.AppendString(b, a.IndexName)
+	b = fastjson.AppendKey(b, "skipCount")
+	b = fastjson.AppendInt(b, a.SkipCount)
+	b = fastjson.AppendKey(b, "pageSize")
+	b = fastjson.AppendInt(b, a.PageSize)
+	if a.KeyRange != nil {
+		b = fastjson.AppendKey(b, "keyRange")
+		if b, err = fastjson.AppendValue(b, a.KeyRange); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetKeyRange sets the KeyRange optional argument. Key range.
 func (a *RequestDataArgs) SetKeyRange(keyRange KeyRange) *RequestDataArgs {
 	a.KeyRange = &keyRange
@@ -85,6 +181,20 @@ type RequestDataReply struct {
 	HasMore                bool        `json:"hasMore"`                // If true, there are more entries to fetch in the given range.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestDataReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "objectStoreDataEntries":
+			err = json.Unmarshal(value, &r.ObjectStoreDataEntries)
+		case "hasMore":
+			r.HasMore, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // GetMetadataArgs represents the arguments for GetMetadata in the IndexedDB domain.
 type GetMetadataArgs struct {
 	SecurityOrigin  string `json:"securityOrigin"`  // Security origin.
@@ -101,12 +211,45 @@ func NewGetMetadataArgs(securityOrigin string, databaseName string, objectStoreN
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetMetadataArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = fastjson.AppendKey(b, "objectStoreName")
+	b = fastjson.AppendString(b, a.ObjectStoreName)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetMetadataReply represents the return values for GetMetadata in the IndexedDB domain.
 type GetMetadataReply struct {
 	EntriesCount      float64 `json:"entriesCount"`      // the entries count
 	KeyGeneratorValue float64 `json:"keyGeneratorValue"` // the current value of key generator, to become the next inserted key into the object store. Valid if objectStore.autoIncrement is true.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetMetadataReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "entriesCount":
+			r.EntriesCount, err = fastjson.Float(value)
+		case "keyGeneratorValue":
+			r.KeyGeneratorValue, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // RequestDatabaseArgs represents the arguments for RequestDatabase in the IndexedDB domain.
 type RequestDatabaseArgs struct {
 	SecurityOrigin string `json:"securityOrigin"` // Security origin.
@@ -121,11 +264,40 @@ func NewRequestDatabaseArgs(securityOrigin string, databaseName string) *Request
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestDatabaseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = fastjson.AppendKey(b, "databaseName")
+	b = fastjson.AppendString(b, a.DatabaseName)
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestDatabaseReply represents the return values for RequestDatabase in the IndexedDB domain.
 type RequestDatabaseReply struct {
 	DatabaseWithObjectStores DatabaseWithObjectStores `json:"databaseWithObjectStores"` // Database with an array of object stores.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestDatabaseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "databaseWithObjectStores":
+			err = json.Unmarshal(value, &r.DatabaseWithObjectStores)
+		}
+		return err
+	})
+}
+
 // RequestDatabaseNamesArgs represents the arguments for RequestDatabaseNames in the IndexedDB domain.
 type RequestDatabaseNamesArgs struct {
 	SecurityOrigin string `json:"securityOrigin"` // Security origin.
@@ -138,7 +310,34 @@ func NewRequestDatabaseNamesArgs(securityOrigin string) *RequestDatabaseNamesArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestDatabaseNamesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "securityOrigin")
+	b = fastjson.AppendString(b, a.SecurityOrigin)
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestDatabaseNamesReply represents the return values for RequestDatabaseNames in the IndexedDB domain.
 type RequestDatabaseNamesReply struct {
 	DatabaseNames []string `json:"databaseNames"` // Database names for origin.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestDatabaseNamesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "databaseNames":
+			err = json.Unmarshal(value, &r.DatabaseNames)
+		}
+		return err
+	})
+}
@@ -2,6 +2,10 @@
 
 package input
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // DispatchKeyEventArgs represents the arguments for DispatchKeyEvent in the Input domain.
 type DispatchKeyEventArgs struct {
 	// Type Type of the key event.
@@ -30,6 +34,75 @@ func NewDispatchKeyEventArgs(typ string) *DispatchKeyEventArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DispatchKeyEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, a.Type)
+	if a.Modifiers != nil {
+		b = fastjson.AppendKey(b, "modifiers")
+		b = fastjson.AppendInt(b, *a.Modifiers)
+	}
+	if a.Timestamp != 0 {
+		b = fastjson.AppendKey(b, "timestamp")
+		if b, err = fastjson.AppendValue(b, a.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	if a.Text != nil {
+		b = fastjson.AppendKey(b, "text")
+		b = fastjson.AppendString(b, *a.Text)
+	}
+	if a.UnmodifiedText != nil {
+		b = fastjson.AppendKey(b, "unmodifiedText")
+		b = fastjson.AppendString(b, *a.UnmodifiedText)
+	}
+	if a.KeyIdentifier != nil {
+		b = fastjson.AppendKey(b, "keyIdentifier")
+		b = fastjson.AppendString(b, *a.KeyIdentifier)
+	}
+	if a.Code != nil {
+		b = fastjson.AppendKey(b, "code")
+		b = fastjson.AppendString(b, *a.Code)
+	}
+	if a.Key != nil {
+		b = fastjson.AppendKey(b, "key")
+		b = fastjson.AppendString(b, *a.Key)
+	}
+	if a.WindowsVirtualKeyCode != nil {
+		b = fastjson.AppendKey(b, "windowsVirtualKeyCode")
+		b = fastjson.AppendInt(b, *a.WindowsVirtualKeyCode)
+	}
+	if a.NativeVirtualKeyCode != nil {
+		b = fastjson.AppendKey(b, "nativeVirtualKeyCode")
+		b = fastjson.AppendInt(b, *a.NativeVirtualKeyCode)
+	}
+	if a.AutoRepeat != nil {
+		b = fastjson.AppendKey(b, "autoRepeat")
+		b = fastjson.AppendBool(b, *a.AutoRepeat)
+	}
+	if a.IsKeypad != nil {
+		b = fastjson.AppendKey(b, "isKeypad")
+		b = fastjson.AppendBool(b, *a.IsKeypad)
+	}
+	if a.IsSystemKey != nil {
+		b = fastjson.AppendKey(b, "isSystemKey")
+		b = fastjson.AppendBool(b, *a.IsSystemKey)
+	}
+	if a.Location != nil {
+		b = fastjson.AppendKey(b, "location")
+		b = fastjson.AppendInt(b, *a.Location)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetModifiers sets the Modifiers optional argument. Bit field
 // representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
 // Shift=8 (default: 0).
@@ -139,6 +212,21 @@ func NewInsertTextArgs(text string) *InsertTextArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *InsertTextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "text")
+	b = fastjson.AppendString(b, a.Text)
+	b = append(b, '}')
+	return b, err
+}
+
 // DispatchMouseEventArgs represents the arguments for DispatchMouseEvent in the Input domain.
 type DispatchMouseEventArgs struct {
 	// Type Type of the mouse event.
@@ -169,6 +257,67 @@ func NewDispatchMouseEventArgs(typ string, x float64, y float64) *DispatchMouseE
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DispatchMouseEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, a.Type)
+	b = fastjson.AppendKey(b, "x")
+	if b, err = fastjson.AppendFloat(b, a.X); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "y")
+	if b, err = fastjson.AppendFloat(b, a.Y); err != nil {
+		return nil, err
+	}
+	if a.Modifiers != nil {
+		b = fastjson.AppendKey(b, "modifiers")
+		b = fastjson.AppendInt(b, *a.Modifiers)
+	}
+	if a.Timestamp != 0 {
+		b = fastjson.AppendKey(b, "timestamp")
+		if b, err = fastjson.AppendValue(b, a.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	if a.Button != "" {
+		b = fastjson.AppendKey(b, "button")
+		b = fastjson.AppendString(b, string(a.Button))
+	}
+	if a.Buttons != nil {
+		b = fastjson.AppendKey(b, "buttons")
+		b = fastjson.AppendInt(b, *a.Buttons)
+	}
+	if a.ClickCount != nil {
+		b = fastjson.AppendKey(b, "clickCount")
+		b = fastjson.AppendInt(b, *a.ClickCount)
+	}
+	if a.DeltaX != nil {
+		b = fastjson.AppendKey(b, "deltaX")
+		if b, err = fastjson.AppendFloat(b, *a.DeltaX); err != nil {
+			return nil, err
+		}
+	}
+	if a.DeltaY != nil {
+		b = fastjson.AppendKey(b, "deltaY")
+		if b, err = fastjson.AppendFloat(b, *a.DeltaY); err != nil {
+			return nil, err
+		}
+	}
+	if a.PointerType != nil {
+		b = fastjson.AppendKey(b, "pointerType")
+		b = fastjson.AppendString(b, *a.PointerType)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetModifiers sets the Modifiers optional argument. Bit field
 // representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
 // Shift=8 (default: 0).
@@ -250,6 +399,35 @@ func NewDispatchTouchEventArgs(typ string, touchPoints []TouchPoint) *DispatchTo
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DispatchTouchEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, a.Type)
+	b = fastjson.AppendKey(b, "touchPoints")
+	if b, err = fastjson.AppendValue(b, a.TouchPoints); err != nil {
+		return nil, err
+	}
+	if a.Modifiers != nil {
+		b = fastjson.AppendKey(b, "modifiers")
+		b = fastjson.AppendInt(b, *a.Modifiers)
+	}
+	if a.Timestamp != 0 {
+		b = fastjson.AppendKey(b, "timestamp")
+		if b, err = fastjson.AppendValue(b, a.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetModifiers sets the Modifiers optional argument. Bit field
 // representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4,
 // Shift=8 (default: 0).
@@ -291,6 +469,53 @@ func NewEmulateTouchFromMouseEventArgs(typ string, x int, y int, button MouseBut
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EmulateTouchFromMouseEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "type")
+	b = fastjson.AppendString(b, a.Type)
+	b = fastjson.AppendKey(b, "x")
+	b = fastjson.AppendInt(b, a.X)
+	b = fastjson.AppendKey(b, "y")
+	b = fastjson.AppendInt(b, a.Y)
+	b = fastjson.AppendKey(b, "button")
+	b = fastjson.AppendString(b, string(a.Button))
+	if a.Timestamp != 0 {
+		b = fastjson.AppendKey(b, "timestamp")
+		if b, err = fastjson.AppendValue(b, a.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	if a.DeltaX != nil {
+		b = fastjson.AppendKey(b, "deltaX")
+		if b, err = fastjson.AppendFloat(b, *a.DeltaX); err != nil {
+			return nil, err
+		}
+	}
+	if a.DeltaY != nil {
+		b = fastjson.AppendKey(b, "deltaY")
+		if b, err = fastjson.AppendFloat(b, *a.DeltaY); err != nil {
+			return nil, err
+		}
+	}
+	if a.Modifiers != nil {
+		b = fastjson.AppendKey(b, "modifiers")
+		b = fastjson.AppendInt(b, *a.Modifiers)
+	}
+	if a.ClickCount != nil {
+		b = fastjson.AppendKey(b, "clickCount")
+		b = fastjson.AppendInt(b, *a.ClickCount)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTimestamp sets the Timestamp optional argument. Time at which
 // the event occurred (default: current time).
 func (a *EmulateTouchFromMouseEventArgs) SetTimestamp(timestamp TimeSinceEpoch) *EmulateTouchFromMouseEventArgs {
@@ -339,6 +564,21 @@ func NewSetIgnoreInputEventsArgs(ignore bool) *SetIgnoreInputEventsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetIgnoreInputEventsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "ignore")
+	b = fastjson.AppendBool(b, a.Ignore)
+	b = append(b, '}')
+	return b, err
+}
+
 // SynthesizePinchGestureArgs represents the arguments for SynthesizePinchGesture in the Input domain.
 type SynthesizePinchGestureArgs struct {
 	X                 float64           `json:"x"`                           // X coordinate of the start of the gesture in CSS pixels.
@@ -357,6 +597,39 @@ func NewSynthesizePinchGestureArgs(x float64, y float64, scaleFactor float64) *S
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SynthesizePinchGestureArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "x")
+	if b, err = fastjson.AppendFloat(b, a.X); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "y")
+	if b, err = fastjson.AppendFloat(b, a.Y); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "scaleFactor")
+	if b, err = fastjson.AppendFloat(b, a.ScaleFactor); err != nil {
+		return nil, err
+	}
+	if a.RelativeSpeed != nil {
+		b = fastjson.AppendKey(b, "relativeSpeed")
+		b = fastjson.AppendInt(b, *a.RelativeSpeed)
+	}
+	if a.GestureSourceType != "" {
+		b = fastjson.AppendKey(b, "gestureSourceType")
+		b = fastjson.AppendString(b, string(a.GestureSourceType))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetRelativeSpeed sets the RelativeSpeed optional argument. Relative
 // pointer speed in pixels per second (default: 800).
 func (a *SynthesizePinchGestureArgs) SetRelativeSpeed(relativeSpeed int) *SynthesizePinchGestureArgs {
@@ -396,6 +669,75 @@ func NewSynthesizeScrollGestureArgs(x float64, y float64) *SynthesizeScrollGestu
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SynthesizeScrollGestureArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "x")
+	if b, err = fastjson.AppendFloat(b, a.X); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "y")
+	if b, err = fastjson.AppendFloat(b, a.Y); err != nil {
+		return nil, err
+	}
+	if a.XDistance != nil {
+		b = fastjson.AppendKey(b, "xDistance")
+		if b, err = fastjson.AppendFloat(b, *a.XDistance); err != nil {
+			return nil, err
+		}
+	}
+	if a.YDistance != nil {
+		b = fastjson.AppendKey(b, "yDistance")
+		if b, err = fastjson.AppendFloat(b, *a.YDistance); err != nil {
+			return nil, err
+		}
+	}
+	if a.XOverscroll != nil {
+		b = fastjson.AppendKey(b, "xOverscroll")
+		if b, err = fastjson.AppendFloat(b, *a.XOverscroll); err != nil {
+			return nil, err
+		}
+	}
+	if a.YOverscroll != nil {
+		b = fastjson.AppendKey(b, "yOverscroll")
+		if b, err = fastjson.AppendFloat(b, *a.YOverscroll); err != nil {
+			return nil, err
+		}
+	}
+	if a.PreventFling != nil {
+		b = fastjson.AppendKey(b, "preventFling")
+		b = fastjson.AppendBool(b, *a.PreventFling)
+	}
+	if a.Speed != nil {
+		b = fastjson.AppendKey(b, "speed")
+		b = fastjson.AppendInt(b, *a.Speed)
+	}
+	if a.GestureSourceType != "" {
+		b = fastjson.AppendKey(b, "gestureSourceType")
+		b = fastjson.AppendString(b, string(a.GestureSourceType))
+	}
+	if a.RepeatCount != nil {
+		b = fastjson.AppendKey(b, "repeatCount")
+		b = fastjson.AppendInt(b, *a.RepeatCount)
+	}
+	if a.RepeatDelayMs != nil {
+		b = fastjson.AppendKey(b, "repeatDelayMs")
+		b = fastjson.AppendInt(b, *a.RepeatDelayMs)
+	}
+	if a.InteractionMarkerName != nil {
+		b = fastjson.AppendKey(b, "interactionMarkerName")
+		b = fastjson.AppendString(b, *a.InteractionMarkerName)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetXDistance sets the XDistance optional argument. The distance to
 // scroll along the X axis (positive to scroll left).
 func (a *SynthesizeScrollGestureArgs) SetXDistance(xDistance float64) *SynthesizeScrollGestureArgs {
@@ -487,6 +829,39 @@ func NewSynthesizeTapGestureArgs(x float64, y float64) *SynthesizeTapGestureArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SynthesizeTapGestureArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "x")
+	if b, err = fastjson.AppendFloat(b, a.X); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "y")
+	if b, err = fastjson.AppendFloat(b, a.Y); err != nil {
+		return nil, err
+	}
+	if a.Duration != nil {
+		b = fastjson.AppendKey(b, "duration")
+		b = fastjson.AppendInt(b, *a.Duration)
+	}
+	if a.TapCount != nil {
+		b = fastjson.AppendKey(b, "tapCount")
+		b = fastjson.AppendInt(b, *a.TapCount)
+	}
+	if a.GestureSourceType != "" {
+		b = fastjson.AppendKey(b, "gestureSourceType")
+		b = fastjson.AppendString(b, string(a.GestureSourceType))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDuration sets the Duration optional argument. Duration between
 // touchdown and touchup events in ms (default: 50).
 func (a *SynthesizeTapGestureArgs) SetDuration(duration int) *SynthesizeTapGestureArgs {
@@ -5,6 +5,7 @@ package inspector
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -38,14 +39,29 @@ func (d *domainClient) Enable(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) Detached(ctx context.Context) (DetachedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Inspector.detached", d.conn)
+func (d *domainClient) Detached(ctx context.Context, opts ...rpcc.StreamOption) (DetachedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Inspector.detached", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &detachedClient{Stream: s}, nil
 }
 
+// OnDetached registers fn to be called for each
+// Detached event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDetached(fn func(*DetachedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Inspector.detached", d.conn, func(args []byte) error {
+		ev := new(DetachedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type detachedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -59,14 +75,29 @@ func (c *detachedClient) Recv() (*DetachedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TargetCrashed(ctx context.Context) (TargetCrashedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Inspector.targetCrashed", d.conn)
+func (d *domainClient) TargetCrashed(ctx context.Context, opts ...rpcc.StreamOption) (TargetCrashedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Inspector.targetCrashed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &targetCrashedClient{Stream: s}, nil
 }
 
+// OnTargetCrashed registers fn to be called for each
+// TargetCrashed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetCrashed(fn func(*TargetCrashedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Inspector.targetCrashed", d.conn, func(args []byte) error {
+		ev := new(TargetCrashedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type targetCrashedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -80,14 +111,29 @@ func (c *targetCrashedClient) Recv() (*TargetCrashedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TargetReloadedAfterCrash(ctx context.Context) (TargetReloadedAfterCrashClient, error) {
-	s, err := rpcc.NewStream(ctx, "Inspector.targetReloadedAfterCrash", d.conn)
+func (d *domainClient) TargetReloadedAfterCrash(ctx context.Context, opts ...rpcc.StreamOption) (TargetReloadedAfterCrashClient, error) {
+	s, err := rpcc.NewStream(ctx, "Inspector.targetReloadedAfterCrash", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &targetReloadedAfterCrashClient{Stream: s}, nil
 }
 
+// OnTargetReloadedAfterCrash registers fn to be called for each
+// TargetReloadedAfterCrash event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetReloadedAfterCrash(fn func(*TargetReloadedAfterCrashReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Inspector.targetReloadedAfterCrash", d.conn, func(args []byte) error {
+		ev := new(TargetReloadedAfterCrashReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type targetReloadedAfterCrashClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
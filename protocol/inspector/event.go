@@ -3,6 +3,7 @@
 package inspector
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -20,6 +21,18 @@ type DetachedReply struct {
 	Reason string `json:"reason"` // The reason why connection has been terminated.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DetachedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "reason":
+			r.Reason, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // TargetCrashedClient is a client for TargetCrashed events. Fired when
 // debugging target has crashed
 type TargetCrashedClient interface {
@@ -3,6 +3,7 @@
 package io
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/runtime"
 )
 
@@ -18,6 +19,21 @@ func NewCloseArgs(handle StreamHandle) *CloseArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CloseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "handle")
+	b = fastjson.AppendString(b, string(a.Handle))
+	b = append(b, '}')
+	return b, err
+}
+
 // ReadArgs represents the arguments for Read in the IO domain.
 type ReadArgs struct {
 	Handle StreamHandle `json:"handle"`           // Handle of the stream to read.
@@ -32,6 +48,29 @@ func NewReadArgs(handle StreamHandle) *ReadArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "handle")
+	b = fastjson.AppendString(b, string(a.Handle))
+	if a.Offset != nil {
+		b = fastjson.AppendKey(b, "offset")
+		b = fastjson.AppendInt(b, *a.Offset)
+	}
+	if a.Size != nil {
+		b = fastjson.AppendKey(b, "size")
+		b = fastjson.AppendInt(b, *a.Size)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOffset sets the Offset optional argument. Seek to the specified
 // offset before reading (if not specificed, proceed with offset
 // following the last read). Some types of streams may only support
@@ -55,6 +94,28 @@ type ReadReply struct {
 	EOF           bool   `json:"eof"`                     // Set if the end-of-file condition occurred while reading.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ReadReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "base64Encoded":
+			if fastjson.IsNull(value) {
+				r.Base64Encoded = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.Base64Encoded = &v
+		case "data":
+			r.Data, err = fastjson.String(value)
+		case "eof":
+			r.EOF, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // ResolveBlobArgs represents the arguments for ResolveBlob in the IO domain.
 type ResolveBlobArgs struct {
 	ObjectID runtime.RemoteObjectID `json:"objectId"` // Object id of a Blob object wrapper.
@@ -67,7 +128,34 @@ func NewResolveBlobArgs(objectID runtime.RemoteObjectID) *ResolveBlobArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ResolveBlobArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // ResolveBlobReply represents the return values for ResolveBlob in the IO domain.
 type ResolveBlobReply struct {
 	UUID string `json:"uuid"` // UUID of the specified Blob.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResolveBlobReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "uuid":
+			r.UUID, err = fastjson.String(value)
+		}
+		return err
+	})
+}
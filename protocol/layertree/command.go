@@ -5,6 +5,7 @@ package layertree
 import (
 	"encoding/json"
 
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 )
 
@@ -20,6 +21,21 @@ func NewCompositingReasonsArgs(layerID LayerID) *CompositingReasonsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CompositingReasonsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "layerId")
+	b = fastjson.AppendString(b, string(a.LayerID))
+	b = append(b, '}')
+	return b, err
+}
+
 // CompositingReasonsReply represents the return values for CompositingReasons in the LayerTree domain.
 type CompositingReasonsReply struct {
 	// CompositingReasons is deprecated.
@@ -30,6 +46,20 @@ type CompositingReasonsReply struct {
 	CompositingReasonIDs []string `json:"compositingReasonIds"` // A list of strings specifying reason IDs for the given layer to become composited.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CompositingReasonsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "compositingReasons":
+			err = json.Unmarshal(value, &r.CompositingReasons)
+		case "compositingReasonIds":
+			err = json.Unmarshal(value, &r.CompositingReasonIDs)
+		}
+		return err
+	})
+}
+
 // LoadSnapshotArgs represents the arguments for LoadSnapshot in the LayerTree domain.
 type LoadSnapshotArgs struct {
 	Tiles []PictureTile `json:"tiles"` // An array of tiles composing the snapshot.
@@ -42,11 +72,42 @@ func NewLoadSnapshotArgs(tiles []PictureTile) *LoadSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *LoadSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "tiles")
+	if b, err = fastjson.AppendValue(b, a.Tiles); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // LoadSnapshotReply represents the return values for LoadSnapshot in the LayerTree domain.
 type LoadSnapshotReply struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the snapshot.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LoadSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "snapshotId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SnapshotID = SnapshotID(v)
+		}
+		return err
+	})
+}
+
 // MakeSnapshotArgs represents the arguments for MakeSnapshot in the LayerTree domain.
 type MakeSnapshotArgs struct {
 	LayerID LayerID `json:"layerId"` // The id of the layer.
@@ -59,11 +120,40 @@ func NewMakeSnapshotArgs(layerID LayerID) *MakeSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *MakeSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "layerId")
+	b = fastjson.AppendString(b, string(a.LayerID))
+	b = append(b, '}')
+	return b, err
+}
+
 // MakeSnapshotReply represents the return values for MakeSnapshot in the LayerTree domain.
 type MakeSnapshotReply struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the layer snapshot.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *MakeSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "snapshotId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SnapshotID = SnapshotID(v)
+		}
+		return err
+	})
+}
+
 // ProfileSnapshotArgs represents the arguments for ProfileSnapshot in the LayerTree domain.
 type ProfileSnapshotArgs struct {
 	SnapshotID     SnapshotID `json:"snapshotId"`               // The id of the layer snapshot.
@@ -79,6 +169,37 @@ func NewProfileSnapshotArgs(snapshotID SnapshotID) *ProfileSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ProfileSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "snapshotId")
+	b = fastjson.AppendString(b, string(a.SnapshotID))
+	if a.MinRepeatCount != nil {
+		b = fastjson.AppendKey(b, "minRepeatCount")
+		b = fastjson.AppendInt(b, *a.MinRepeatCount)
+	}
+	if a.MinDuration != nil {
+		b = fastjson.AppendKey(b, "minDuration")
+		if b, err = fastjson.AppendFloat(b, *a.MinDuration); err != nil {
+			return nil, err
+		}
+	}
+	if a.ClipRect != nil {
+		b = fastjson.AppendKey(b, "clipRect")
+		if b, err = fastjson.AppendValue(b, a.ClipRect); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMinRepeatCount sets the MinRepeatCount optional argument. The
 // maximum number of times to replay the snapshot (1, if not
 // specified).
@@ -106,6 +227,18 @@ type ProfileSnapshotReply struct {
 	Timings []PaintProfile `json:"timings"` // The array of paint profiles, one per run.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ProfileSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timings":
+			err = json.Unmarshal(value, &r.Timings)
+		}
+		return err
+	})
+}
+
 // ReleaseSnapshotArgs represents the arguments for ReleaseSnapshot in the LayerTree domain.
 type ReleaseSnapshotArgs struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the layer snapshot.
@@ -118,6 +251,21 @@ func NewReleaseSnapshotArgs(snapshotID SnapshotID) *ReleaseSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReleaseSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "snapshotId")
+	b = fastjson.AppendString(b, string(a.SnapshotID))
+	b = append(b, '}')
+	return b, err
+}
+
 // ReplaySnapshotArgs represents the arguments for ReplaySnapshot in the LayerTree domain.
 type ReplaySnapshotArgs struct {
 	SnapshotID SnapshotID `json:"snapshotId"`         // The id of the layer snapshot.
@@ -133,6 +281,35 @@ func NewReplaySnapshotArgs(snapshotID SnapshotID) *ReplaySnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReplaySnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "snapshotId")
+	b = fastjson.AppendString(b, string(a.SnapshotID))
+	if a.FromStep != nil {
+		b = fastjson.AppendKey(b, "fromStep")
+		b = fastjson.AppendInt(b, *a.FromStep)
+	}
+	if a.ToStep != nil {
+		b = fastjson.AppendKey(b, "toStep")
+		b = fastjson.AppendInt(b, *a.ToStep)
+	}
+	if a.Scale != nil {
+		b = fastjson.AppendKey(b, "scale")
+		if b, err = fastjson.AppendFloat(b, *a.Scale); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFromStep sets the FromStep optional argument. The first step to
 // replay from (replay from the very start if not specified).
 func (a *ReplaySnapshotArgs) SetFromStep(fromStep int) *ReplaySnapshotArgs {
@@ -159,6 +336,18 @@ type ReplaySnapshotReply struct {
 	DataURL string `json:"dataURL"` // A data: URL for resulting image.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ReplaySnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "dataURL":
+			r.DataURL, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // SnapshotCommandLogArgs represents the arguments for SnapshotCommandLog in the LayerTree domain.
 type SnapshotCommandLogArgs struct {
 	SnapshotID SnapshotID `json:"snapshotId"` // The id of the layer snapshot.
@@ -171,7 +360,34 @@ func NewSnapshotCommandLogArgs(snapshotID SnapshotID) *SnapshotCommandLogArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SnapshotCommandLogArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "snapshotId")
+	b = fastjson.AppendString(b, string(a.SnapshotID))
+	b = append(b, '}')
+	return b, err
+}
+
 // SnapshotCommandLogReply represents the return values for SnapshotCommandLog in the LayerTree domain.
 type SnapshotCommandLogReply struct {
 	CommandLog []json.RawMessage `json:"commandLog"` // The array of canvas function calls.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SnapshotCommandLogReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "commandLog":
+			err = json.Unmarshal(value, &r.CommandLog)
+		}
+		return err
+	})
+}
@@ -5,6 +5,7 @@ package layertree
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -138,14 +139,29 @@ func (d *domainClient) SnapshotCommandLog(ctx context.Context, args *SnapshotCom
 	return
 }
 
-func (d *domainClient) LayerPainted(ctx context.Context) (LayerPaintedClient, error) {
-	s, err := rpcc.NewStream(ctx, "LayerTree.layerPainted", d.conn)
+func (d *domainClient) LayerPainted(ctx context.Context, opts ...rpcc.StreamOption) (LayerPaintedClient, error) {
+	s, err := rpcc.NewStream(ctx, "LayerTree.layerPainted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &layerPaintedClient{Stream: s}, nil
 }
 
+// OnLayerPainted registers fn to be called for each
+// LayerPainted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLayerPainted(fn func(*LayerPaintedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("LayerTree.layerPainted", d.conn, func(args []byte) error {
+		ev := new(LayerPaintedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type layerPaintedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -159,14 +175,29 @@ func (c *layerPaintedClient) Recv() (*LayerPaintedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) LayerTreeDidChange(ctx context.Context) (DidChangeClient, error) {
-	s, err := rpcc.NewStream(ctx, "LayerTree.layerTreeDidChange", d.conn)
+func (d *domainClient) LayerTreeDidChange(ctx context.Context, opts ...rpcc.StreamOption) (DidChangeClient, error) {
+	s, err := rpcc.NewStream(ctx, "LayerTree.layerTreeDidChange", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &didChangeClient{Stream: s}, nil
 }
 
+// OnLayerTreeDidChange registers fn to be called for each
+// LayerTreeDidChange event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLayerTreeDidChange(fn func(*DidChangeReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("LayerTree.layerTreeDidChange", d.conn, func(args []byte) error {
+		ev := new(DidChangeReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type didChangeClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package layertree
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -21,6 +24,22 @@ type LayerPaintedReply struct {
 	Clip    dom.Rect `json:"clip"`    // Clip rectangle.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LayerPaintedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "layerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.LayerID = LayerID(v)
+		case "clip":
+			err = json.Unmarshal(value, &r.Clip)
+		}
+		return err
+	})
+}
+
 // DidChangeClient is a client for LayerTreeDidChange events.
 type DidChangeClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -33,3 +52,15 @@ type DidChangeClient interface {
 type DidChangeReply struct {
 	Layers []Layer `json:"layers,omitempty"` // Layer tree, absent if not in the comspositing mode.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DidChangeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "layers":
+			err = json.Unmarshal(value, &r.Layers)
+		}
+		return err
+	})
+}
@@ -2,6 +2,10 @@
 
 package log
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // StartViolationsReportArgs represents the arguments for StartViolationsReport in the Log domain.
 type StartViolationsReportArgs struct {
 	Config []ViolationSetting `json:"config"` // Configuration for violations.
@@ -13,3 +17,20 @@ func NewStartViolationsReportArgs(config []ViolationSetting) *StartViolationsRep
 	args.Config = config
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartViolationsReportArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "config")
+	if b, err = fastjson.AppendValue(b, a.Config); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
@@ -5,6 +5,7 @@ package log
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -70,14 +71,29 @@ func (d *domainClient) StopViolationsReport(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) EntryAdded(ctx context.Context) (EntryAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Log.entryAdded", d.conn)
+func (d *domainClient) EntryAdded(ctx context.Context, opts ...rpcc.StreamOption) (EntryAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Log.entryAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &entryAddedClient{Stream: s}, nil
 }
 
+// OnEntryAdded registers fn to be called for each
+// EntryAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnEntryAdded(fn func(*EntryAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Log.entryAdded", d.conn, func(args []byte) error {
+		ev := new(EntryAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type entryAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
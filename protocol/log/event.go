@@ -3,6 +3,9 @@
 package log
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -19,3 +22,15 @@ type EntryAddedClient interface {
 type EntryAddedReply struct {
 	Entry Entry `json:"entry"` // The entry.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EntryAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "entry":
+			err = json.Unmarshal(value, &r.Entry)
+		}
+		return err
+	})
+}
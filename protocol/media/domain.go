@@ -6,6 +6,7 @@ package media
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -38,14 +39,29 @@ func (d *domainClient) Disable(ctx context.Context) (err error) {
 	return
 }
 
-func (d *domainClient) PlayerPropertiesChanged(ctx context.Context) (PlayerPropertiesChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Media.playerPropertiesChanged", d.conn)
+func (d *domainClient) PlayerPropertiesChanged(ctx context.Context, opts ...rpcc.StreamOption) (PlayerPropertiesChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Media.playerPropertiesChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &playerPropertiesChangedClient{Stream: s}, nil
 }
 
+// OnPlayerPropertiesChanged registers fn to be called for each
+// PlayerPropertiesChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPlayerPropertiesChanged(fn func(*PlayerPropertiesChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Media.playerPropertiesChanged", d.conn, func(args []byte) error {
+		ev := new(PlayerPropertiesChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type playerPropertiesChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -59,14 +75,29 @@ func (c *playerPropertiesChangedClient) Recv() (*PlayerPropertiesChangedReply, e
 	return event, nil
 }
 
-func (d *domainClient) PlayerEventsAdded(ctx context.Context) (PlayerEventsAddedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Media.playerEventsAdded", d.conn)
+func (d *domainClient) PlayerEventsAdded(ctx context.Context, opts ...rpcc.StreamOption) (PlayerEventsAddedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Media.playerEventsAdded", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &playerEventsAddedClient{Stream: s}, nil
 }
 
+// OnPlayerEventsAdded registers fn to be called for each
+// PlayerEventsAdded event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPlayerEventsAdded(fn func(*PlayerEventsAddedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Media.playerEventsAdded", d.conn, func(args []byte) error {
+		ev := new(PlayerEventsAddedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type playerEventsAddedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -80,14 +111,29 @@ func (c *playerEventsAddedClient) Recv() (*PlayerEventsAddedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) PlayerMessagesLogged(ctx context.Context) (PlayerMessagesLoggedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Media.playerMessagesLogged", d.conn)
+func (d *domainClient) PlayerMessagesLogged(ctx context.Context, opts ...rpcc.StreamOption) (PlayerMessagesLoggedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Media.playerMessagesLogged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &playerMessagesLoggedClient{Stream: s}, nil
 }
 
+// OnPlayerMessagesLogged registers fn to be called for each
+// PlayerMessagesLogged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPlayerMessagesLogged(fn func(*PlayerMessagesLoggedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Media.playerMessagesLogged", d.conn, func(args []byte) error {
+		ev := new(PlayerMessagesLoggedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type playerMessagesLoggedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -101,14 +147,29 @@ func (c *playerMessagesLoggedClient) Recv() (*PlayerMessagesLoggedReply, error)
 	return event, nil
 }
 
-func (d *domainClient) PlayerErrorsRaised(ctx context.Context) (PlayerErrorsRaisedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Media.playerErrorsRaised", d.conn)
+func (d *domainClient) PlayerErrorsRaised(ctx context.Context, opts ...rpcc.StreamOption) (PlayerErrorsRaisedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Media.playerErrorsRaised", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &playerErrorsRaisedClient{Stream: s}, nil
 }
 
+// OnPlayerErrorsRaised registers fn to be called for each
+// PlayerErrorsRaised event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPlayerErrorsRaised(fn func(*PlayerErrorsRaisedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Media.playerErrorsRaised", d.conn, func(args []byte) error {
+		ev := new(PlayerErrorsRaisedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type playerErrorsRaisedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -122,14 +183,29 @@ func (c *playerErrorsRaisedClient) Recv() (*PlayerErrorsRaisedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) PlayersCreated(ctx context.Context) (PlayersCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Media.playersCreated", d.conn)
+func (d *domainClient) PlayersCreated(ctx context.Context, opts ...rpcc.StreamOption) (PlayersCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Media.playersCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &playersCreatedClient{Stream: s}, nil
 }
 
+// OnPlayersCreated registers fn to be called for each
+// PlayersCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPlayersCreated(fn func(*PlayersCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Media.playersCreated", d.conn, func(args []byte) error {
+		ev := new(PlayersCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type playersCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
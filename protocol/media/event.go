@@ -3,6 +3,9 @@
 package media
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -22,6 +25,22 @@ type PlayerPropertiesChangedReply struct {
 	Properties []PlayerProperty `json:"properties"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PlayerPropertiesChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "playerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.PlayerID = PlayerID(v)
+		case "properties":
+			err = json.Unmarshal(value, &r.Properties)
+		}
+		return err
+	})
+}
+
 // PlayerEventsAddedClient is a client for PlayerEventsAdded events. Send
 // events as a list, allowing them to be batched on the browser for less
 // congestion. If batched, events must ALWAYS be in chronological order.
@@ -38,6 +57,22 @@ type PlayerEventsAddedReply struct {
 	Events   []PlayerEvent `json:"events"`   // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PlayerEventsAddedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "playerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.PlayerID = PlayerID(v)
+		case "events":
+			err = json.Unmarshal(value, &r.Events)
+		}
+		return err
+	})
+}
+
 // PlayerMessagesLoggedClient is a client for PlayerMessagesLogged events.
 // Send a list of any messages that need to be delivered.
 type PlayerMessagesLoggedClient interface {
@@ -53,6 +88,22 @@ type PlayerMessagesLoggedReply struct {
 	Messages []PlayerMessage `json:"messages"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PlayerMessagesLoggedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "playerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.PlayerID = PlayerID(v)
+		case "messages":
+			err = json.Unmarshal(value, &r.Messages)
+		}
+		return err
+	})
+}
+
 // PlayerErrorsRaisedClient is a client for PlayerErrorsRaised events. Send a
 // list of any errors that need to be delivered.
 type PlayerErrorsRaisedClient interface {
@@ -68,6 +119,22 @@ type PlayerErrorsRaisedReply struct {
 	Errors   []PlayerError `json:"errors"`   // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PlayerErrorsRaisedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "playerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.PlayerID = PlayerID(v)
+		case "errors":
+			err = json.Unmarshal(value, &r.Errors)
+		}
+		return err
+	})
+}
+
 // PlayersCreatedClient is a client for PlayersCreated events. Called whenever
 // a player is created, or when a new agent joins and receives a list of active
 // players. If an agent is restored, it will receive the full list of player
@@ -83,3 +150,15 @@ type PlayersCreatedClient interface {
 type PlayersCreatedReply struct {
 	Players []PlayerID `json:"players"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PlayersCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "players":
+			err = json.Unmarshal(value, &r.Players)
+		}
+		return err
+	})
+}
@@ -2,6 +2,12 @@
 
 package memory
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetDOMCountersReply represents the return values for GetDOMCounters in the Memory domain.
 type GetDOMCountersReply struct {
 	Documents        int `json:"documents"`        // No description.
@@ -9,6 +15,22 @@ type GetDOMCountersReply struct {
 	JsEventListeners int `json:"jsEventListeners"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetDOMCountersReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "documents":
+			r.Documents, err = fastjson.Int(value)
+		case "nodes":
+			r.Nodes, err = fastjson.Int(value)
+		case "jsEventListeners":
+			r.JsEventListeners, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // SetPressureNotificationsSuppressedArgs represents the arguments for SetPressureNotificationsSuppressed in the Memory domain.
 type SetPressureNotificationsSuppressedArgs struct {
 	Suppressed bool `json:"suppressed"` // If true, memory pressure notifications will be suppressed.
@@ -21,6 +43,21 @@ func NewSetPressureNotificationsSuppressedArgs(suppressed bool) *SetPressureNoti
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPressureNotificationsSuppressedArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "suppressed")
+	b = fastjson.AppendBool(b, a.Suppressed)
+	b = append(b, '}')
+	return b, err
+}
+
 // SimulatePressureNotificationArgs represents the arguments for SimulatePressureNotification in the Memory domain.
 type SimulatePressureNotificationArgs struct {
 	Level PressureLevel `json:"level"` // Memory pressure level of the notification.
@@ -33,6 +70,21 @@ func NewSimulatePressureNotificationArgs(level PressureLevel) *SimulatePressureN
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SimulatePressureNotificationArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "level")
+	b = fastjson.AppendString(b, string(a.Level))
+	b = append(b, '}')
+	return b, err
+}
+
 // StartSamplingArgs represents the arguments for StartSampling in the Memory domain.
 type StartSamplingArgs struct {
 	SamplingInterval   *int  `json:"samplingInterval,omitempty"`   // Average number of bytes between samples.
@@ -46,6 +98,27 @@ func NewStartSamplingArgs() *StartSamplingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartSamplingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.SamplingInterval != nil {
+		b = fastjson.AppendKey(b, "samplingInterval")
+		b = fastjson.AppendInt(b, *a.SamplingInterval)
+	}
+	if a.SuppressRandomness != nil {
+		b = fastjson.AppendKey(b, "suppressRandomness")
+		b = fastjson.AppendBool(b, *a.SuppressRandomness)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetSamplingInterval sets the SamplingInterval optional argument.
 // Average number of bytes between samples.
 func (a *StartSamplingArgs) SetSamplingInterval(samplingInterval int) *StartSamplingArgs {
@@ -65,12 +138,48 @@ type GetAllTimeSamplingProfileReply struct {
 	Profile SamplingProfile `json:"profile"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetAllTimeSamplingProfileReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
+
 // GetBrowserSamplingProfileReply represents the return values for GetBrowserSamplingProfile in the Memory domain.
 type GetBrowserSamplingProfileReply struct {
 	Profile SamplingProfile `json:"profile"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBrowserSamplingProfileReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
+
 // GetSamplingProfileReply represents the return values for GetSamplingProfile in the Memory domain.
 type GetSamplingProfileReply struct {
 	Profile SamplingProfile `json:"profile"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetSamplingProfileReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
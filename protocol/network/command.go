@@ -3,6 +3,9 @@
 package network
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/debugger"
 	"github.com/mafredri/cdp/protocol/io"
 )
@@ -12,16 +15,52 @@ type CanClearBrowserCacheReply struct {
 	Result bool `json:"result"` // True if browser cache can be cleared.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CanClearBrowserCacheReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			r.Result, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // CanClearBrowserCookiesReply represents the return values for CanClearBrowserCookies in the Network domain.
 type CanClearBrowserCookiesReply struct {
 	Result bool `json:"result"` // True if browser cookies can be cleared.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CanClearBrowserCookiesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			r.Result, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // CanEmulateNetworkConditionsReply represents the return values for CanEmulateNetworkConditions in the Network domain.
 type CanEmulateNetworkConditionsReply struct {
 	Result bool `json:"result"` // True if emulation of network conditions is supported.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CanEmulateNetworkConditionsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			r.Result, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // ContinueInterceptedRequestArgs represents the arguments for ContinueInterceptedRequest in the Network domain.
 type ContinueInterceptedRequestArgs struct {
 	InterceptionID        InterceptionID         `json:"interceptionId"`                  // No description.
@@ -41,6 +80,53 @@ func NewContinueInterceptedRequestArgs(interceptionID InterceptionID) *ContinueI
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ContinueInterceptedRequestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "interceptionId")
+	b = fastjson.AppendString(b, string(a.InterceptionID))
+	if a.ErrorReason != "" {
+		b = fastjson.AppendKey(b, "errorReason")
+		b = fastjson.AppendString(b, string(a.ErrorReason))
+	}
+	if a.RawResponse != nil {
+		b = fastjson.AppendKey(b, "rawResponse")
+		b = fastjson.AppendString(b, *a.RawResponse)
+	}
+	if a.URL != nil {
+		b = fastjson.AppendKey(b, "url")
+		b = fastjson.AppendString(b, *a.URL)
+	}
+	if a.Method != nil {
+		b = fastjson.AppendKey(b, "method")
+		b = fastjson.AppendString(b, *a.Method)
+	}
+	if a.PostData != nil {
+		b = fastjson.AppendKey(b, "postData")
+		b = fastjson.AppendString(b, *a.PostData)
+	}
+	if len(a.Headers) > 0 {
+		b = fastjson.AppendKey(b, "headers")
+		if b, err = fastjson.AppendValue(b, a.Headers); err != nil {
+			return nil, err
+		}
+	}
+	if a.AuthChallengeResponse != nil {
+		b = fastjson.AppendKey(b, "authChallengeResponse")
+		if b, err = fastjson.AppendValue(b, a.AuthChallengeResponse); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetErrorReason sets the ErrorReason optional argument. If set this
 // causes the request to fail with the given reason. Passing `Aborted`
 // for requests marked with `isNavigationRequest` also cancels the
@@ -113,6 +199,33 @@ func NewDeleteCookiesArgs(name string) *DeleteCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeleteCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	if a.URL != nil {
+		b = fastjson.AppendKey(b, "url")
+		b = fastjson.AppendString(b, *a.URL)
+	}
+	if a.Domain != nil {
+		b = fastjson.AppendKey(b, "domain")
+		b = fastjson.AppendString(b, *a.Domain)
+	}
+	if a.Path != nil {
+		b = fastjson.AppendKey(b, "path")
+		b = fastjson.AppendString(b, *a.Path)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetURL sets the URL optional argument. If specified, deletes all
 // the cookies with the given name where domain and path match provided
 // URL.
@@ -154,6 +267,37 @@ func NewEmulateNetworkConditionsArgs(offline bool, latency float64, downloadThro
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EmulateNetworkConditionsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "offline")
+	b = fastjson.AppendBool(b, a.Offline)
+	b = fastjson.AppendKey(b, "latency")
+	if b, err = fastjson.AppendFloat(b, a.Latency); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "downloadThroughput")
+	if b, err = fastjson.AppendFloat(b, a.DownloadThroughput); err != nil {
+		return nil, err
+	}
+	b = fastjson.AppendKey(b, "uploadThroughput")
+	if b, err = fastjson.AppendFloat(b, a.UploadThroughput); err != nil {
+		return nil, err
+	}
+	if a.ConnectionType != "" {
+		b = fastjson.AppendKey(b, "connectionType")
+		b = fastjson.AppendString(b, string(a.ConnectionType))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetConnectionType sets the ConnectionType optional argument.
 // Connection type if known.
 func (a *EmulateNetworkConditionsArgs) SetConnectionType(connectionType ConnectionType) *EmulateNetworkConditionsArgs {
@@ -183,6 +327,31 @@ func NewEnableArgs() *EnableArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EnableArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.MaxTotalBufferSize != nil {
+		b = fastjson.AppendKey(b, "maxTotalBufferSize")
+		b = fastjson.AppendInt(b, *a.MaxTotalBufferSize)
+	}
+	if a.MaxResourceBufferSize != nil {
+		b = fastjson.AppendKey(b, "maxResourceBufferSize")
+		b = fastjson.AppendInt(b, *a.MaxResourceBufferSize)
+	}
+	if a.MaxPostDataSize != nil {
+		b = fastjson.AppendKey(b, "maxPostDataSize")
+		b = fastjson.AppendInt(b, *a.MaxPostDataSize)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMaxTotalBufferSize sets the MaxTotalBufferSize optional argument.
 // Buffer size in bytes to use when preserving network payloads (XHRs,
 // etc).
@@ -216,6 +385,18 @@ type GetAllCookiesReply struct {
 	Cookies []Cookie `json:"cookies"` // Array of cookie objects.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetAllCookiesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "cookies":
+			err = json.Unmarshal(value, &r.Cookies)
+		}
+		return err
+	})
+}
+
 // GetCertificateArgs represents the arguments for GetCertificate in the Network domain.
 type GetCertificateArgs struct {
 	Origin string `json:"origin"` // Origin to get certificate for.
@@ -228,11 +409,38 @@ func NewGetCertificateArgs(origin string) *GetCertificateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCertificateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCertificateReply represents the return values for GetCertificate in the Network domain.
 type GetCertificateReply struct {
 	TableNames []string `json:"tableNames"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCertificateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "tableNames":
+			err = json.Unmarshal(value, &r.TableNames)
+		}
+		return err
+	})
+}
+
 // GetCookiesArgs represents the arguments for GetCookies in the Network domain.
 type GetCookiesArgs struct {
 	URLs []string `json:"urls,omitempty"` // The list of URLs for which applicable cookies will be fetched
@@ -245,6 +453,25 @@ func NewGetCookiesArgs() *GetCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if len(a.URLs) > 0 {
+		b = fastjson.AppendKey(b, "urls")
+		if b, err = fastjson.AppendValue(b, a.URLs); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetURLs sets the URLs optional argument. The list of URLs for which
 // applicable cookies will be fetched
 func (a *GetCookiesArgs) SetURLs(urls []string) *GetCookiesArgs {
@@ -257,6 +484,18 @@ type GetCookiesReply struct {
 	Cookies []Cookie `json:"cookies"` // Array of cookie objects.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCookiesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "cookies":
+			err = json.Unmarshal(value, &r.Cookies)
+		}
+		return err
+	})
+}
+
 // GetResponseBodyArgs represents the arguments for GetResponseBody in the Network domain.
 type GetResponseBodyArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier of the network request to get content for.
@@ -269,12 +508,41 @@ func NewGetResponseBodyArgs(requestID RequestID) *GetResponseBodyArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetResponseBodyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetResponseBodyReply represents the return values for GetResponseBody in the Network domain.
 type GetResponseBodyReply struct {
 	Body          string `json:"body"`          // Response body.
 	Base64Encoded bool   `json:"base64Encoded"` // True, if content was sent as base64.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetResponseBodyReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "body":
+			r.Body, err = fastjson.String(value)
+		case "base64Encoded":
+			r.Base64Encoded, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // GetRequestPostDataArgs represents the arguments for GetRequestPostData in the Network domain.
 type GetRequestPostDataArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier of the network request to get content for.
@@ -287,11 +555,38 @@ func NewGetRequestPostDataArgs(requestID RequestID) *GetRequestPostDataArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetRequestPostDataArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetRequestPostDataReply represents the return values for GetRequestPostData in the Network domain.
 type GetRequestPostDataReply struct {
 	PostData string `json:"postData"` // Request body string, omitting files from multipart requests
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetRequestPostDataReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "postData":
+			r.PostData, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetResponseBodyForInterceptionArgs represents the arguments for GetResponseBodyForInterception in the Network domain.
 type GetResponseBodyForInterceptionArgs struct {
 	InterceptionID InterceptionID `json:"interceptionId"` // Identifier for the intercepted request to get body for.
@@ -304,12 +599,41 @@ func NewGetResponseBodyForInterceptionArgs(interceptionID InterceptionID) *GetRe
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetResponseBodyForInterceptionArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "interceptionId")
+	b = fastjson.AppendString(b, string(a.InterceptionID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetResponseBodyForInterceptionReply represents the return values for GetResponseBodyForInterception in the Network domain.
 type GetResponseBodyForInterceptionReply struct {
 	Body          string `json:"body"`          // Response body.
 	Base64Encoded bool   `json:"base64Encoded"` // True, if content was sent as base64.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetResponseBodyForInterceptionReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "body":
+			r.Body, err = fastjson.String(value)
+		case "base64Encoded":
+			r.Base64Encoded, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // TakeResponseBodyForInterceptionAsStreamArgs represents the arguments for TakeResponseBodyForInterceptionAsStream in the Network domain.
 type TakeResponseBodyForInterceptionAsStreamArgs struct {
 	InterceptionID InterceptionID `json:"interceptionId"` // No description.
@@ -322,11 +646,40 @@ func NewTakeResponseBodyForInterceptionAsStreamArgs(interceptionID InterceptionI
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *TakeResponseBodyForInterceptionAsStreamArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "interceptionId")
+	b = fastjson.AppendString(b, string(a.InterceptionID))
+	b = append(b, '}')
+	return b, err
+}
+
 // TakeResponseBodyForInterceptionAsStreamReply represents the return values for TakeResponseBodyForInterceptionAsStream in the Network domain.
 type TakeResponseBodyForInterceptionAsStreamReply struct {
 	Stream io.StreamHandle `json:"stream"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *TakeResponseBodyForInterceptionAsStreamReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "stream":
+			var v string
+			v, err = fastjson.String(value)
+			r.Stream = io.StreamHandle(v)
+		}
+		return err
+	})
+}
+
 // ReplayXHRArgs represents the arguments for ReplayXHR in the Network domain.
 type ReplayXHRArgs struct {
 	RequestID RequestID `json:"requestId"` // Identifier of XHR to replay.
@@ -339,6 +692,21 @@ func NewReplayXHRArgs(requestID RequestID) *ReplayXHRArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReplayXHRArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = append(b, '}')
+	return b, err
+}
+
 // SearchInResponseBodyArgs represents the arguments for SearchInResponseBody in the Network domain.
 type SearchInResponseBodyArgs struct {
 	RequestID     RequestID `json:"requestId"`               // Identifier of the network response to search.
@@ -355,6 +723,31 @@ func NewSearchInResponseBodyArgs(requestID RequestID, query string) *SearchInRes
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SearchInResponseBodyArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "requestId")
+	b = fastjson.AppendString(b, string(a.RequestID))
+	b = fastjson.AppendKey(b, "query")
+	b = fastjson.AppendString(b, a.Query)
+	if a.CaseSensitive != nil {
+		b = fastjson.AppendKey(b, "caseSensitive")
+		b = fastjson.AppendBool(b, *a.CaseSensitive)
+	}
+	if a.IsRegex != nil {
+		b = fastjson.AppendKey(b, "isRegex")
+		b = fastjson.AppendBool(b, *a.IsRegex)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCaseSensitive sets the CaseSensitive optional argument. If true,
 // search is case sensitive.
 func (a *SearchInResponseBodyArgs) SetCaseSensitive(caseSensitive bool) *SearchInResponseBodyArgs {
@@ -374,6 +767,18 @@ type SearchInResponseBodyReply struct {
 	Result []debugger.SearchMatch `json:"result"` // List of search matches.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SearchInResponseBodyReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // SetBlockedURLsArgs represents the arguments for SetBlockedURLs in the Network domain.
 type SetBlockedURLsArgs struct {
 	URLs []string `json:"urls"` // URL patterns to block. Wildcards ('*') are allowed.
@@ -386,6 +791,23 @@ func NewSetBlockedURLsArgs(urls []string) *SetBlockedURLsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBlockedURLsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "urls")
+	if b, err = fastjson.AppendValue(b, a.URLs); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBypassServiceWorkerArgs represents the arguments for SetBypassServiceWorker in the Network domain.
 type SetBypassServiceWorkerArgs struct {
 	Bypass bool `json:"bypass"` // Bypass service worker and load from network.
@@ -398,6 +820,21 @@ func NewSetBypassServiceWorkerArgs(bypass bool) *SetBypassServiceWorkerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBypassServiceWorkerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "bypass")
+	b = fastjson.AppendBool(b, a.Bypass)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCacheDisabledArgs represents the arguments for SetCacheDisabled in the Network domain.
 type SetCacheDisabledArgs struct {
 	CacheDisabled bool `json:"cacheDisabled"` // Cache disabled state.
@@ -410,6 +847,21 @@ func NewSetCacheDisabledArgs(cacheDisabled bool) *SetCacheDisabledArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCacheDisabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cacheDisabled")
+	b = fastjson.AppendBool(b, a.CacheDisabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCookieArgs represents the arguments for SetCookie in the Network domain.
 type SetCookieArgs struct {
 	Name     string         `json:"name"`               // Cookie name.
@@ -435,6 +887,57 @@ func NewSetCookieArgs(name string, value string) *SetCookieArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCookieArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	b = fastjson.AppendKey(b, "value")
+	b = fastjson.AppendString(b, a.Value)
+	if a.URL != nil {
+		b = fastjson.AppendKey(b, "url")
+		b = fastjson.AppendString(b, *a.URL)
+	}
+	if a.Domain != nil {
+		b = fastjson.AppendKey(b, "domain")
+		b = fastjson.AppendString(b, *a.Domain)
+	}
+	if a.Path != nil {
+		b = fastjson.AppendKey(b, "path")
+		b = fastjson.AppendString(b, *a.Path)
+	}
+	if a.Secure != nil {
+		b = fastjson.AppendKey(b, "secure")
+		b = fastjson.AppendBool(b, *a.Secure)
+	}
+	if a.HTTPOnly != nil {
+		b = fastjson.AppendKey(b, "httpOnly")
+		b = fastjson.AppendBool(b, *a.HTTPOnly)
+	}
+	if a.SameSite != "" {
+		b = fastjson.AppendKey(b, "sameSite")
+		b = fastjson.AppendString(b, string(a.SameSite))
+	}
+	if a.Expires != 0 {
+		b = fastjson.AppendKey(b, "expires")
+		if b, err = fastjson.AppendValue(b, a.Expires); err != nil {
+			return nil, err
+		}
+	}
+	if a.Priority != "" {
+		b = fastjson.AppendKey(b, "priority")
+		b = fastjson.AppendString(b, string(a.Priority))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetURL sets the URL optional argument. The request-URI to associate
 // with the setting of the cookie. This value can affect the default
 // domain and path values of the created cookie.
@@ -497,6 +1000,18 @@ type SetCookieReply struct {
 	Success bool `json:"success"` // True if successfully set cookie.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SetCookieReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "success":
+			r.Success, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // SetCookiesArgs represents the arguments for SetCookies in the Network domain.
 type SetCookiesArgs struct {
 	Cookies []CookieParam `json:"cookies"` // Cookies to be set.
@@ -509,6 +1024,23 @@ func NewSetCookiesArgs(cookies []CookieParam) *SetCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cookies")
+	if b, err = fastjson.AppendValue(b, a.Cookies); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDataSizeLimitsForTestArgs represents the arguments for SetDataSizeLimitsForTest in the Network domain.
 type SetDataSizeLimitsForTestArgs struct {
 	MaxTotalSize    int `json:"maxTotalSize"`    // Maximum total buffer size.
@@ -523,6 +1055,23 @@ func NewSetDataSizeLimitsForTestArgs(maxTotalSize int, maxResourceSize int) *Set
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDataSizeLimitsForTestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "maxTotalSize")
+	b = fastjson.AppendInt(b, a.MaxTotalSize)
+	b = fastjson.AppendKey(b, "maxResourceSize")
+	b = fastjson.AppendInt(b, a.MaxResourceSize)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetExtraHTTPHeadersArgs represents the arguments for SetExtraHTTPHeaders in the Network domain.
 type SetExtraHTTPHeadersArgs struct {
 	Headers Headers `json:"headers"` // Map with extra HTTP headers.
@@ -535,6 +1084,23 @@ func NewSetExtraHTTPHeadersArgs(headers Headers) *SetExtraHTTPHeadersArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetExtraHTTPHeadersArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "headers")
+	if b, err = fastjson.AppendValue(b, a.Headers); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetRequestInterceptionArgs represents the arguments for SetRequestInterception in the Network domain.
 type SetRequestInterceptionArgs struct {
 	Patterns []RequestPattern `json:"patterns"` // Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.
@@ -546,3 +1112,20 @@ func NewSetRequestInterceptionArgs(patterns []RequestPattern) *SetRequestInterce
 	args.Patterns = patterns
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetRequestInterceptionArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "patterns")
+	if b, err = fastjson.AppendValue(b, a.Patterns); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
@@ -8,6 +8,7 @@ package network
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -394,14 +395,29 @@ func (d *domainClient) SetRequestInterception(ctx context.Context, args *SetRequ
 	return
 }
 
-func (d *domainClient) DataReceived(ctx context.Context) (DataReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.dataReceived", d.conn)
+func (d *domainClient) DataReceived(ctx context.Context, opts ...rpcc.StreamOption) (DataReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.dataReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &dataReceivedClient{Stream: s}, nil
 }
 
+// OnDataReceived registers fn to be called for each
+// DataReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDataReceived(fn func(*DataReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.dataReceived", d.conn, func(args []byte) error {
+		ev := new(DataReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type dataReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -415,14 +431,29 @@ func (c *dataReceivedClient) Recv() (*DataReceivedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) EventSourceMessageReceived(ctx context.Context) (EventSourceMessageReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.eventSourceMessageReceived", d.conn)
+func (d *domainClient) EventSourceMessageReceived(ctx context.Context, opts ...rpcc.StreamOption) (EventSourceMessageReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.eventSourceMessageReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &eventSourceMessageReceivedClient{Stream: s}, nil
 }
 
+// OnEventSourceMessageReceived registers fn to be called for each
+// EventSourceMessageReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnEventSourceMessageReceived(fn func(*EventSourceMessageReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.eventSourceMessageReceived", d.conn, func(args []byte) error {
+		ev := new(EventSourceMessageReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type eventSourceMessageReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -436,14 +467,29 @@ func (c *eventSourceMessageReceivedClient) Recv() (*EventSourceMessageReceivedRe
 	return event, nil
 }
 
-func (d *domainClient) LoadingFailed(ctx context.Context) (LoadingFailedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.loadingFailed", d.conn)
+func (d *domainClient) LoadingFailed(ctx context.Context, opts ...rpcc.StreamOption) (LoadingFailedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.loadingFailed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &loadingFailedClient{Stream: s}, nil
 }
 
+// OnLoadingFailed registers fn to be called for each
+// LoadingFailed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLoadingFailed(fn func(*LoadingFailedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.loadingFailed", d.conn, func(args []byte) error {
+		ev := new(LoadingFailedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type loadingFailedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -457,14 +503,29 @@ func (c *loadingFailedClient) Recv() (*LoadingFailedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) LoadingFinished(ctx context.Context) (LoadingFinishedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.loadingFinished", d.conn)
+func (d *domainClient) LoadingFinished(ctx context.Context, opts ...rpcc.StreamOption) (LoadingFinishedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.loadingFinished", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &loadingFinishedClient{Stream: s}, nil
 }
 
+// OnLoadingFinished registers fn to be called for each
+// LoadingFinished event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLoadingFinished(fn func(*LoadingFinishedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.loadingFinished", d.conn, func(args []byte) error {
+		ev := new(LoadingFinishedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type loadingFinishedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -478,14 +539,29 @@ func (c *loadingFinishedClient) Recv() (*LoadingFinishedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) RequestIntercepted(ctx context.Context) (RequestInterceptedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.requestIntercepted", d.conn)
+func (d *domainClient) RequestIntercepted(ctx context.Context, opts ...rpcc.StreamOption) (RequestInterceptedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestIntercepted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &requestInterceptedClient{Stream: s}, nil
 }
 
+// OnRequestIntercepted registers fn to be called for each
+// RequestIntercepted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRequestIntercepted(fn func(*RequestInterceptedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.requestIntercepted", d.conn, func(args []byte) error {
+		ev := new(RequestInterceptedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type requestInterceptedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -499,14 +575,29 @@ func (c *requestInterceptedClient) Recv() (*RequestInterceptedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) RequestServedFromCache(ctx context.Context) (RequestServedFromCacheClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.requestServedFromCache", d.conn)
+func (d *domainClient) RequestServedFromCache(ctx context.Context, opts ...rpcc.StreamOption) (RequestServedFromCacheClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestServedFromCache", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &requestServedFromCacheClient{Stream: s}, nil
 }
 
+// OnRequestServedFromCache registers fn to be called for each
+// RequestServedFromCache event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRequestServedFromCache(fn func(*RequestServedFromCacheReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.requestServedFromCache", d.conn, func(args []byte) error {
+		ev := new(RequestServedFromCacheReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type requestServedFromCacheClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -520,14 +611,29 @@ func (c *requestServedFromCacheClient) Recv() (*RequestServedFromCacheReply, err
 	return event, nil
 }
 
-func (d *domainClient) RequestWillBeSent(ctx context.Context) (RequestWillBeSentClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSent", d.conn)
+func (d *domainClient) RequestWillBeSent(ctx context.Context, opts ...rpcc.StreamOption) (RequestWillBeSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSent", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &requestWillBeSentClient{Stream: s}, nil
 }
 
+// OnRequestWillBeSent registers fn to be called for each
+// RequestWillBeSent event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRequestWillBeSent(fn func(*RequestWillBeSentReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.requestWillBeSent", d.conn, func(args []byte) error {
+		ev := new(RequestWillBeSentReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type requestWillBeSentClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -541,14 +647,29 @@ func (c *requestWillBeSentClient) Recv() (*RequestWillBeSentReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ResourceChangedPriority(ctx context.Context) (ResourceChangedPriorityClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.resourceChangedPriority", d.conn)
+func (d *domainClient) ResourceChangedPriority(ctx context.Context, opts ...rpcc.StreamOption) (ResourceChangedPriorityClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.resourceChangedPriority", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &resourceChangedPriorityClient{Stream: s}, nil
 }
 
+// OnResourceChangedPriority registers fn to be called for each
+// ResourceChangedPriority event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResourceChangedPriority(fn func(*ResourceChangedPriorityReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.resourceChangedPriority", d.conn, func(args []byte) error {
+		ev := new(ResourceChangedPriorityReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type resourceChangedPriorityClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -562,14 +683,29 @@ func (c *resourceChangedPriorityClient) Recv() (*ResourceChangedPriorityReply, e
 	return event, nil
 }
 
-func (d *domainClient) SignedExchangeReceived(ctx context.Context) (SignedExchangeReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.signedExchangeReceived", d.conn)
+func (d *domainClient) SignedExchangeReceived(ctx context.Context, opts ...rpcc.StreamOption) (SignedExchangeReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.signedExchangeReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &signedExchangeReceivedClient{Stream: s}, nil
 }
 
+// OnSignedExchangeReceived registers fn to be called for each
+// SignedExchangeReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnSignedExchangeReceived(fn func(*SignedExchangeReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.signedExchangeReceived", d.conn, func(args []byte) error {
+		ev := new(SignedExchangeReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type signedExchangeReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -583,14 +719,29 @@ func (c *signedExchangeReceivedClient) Recv() (*SignedExchangeReceivedReply, err
 	return event, nil
 }
 
-func (d *domainClient) ResponseReceived(ctx context.Context) (ResponseReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.responseReceived", d.conn)
+func (d *domainClient) ResponseReceived(ctx context.Context, opts ...rpcc.StreamOption) (ResponseReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.responseReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &responseReceivedClient{Stream: s}, nil
 }
 
+// OnResponseReceived registers fn to be called for each
+// ResponseReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResponseReceived(fn func(*ResponseReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.responseReceived", d.conn, func(args []byte) error {
+		ev := new(ResponseReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type responseReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -604,14 +755,29 @@ func (c *responseReceivedClient) Recv() (*ResponseReceivedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WebSocketClosed(ctx context.Context) (WebSocketClosedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketClosed", d.conn)
+func (d *domainClient) WebSocketClosed(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketClosed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketClosedClient{Stream: s}, nil
 }
 
+// OnWebSocketClosed registers fn to be called for each
+// WebSocketClosed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketClosed(fn func(*WebSocketClosedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketClosed", d.conn, func(args []byte) error {
+		ev := new(WebSocketClosedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketClosedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -625,14 +791,29 @@ func (c *webSocketClosedClient) Recv() (*WebSocketClosedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WebSocketCreated(ctx context.Context) (WebSocketCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketCreated", d.conn)
+func (d *domainClient) WebSocketCreated(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketCreatedClient{Stream: s}, nil
 }
 
+// OnWebSocketCreated registers fn to be called for each
+// WebSocketCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketCreated(fn func(*WebSocketCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketCreated", d.conn, func(args []byte) error {
+		ev := new(WebSocketCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -646,14 +827,29 @@ func (c *webSocketCreatedClient) Recv() (*WebSocketCreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WebSocketFrameError(ctx context.Context) (WebSocketFrameErrorClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameError", d.conn)
+func (d *domainClient) WebSocketFrameError(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketFrameErrorClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameError", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketFrameErrorClient{Stream: s}, nil
 }
 
+// OnWebSocketFrameError registers fn to be called for each
+// WebSocketFrameError event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketFrameError(fn func(*WebSocketFrameErrorReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketFrameError", d.conn, func(args []byte) error {
+		ev := new(WebSocketFrameErrorReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketFrameErrorClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -667,14 +863,29 @@ func (c *webSocketFrameErrorClient) Recv() (*WebSocketFrameErrorReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WebSocketFrameReceived(ctx context.Context) (WebSocketFrameReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameReceived", d.conn)
+func (d *domainClient) WebSocketFrameReceived(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketFrameReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketFrameReceivedClient{Stream: s}, nil
 }
 
+// OnWebSocketFrameReceived registers fn to be called for each
+// WebSocketFrameReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketFrameReceived(fn func(*WebSocketFrameReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketFrameReceived", d.conn, func(args []byte) error {
+		ev := new(WebSocketFrameReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketFrameReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -688,14 +899,29 @@ func (c *webSocketFrameReceivedClient) Recv() (*WebSocketFrameReceivedReply, err
 	return event, nil
 }
 
-func (d *domainClient) WebSocketFrameSent(ctx context.Context) (WebSocketFrameSentClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameSent", d.conn)
+func (d *domainClient) WebSocketFrameSent(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketFrameSentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketFrameSent", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketFrameSentClient{Stream: s}, nil
 }
 
+// OnWebSocketFrameSent registers fn to be called for each
+// WebSocketFrameSent event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketFrameSent(fn func(*WebSocketFrameSentReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketFrameSent", d.conn, func(args []byte) error {
+		ev := new(WebSocketFrameSentReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketFrameSentClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -709,14 +935,29 @@ func (c *webSocketFrameSentClient) Recv() (*WebSocketFrameSentReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WebSocketHandshakeResponseReceived(ctx context.Context) (WebSocketHandshakeResponseReceivedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketHandshakeResponseReceived", d.conn)
+func (d *domainClient) WebSocketHandshakeResponseReceived(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketHandshakeResponseReceivedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketHandshakeResponseReceived", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketHandshakeResponseReceivedClient{Stream: s}, nil
 }
 
+// OnWebSocketHandshakeResponseReceived registers fn to be called for each
+// WebSocketHandshakeResponseReceived event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketHandshakeResponseReceived(fn func(*WebSocketHandshakeResponseReceivedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketHandshakeResponseReceived", d.conn, func(args []byte) error {
+		ev := new(WebSocketHandshakeResponseReceivedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketHandshakeResponseReceivedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -730,14 +971,29 @@ func (c *webSocketHandshakeResponseReceivedClient) Recv() (*WebSocketHandshakeRe
 	return event, nil
 }
 
-func (d *domainClient) WebSocketWillSendHandshakeRequest(ctx context.Context) (WebSocketWillSendHandshakeRequestClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.webSocketWillSendHandshakeRequest", d.conn)
+func (d *domainClient) WebSocketWillSendHandshakeRequest(ctx context.Context, opts ...rpcc.StreamOption) (WebSocketWillSendHandshakeRequestClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.webSocketWillSendHandshakeRequest", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &webSocketWillSendHandshakeRequestClient{Stream: s}, nil
 }
 
+// OnWebSocketWillSendHandshakeRequest registers fn to be called for each
+// WebSocketWillSendHandshakeRequest event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWebSocketWillSendHandshakeRequest(fn func(*WebSocketWillSendHandshakeRequestReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.webSocketWillSendHandshakeRequest", d.conn, func(args []byte) error {
+		ev := new(WebSocketWillSendHandshakeRequestReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type webSocketWillSendHandshakeRequestClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -751,14 +1007,29 @@ func (c *webSocketWillSendHandshakeRequestClient) Recv() (*WebSocketWillSendHand
 	return event, nil
 }
 
-func (d *domainClient) RequestWillBeSentExtraInfo(ctx context.Context) (RequestWillBeSentExtraInfoClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSentExtraInfo", d.conn)
+func (d *domainClient) RequestWillBeSentExtraInfo(ctx context.Context, opts ...rpcc.StreamOption) (RequestWillBeSentExtraInfoClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.requestWillBeSentExtraInfo", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &requestWillBeSentExtraInfoClient{Stream: s}, nil
 }
 
+// OnRequestWillBeSentExtraInfo registers fn to be called for each
+// RequestWillBeSentExtraInfo event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnRequestWillBeSentExtraInfo(fn func(*RequestWillBeSentExtraInfoReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.requestWillBeSentExtraInfo", d.conn, func(args []byte) error {
+		ev := new(RequestWillBeSentExtraInfoReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type requestWillBeSentExtraInfoClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -772,14 +1043,29 @@ func (c *requestWillBeSentExtraInfoClient) Recv() (*RequestWillBeSentExtraInfoRe
 	return event, nil
 }
 
-func (d *domainClient) ResponseReceivedExtraInfo(ctx context.Context) (ResponseReceivedExtraInfoClient, error) {
-	s, err := rpcc.NewStream(ctx, "Network.responseReceivedExtraInfo", d.conn)
+func (d *domainClient) ResponseReceivedExtraInfo(ctx context.Context, opts ...rpcc.StreamOption) (ResponseReceivedExtraInfoClient, error) {
+	s, err := rpcc.NewStream(ctx, "Network.responseReceivedExtraInfo", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &responseReceivedExtraInfoClient{Stream: s}, nil
 }
 
+// OnResponseReceivedExtraInfo registers fn to be called for each
+// ResponseReceivedExtraInfo event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnResponseReceivedExtraInfo(fn func(*ResponseReceivedExtraInfoReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Network.responseReceivedExtraInfo", d.conn, func(args []byte) error {
+		ev := new(ResponseReceivedExtraInfoReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type responseReceivedExtraInfoClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
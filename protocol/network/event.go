@@ -3,6 +3,9 @@
 package network
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -24,6 +27,28 @@ type DataReceivedReply struct {
 	EncodedDataLength int           `json:"encodedDataLength"` // Actual bytes received (might be less than dataLength for compressed encodings).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DataReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "dataLength":
+			r.DataLength, err = fastjson.Int(value)
+		case "encodedDataLength":
+			r.EncodedDataLength, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // EventSourceMessageReceivedClient is a client for EventSourceMessageReceived events.
 // Fired when EventSource message is received.
 type EventSourceMessageReceivedClient interface {
@@ -42,6 +67,30 @@ type EventSourceMessageReceivedReply struct {
 	Data      string        `json:"data"`      // Message content.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EventSourceMessageReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "eventName":
+			r.EventName, err = fastjson.String(value)
+		case "eventId":
+			r.EventID, err = fastjson.String(value)
+		case "data":
+			r.Data, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // LoadingFailedClient is a client for LoadingFailed events. Fired when HTTP
 // request has failed to load.
 type LoadingFailedClient interface {
@@ -61,6 +110,42 @@ type LoadingFailedReply struct {
 	BlockedReason BlockedReason `json:"blockedReason,omitempty"` // The reason why loading was blocked, if any.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LoadingFailedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "type":
+			var v string
+			v, err = fastjson.String(value)
+			r.Type = ResourceType(v)
+		case "errorText":
+			r.ErrorText, err = fastjson.String(value)
+		case "canceled":
+			if fastjson.IsNull(value) {
+				r.Canceled = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.Canceled = &v
+		case "blockedReason":
+			var v string
+			v, err = fastjson.String(value)
+			r.BlockedReason = BlockedReason(v)
+		}
+		return err
+	})
+}
+
 // LoadingFinishedClient is a client for LoadingFinished events. Fired when
 // HTTP request has finished loading.
 type LoadingFinishedClient interface {
@@ -78,6 +163,34 @@ type LoadingFinishedReply struct {
 	ShouldReportCorbBlocking *bool         `json:"shouldReportCorbBlocking,omitempty"` // Set when 1) response was blocked by Cross-Origin Read Blocking and also 2) this needs to be reported to the DevTools console.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LoadingFinishedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "encodedDataLength":
+			r.EncodedDataLength, err = fastjson.Float(value)
+		case "shouldReportCorbBlocking":
+			if fastjson.IsNull(value) {
+				r.ShouldReportCorbBlocking = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.ShouldReportCorbBlocking = &v
+		}
+		return err
+	})
+}
+
 // RequestInterceptedClient is a client for RequestIntercepted events. Details
 // of an intercepted HTTP request, which must be either allowed, blocked,
 // modified or mocked. Deprecated, use Fetch.requestPaused instead.
@@ -104,6 +217,73 @@ type RequestInterceptedReply struct {
 	RequestID           *RequestID           `json:"requestId,omitempty"`           // If the intercepted request had a corresponding requestWillBeSent event fired for it, then this requestId will be the same as the requestId present in the requestWillBeSent event.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestInterceptedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "interceptionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.InterceptionID = InterceptionID(v)
+		case "request":
+			err = json.Unmarshal(value, &r.Request)
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = internal.PageFrameID(v)
+		case "resourceType":
+			var v string
+			v, err = fastjson.String(value)
+			r.ResourceType = ResourceType(v)
+		case "isNavigationRequest":
+			r.IsNavigationRequest, err = fastjson.Bool(value)
+		case "isDownload":
+			if fastjson.IsNull(value) {
+				r.IsDownload = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.IsDownload = &v
+		case "redirectUrl":
+			if fastjson.IsNull(value) {
+				r.RedirectURL = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.RedirectURL = &v
+		case "authChallenge":
+			err = json.Unmarshal(value, &r.AuthChallenge)
+		case "responseErrorReason":
+			var v string
+			v, err = fastjson.String(value)
+			r.ResponseErrorReason = ErrorReason(v)
+		case "responseStatusCode":
+			if fastjson.IsNull(value) {
+				r.ResponseStatusCode = nil
+				break
+			}
+			var v int
+			v, err = fastjson.Int(value)
+			r.ResponseStatusCode = &v
+		case "responseHeaders":
+			err = json.Unmarshal(value, &r.ResponseHeaders)
+		case "requestId":
+			if fastjson.IsNull(value) {
+				r.RequestID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := RequestID(v)
+			r.RequestID = &c
+		}
+		return err
+	})
+}
+
 // RequestServedFromCacheClient is a client for RequestServedFromCache events.
 // Fired if request ended up loading from cache.
 type RequestServedFromCacheClient interface {
@@ -118,6 +298,20 @@ type RequestServedFromCacheReply struct {
 	RequestID RequestID `json:"requestId"` // Request identifier.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestServedFromCacheReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		}
+		return err
+	})
+}
+
 // RequestWillBeSentClient is a client for RequestWillBeSent events. Fired
 // when page is about to send HTTP request.
 type RequestWillBeSentClient interface {
@@ -142,6 +336,61 @@ type RequestWillBeSentReply struct {
 	HasUserGesture   *bool                 `json:"hasUserGesture,omitempty"`   // Whether the request is initiated by a user gesture. Defaults to false.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestWillBeSentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "loaderId":
+			var v string
+			v, err = fastjson.String(value)
+			r.LoaderID = LoaderID(v)
+		case "documentURL":
+			r.DocumentURL, err = fastjson.String(value)
+		case "request":
+			err = json.Unmarshal(value, &r.Request)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "wallTime":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.WallTime = TimeSinceEpoch(v)
+		case "initiator":
+			err = json.Unmarshal(value, &r.Initiator)
+		case "redirectResponse":
+			err = json.Unmarshal(value, &r.RedirectResponse)
+		case "type":
+			var v string
+			v, err = fastjson.String(value)
+			r.Type = ResourceType(v)
+		case "frameId":
+			if fastjson.IsNull(value) {
+				r.FrameID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := internal.PageFrameID(v)
+			r.FrameID = &c
+		case "hasUserGesture":
+			if fastjson.IsNull(value) {
+				r.HasUserGesture = nil
+				break
+			}
+			var v bool
+			v, err = fastjson.Bool(value)
+			r.HasUserGesture = &v
+		}
+		return err
+	})
+}
+
 // ResourceChangedPriorityClient is a client for ResourceChangedPriority events.
 // Fired when resource loading priority is changed
 type ResourceChangedPriorityClient interface {
@@ -158,6 +407,28 @@ type ResourceChangedPriorityReply struct {
 	Timestamp   MonotonicTime    `json:"timestamp"`   // Timestamp.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResourceChangedPriorityReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "newPriority":
+			var v string
+			v, err = fastjson.String(value)
+			r.NewPriority = ResourcePriority(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		}
+		return err
+	})
+}
+
 // SignedExchangeReceivedClient is a client for SignedExchangeReceived events.
 // Fired when a signed exchange was received over the network
 type SignedExchangeReceivedClient interface {
@@ -173,6 +444,22 @@ type SignedExchangeReceivedReply struct {
 	Info      SignedExchangeInfo `json:"info"`      // Information about the signed exchange response.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SignedExchangeReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "info":
+			err = json.Unmarshal(value, &r.Info)
+		}
+		return err
+	})
+}
+
 // ResponseReceivedClient is a client for ResponseReceived events. Fired when
 // HTTP response is available.
 type ResponseReceivedClient interface {
@@ -192,6 +479,43 @@ type ResponseReceivedReply struct {
 	FrameID   *internal.PageFrameID `json:"frameId,omitempty"` // Frame identifier.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResponseReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "loaderId":
+			var v string
+			v, err = fastjson.String(value)
+			r.LoaderID = LoaderID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "type":
+			var v string
+			v, err = fastjson.String(value)
+			r.Type = ResourceType(v)
+		case "response":
+			err = json.Unmarshal(value, &r.Response)
+		case "frameId":
+			if fastjson.IsNull(value) {
+				r.FrameID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := internal.PageFrameID(v)
+			r.FrameID = &c
+		}
+		return err
+	})
+}
+
 // WebSocketClosedClient is a client for WebSocketClosed events. Fired when
 // WebSocket is closed.
 type WebSocketClosedClient interface {
@@ -207,6 +531,24 @@ type WebSocketClosedReply struct {
 	Timestamp MonotonicTime `json:"timestamp"` // Timestamp.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketClosedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		}
+		return err
+	})
+}
+
 // WebSocketCreatedClient is a client for WebSocketCreated events. Fired upon
 // WebSocket creation.
 type WebSocketCreatedClient interface {
@@ -223,6 +565,24 @@ type WebSocketCreatedReply struct {
 	Initiator *Initiator `json:"initiator,omitempty"` // Request initiator.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "initiator":
+			err = json.Unmarshal(value, &r.Initiator)
+		}
+		return err
+	})
+}
+
 // WebSocketFrameErrorClient is a client for WebSocketFrameError events. Fired
 // when WebSocket message error occurs.
 type WebSocketFrameErrorClient interface {
@@ -239,6 +599,26 @@ type WebSocketFrameErrorReply struct {
 	ErrorMessage string        `json:"errorMessage"` // WebSocket error message.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketFrameErrorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "errorMessage":
+			r.ErrorMessage, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // WebSocketFrameReceivedClient is a client for WebSocketFrameReceived events.
 // Fired when WebSocket message is received.
 type WebSocketFrameReceivedClient interface {
@@ -255,6 +635,26 @@ type WebSocketFrameReceivedReply struct {
 	Response  WebSocketFrame `json:"response"`  // WebSocket response data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketFrameReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "response":
+			err = json.Unmarshal(value, &r.Response)
+		}
+		return err
+	})
+}
+
 // WebSocketFrameSentClient is a client for WebSocketFrameSent events. Fired
 // when WebSocket message is sent.
 type WebSocketFrameSentClient interface {
@@ -271,6 +671,26 @@ type WebSocketFrameSentReply struct {
 	Response  WebSocketFrame `json:"response"`  // WebSocket response data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketFrameSentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "response":
+			err = json.Unmarshal(value, &r.Response)
+		}
+		return err
+	})
+}
+
 // WebSocketHandshakeResponseReceivedClient is a client for WebSocketHandshakeResponseReceived events.
 // Fired when WebSocket handshake response becomes available.
 type WebSocketHandshakeResponseReceivedClient interface {
@@ -287,6 +707,26 @@ type WebSocketHandshakeResponseReceivedReply struct {
 	Response  WebSocketResponse `json:"response"`  // WebSocket response data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketHandshakeResponseReceivedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "response":
+			err = json.Unmarshal(value, &r.Response)
+		}
+		return err
+	})
+}
+
 // WebSocketWillSendHandshakeRequestClient is a client for WebSocketWillSendHandshakeRequest events.
 // Fired when WebSocket is about to initiate handshake.
 type WebSocketWillSendHandshakeRequestClient interface {
@@ -304,6 +744,30 @@ type WebSocketWillSendHandshakeRequestReply struct {
 	Request   WebSocketRequest `json:"request"`   // WebSocket request data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WebSocketWillSendHandshakeRequestReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = MonotonicTime(v)
+		case "wallTime":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.WallTime = TimeSinceEpoch(v)
+		case "request":
+			err = json.Unmarshal(value, &r.Request)
+		}
+		return err
+	})
+}
+
 // RequestWillBeSentExtraInfoClient is a client for RequestWillBeSentExtraInfo events.
 // Fired when additional information about a requestWillBeSent event is
 // available from the network stack. Not every requestWillBeSent event will
@@ -324,6 +788,24 @@ type RequestWillBeSentExtraInfoReply struct {
 	Headers           Headers                   `json:"headers"`           // Raw request headers as they will be sent over the wire.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestWillBeSentExtraInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "associatedCookies":
+			err = json.Unmarshal(value, &r.AssociatedCookies)
+		case "headers":
+			err = json.Unmarshal(value, &r.Headers)
+		}
+		return err
+	})
+}
+
 // ResponseReceivedExtraInfoClient is a client for ResponseReceivedExtraInfo events.
 // Fired when additional information about a responseReceived event is
 // available from the network stack. Not every responseReceived event will have
@@ -343,3 +825,29 @@ type ResponseReceivedExtraInfoReply struct {
 	Headers        Headers                      `json:"headers"`               // Raw response headers as they were received over the wire.
 	HeadersText    *string                      `json:"headersText,omitempty"` // Raw response header text as it was received over the wire. The raw text may not always be available, such as in the case of HTTP/2 or QUIC.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ResponseReceivedExtraInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "requestId":
+			var v string
+			v, err = fastjson.String(value)
+			r.RequestID = RequestID(v)
+		case "blockedCookies":
+			err = json.Unmarshal(value, &r.BlockedCookies)
+		case "headers":
+			err = json.Unmarshal(value, &r.Headers)
+		case "headersText":
+			if fastjson.IsNull(value) {
+				r.HeadersText = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.HeadersText = &v
+		}
+		return err
+	})
+}
@@ -0,0 +1,129 @@
+package network_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mafredri/cdp/protocol/network"
+	"github.com/mafredri/cdp/protocol/page"
+)
+
+// Types without the generated (fast) methods, decoded via reflection.
+type (
+	plainDataReceivedReply            network.DataReceivedReply
+	plainRequestWillBeSentReply       network.RequestWillBeSentReply
+	plainNavigateArgs                 page.NavigateArgs
+	plainSetExtraHTTPHeadersArgs      network.SetExtraHTTPHeadersArgs
+	plainSetCookieArgs                network.SetCookieArgs
+	plainEmulateNetworkConditionsArgs network.EmulateNetworkConditionsArgs
+)
+
+var dataReceived = []byte(`{"requestId":"1000.42","timestamp":12345.678901,"dataLength":65536,"encodedDataLength":8192}`)
+
+var requestWillBeSent = []byte(`{"requestId":"1000.42","loaderId":"L1","documentURL":"https://example.com/","request":{"url":"https://example.com/","method":"GET","headers":{"Accept":"*/*"},"initialPriority":"High","referrerPolicy":"no-referrer"},"timestamp":1.5,"wallTime":1600000000.5,"initiator":{"type":"other"},"type":"Document","frameId":"F1","hasUserGesture":false}`)
+
+func TestFastJSON_Unmarshal(t *testing.T) {
+	var got network.DataReceivedReply
+	var want plainDataReceivedReply
+	if err := json.Unmarshal(dataReceived, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(dataReceived, &want); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(network.DataReceivedReply(want), got); diff != "" {
+		t.Errorf("DataReceivedReply diff (-want +got):\n%s", diff)
+	}
+
+	var got2 network.RequestWillBeSentReply
+	var want2 plainRequestWillBeSentReply
+	if err := json.Unmarshal(requestWillBeSent, &got2); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(requestWillBeSent, &want2); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(network.RequestWillBeSentReply(want2), got2); diff != "" {
+		t.Errorf("RequestWillBeSentReply diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestFastJSON_Marshal(t *testing.T) {
+	navigate := page.NewNavigateArgs("https://example.com/?a=<b>&c").
+		SetReferrer("https://example.com/").
+		SetTransitionType(page.TransitionTypeLink)
+	headers := network.NewSetExtraHTTPHeadersArgs(network.Headers(`{"X-Test":"1"}`))
+	cookie := network.NewSetCookieArgs("name", "value").
+		SetSecure(true).
+		SetExpires(network.TimeSinceEpoch(1600000000))
+	conditions := network.NewEmulateNetworkConditionsArgs(false, 10.5, 1e-7, -1)
+
+	for _, tt := range []struct {
+		v     interface{}
+		plain interface{}
+	}{
+		{navigate, (*plainNavigateArgs)(navigate)},
+		{page.NewNavigateArgs(""), (*plainNavigateArgs)(page.NewNavigateArgs(""))},
+		{headers, (*plainSetExtraHTTPHeadersArgs)(headers)},
+		{cookie, (*plainSetCookieArgs)(cookie)},
+		{conditions, (*plainEmulateNetworkConditionsArgs)(conditions)},
+		{(*page.NavigateArgs)(nil), (*plainNavigateArgs)(nil)},
+	} {
+		got, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := json.Marshal(tt.plain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Marshal(%T) diff (-want +got):\n%s", tt.v, diff)
+		}
+	}
+}
+
+func BenchmarkDataReceivedReply_Unmarshal(b *testing.B) {
+	b.Run("Reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var r plainDataReceivedReply
+			if err := json.Unmarshal(dataReceived, &r); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Fast", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			// Called directly, as done by rpcc.Stream.RecvMsg.
+			var r network.DataReceivedReply
+			if err := r.UnmarshalJSON(dataReceived); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkNavigateArgs_Marshal(b *testing.B) {
+	args := page.NewNavigateArgs("https://github.com/mafredri/cdp").
+		SetTransitionType(page.TransitionTypeLink)
+	b.Run("Reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal((*plainNavigateArgs)(args)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Fast", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := args.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
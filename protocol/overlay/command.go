@@ -5,6 +5,7 @@ package overlay
 import (
 	"encoding/json"
 
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/runtime"
@@ -25,6 +26,33 @@ func NewGetHighlightObjectForTestArgs(nodeID dom.NodeID) *GetHighlightObjectForT
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetHighlightObjectForTestArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "nodeId")
+	b = fastjson.AppendInt(b, int(a.NodeID))
+	if a.IncludeDistance != nil {
+		b = fastjson.AppendKey(b, "includeDistance")
+		b = fastjson.AppendBool(b, *a.IncludeDistance)
+	}
+	if a.IncludeStyle != nil {
+		b = fastjson.AppendKey(b, "includeStyle")
+		b = fastjson.AppendBool(b, *a.IncludeStyle)
+	}
+	if a.ColorFormat != "" {
+		b = fastjson.AppendKey(b, "colorFormat")
+		b = fastjson.AppendString(b, string(a.ColorFormat))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIncludeDistance sets the IncludeDistance optional argument.
 // Whether to include distance info.
 func (a *GetHighlightObjectForTestArgs) SetIncludeDistance(includeDistance bool) *GetHighlightObjectForTestArgs {
@@ -51,6 +79,18 @@ type GetHighlightObjectForTestReply struct {
 	Highlight json.RawMessage `json:"highlight"` // Highlight data for the node.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetHighlightObjectForTestReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "highlight":
+			err = json.Unmarshal(value, &r.Highlight)
+		}
+		return err
+	})
+}
+
 // HighlightFrameArgs represents the arguments for HighlightFrame in the Overlay domain.
 type HighlightFrameArgs struct {
 	FrameID             page.FrameID `json:"frameId"`                       // Identifier of the frame to highlight.
@@ -65,6 +105,33 @@ func NewHighlightFrameArgs(frameID page.FrameID) *HighlightFrameArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HighlightFrameArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	if a.ContentColor != nil {
+		b = fastjson.AppendKey(b, "contentColor")
+		if b, err = fastjson.AppendValue(b, a.ContentColor); err != nil {
+			return nil, err
+		}
+	}
+	if a.ContentOutlineColor != nil {
+		b = fastjson.AppendKey(b, "contentOutlineColor")
+		if b, err = fastjson.AppendValue(b, a.ContentOutlineColor); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetContentColor sets the ContentColor optional argument. The
 // content box highlight fill color (default: transparent).
 func (a *HighlightFrameArgs) SetContentColor(contentColor dom.RGBA) *HighlightFrameArgs {
@@ -95,6 +162,39 @@ func NewHighlightNodeArgs(highlightConfig HighlightConfig) *HighlightNodeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HighlightNodeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "highlightConfig")
+	if b, err = fastjson.AppendValue(b, a.HighlightConfig); err != nil {
+		return nil, err
+	}
+	if a.NodeID != nil {
+		b = fastjson.AppendKey(b, "nodeId")
+		b = fastjson.AppendInt(b, int(*a.NodeID))
+	}
+	if a.BackendNodeID != nil {
+		b = fastjson.AppendKey(b, "backendNodeId")
+		b = fastjson.AppendInt(b, int(*a.BackendNodeID))
+	}
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	if a.Selector != nil {
+		b = fastjson.AppendKey(b, "selector")
+		b = fastjson.AppendString(b, *a.Selector)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetNodeID sets the NodeID optional argument. Identifier of the node
 // to highlight.
 func (a *HighlightNodeArgs) SetNodeID(nodeID dom.NodeID) *HighlightNodeArgs {
@@ -137,6 +237,35 @@ func NewHighlightQuadArgs(quad dom.Quad) *HighlightQuadArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HighlightQuadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "quad")
+	if b, err = fastjson.AppendValue(b, a.Quad); err != nil {
+		return nil, err
+	}
+	if a.Color != nil {
+		b = fastjson.AppendKey(b, "color")
+		if b, err = fastjson.AppendValue(b, a.Color); err != nil {
+			return nil, err
+		}
+	}
+	if a.OutlineColor != nil {
+		b = fastjson.AppendKey(b, "outlineColor")
+		if b, err = fastjson.AppendValue(b, a.OutlineColor); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetColor sets the Color optional argument. The highlight fill color
 // (default: transparent).
 func (a *HighlightQuadArgs) SetColor(color dom.RGBA) *HighlightQuadArgs {
@@ -171,6 +300,39 @@ func NewHighlightRectArgs(x int, y int, width int, height int) *HighlightRectArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HighlightRectArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "x")
+	b = fastjson.AppendInt(b, a.X)
+	b = fastjson.AppendKey(b, "y")
+	b = fastjson.AppendInt(b, a.Y)
+	b = fastjson.AppendKey(b, "width")
+	b = fastjson.AppendInt(b, a.Width)
+	b = fastjson.AppendKey(b, "height")
+	b = fastjson.AppendInt(b, a.Height)
+	if a.Color != nil {
+		b = fastjson.AppendKey(b, "color")
+		if b, err = fastjson.AppendValue(b, a.Color); err != nil {
+			return nil, err
+		}
+	}
+	if a.OutlineColor != nil {
+		b = fastjson.AppendKey(b, "outlineColor")
+		if b, err = fastjson.AppendValue(b, a.OutlineColor); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetColor sets the Color optional argument. The highlight fill color
 // (default: transparent).
 func (a *HighlightRectArgs) SetColor(color dom.RGBA) *HighlightRectArgs {
@@ -198,6 +360,27 @@ func NewSetInspectModeArgs(mode InspectMode) *SetInspectModeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetInspectModeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "mode")
+	b = fastjson.AppendString(b, string(a.Mode))
+	if a.HighlightConfig != nil {
+		b = fastjson.AppendKey(b, "highlightConfig")
+		if b, err = fastjson.AppendValue(b, a.HighlightConfig); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetHighlightConfig sets the HighlightConfig optional argument. A
 // descriptor for the highlight appearance of hovered-over nodes. May
 // be omitted if `enabled == false`.
@@ -218,6 +401,21 @@ func NewSetShowAdHighlightsArgs(show bool) *SetShowAdHighlightsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowAdHighlightsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPausedInDebuggerMessageArgs represents the arguments for SetPausedInDebuggerMessage in the Overlay domain.
 type SetPausedInDebuggerMessageArgs struct {
 	Message *string `json:"message,omitempty"` // The message to display, also triggers resume and step over controls.
@@ -230,6 +428,23 @@ func NewSetPausedInDebuggerMessageArgs() *SetPausedInDebuggerMessageArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetPausedInDebuggerMessageArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Message != nil {
+		b = fastjson.AppendKey(b, "message")
+		b = fastjson.AppendString(b, *a.Message)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMessage sets the Message optional argument. The message to
 // display, also triggers resume and step over controls.
 func (a *SetPausedInDebuggerMessageArgs) SetMessage(message string) *SetPausedInDebuggerMessageArgs {
@@ -249,6 +464,21 @@ func NewSetShowDebugBordersArgs(show bool) *SetShowDebugBordersArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowDebugBordersArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowFPSCounterArgs represents the arguments for SetShowFPSCounter in the Overlay domain.
 type SetShowFPSCounterArgs struct {
 	Show bool `json:"show"` // True for showing the FPS counter
@@ -261,6 +491,21 @@ func NewSetShowFPSCounterArgs(show bool) *SetShowFPSCounterArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowFPSCounterArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowPaintRectsArgs represents the arguments for SetShowPaintRects in the Overlay domain.
 type SetShowPaintRectsArgs struct {
 	Result bool `json:"result"` // True for showing paint rectangles
@@ -273,6 +518,21 @@ func NewSetShowPaintRectsArgs(result bool) *SetShowPaintRectsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowPaintRectsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "result")
+	b = fastjson.AppendBool(b, a.Result)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowLayoutShiftRegionsArgs represents the arguments for SetShowLayoutShiftRegions in the Overlay domain.
 type SetShowLayoutShiftRegionsArgs struct {
 	Result bool `json:"result"` // True for showing layout shift regions
@@ -285,6 +545,21 @@ func NewSetShowLayoutShiftRegionsArgs(result bool) *SetShowLayoutShiftRegionsArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowLayoutShiftRegionsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "result")
+	b = fastjson.AppendBool(b, a.Result)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowScrollBottleneckRectsArgs represents the arguments for SetShowScrollBottleneckRects in the Overlay domain.
 type SetShowScrollBottleneckRectsArgs struct {
 	Show bool `json:"show"` // True for showing scroll bottleneck rects
@@ -297,6 +572,21 @@ func NewSetShowScrollBottleneckRectsArgs(show bool) *SetShowScrollBottleneckRect
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowScrollBottleneckRectsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowHitTestBordersArgs represents the arguments for SetShowHitTestBorders in the Overlay domain.
 type SetShowHitTestBordersArgs struct {
 	Show bool `json:"show"` // True for showing hit-test borders
@@ -309,6 +599,21 @@ func NewSetShowHitTestBordersArgs(show bool) *SetShowHitTestBordersArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowHitTestBordersArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowViewportSizeOnResizeArgs represents the arguments for SetShowViewportSizeOnResize in the Overlay domain.
 type SetShowViewportSizeOnResizeArgs struct {
 	Show bool `json:"show"` // Whether to paint size or not.
@@ -321,6 +626,21 @@ func NewSetShowViewportSizeOnResizeArgs(show bool) *SetShowViewportSizeOnResizeA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowViewportSizeOnResizeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "show")
+	b = fastjson.AppendBool(b, a.Show)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetShowHingeArgs represents the arguments for SetShowHinge in the Overlay domain.
 type SetShowHingeArgs struct {
 	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"` // hinge data, null means hideHinge
@@ -333,6 +653,25 @@ func NewSetShowHingeArgs() *SetShowHingeArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetShowHingeArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.HingeConfig != nil {
+		b = fastjson.AppendKey(b, "hingeConfig")
+		if b, err = fastjson.AppendValue(b, a.HingeConfig); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetHingeConfig sets the HingeConfig optional argument. hinge data,
 // null means hideHinge
 func (a *SetShowHingeArgs) SetHingeConfig(hingeConfig HingeConfig) *SetShowHingeArgs {
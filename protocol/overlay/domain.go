@@ -6,6 +6,7 @@ package overlay
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -271,14 +272,29 @@ func (d *domainClient) SetShowHinge(ctx context.Context, args *SetShowHingeArgs)
 	return
 }
 
-func (d *domainClient) InspectNodeRequested(ctx context.Context) (InspectNodeRequestedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Overlay.inspectNodeRequested", d.conn)
+func (d *domainClient) InspectNodeRequested(ctx context.Context, opts ...rpcc.StreamOption) (InspectNodeRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Overlay.inspectNodeRequested", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &inspectNodeRequestedClient{Stream: s}, nil
 }
 
+// OnInspectNodeRequested registers fn to be called for each
+// InspectNodeRequested event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInspectNodeRequested(fn func(*InspectNodeRequestedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Overlay.inspectNodeRequested", d.conn, func(args []byte) error {
+		ev := new(InspectNodeRequestedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type inspectNodeRequestedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -292,14 +308,29 @@ func (c *inspectNodeRequestedClient) Recv() (*InspectNodeRequestedReply, error)
 	return event, nil
 }
 
-func (d *domainClient) NodeHighlightRequested(ctx context.Context) (NodeHighlightRequestedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Overlay.nodeHighlightRequested", d.conn)
+func (d *domainClient) NodeHighlightRequested(ctx context.Context, opts ...rpcc.StreamOption) (NodeHighlightRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Overlay.nodeHighlightRequested", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &nodeHighlightRequestedClient{Stream: s}, nil
 }
 
+// OnNodeHighlightRequested registers fn to be called for each
+// NodeHighlightRequested event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNodeHighlightRequested(fn func(*NodeHighlightRequestedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Overlay.nodeHighlightRequested", d.conn, func(args []byte) error {
+		ev := new(NodeHighlightRequestedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type nodeHighlightRequestedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -313,14 +344,29 @@ func (c *nodeHighlightRequestedClient) Recv() (*NodeHighlightRequestedReply, err
 	return event, nil
 }
 
-func (d *domainClient) ScreenshotRequested(ctx context.Context) (ScreenshotRequestedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Overlay.screenshotRequested", d.conn)
+func (d *domainClient) ScreenshotRequested(ctx context.Context, opts ...rpcc.StreamOption) (ScreenshotRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Overlay.screenshotRequested", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &screenshotRequestedClient{Stream: s}, nil
 }
 
+// OnScreenshotRequested registers fn to be called for each
+// ScreenshotRequested event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScreenshotRequested(fn func(*ScreenshotRequestedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Overlay.screenshotRequested", d.conn, func(args []byte) error {
+		ev := new(ScreenshotRequestedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type screenshotRequestedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -334,14 +380,29 @@ func (c *screenshotRequestedClient) Recv() (*ScreenshotRequestedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) InspectModeCanceled(ctx context.Context) (InspectModeCanceledClient, error) {
-	s, err := rpcc.NewStream(ctx, "Overlay.inspectModeCanceled", d.conn)
+func (d *domainClient) InspectModeCanceled(ctx context.Context, opts ...rpcc.StreamOption) (InspectModeCanceledClient, error) {
+	s, err := rpcc.NewStream(ctx, "Overlay.inspectModeCanceled", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &inspectModeCanceledClient{Stream: s}, nil
 }
 
+// OnInspectModeCanceled registers fn to be called for each
+// InspectModeCanceled event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInspectModeCanceled(fn func(*InspectModeCanceledReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Overlay.inspectModeCanceled", d.conn, func(args []byte) error {
+		ev := new(InspectModeCanceledReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type inspectModeCanceledClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
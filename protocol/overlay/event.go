@@ -3,6 +3,9 @@
 package overlay
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/rpcc"
@@ -23,6 +26,20 @@ type InspectNodeRequestedReply struct {
 	BackendNodeID dom.BackendNodeID `json:"backendNodeId"` // Id of the node to inspect.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *InspectNodeRequestedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "backendNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.BackendNodeID = dom.BackendNodeID(v)
+		}
+		return err
+	})
+}
+
 // NodeHighlightRequestedClient is a client for NodeHighlightRequested events.
 // Fired when the node should be highlighted. This happens after call to
 // `setInspectMode`.
@@ -38,6 +55,20 @@ type NodeHighlightRequestedReply struct {
 	NodeID dom.NodeID `json:"nodeId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NodeHighlightRequestedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "nodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.NodeID = dom.NodeID(v)
+		}
+		return err
+	})
+}
+
 // ScreenshotRequestedClient is a client for ScreenshotRequested events. Fired
 // when user asks to capture screenshot of some area on the page.
 type ScreenshotRequestedClient interface {
@@ -52,6 +83,18 @@ type ScreenshotRequestedReply struct {
 	Viewport page.Viewport `json:"viewport"` // Viewport to capture, in device independent pixels (dip).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ScreenshotRequestedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "viewport":
+			err = json.Unmarshal(value, &r.Viewport)
+		}
+		return err
+	})
+}
+
 // InspectModeCanceledClient is a client for InspectModeCanceled events. Fired
 // when user cancels the inspect mode.
 type InspectModeCanceledClient interface {
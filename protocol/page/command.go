@@ -3,6 +3,9 @@
 package page
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/debugger"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/io"
@@ -22,11 +25,40 @@ func NewAddScriptToEvaluateOnLoadArgs(scriptSource string) *AddScriptToEvaluateO
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddScriptToEvaluateOnLoadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptSource")
+	b = fastjson.AppendString(b, a.ScriptSource)
+	b = append(b, '}')
+	return b, err
+}
+
 // AddScriptToEvaluateOnLoadReply represents the return values for AddScriptToEvaluateOnLoad in the Page domain.
 type AddScriptToEvaluateOnLoadReply struct {
 	Identifier ScriptIdentifier `json:"identifier"` // Identifier of the added script.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddScriptToEvaluateOnLoadReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "identifier":
+			var v string
+			v, err = fastjson.String(value)
+			r.Identifier = ScriptIdentifier(v)
+		}
+		return err
+	})
+}
+
 // AddScriptToEvaluateOnNewDocumentArgs represents the arguments for AddScriptToEvaluateOnNewDocument in the Page domain.
 type AddScriptToEvaluateOnNewDocumentArgs struct {
 	Source string `json:"source"` // No description.
@@ -46,6 +78,25 @@ func NewAddScriptToEvaluateOnNewDocumentArgs(source string) *AddScriptToEvaluate
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddScriptToEvaluateOnNewDocumentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "source")
+	b = fastjson.AppendString(b, a.Source)
+	if a.WorldName != nil {
+		b = fastjson.AppendKey(b, "worldName")
+		b = fastjson.AppendString(b, *a.WorldName)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetWorldName sets the WorldName optional argument. If specified,
 // creates an isolated world with the given name and evaluates given
 // script in it. This world name will be used as the
@@ -63,6 +114,20 @@ type AddScriptToEvaluateOnNewDocumentReply struct {
 	Identifier ScriptIdentifier `json:"identifier"` // Identifier of the added script.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddScriptToEvaluateOnNewDocumentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "identifier":
+			var v string
+			v, err = fastjson.String(value)
+			r.Identifier = ScriptIdentifier(v)
+		}
+		return err
+	})
+}
+
 // CaptureScreenshotArgs represents the arguments for CaptureScreenshot in the Page domain.
 type CaptureScreenshotArgs struct {
 	// Format Image compression format (defaults to png).
@@ -85,6 +150,37 @@ func NewCaptureScreenshotArgs() *CaptureScreenshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CaptureScreenshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Format != nil {
+		b = fastjson.AppendKey(b, "format")
+		b = fastjson.AppendString(b, *a.Format)
+	}
+	if a.Quality != nil {
+		b = fastjson.AppendKey(b, "quality")
+		b = fastjson.AppendInt(b, *a.Quality)
+	}
+	if a.Clip != nil {
+		b = fastjson.AppendKey(b, "clip")
+		if b, err = fastjson.AppendValue(b, a.Clip); err != nil {
+			return nil, err
+		}
+	}
+	if a.FromSurface != nil {
+		b = fastjson.AppendKey(b, "fromSurface")
+		b = fastjson.AppendBool(b, *a.FromSurface)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFormat sets the Format optional argument. Image compression
 // format (defaults to png).
 //
@@ -122,6 +218,18 @@ type CaptureScreenshotReply struct {
 	Data []byte `json:"data"` // Base64-encoded image data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CaptureScreenshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "data":
+			err = json.Unmarshal(value, &r.Data)
+		}
+		return err
+	})
+}
+
 // CaptureSnapshotArgs represents the arguments for CaptureSnapshot in the Page domain.
 type CaptureSnapshotArgs struct {
 	// Format Format (defaults to mhtml).
@@ -137,6 +245,23 @@ func NewCaptureSnapshotArgs() *CaptureSnapshotArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CaptureSnapshotArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Format != nil {
+		b = fastjson.AppendKey(b, "format")
+		b = fastjson.AppendString(b, *a.Format)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFormat sets the Format optional argument. Format (defaults to
 // mhtml).
 //
@@ -151,6 +276,18 @@ type CaptureSnapshotReply struct {
 	Data string `json:"data"` // Serialized page data.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CaptureSnapshotReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "data":
+			r.Data, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // CreateIsolatedWorldArgs represents the arguments for CreateIsolatedWorld in the Page domain.
 type CreateIsolatedWorldArgs struct {
 	FrameID             FrameID `json:"frameId"`                       // Id of the frame in which the isolated world should be created.
@@ -165,6 +302,29 @@ func NewCreateIsolatedWorldArgs(frameID FrameID) *CreateIsolatedWorldArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CreateIsolatedWorldArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	if a.WorldName != nil {
+		b = fastjson.AppendKey(b, "worldName")
+		b = fastjson.AppendString(b, *a.WorldName)
+	}
+	if a.GrantUniveralAccess != nil {
+		b = fastjson.AppendKey(b, "grantUniveralAccess")
+		b = fastjson.AppendBool(b, *a.GrantUniveralAccess)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetWorldName sets the WorldName optional argument. An optional name
 // which is reported in the Execution Context.
 func (a *CreateIsolatedWorldArgs) SetWorldName(worldName string) *CreateIsolatedWorldArgs {
@@ -185,6 +345,20 @@ type CreateIsolatedWorldReply struct {
 	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"` // Execution context of the isolated world.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreateIsolatedWorldReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = runtime.ExecutionContextID(v)
+		}
+		return err
+	})
+}
+
 // GetAppManifestReply represents the return values for GetAppManifest in the Page domain.
 type GetAppManifestReply struct {
 	URL    string             `json:"url"`            // Manifest location.
@@ -196,21 +370,87 @@ type GetAppManifestReply struct {
 	Parsed *AppManifestParsedProperties `json:"parsed,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetAppManifestReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "errors":
+			err = json.Unmarshal(value, &r.Errors)
+		case "data":
+			if fastjson.IsNull(value) {
+				r.Data = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Data = &v
+		case "parsed":
+			err = json.Unmarshal(value, &r.Parsed)
+		}
+		return err
+	})
+}
+
 // GetInstallabilityErrorsReply represents the return values for GetInstallabilityErrors in the Page domain.
 type GetInstallabilityErrorsReply struct {
 	InstallabilityErrors []InstallabilityError `json:"installabilityErrors"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetInstallabilityErrorsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "installabilityErrors":
+			err = json.Unmarshal(value, &r.InstallabilityErrors)
+		}
+		return err
+	})
+}
+
 // GetManifestIconsReply represents the return values for GetManifestIcons in the Page domain.
 type GetManifestIconsReply struct {
 	PrimaryIcon *string `json:"primaryIcon,omitempty"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetManifestIconsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "primaryIcon":
+			if fastjson.IsNull(value) {
+				r.PrimaryIcon = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.PrimaryIcon = &v
+		}
+		return err
+	})
+}
+
 // GetFrameTreeReply represents the return values for GetFrameTree in the Page domain.
 type GetFrameTreeReply struct {
 	FrameTree FrameTree `json:"frameTree"` // Present frame tree structure.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetFrameTreeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameTree":
+			err = json.Unmarshal(value, &r.FrameTree)
+		}
+		return err
+	})
+}
+
 // GetLayoutMetricsReply represents the return values for GetLayoutMetrics in the Page domain.
 type GetLayoutMetricsReply struct {
 	LayoutViewport LayoutViewport `json:"layoutViewport"` // Metrics relating to the layout viewport.
@@ -218,12 +458,42 @@ type GetLayoutMetricsReply struct {
 	ContentSize    dom.Rect       `json:"contentSize"`    // Size of scrollable area.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetLayoutMetricsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "layoutViewport":
+			err = json.Unmarshal(value, &r.LayoutViewport)
+		case "visualViewport":
+			err = json.Unmarshal(value, &r.VisualViewport)
+		case "contentSize":
+			err = json.Unmarshal(value, &r.ContentSize)
+		}
+		return err
+	})
+}
+
 // GetNavigationHistoryReply represents the return values for GetNavigationHistory in the Page domain.
 type GetNavigationHistoryReply struct {
 	CurrentIndex int               `json:"currentIndex"` // Index of the current navigation history entry.
 	Entries      []NavigationEntry `json:"entries"`      // Array of navigation history entries.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetNavigationHistoryReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "currentIndex":
+			r.CurrentIndex, err = fastjson.Int(value)
+		case "entries":
+			err = json.Unmarshal(value, &r.Entries)
+		}
+		return err
+	})
+}
+
 // GetResourceContentArgs represents the arguments for GetResourceContent in the Page domain.
 type GetResourceContentArgs struct {
 	FrameID FrameID `json:"frameId"` // Frame id to get resource for.
@@ -238,17 +508,60 @@ func NewGetResourceContentArgs(frameID FrameID, url string) *GetResourceContentA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetResourceContentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetResourceContentReply represents the return values for GetResourceContent in the Page domain.
 type GetResourceContentReply struct {
 	Content       string `json:"content"`       // Resource content.
 	Base64Encoded bool   `json:"base64Encoded"` // True, if content was served as base64.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetResourceContentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "content":
+			r.Content, err = fastjson.String(value)
+		case "base64Encoded":
+			r.Base64Encoded, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // GetResourceTreeReply represents the return values for GetResourceTree in the Page domain.
 type GetResourceTreeReply struct {
 	FrameTree FrameResourceTree `json:"frameTree"` // Present frame / resource tree structure.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetResourceTreeReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameTree":
+			err = json.Unmarshal(value, &r.FrameTree)
+		}
+		return err
+	})
+}
+
 // HandleJavaScriptDialogArgs represents the arguments for HandleJavaScriptDialog in the Page domain.
 type HandleJavaScriptDialogArgs struct {
 	Accept     bool    `json:"accept"`               // Whether to accept or dismiss the dialog.
@@ -262,6 +575,25 @@ func NewHandleJavaScriptDialogArgs(accept bool) *HandleJavaScriptDialogArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HandleJavaScriptDialogArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "accept")
+	b = fastjson.AppendBool(b, a.Accept)
+	if a.PromptText != nil {
+		b = fastjson.AppendKey(b, "promptText")
+		b = fastjson.AppendString(b, *a.PromptText)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetPromptText sets the PromptText optional argument. The text to
 // enter into the dialog prompt before accepting. Used only if this is
 // a prompt dialog.
@@ -289,6 +621,37 @@ func NewNavigateArgs(url string) *NavigateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *NavigateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	if a.Referrer != nil {
+		b = fastjson.AppendKey(b, "referrer")
+		b = fastjson.AppendString(b, *a.Referrer)
+	}
+	if a.TransitionType != "" {
+		b = fastjson.AppendKey(b, "transitionType")
+		b = fastjson.AppendString(b, string(a.TransitionType))
+	}
+	if a.FrameID != nil {
+		b = fastjson.AppendKey(b, "frameId")
+		b = fastjson.AppendString(b, string(*a.FrameID))
+	}
+	if a.ReferrerPolicy != "" {
+		b = fastjson.AppendKey(b, "referrerPolicy")
+		b = fastjson.AppendString(b, string(a.ReferrerPolicy))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetReferrer sets the Referrer optional argument. Referrer URL.
 func (a *NavigateArgs) SetReferrer(referrer string) *NavigateArgs {
 	a.Referrer = &referrer
@@ -325,6 +688,37 @@ type NavigateReply struct {
 	ErrorText *string           `json:"errorText,omitempty"` // User friendly error message, present if and only if navigation has failed.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NavigateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "loaderId":
+			if fastjson.IsNull(value) {
+				r.LoaderID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := network.LoaderID(v)
+			r.LoaderID = &c
+		case "errorText":
+			if fastjson.IsNull(value) {
+				r.ErrorText = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.ErrorText = &v
+		}
+		return err
+	})
+}
+
 // NavigateToHistoryEntryArgs represents the arguments for NavigateToHistoryEntry in the Page domain.
 type NavigateToHistoryEntryArgs struct {
 	EntryID int `json:"entryId"` // Unique id of the entry to navigate to.
@@ -337,6 +731,21 @@ func NewNavigateToHistoryEntryArgs(entryID int) *NavigateToHistoryEntryArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *NavigateToHistoryEntryArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "entryId")
+	b = fastjson.AppendInt(b, a.EntryID)
+	b = append(b, '}')
+	return b, err
+}
+
 // PrintToPDFArgs represents the arguments for PrintToPDF in the Page domain.
 type PrintToPDFArgs struct {
 	Landscape               *bool    `json:"landscape,omitempty"`               // Paper orientation. Defaults to false.
@@ -369,6 +778,97 @@ func NewPrintToPDFArgs() *PrintToPDFArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *PrintToPDFArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Landscape != nil {
+		b = fastjson.AppendKey(b, "landscape")
+		b = fastjson.AppendBool(b, *a.Landscape)
+	}
+	if a.DisplayHeaderFooter != nil {
+		b = fastjson.AppendKey(b, "displayHeaderFooter")
+		b = fastjson.AppendBool(b, *a.DisplayHeaderFooter)
+	}
+	if a.PrintBackground != nil {
+		b = fastjson.AppendKey(b, "printBackground")
+		b = fastjson.AppendBool(b, *a.PrintBackground)
+	}
+	if a.Scale != nil {
+		b = fastjson.AppendKey(b, "scale")
+		if b, err = fastjson.AppendFloat(b, *a.Scale); err != nil {
+			return nil, err
+		}
+	}
+	if a.PaperWidth != nil {
+		b = fastjson.AppendKey(b, "paperWidth")
+		if b, err = fastjson.AppendFloat(b, *a.PaperWidth); err != nil {
+			return nil, err
+		}
+	}
+	if a.PaperHeight != nil {
+		b = fastjson.AppendKey(b, "paperHeight")
+		if b, err = fastjson.AppendFloat(b, *a.PaperHeight); err != nil {
+			return nil, err
+		}
+	}
+	if a.MarginTop != nil {
+		b = fastjson.AppendKey(b, "marginTop")
+		if b, err = fastjson.AppendFloat(b, *a.MarginTop); err != nil {
+			return nil, err
+		}
+	}
+	if a.MarginBottom != nil {
+		b = fastjson.AppendKey(b, "marginBottom")
+		if b, err = fastjson.AppendFloat(b, *a.MarginBottom); err != nil {
+			return nil, err
+		}
+	}
+	if a.MarginLeft != nil {
+		b = fastjson.AppendKey(b, "marginLeft")
+		if b, err = fastjson.AppendFloat(b, *a.MarginLeft); err != nil {
+			return nil, err
+		}
+	}
+	if a.MarginRight != nil {
+		b = fastjson.AppendKey(b, "marginRight")
+		if b, err = fastjson.AppendFloat(b, *a.MarginRight); err != nil {
+			return nil, err
+		}
+	}
+	if a.PageRanges != nil {
+		b = fastjson.AppendKey(b, "pageRanges")
+		b = fastjson.AppendString(b, *a.PageRanges)
+	}
+	if a.IgnoreInvalidPageRanges != nil {
+		b = fastjson.AppendKey(b, "ignoreInvalidPageRanges")
+		b = fastjson.AppendBool(b, *a.IgnoreInvalidPageRanges)
+	}
+	if a.HeaderTemplate != nil {
+		b = fastjson.AppendKey(b, "headerTemplate")
+		b = fastjson.AppendString(b, *a.HeaderTemplate)
+	}
+	if a.FooterTemplate != nil {
+		b = fastjson.AppendKey(b, "footerTemplate")
+		b = fastjson.AppendString(b, *a.FooterTemplate)
+	}
+	if a.PreferCSSPageSize != nil {
+		b = fastjson.AppendKey(b, "preferCSSPageSize")
+		b = fastjson.AppendBool(b, *a.PreferCSSPageSize)
+	}
+	if a.TransferMode != nil {
+		b = fastjson.AppendKey(b, "transferMode")
+		b = fastjson.AppendString(b, *a.TransferMode)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetLandscape sets the Landscape optional argument. Paper
 // orientation. Defaults to false.
 func (a *PrintToPDFArgs) SetLandscape(landscape bool) *PrintToPDFArgs {
@@ -506,6 +1006,27 @@ type PrintToPDFReply struct {
 	Stream *io.StreamHandle `json:"stream,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PrintToPDFReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "data":
+			err = json.Unmarshal(value, &r.Data)
+		case "stream":
+			if fastjson.IsNull(value) {
+				r.Stream = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := io.StreamHandle(v)
+			r.Stream = &c
+		}
+		return err
+	})
+}
+
 // ReloadArgs represents the arguments for Reload in the Page domain.
 type ReloadArgs struct {
 	IgnoreCache            *bool   `json:"ignoreCache,omitempty"`            // If true, browser cache is ignored (as if the user pressed Shift+refresh).
@@ -519,6 +1040,27 @@ func NewReloadArgs() *ReloadArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReloadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.IgnoreCache != nil {
+		b = fastjson.AppendKey(b, "ignoreCache")
+		b = fastjson.AppendBool(b, *a.IgnoreCache)
+	}
+	if a.ScriptToEvaluateOnLoad != nil {
+		b = fastjson.AppendKey(b, "scriptToEvaluateOnLoad")
+		b = fastjson.AppendString(b, *a.ScriptToEvaluateOnLoad)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetIgnoreCache sets the IgnoreCache optional argument. If true,
 // browser cache is ignored (as if the user pressed Shift+refresh).
 func (a *ReloadArgs) SetIgnoreCache(ignoreCache bool) *ReloadArgs {
@@ -547,6 +1089,21 @@ func NewRemoveScriptToEvaluateOnLoadArgs(identifier ScriptIdentifier) *RemoveScr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveScriptToEvaluateOnLoadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "identifier")
+	b = fastjson.AppendString(b, string(a.Identifier))
+	b = append(b, '}')
+	return b, err
+}
+
 // RemoveScriptToEvaluateOnNewDocumentArgs represents the arguments for RemoveScriptToEvaluateOnNewDocument in the Page domain.
 type RemoveScriptToEvaluateOnNewDocumentArgs struct {
 	Identifier ScriptIdentifier `json:"identifier"` // No description.
@@ -559,6 +1116,21 @@ func NewRemoveScriptToEvaluateOnNewDocumentArgs(identifier ScriptIdentifier) *Re
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveScriptToEvaluateOnNewDocumentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "identifier")
+	b = fastjson.AppendString(b, string(a.Identifier))
+	b = append(b, '}')
+	return b, err
+}
+
 // ScreencastFrameAckArgs represents the arguments for ScreencastFrameAck in the Page domain.
 type ScreencastFrameAckArgs struct {
 	SessionID int `json:"sessionId"` // Frame number.
@@ -571,6 +1143,21 @@ func NewScreencastFrameAckArgs(sessionID int) *ScreencastFrameAckArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ScreencastFrameAckArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "sessionId")
+	b = fastjson.AppendInt(b, a.SessionID)
+	b = append(b, '}')
+	return b, err
+}
+
 // SearchInResourceArgs represents the arguments for SearchInResource in the Page domain.
 type SearchInResourceArgs struct {
 	FrameID       FrameID `json:"frameId"`                 // Frame id for resource to search in.
@@ -589,6 +1176,33 @@ func NewSearchInResourceArgs(frameID FrameID, url string, query string) *SearchI
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SearchInResourceArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	b = fastjson.AppendKey(b, "query")
+	b = fastjson.AppendString(b, a.Query)
+	if a.CaseSensitive != nil {
+		b = fastjson.AppendKey(b, "caseSensitive")
+		b = fastjson.AppendBool(b, *a.CaseSensitive)
+	}
+	if a.IsRegex != nil {
+		b = fastjson.AppendKey(b, "isRegex")
+		b = fastjson.AppendBool(b, *a.IsRegex)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCaseSensitive sets the CaseSensitive optional argument. If true,
 // search is case sensitive.
 func (a *SearchInResourceArgs) SetCaseSensitive(caseSensitive bool) *SearchInResourceArgs {
@@ -608,6 +1222,18 @@ type SearchInResourceReply struct {
 	Result []debugger.SearchMatch `json:"result"` // List of search matches.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *SearchInResourceReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // SetAdBlockingEnabledArgs represents the arguments for SetAdBlockingEnabled in the Page domain.
 type SetAdBlockingEnabledArgs struct {
 	Enabled bool `json:"enabled"` // Whether to block ads.
@@ -620,6 +1246,21 @@ func NewSetAdBlockingEnabledArgs(enabled bool) *SetAdBlockingEnabledArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetAdBlockingEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBypassCSPArgs represents the arguments for SetBypassCSP in the Page domain.
 type SetBypassCSPArgs struct {
 	Enabled bool `json:"enabled"` // Whether to bypass page CSP.
@@ -632,6 +1273,21 @@ func NewSetBypassCSPArgs(enabled bool) *SetBypassCSPArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetBypassCSPArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFontFamiliesArgs represents the arguments for SetFontFamilies in the Page domain.
 type SetFontFamiliesArgs struct {
 	FontFamilies FontFamilies `json:"fontFamilies"` // Specifies font families to set. If a font family is not specified, it won't be changed.
@@ -644,6 +1300,23 @@ func NewSetFontFamiliesArgs(fontFamilies FontFamilies) *SetFontFamiliesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetFontFamiliesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "fontFamilies")
+	if b, err = fastjson.AppendValue(b, a.FontFamilies); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFontSizesArgs represents the arguments for SetFontSizes in the Page domain.
 type SetFontSizesArgs struct {
 	FontSizes FontSizes `json:"fontSizes"` // Specifies font sizes to set. If a font size is not specified, it won't be changed.
@@ -656,6 +1329,23 @@ func NewSetFontSizesArgs(fontSizes FontSizes) *SetFontSizesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetFontSizesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "fontSizes")
+	if b, err = fastjson.AppendValue(b, a.FontSizes); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDocumentContentArgs represents the arguments for SetDocumentContent in the Page domain.
 type SetDocumentContentArgs struct {
 	FrameID FrameID `json:"frameId"` // Frame id to set HTML for.
@@ -670,6 +1360,23 @@ func NewSetDocumentContentArgs(frameID FrameID, html string) *SetDocumentContent
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDocumentContentArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "frameId")
+	b = fastjson.AppendString(b, string(a.FrameID))
+	b = fastjson.AppendKey(b, "html")
+	b = fastjson.AppendString(b, a.HTML)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDownloadBehaviorArgs represents the arguments for SetDownloadBehavior in the Page domain.
 type SetDownloadBehaviorArgs struct {
 	// Behavior Whether to allow all or deny all download requests, or use
@@ -687,6 +1394,25 @@ func NewSetDownloadBehaviorArgs(behavior string) *SetDownloadBehaviorArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDownloadBehaviorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "behavior")
+	b = fastjson.AppendString(b, a.Behavior)
+	if a.DownloadPath != nil {
+		b = fastjson.AppendKey(b, "downloadPath")
+		b = fastjson.AppendString(b, *a.DownloadPath)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDownloadPath sets the DownloadPath optional argument. The
 // default path to save downloaded files to. This is required if
 // behavior is set to 'allow'
@@ -707,6 +1433,21 @@ func NewSetLifecycleEventsEnabledArgs(enabled bool) *SetLifecycleEventsEnabledAr
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetLifecycleEventsEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // StartScreencastArgs represents the arguments for StartScreencast in the Page domain.
 type StartScreencastArgs struct {
 	// Format Image compression format.
@@ -726,6 +1467,39 @@ func NewStartScreencastArgs() *StartScreencastArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartScreencastArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Format != nil {
+		b = fastjson.AppendKey(b, "format")
+		b = fastjson.AppendString(b, *a.Format)
+	}
+	if a.Quality != nil {
+		b = fastjson.AppendKey(b, "quality")
+		b = fastjson.AppendInt(b, *a.Quality)
+	}
+	if a.MaxWidth != nil {
+		b = fastjson.AppendKey(b, "maxWidth")
+		b = fastjson.AppendInt(b, *a.MaxWidth)
+	}
+	if a.MaxHeight != nil {
+		b = fastjson.AppendKey(b, "maxHeight")
+		b = fastjson.AppendInt(b, *a.MaxHeight)
+	}
+	if a.EveryNthFrame != nil {
+		b = fastjson.AppendKey(b, "everyNthFrame")
+		b = fastjson.AppendInt(b, *a.EveryNthFrame)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFormat sets the Format optional argument. Image compression
 // format.
 //
@@ -778,6 +1552,21 @@ func NewSetWebLifecycleStateArgs(state string) *SetWebLifecycleStateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetWebLifecycleStateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "state")
+	b = fastjson.AppendString(b, a.State)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetProduceCompilationCacheArgs represents the arguments for SetProduceCompilationCache in the Page domain.
 type SetProduceCompilationCacheArgs struct {
 	Enabled bool `json:"enabled"` // No description.
@@ -790,6 +1579,21 @@ func NewSetProduceCompilationCacheArgs(enabled bool) *SetProduceCompilationCache
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetProduceCompilationCacheArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // AddCompilationCacheArgs represents the arguments for AddCompilationCache in the Page domain.
 type AddCompilationCacheArgs struct {
 	URL  string `json:"url"`  // No description.
@@ -804,6 +1608,25 @@ func NewAddCompilationCacheArgs(url string, data []byte) *AddCompilationCacheArg
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddCompilationCacheArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	b = fastjson.AppendKey(b, "data")
+	if b, err = fastjson.AppendValue(b, a.Data); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GenerateTestReportArgs represents the arguments for GenerateTestReport in the Page domain.
 type GenerateTestReportArgs struct {
 	Message string  `json:"message"`         // Message to be displayed in the report.
@@ -817,6 +1640,25 @@ func NewGenerateTestReportArgs(message string) *GenerateTestReportArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GenerateTestReportArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "message")
+	b = fastjson.AppendString(b, a.Message)
+	if a.Group != nil {
+		b = fastjson.AppendKey(b, "group")
+		b = fastjson.AppendString(b, *a.Group)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetGroup sets the Group optional argument. Specifies the endpoint
 // group to deliver the report to.
 func (a *GenerateTestReportArgs) SetGroup(group string) *GenerateTestReportArgs {
@@ -835,3 +1677,18 @@ func NewSetInterceptFileChooserDialogArgs(enabled bool) *SetInterceptFileChooser
 	args.Enabled = enabled
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetInterceptFileChooserDialogArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
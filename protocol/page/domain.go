@@ -6,6 +6,7 @@ package page
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -589,14 +590,29 @@ func (d *domainClient) SetInterceptFileChooserDialog(ctx context.Context, args *
 	return
 }
 
-func (d *domainClient) DOMContentEventFired(ctx context.Context) (DOMContentEventFiredClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.domContentEventFired", d.conn)
+func (d *domainClient) DOMContentEventFired(ctx context.Context, opts ...rpcc.StreamOption) (DOMContentEventFiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.domContentEventFired", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &dOMContentEventFiredClient{Stream: s}, nil
 }
 
+// OnDOMContentEventFired registers fn to be called for each
+// DOMContentEventFired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDOMContentEventFired(fn func(*DOMContentEventFiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.domContentEventFired", d.conn, func(args []byte) error {
+		ev := new(DOMContentEventFiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type dOMContentEventFiredClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -610,14 +626,29 @@ func (c *dOMContentEventFiredClient) Recv() (*DOMContentEventFiredReply, error)
 	return event, nil
 }
 
-func (d *domainClient) FileChooserOpened(ctx context.Context) (FileChooserOpenedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.fileChooserOpened", d.conn)
+func (d *domainClient) FileChooserOpened(ctx context.Context, opts ...rpcc.StreamOption) (FileChooserOpenedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.fileChooserOpened", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &fileChooserOpenedClient{Stream: s}, nil
 }
 
+// OnFileChooserOpened registers fn to be called for each
+// FileChooserOpened event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFileChooserOpened(fn func(*FileChooserOpenedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.fileChooserOpened", d.conn, func(args []byte) error {
+		ev := new(FileChooserOpenedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type fileChooserOpenedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -631,14 +662,29 @@ func (c *fileChooserOpenedClient) Recv() (*FileChooserOpenedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameAttached(ctx context.Context) (FrameAttachedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameAttached", d.conn)
+func (d *domainClient) FrameAttached(ctx context.Context, opts ...rpcc.StreamOption) (FrameAttachedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameAttached", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameAttachedClient{Stream: s}, nil
 }
 
+// OnFrameAttached registers fn to be called for each
+// FrameAttached event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameAttached(fn func(*FrameAttachedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameAttached", d.conn, func(args []byte) error {
+		ev := new(FrameAttachedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameAttachedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -652,14 +698,29 @@ func (c *frameAttachedClient) Recv() (*FrameAttachedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameClearedScheduledNavigation(ctx context.Context) (FrameClearedScheduledNavigationClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameClearedScheduledNavigation", d.conn)
+func (d *domainClient) FrameClearedScheduledNavigation(ctx context.Context, opts ...rpcc.StreamOption) (FrameClearedScheduledNavigationClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameClearedScheduledNavigation", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameClearedScheduledNavigationClient{Stream: s}, nil
 }
 
+// OnFrameClearedScheduledNavigation registers fn to be called for each
+// FrameClearedScheduledNavigation event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameClearedScheduledNavigation(fn func(*FrameClearedScheduledNavigationReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameClearedScheduledNavigation", d.conn, func(args []byte) error {
+		ev := new(FrameClearedScheduledNavigationReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameClearedScheduledNavigationClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -673,14 +734,29 @@ func (c *frameClearedScheduledNavigationClient) Recv() (*FrameClearedScheduledNa
 	return event, nil
 }
 
-func (d *domainClient) FrameDetached(ctx context.Context) (FrameDetachedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameDetached", d.conn)
+func (d *domainClient) FrameDetached(ctx context.Context, opts ...rpcc.StreamOption) (FrameDetachedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameDetached", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameDetachedClient{Stream: s}, nil
 }
 
+// OnFrameDetached registers fn to be called for each
+// FrameDetached event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameDetached(fn func(*FrameDetachedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameDetached", d.conn, func(args []byte) error {
+		ev := new(FrameDetachedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameDetachedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -694,14 +770,29 @@ func (c *frameDetachedClient) Recv() (*FrameDetachedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameNavigated(ctx context.Context) (FrameNavigatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameNavigated", d.conn)
+func (d *domainClient) FrameNavigated(ctx context.Context, opts ...rpcc.StreamOption) (FrameNavigatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameNavigated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameNavigatedClient{Stream: s}, nil
 }
 
+// OnFrameNavigated registers fn to be called for each
+// FrameNavigated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameNavigated(fn func(*FrameNavigatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameNavigated", d.conn, func(args []byte) error {
+		ev := new(FrameNavigatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameNavigatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -715,14 +806,29 @@ func (c *frameNavigatedClient) Recv() (*FrameNavigatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameResized(ctx context.Context) (FrameResizedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameResized", d.conn)
+func (d *domainClient) FrameResized(ctx context.Context, opts ...rpcc.StreamOption) (FrameResizedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameResized", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameResizedClient{Stream: s}, nil
 }
 
+// OnFrameResized registers fn to be called for each
+// FrameResized event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameResized(fn func(*FrameResizedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameResized", d.conn, func(args []byte) error {
+		ev := new(FrameResizedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameResizedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -736,14 +842,29 @@ func (c *frameResizedClient) Recv() (*FrameResizedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameRequestedNavigation(ctx context.Context) (FrameRequestedNavigationClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameRequestedNavigation", d.conn)
+func (d *domainClient) FrameRequestedNavigation(ctx context.Context, opts ...rpcc.StreamOption) (FrameRequestedNavigationClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameRequestedNavigation", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameRequestedNavigationClient{Stream: s}, nil
 }
 
+// OnFrameRequestedNavigation registers fn to be called for each
+// FrameRequestedNavigation event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameRequestedNavigation(fn func(*FrameRequestedNavigationReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameRequestedNavigation", d.conn, func(args []byte) error {
+		ev := new(FrameRequestedNavigationReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameRequestedNavigationClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -757,14 +878,29 @@ func (c *frameRequestedNavigationClient) Recv() (*FrameRequestedNavigationReply,
 	return event, nil
 }
 
-func (d *domainClient) FrameScheduledNavigation(ctx context.Context) (FrameScheduledNavigationClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameScheduledNavigation", d.conn)
+func (d *domainClient) FrameScheduledNavigation(ctx context.Context, opts ...rpcc.StreamOption) (FrameScheduledNavigationClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameScheduledNavigation", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameScheduledNavigationClient{Stream: s}, nil
 }
 
+// OnFrameScheduledNavigation registers fn to be called for each
+// FrameScheduledNavigation event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameScheduledNavigation(fn func(*FrameScheduledNavigationReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameScheduledNavigation", d.conn, func(args []byte) error {
+		ev := new(FrameScheduledNavigationReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameScheduledNavigationClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -778,14 +914,29 @@ func (c *frameScheduledNavigationClient) Recv() (*FrameScheduledNavigationReply,
 	return event, nil
 }
 
-func (d *domainClient) FrameStartedLoading(ctx context.Context) (FrameStartedLoadingClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameStartedLoading", d.conn)
+func (d *domainClient) FrameStartedLoading(ctx context.Context, opts ...rpcc.StreamOption) (FrameStartedLoadingClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameStartedLoading", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameStartedLoadingClient{Stream: s}, nil
 }
 
+// OnFrameStartedLoading registers fn to be called for each
+// FrameStartedLoading event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameStartedLoading(fn func(*FrameStartedLoadingReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameStartedLoading", d.conn, func(args []byte) error {
+		ev := new(FrameStartedLoadingReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameStartedLoadingClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -799,14 +950,29 @@ func (c *frameStartedLoadingClient) Recv() (*FrameStartedLoadingReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) FrameStoppedLoading(ctx context.Context) (FrameStoppedLoadingClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.frameStoppedLoading", d.conn)
+func (d *domainClient) FrameStoppedLoading(ctx context.Context, opts ...rpcc.StreamOption) (FrameStoppedLoadingClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.frameStoppedLoading", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &frameStoppedLoadingClient{Stream: s}, nil
 }
 
+// OnFrameStoppedLoading registers fn to be called for each
+// FrameStoppedLoading event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnFrameStoppedLoading(fn func(*FrameStoppedLoadingReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.frameStoppedLoading", d.conn, func(args []byte) error {
+		ev := new(FrameStoppedLoadingReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type frameStoppedLoadingClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -820,14 +986,29 @@ func (c *frameStoppedLoadingClient) Recv() (*FrameStoppedLoadingReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DownloadWillBegin(ctx context.Context) (DownloadWillBeginClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.downloadWillBegin", d.conn)
+func (d *domainClient) DownloadWillBegin(ctx context.Context, opts ...rpcc.StreamOption) (DownloadWillBeginClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.downloadWillBegin", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &downloadWillBeginClient{Stream: s}, nil
 }
 
+// OnDownloadWillBegin registers fn to be called for each
+// DownloadWillBegin event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDownloadWillBegin(fn func(*DownloadWillBeginReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.downloadWillBegin", d.conn, func(args []byte) error {
+		ev := new(DownloadWillBeginReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type downloadWillBeginClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -841,14 +1022,29 @@ func (c *downloadWillBeginClient) Recv() (*DownloadWillBeginReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DownloadProgress(ctx context.Context) (DownloadProgressClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.downloadProgress", d.conn)
+func (d *domainClient) DownloadProgress(ctx context.Context, opts ...rpcc.StreamOption) (DownloadProgressClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.downloadProgress", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &downloadProgressClient{Stream: s}, nil
 }
 
+// OnDownloadProgress registers fn to be called for each
+// DownloadProgress event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDownloadProgress(fn func(*DownloadProgressReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.downloadProgress", d.conn, func(args []byte) error {
+		ev := new(DownloadProgressReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type downloadProgressClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -862,14 +1058,29 @@ func (c *downloadProgressClient) Recv() (*DownloadProgressReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) InterstitialHidden(ctx context.Context) (InterstitialHiddenClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.interstitialHidden", d.conn)
+func (d *domainClient) InterstitialHidden(ctx context.Context, opts ...rpcc.StreamOption) (InterstitialHiddenClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.interstitialHidden", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &interstitialHiddenClient{Stream: s}, nil
 }
 
+// OnInterstitialHidden registers fn to be called for each
+// InterstitialHidden event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInterstitialHidden(fn func(*InterstitialHiddenReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.interstitialHidden", d.conn, func(args []byte) error {
+		ev := new(InterstitialHiddenReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type interstitialHiddenClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -883,14 +1094,29 @@ func (c *interstitialHiddenClient) Recv() (*InterstitialHiddenReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) InterstitialShown(ctx context.Context) (InterstitialShownClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.interstitialShown", d.conn)
+func (d *domainClient) InterstitialShown(ctx context.Context, opts ...rpcc.StreamOption) (InterstitialShownClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.interstitialShown", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &interstitialShownClient{Stream: s}, nil
 }
 
+// OnInterstitialShown registers fn to be called for each
+// InterstitialShown event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInterstitialShown(fn func(*InterstitialShownReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.interstitialShown", d.conn, func(args []byte) error {
+		ev := new(InterstitialShownReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type interstitialShownClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -904,14 +1130,29 @@ func (c *interstitialShownClient) Recv() (*InterstitialShownReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) JavascriptDialogClosed(ctx context.Context) (JavascriptDialogClosedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.javascriptDialogClosed", d.conn)
+func (d *domainClient) JavascriptDialogClosed(ctx context.Context, opts ...rpcc.StreamOption) (JavascriptDialogClosedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.javascriptDialogClosed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &javascriptDialogClosedClient{Stream: s}, nil
 }
 
+// OnJavascriptDialogClosed registers fn to be called for each
+// JavascriptDialogClosed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnJavascriptDialogClosed(fn func(*JavascriptDialogClosedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.javascriptDialogClosed", d.conn, func(args []byte) error {
+		ev := new(JavascriptDialogClosedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type javascriptDialogClosedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -925,14 +1166,29 @@ func (c *javascriptDialogClosedClient) Recv() (*JavascriptDialogClosedReply, err
 	return event, nil
 }
 
-func (d *domainClient) JavascriptDialogOpening(ctx context.Context) (JavascriptDialogOpeningClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.javascriptDialogOpening", d.conn)
+func (d *domainClient) JavascriptDialogOpening(ctx context.Context, opts ...rpcc.StreamOption) (JavascriptDialogOpeningClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.javascriptDialogOpening", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &javascriptDialogOpeningClient{Stream: s}, nil
 }
 
+// OnJavascriptDialogOpening registers fn to be called for each
+// JavascriptDialogOpening event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnJavascriptDialogOpening(fn func(*JavascriptDialogOpeningReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.javascriptDialogOpening", d.conn, func(args []byte) error {
+		ev := new(JavascriptDialogOpeningReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type javascriptDialogOpeningClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -946,14 +1202,29 @@ func (c *javascriptDialogOpeningClient) Recv() (*JavascriptDialogOpeningReply, e
 	return event, nil
 }
 
-func (d *domainClient) LifecycleEvent(ctx context.Context) (LifecycleEventClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.lifecycleEvent", d.conn)
+func (d *domainClient) LifecycleEvent(ctx context.Context, opts ...rpcc.StreamOption) (LifecycleEventClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.lifecycleEvent", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &lifecycleEventClient{Stream: s}, nil
 }
 
+// OnLifecycleEvent registers fn to be called for each
+// LifecycleEvent event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLifecycleEvent(fn func(*LifecycleEventReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.lifecycleEvent", d.conn, func(args []byte) error {
+		ev := new(LifecycleEventReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type lifecycleEventClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -967,14 +1238,29 @@ func (c *lifecycleEventClient) Recv() (*LifecycleEventReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) LoadEventFired(ctx context.Context) (LoadEventFiredClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.loadEventFired", d.conn)
+func (d *domainClient) LoadEventFired(ctx context.Context, opts ...rpcc.StreamOption) (LoadEventFiredClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.loadEventFired", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &loadEventFiredClient{Stream: s}, nil
 }
 
+// OnLoadEventFired registers fn to be called for each
+// LoadEventFired event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnLoadEventFired(fn func(*LoadEventFiredReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.loadEventFired", d.conn, func(args []byte) error {
+		ev := new(LoadEventFiredReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type loadEventFiredClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -988,14 +1274,29 @@ func (c *loadEventFiredClient) Recv() (*LoadEventFiredReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) NavigatedWithinDocument(ctx context.Context) (NavigatedWithinDocumentClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.navigatedWithinDocument", d.conn)
+func (d *domainClient) NavigatedWithinDocument(ctx context.Context, opts ...rpcc.StreamOption) (NavigatedWithinDocumentClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.navigatedWithinDocument", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &navigatedWithinDocumentClient{Stream: s}, nil
 }
 
+// OnNavigatedWithinDocument registers fn to be called for each
+// NavigatedWithinDocument event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNavigatedWithinDocument(fn func(*NavigatedWithinDocumentReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.navigatedWithinDocument", d.conn, func(args []byte) error {
+		ev := new(NavigatedWithinDocumentReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type navigatedWithinDocumentClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -1009,14 +1310,29 @@ func (c *navigatedWithinDocumentClient) Recv() (*NavigatedWithinDocumentReply, e
 	return event, nil
 }
 
-func (d *domainClient) ScreencastFrame(ctx context.Context) (ScreencastFrameClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.screencastFrame", d.conn)
+func (d *domainClient) ScreencastFrame(ctx context.Context, opts ...rpcc.StreamOption) (ScreencastFrameClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.screencastFrame", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &screencastFrameClient{Stream: s}, nil
 }
 
+// OnScreencastFrame registers fn to be called for each
+// ScreencastFrame event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScreencastFrame(fn func(*ScreencastFrameReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.screencastFrame", d.conn, func(args []byte) error {
+		ev := new(ScreencastFrameReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type screencastFrameClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -1030,14 +1346,29 @@ func (c *screencastFrameClient) Recv() (*ScreencastFrameReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ScreencastVisibilityChanged(ctx context.Context) (ScreencastVisibilityChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.screencastVisibilityChanged", d.conn)
+func (d *domainClient) ScreencastVisibilityChanged(ctx context.Context, opts ...rpcc.StreamOption) (ScreencastVisibilityChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.screencastVisibilityChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &screencastVisibilityChangedClient{Stream: s}, nil
 }
 
+// OnScreencastVisibilityChanged registers fn to be called for each
+// ScreencastVisibilityChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnScreencastVisibilityChanged(fn func(*ScreencastVisibilityChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.screencastVisibilityChanged", d.conn, func(args []byte) error {
+		ev := new(ScreencastVisibilityChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type screencastVisibilityChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -1051,14 +1382,29 @@ func (c *screencastVisibilityChangedClient) Recv() (*ScreencastVisibilityChanged
 	return event, nil
 }
 
-func (d *domainClient) WindowOpen(ctx context.Context) (WindowOpenClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.windowOpen", d.conn)
+func (d *domainClient) WindowOpen(ctx context.Context, opts ...rpcc.StreamOption) (WindowOpenClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.windowOpen", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &windowOpenClient{Stream: s}, nil
 }
 
+// OnWindowOpen registers fn to be called for each
+// WindowOpen event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWindowOpen(fn func(*WindowOpenReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.windowOpen", d.conn, func(args []byte) error {
+		ev := new(WindowOpenReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type windowOpenClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -1072,14 +1418,29 @@ func (c *windowOpenClient) Recv() (*WindowOpenReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) CompilationCacheProduced(ctx context.Context) (CompilationCacheProducedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Page.compilationCacheProduced", d.conn)
+func (d *domainClient) CompilationCacheProduced(ctx context.Context, opts ...rpcc.StreamOption) (CompilationCacheProducedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Page.compilationCacheProduced", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &compilationCacheProducedClient{Stream: s}, nil
 }
 
+// OnCompilationCacheProduced registers fn to be called for each
+// CompilationCacheProduced event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCompilationCacheProduced(fn func(*CompilationCacheProducedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Page.compilationCacheProduced", d.conn, func(args []byte) error {
+		ev := new(CompilationCacheProducedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type compilationCacheProducedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package page
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/dom"
 	"github.com/mafredri/cdp/protocol/network"
 	"github.com/mafredri/cdp/protocol/runtime"
@@ -22,6 +25,20 @@ type DOMContentEventFiredReply struct {
 	Timestamp network.MonotonicTime `json:"timestamp"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DOMContentEventFiredReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = network.MonotonicTime(v)
+		}
+		return err
+	})
+}
+
 // FileChooserOpenedClient is a client for FileChooserOpened events. Emitted
 // only when `page.interceptFileChooser` is enabled.
 type FileChooserOpenedClient interface {
@@ -47,6 +64,26 @@ type FileChooserOpenedReply struct {
 	Mode string `json:"mode"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FileChooserOpenedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "backendNodeId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.BackendNodeID = dom.BackendNodeID(v)
+		case "mode":
+			r.Mode, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // FrameAttachedClient is a client for FrameAttached events. Fired when frame
 // has been attached to its parent.
 type FrameAttachedClient interface {
@@ -63,6 +100,26 @@ type FrameAttachedReply struct {
 	Stack         *runtime.StackTrace `json:"stack,omitempty"` // JavaScript stack trace of when frame was attached, only set if frame initiated from script.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameAttachedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "parentFrameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ParentFrameID = FrameID(v)
+		case "stack":
+			err = json.Unmarshal(value, &r.Stack)
+		}
+		return err
+	})
+}
+
 // FrameClearedScheduledNavigationClient is a client for FrameClearedScheduledNavigation events.
 // Fired when frame no longer has a scheduled navigation.
 type FrameClearedScheduledNavigationClient interface {
@@ -77,6 +134,20 @@ type FrameClearedScheduledNavigationReply struct {
 	FrameID FrameID `json:"frameId"` // Id of the frame that has cleared its scheduled navigation.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameClearedScheduledNavigationReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		}
+		return err
+	})
+}
+
 // FrameDetachedClient is a client for FrameDetached events. Fired when frame
 // has been detached from its parent.
 type FrameDetachedClient interface {
@@ -91,6 +162,20 @@ type FrameDetachedReply struct {
 	FrameID FrameID `json:"frameId"` // Id of the frame that has been detached.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameDetachedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		}
+		return err
+	})
+}
+
 // FrameNavigatedClient is a client for FrameNavigated events. Fired once
 // navigation of the frame has completed. Frame is now associated with the new
 // loader.
@@ -106,6 +191,18 @@ type FrameNavigatedReply struct {
 	Frame Frame `json:"frame"` // Frame object.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameNavigatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frame":
+			err = json.Unmarshal(value, &r.Frame)
+		}
+		return err
+	})
+}
+
 // FrameResizedClient is a client for FrameResized events.
 type FrameResizedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -136,6 +233,30 @@ type FrameRequestedNavigationReply struct {
 	Disposition ClientNavigationDisposition `json:"disposition"` // The disposition for the navigation.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameRequestedNavigationReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "reason":
+			var v string
+			v, err = fastjson.String(value)
+			r.Reason = ClientNavigationReason(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "disposition":
+			var v string
+			v, err = fastjson.String(value)
+			r.Disposition = ClientNavigationDisposition(v)
+		}
+		return err
+	})
+}
+
 // FrameScheduledNavigationClient is a client for FrameScheduledNavigation events.
 // Fired when frame schedules a potential navigation.
 type FrameScheduledNavigationClient interface {
@@ -153,6 +274,28 @@ type FrameScheduledNavigationReply struct {
 	URL     string                 `json:"url"`     // The destination URL for the scheduled navigation.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameScheduledNavigationReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "delay":
+			r.Delay, err = fastjson.Float(value)
+		case "reason":
+			var v string
+			v, err = fastjson.String(value)
+			r.Reason = ClientNavigationReason(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // FrameStartedLoadingClient is a client for FrameStartedLoading events. Fired
 // when frame has started loading.
 type FrameStartedLoadingClient interface {
@@ -167,6 +310,20 @@ type FrameStartedLoadingReply struct {
 	FrameID FrameID `json:"frameId"` // Id of the frame that has started loading.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameStartedLoadingReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		}
+		return err
+	})
+}
+
 // FrameStoppedLoadingClient is a client for FrameStoppedLoading events. Fired
 // when frame has stopped loading.
 type FrameStoppedLoadingClient interface {
@@ -181,6 +338,20 @@ type FrameStoppedLoadingReply struct {
 	FrameID FrameID `json:"frameId"` // Id of the frame that has stopped loading.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *FrameStoppedLoadingReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		}
+		return err
+	})
+}
+
 // DownloadWillBeginClient is a client for DownloadWillBegin events. Fired
 // when page is about to start a download.
 type DownloadWillBeginClient interface {
@@ -198,6 +369,26 @@ type DownloadWillBeginReply struct {
 	SuggestedFilename string  `json:"suggestedFilename"` // Suggested file name of the resource (the actual name of the file saved on disk may differ).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DownloadWillBeginReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "guid":
+			r.GUID, err = fastjson.String(value)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "suggestedFilename":
+			r.SuggestedFilename, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // DownloadProgressClient is a client for DownloadProgress events. Fired when
 // download makes progress. Last call has |done| == true.
 type DownloadProgressClient interface {
@@ -218,6 +409,24 @@ type DownloadProgressReply struct {
 	State string `json:"state"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DownloadProgressReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "guid":
+			r.GUID, err = fastjson.String(value)
+		case "totalBytes":
+			r.TotalBytes, err = fastjson.Float(value)
+		case "receivedBytes":
+			r.ReceivedBytes, err = fastjson.Float(value)
+		case "state":
+			r.State, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // InterstitialHiddenClient is a client for InterstitialHidden events. Fired
 // when interstitial page was hidden
 type InterstitialHiddenClient interface {
@@ -260,6 +469,20 @@ type JavascriptDialogClosedReply struct {
 	UserInput string `json:"userInput"` // User input in case of prompt.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *JavascriptDialogClosedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			r.Result, err = fastjson.Bool(value)
+		case "userInput":
+			r.UserInput, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // JavascriptDialogOpeningClient is a client for JavascriptDialogOpening events.
 // Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
 // onbeforeunload) is about to open.
@@ -279,6 +502,34 @@ type JavascriptDialogOpeningReply struct {
 	DefaultPrompt     *string    `json:"defaultPrompt,omitempty"` // Default dialog prompt.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *JavascriptDialogOpeningReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "message":
+			r.Message, err = fastjson.String(value)
+		case "type":
+			var v string
+			v, err = fastjson.String(value)
+			r.Type = DialogType(v)
+		case "hasBrowserHandler":
+			r.HasBrowserHandler, err = fastjson.Bool(value)
+		case "defaultPrompt":
+			if fastjson.IsNull(value) {
+				r.DefaultPrompt = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.DefaultPrompt = &v
+		}
+		return err
+	})
+}
+
 // LifecycleEventClient is a client for LifecycleEvent events. Fired for top
 // level page lifecycle events such as navigation, load, paint, etc.
 type LifecycleEventClient interface {
@@ -296,6 +547,30 @@ type LifecycleEventReply struct {
 	Timestamp network.MonotonicTime `json:"timestamp"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LifecycleEventReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "loaderId":
+			var v string
+			v, err = fastjson.String(value)
+			r.LoaderID = network.LoaderID(v)
+		case "name":
+			r.Name, err = fastjson.String(value)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = network.MonotonicTime(v)
+		}
+		return err
+	})
+}
+
 // LoadEventFiredClient is a client for LoadEventFired events.
 type LoadEventFiredClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -309,6 +584,20 @@ type LoadEventFiredReply struct {
 	Timestamp network.MonotonicTime `json:"timestamp"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *LoadEventFiredReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = network.MonotonicTime(v)
+		}
+		return err
+	})
+}
+
 // NavigatedWithinDocumentClient is a client for NavigatedWithinDocument events.
 // Fired when same-document navigation happens, e.g. due to history API usage
 // or anchor navigation.
@@ -325,6 +614,22 @@ type NavigatedWithinDocumentReply struct {
 	URL     string  `json:"url"`     // Frame's new url.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NavigatedWithinDocumentReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "frameId":
+			var v string
+			v, err = fastjson.String(value)
+			r.FrameID = FrameID(v)
+		case "url":
+			r.URL, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // ScreencastFrameClient is a client for ScreencastFrame events. Compressed
 // image data requested by the `startScreencast`.
 type ScreencastFrameClient interface {
@@ -341,6 +646,22 @@ type ScreencastFrameReply struct {
 	SessionID int                     `json:"sessionId"` // Frame number.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ScreencastFrameReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "data":
+			err = json.Unmarshal(value, &r.Data)
+		case "metadata":
+			err = json.Unmarshal(value, &r.Metadata)
+		case "sessionId":
+			r.SessionID, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // ScreencastVisibilityChangedClient is a client for ScreencastVisibilityChanged events.
 // Fired when the page with currently enabled screencast was shown or hidden `.
 type ScreencastVisibilityChangedClient interface {
@@ -355,6 +676,18 @@ type ScreencastVisibilityChangedReply struct {
 	Visible bool `json:"visible"` // True if the page is visible.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ScreencastVisibilityChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "visible":
+			r.Visible, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // WindowOpenClient is a client for WindowOpen events. Fired when a new window
 // is going to be opened, via window.open(), link click, form submission, etc.
 type WindowOpenClient interface {
@@ -372,6 +705,24 @@ type WindowOpenReply struct {
 	UserGesture    bool     `json:"userGesture"`    // Whether or not it was triggered by user gesture.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WindowOpenReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "windowName":
+			r.WindowName, err = fastjson.String(value)
+		case "windowFeatures":
+			err = json.Unmarshal(value, &r.WindowFeatures)
+		case "userGesture":
+			r.UserGesture, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // CompilationCacheProducedClient is a client for CompilationCacheProduced events.
 // Issued for every compilation cache generated. Is only available if
 // Page.setGenerateCompilationCache is enabled.
@@ -387,3 +738,17 @@ type CompilationCacheProducedReply struct {
 	URL  string `json:"url"`  // No description.
 	Data []byte `json:"data"` // Base64-encoded data
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CompilationCacheProducedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "url":
+			r.URL, err = fastjson.String(value)
+		case "data":
+			err = json.Unmarshal(value, &r.Data)
+		}
+		return err
+	})
+}
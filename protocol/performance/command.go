@@ -2,6 +2,12 @@
 
 package performance
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // EnableArgs represents the arguments for Enable in the Performance domain.
 type EnableArgs struct {
 	// TimeDomain Time domain to use for collecting and reporting duration
@@ -18,6 +24,23 @@ func NewEnableArgs() *EnableArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EnableArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.TimeDomain != nil {
+		b = fastjson.AppendKey(b, "timeDomain")
+		b = fastjson.AppendString(b, *a.TimeDomain)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTimeDomain sets the TimeDomain optional argument. Time domain to
 // use for collecting and reporting duration metrics.
 //
@@ -42,7 +65,34 @@ func NewSetTimeDomainArgs(timeDomain string) *SetTimeDomainArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetTimeDomainArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "timeDomain")
+	b = fastjson.AppendString(b, a.TimeDomain)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetMetricsReply represents the return values for GetMetrics in the Performance domain.
 type GetMetricsReply struct {
 	Metrics []Metric `json:"metrics"` // Current values for run-time metrics.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetMetricsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "metrics":
+			err = json.Unmarshal(value, &r.Metrics)
+		}
+		return err
+	})
+}
@@ -5,6 +5,7 @@ package performance
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -69,14 +70,29 @@ func (d *domainClient) GetMetrics(ctx context.Context) (reply *GetMetricsReply,
 	return
 }
 
-func (d *domainClient) Metrics(ctx context.Context) (MetricsClient, error) {
-	s, err := rpcc.NewStream(ctx, "Performance.metrics", d.conn)
+func (d *domainClient) Metrics(ctx context.Context, opts ...rpcc.StreamOption) (MetricsClient, error) {
+	s, err := rpcc.NewStream(ctx, "Performance.metrics", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &metricsClient{Stream: s}, nil
 }
 
+// OnMetrics registers fn to be called for each
+// Metrics event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnMetrics(fn func(*MetricsReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Performance.metrics", d.conn, func(args []byte) error {
+		ev := new(MetricsReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type metricsClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
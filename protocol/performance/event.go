@@ -3,6 +3,9 @@
 package performance
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -20,3 +23,17 @@ type MetricsReply struct {
 	Metrics []Metric `json:"metrics"` // Current values of the metrics.
 	Title   string   `json:"title"`   // Timestamp title.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *MetricsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "metrics":
+			err = json.Unmarshal(value, &r.Metrics)
+		case "title":
+			r.Title, err = fastjson.String(value)
+		}
+		return err
+	})
+}
@@ -2,11 +2,29 @@
 
 package profiler
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetBestEffortCoverageReply represents the return values for GetBestEffortCoverage in the Profiler domain.
 type GetBestEffortCoverageReply struct {
 	Result []ScriptCoverage `json:"result"` // Coverage data for the current isolate.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBestEffortCoverageReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // SetSamplingIntervalArgs represents the arguments for SetSamplingInterval in the Profiler domain.
 type SetSamplingIntervalArgs struct {
 	Interval int `json:"interval"` // New sampling interval in microseconds.
@@ -19,6 +37,21 @@ func NewSetSamplingIntervalArgs(interval int) *SetSamplingIntervalArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetSamplingIntervalArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "interval")
+	b = fastjson.AppendInt(b, a.Interval)
+	b = append(b, '}')
+	return b, err
+}
+
 // StartPreciseCoverageArgs represents the arguments for StartPreciseCoverage in the Profiler domain.
 type StartPreciseCoverageArgs struct {
 	CallCount             *bool `json:"callCount,omitempty"`             // Collect accurate call counts beyond simple 'covered' or 'not covered'.
@@ -33,6 +66,31 @@ func NewStartPreciseCoverageArgs() *StartPreciseCoverageArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartPreciseCoverageArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.CallCount != nil {
+		b = fastjson.AppendKey(b, "callCount")
+		b = fastjson.AppendBool(b, *a.CallCount)
+	}
+	if a.Detailed != nil {
+		b = fastjson.AppendKey(b, "detailed")
+		b = fastjson.AppendBool(b, *a.Detailed)
+	}
+	if a.AllowTriggeredUpdates != nil {
+		b = fastjson.AppendKey(b, "allowTriggeredUpdates")
+		b = fastjson.AppendBool(b, *a.AllowTriggeredUpdates)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCallCount sets the CallCount optional argument. Collect accurate
 // call counts beyond simple 'covered' or 'not covered'.
 func (a *StartPreciseCoverageArgs) SetCallCount(callCount bool) *StartPreciseCoverageArgs {
@@ -59,23 +117,85 @@ type StartPreciseCoverageReply struct {
 	Timestamp float64 `json:"timestamp"` // Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StartPreciseCoverageReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timestamp":
+			r.Timestamp, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // StopReply represents the return values for Stop in the Profiler domain.
 type StopReply struct {
 	Profile Profile `json:"profile"` // Recorded profile.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StopReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		}
+		return err
+	})
+}
+
 // TakePreciseCoverageReply represents the return values for TakePreciseCoverage in the Profiler domain.
 type TakePreciseCoverageReply struct {
 	Result    []ScriptCoverage `json:"result"`    // Coverage data for the current isolate.
 	Timestamp float64          `json:"timestamp"` // Monotonically increasing time (in seconds) when the coverage update was taken in the backend.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *TakePreciseCoverageReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "timestamp":
+			r.Timestamp, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // TakeTypeProfileReply represents the return values for TakeTypeProfile in the Profiler domain.
 type TakeTypeProfileReply struct {
 	Result []ScriptTypeProfile `json:"result"` // Type profile for all scripts since startTypeProfile() was turned on.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *TakeTypeProfileReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
+
 // GetRuntimeCallStatsReply represents the return values for GetRuntimeCallStats in the Profiler domain.
 type GetRuntimeCallStatsReply struct {
 	Result []CounterInfo `json:"result"` // Collected counter information.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetRuntimeCallStatsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
@@ -5,6 +5,7 @@ package profiler
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -181,14 +182,29 @@ func (d *domainClient) GetRuntimeCallStats(ctx context.Context) (reply *GetRunti
 	return
 }
 
-func (d *domainClient) ConsoleProfileFinished(ctx context.Context) (ConsoleProfileFinishedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileFinished", d.conn)
+func (d *domainClient) ConsoleProfileFinished(ctx context.Context, opts ...rpcc.StreamOption) (ConsoleProfileFinishedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileFinished", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &consoleProfileFinishedClient{Stream: s}, nil
 }
 
+// OnConsoleProfileFinished registers fn to be called for each
+// ConsoleProfileFinished event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnConsoleProfileFinished(fn func(*ConsoleProfileFinishedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Profiler.consoleProfileFinished", d.conn, func(args []byte) error {
+		ev := new(ConsoleProfileFinishedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type consoleProfileFinishedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -202,14 +218,29 @@ func (c *consoleProfileFinishedClient) Recv() (*ConsoleProfileFinishedReply, err
 	return event, nil
 }
 
-func (d *domainClient) ConsoleProfileStarted(ctx context.Context) (ConsoleProfileStartedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileStarted", d.conn)
+func (d *domainClient) ConsoleProfileStarted(ctx context.Context, opts ...rpcc.StreamOption) (ConsoleProfileStartedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Profiler.consoleProfileStarted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &consoleProfileStartedClient{Stream: s}, nil
 }
 
+// OnConsoleProfileStarted registers fn to be called for each
+// ConsoleProfileStarted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnConsoleProfileStarted(fn func(*ConsoleProfileStartedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Profiler.consoleProfileStarted", d.conn, func(args []byte) error {
+		ev := new(ConsoleProfileStartedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type consoleProfileStartedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -223,14 +254,29 @@ func (c *consoleProfileStartedClient) Recv() (*ConsoleProfileStartedReply, error
 	return event, nil
 }
 
-func (d *domainClient) PreciseCoverageDeltaUpdate(ctx context.Context) (PreciseCoverageDeltaUpdateClient, error) {
-	s, err := rpcc.NewStream(ctx, "Profiler.preciseCoverageDeltaUpdate", d.conn)
+func (d *domainClient) PreciseCoverageDeltaUpdate(ctx context.Context, opts ...rpcc.StreamOption) (PreciseCoverageDeltaUpdateClient, error) {
+	s, err := rpcc.NewStream(ctx, "Profiler.preciseCoverageDeltaUpdate", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &preciseCoverageDeltaUpdateClient{Stream: s}, nil
 }
 
+// OnPreciseCoverageDeltaUpdate registers fn to be called for each
+// PreciseCoverageDeltaUpdate event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnPreciseCoverageDeltaUpdate(fn func(*PreciseCoverageDeltaUpdateReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Profiler.preciseCoverageDeltaUpdate", d.conn, func(args []byte) error {
+		ev := new(PreciseCoverageDeltaUpdateReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type preciseCoverageDeltaUpdateClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package profiler
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/debugger"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -23,6 +26,30 @@ type ConsoleProfileFinishedReply struct {
 	Title    *string           `json:"title,omitempty"` // Profile title passed as an argument to console.profile().
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ConsoleProfileFinishedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.String(value)
+		case "location":
+			err = json.Unmarshal(value, &r.Location)
+		case "profile":
+			err = json.Unmarshal(value, &r.Profile)
+		case "title":
+			if fastjson.IsNull(value) {
+				r.Title = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Title = &v
+		}
+		return err
+	})
+}
+
 // ConsoleProfileStartedClient is a client for ConsoleProfileStarted events.
 // Sent when new profile recording is started using console.profile() call.
 type ConsoleProfileStartedClient interface {
@@ -39,6 +66,28 @@ type ConsoleProfileStartedReply struct {
 	Title    *string           `json:"title,omitempty"` // Profile title passed as an argument to console.profile().
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ConsoleProfileStartedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.String(value)
+		case "location":
+			err = json.Unmarshal(value, &r.Location)
+		case "title":
+			if fastjson.IsNull(value) {
+				r.Title = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Title = &v
+		}
+		return err
+	})
+}
+
 // PreciseCoverageDeltaUpdateClient is a client for PreciseCoverageDeltaUpdate events.
 // Reports coverage delta since the last poll (either from an event like this,
 // or from `takePreciseCoverage` for the current isolate. May only be sent if
@@ -58,3 +107,19 @@ type PreciseCoverageDeltaUpdateReply struct {
 	Occassion string           `json:"occassion"` // Identifier for distinguishing coverage events.
 	Result    []ScriptCoverage `json:"result"`    // Coverage data for the current isolate.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *PreciseCoverageDeltaUpdateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timestamp":
+			r.Timestamp, err = fastjson.Float(value)
+		case "occassion":
+			r.Occassion, err = fastjson.String(value)
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		}
+		return err
+	})
+}
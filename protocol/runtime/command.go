@@ -2,6 +2,12 @@
 
 package runtime
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // AwaitPromiseArgs represents the arguments for AwaitPromise in the Runtime domain.
 type AwaitPromiseArgs struct {
 	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`           // Identifier of the promise.
@@ -16,6 +22,29 @@ func NewAwaitPromiseArgs(promiseObjectID RemoteObjectID) *AwaitPromiseArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AwaitPromiseArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "promiseObjectId")
+	b = fastjson.AppendString(b, string(a.PromiseObjectID))
+	if a.ReturnByValue != nil {
+		b = fastjson.AppendKey(b, "returnByValue")
+		b = fastjson.AppendBool(b, *a.ReturnByValue)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetReturnByValue sets the ReturnByValue optional argument. Whether
 // the result is expected to be a JSON object that should be sent by
 // value.
@@ -37,6 +66,20 @@ type AwaitPromiseReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details if stack strace is available.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AwaitPromiseReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // CallFunctionOnArgs represents the arguments for CallFunctionOn in the Runtime domain.
 type CallFunctionOnArgs struct {
 	FunctionDeclaration string          `json:"functionDeclaration"`     // Declaration of the function to call.
@@ -61,6 +104,59 @@ func NewCallFunctionOnArgs(functionDeclaration string) *CallFunctionOnArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CallFunctionOnArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "functionDeclaration")
+	b = fastjson.AppendString(b, a.FunctionDeclaration)
+	if a.ObjectID != nil {
+		b = fastjson.AppendKey(b, "objectId")
+		b = fastjson.AppendString(b, string(*a.ObjectID))
+	}
+	if len(a.Arguments) > 0 {
+		b = fastjson.AppendKey(b, "arguments")
+		if b, err = fastjson.AppendValue(b, a.Arguments); err != nil {
+			return nil, err
+		}
+	}
+	if a.Silent != nil {
+		b = fastjson.AppendKey(b, "silent")
+		b = fastjson.AppendBool(b, *a.Silent)
+	}
+	if a.ReturnByValue != nil {
+		b = fastjson.AppendKey(b, "returnByValue")
+		b = fastjson.AppendBool(b, *a.ReturnByValue)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	if a.UserGesture != nil {
+		b = fastjson.AppendKey(b, "userGesture")
+		b = fastjson.AppendBool(b, *a.UserGesture)
+	}
+	if a.AwaitPromise != nil {
+		b = fastjson.AppendKey(b, "awaitPromise")
+		b = fastjson.AppendBool(b, *a.AwaitPromise)
+	}
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetObjectID sets the ObjectID optional argument. Identifier of the
 // object to call function on. Either objectId or executionContextId
 // should be specified.
@@ -141,6 +237,20 @@ type CallFunctionOnReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CallFunctionOnReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // CompileScriptArgs represents the arguments for CompileScript in the Runtime domain.
 type CompileScriptArgs struct {
 	Expression         string              `json:"expression"`                   // Expression to compile.
@@ -158,6 +268,29 @@ func NewCompileScriptArgs(expression string, sourceURL string, persistScript boo
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CompileScriptArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "expression")
+	b = fastjson.AppendString(b, a.Expression)
+	b = fastjson.AppendKey(b, "sourceURL")
+	b = fastjson.AppendString(b, a.SourceURL)
+	b = fastjson.AppendKey(b, "persistScript")
+	b = fastjson.AppendBool(b, a.PersistScript)
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetExecutionContextID sets the ExecutionContextID optional argument.
 // Specifies in which execution context to perform script run. If the
 // parameter is omitted the evaluation will be performed in the context
@@ -173,6 +306,27 @@ type CompileScriptReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CompileScriptReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "scriptId":
+			if fastjson.IsNull(value) {
+				r.ScriptID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := ScriptID(v)
+			r.ScriptID = &c
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // EvaluateArgs represents the arguments for Evaluate in the Runtime domain.
 type EvaluateArgs struct {
 	Expression            string              `json:"expression"`                      // Expression to evaluate.
@@ -217,6 +371,71 @@ func NewEvaluateArgs(expression string) *EvaluateArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *EvaluateArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "expression")
+	b = fastjson.AppendString(b, a.Expression)
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	if a.IncludeCommandLineAPI != nil {
+		b = fastjson.AppendKey(b, "includeCommandLineAPI")
+		b = fastjson.AppendBool(b, *a.IncludeCommandLineAPI)
+	}
+	if a.Silent != nil {
+		b = fastjson.AppendKey(b, "silent")
+		b = fastjson.AppendBool(b, *a.Silent)
+	}
+	if a.ContextID != nil {
+		b = fastjson.AppendKey(b, "contextId")
+		b = fastjson.AppendInt(b, int(*a.ContextID))
+	}
+	if a.ReturnByValue != nil {
+		b = fastjson.AppendKey(b, "returnByValue")
+		b = fastjson.AppendBool(b, *a.ReturnByValue)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	if a.UserGesture != nil {
+		b = fastjson.AppendKey(b, "userGesture")
+		b = fastjson.AppendBool(b, *a.UserGesture)
+	}
+	if a.AwaitPromise != nil {
+		b = fastjson.AppendKey(b, "awaitPromise")
+		b = fastjson.AppendBool(b, *a.AwaitPromise)
+	}
+	if a.ThrowOnSideEffect != nil {
+		b = fastjson.AppendKey(b, "throwOnSideEffect")
+		b = fastjson.AppendBool(b, *a.ThrowOnSideEffect)
+	}
+	if a.Timeout != nil {
+		b = fastjson.AppendKey(b, "timeout")
+		if b, err = fastjson.AppendFloat(b, float64(*a.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	if a.DisableBreaks != nil {
+		b = fastjson.AppendKey(b, "disableBreaks")
+		b = fastjson.AppendBool(b, *a.DisableBreaks)
+	}
+	if a.ReplMode != nil {
+		b = fastjson.AppendKey(b, "replMode")
+		b = fastjson.AppendBool(b, *a.ReplMode)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetObjectGroup sets the ObjectGroup optional argument. Symbolic
 // group name that can be used to release multiple objects.
 func (a *EvaluateArgs) SetObjectGroup(objectGroup string) *EvaluateArgs {
@@ -326,17 +545,57 @@ type EvaluateReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *EvaluateReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // GetIsolateIDReply represents the return values for GetIsolateID in the Runtime domain.
 type GetIsolateIDReply struct {
 	ID string `json:"id"` // The isolate id.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetIsolateIDReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetHeapUsageReply represents the return values for GetHeapUsage in the Runtime domain.
 type GetHeapUsageReply struct {
 	UsedSize  float64 `json:"usedSize"`  // Used heap size in bytes.
 	TotalSize float64 `json:"totalSize"` // Allocated heap size in bytes.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetHeapUsageReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "usedSize":
+			r.UsedSize, err = fastjson.Float(value)
+		case "totalSize":
+			r.TotalSize, err = fastjson.Float(value)
+		}
+		return err
+	})
+}
+
 // GetPropertiesArgs represents the arguments for GetProperties in the Runtime domain.
 type GetPropertiesArgs struct {
 	ObjectID      RemoteObjectID `json:"objectId"`                // Identifier of the object to return properties for.
@@ -360,6 +619,33 @@ func NewGetPropertiesArgs(objectID RemoteObjectID) *GetPropertiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetPropertiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	if a.OwnProperties != nil {
+		b = fastjson.AppendKey(b, "ownProperties")
+		b = fastjson.AppendBool(b, *a.OwnProperties)
+	}
+	if a.AccessorPropertiesOnly != nil {
+		b = fastjson.AppendKey(b, "accessorPropertiesOnly")
+		b = fastjson.AppendBool(b, *a.AccessorPropertiesOnly)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOwnProperties sets the OwnProperties optional argument. If true,
 // returns properties belonging only to the element itself, not to its
 // prototype chain.
@@ -398,6 +684,24 @@ type GetPropertiesReply struct {
 	ExceptionDetails  *ExceptionDetails           `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetPropertiesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "internalProperties":
+			err = json.Unmarshal(value, &r.InternalProperties)
+		case "privateProperties":
+			err = json.Unmarshal(value, &r.PrivateProperties)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // GlobalLexicalScopeNamesArgs represents the arguments for GlobalLexicalScopeNames in the Runtime domain.
 type GlobalLexicalScopeNamesArgs struct {
 	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"` // Specifies in which execution context to lookup global scope variables.
@@ -410,6 +714,23 @@ func NewGlobalLexicalScopeNamesArgs() *GlobalLexicalScopeNamesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GlobalLexicalScopeNamesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetExecutionContextID sets the ExecutionContextID optional argument.
 // Specifies in which execution context to lookup global scope
 // variables.
@@ -423,6 +744,18 @@ type GlobalLexicalScopeNamesReply struct {
 	Names []string `json:"names"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GlobalLexicalScopeNamesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "names":
+			err = json.Unmarshal(value, &r.Names)
+		}
+		return err
+	})
+}
+
 // QueryObjectsArgs represents the arguments for QueryObjects in the Runtime domain.
 type QueryObjectsArgs struct {
 	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`     // Identifier of the prototype to return objects for.
@@ -436,6 +769,25 @@ func NewQueryObjectsArgs(prototypeObjectID RemoteObjectID) *QueryObjectsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *QueryObjectsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "prototypeObjectId")
+	b = fastjson.AppendString(b, string(a.PrototypeObjectID))
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetObjectGroup sets the ObjectGroup optional argument. Symbolic
 // group name that can be used to release the results.
 func (a *QueryObjectsArgs) SetObjectGroup(objectGroup string) *QueryObjectsArgs {
@@ -448,6 +800,18 @@ type QueryObjectsReply struct {
 	Objects RemoteObject `json:"objects"` // Array with objects.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *QueryObjectsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "objects":
+			err = json.Unmarshal(value, &r.Objects)
+		}
+		return err
+	})
+}
+
 // ReleaseObjectArgs represents the arguments for ReleaseObject in the Runtime domain.
 type ReleaseObjectArgs struct {
 	ObjectID RemoteObjectID `json:"objectId"` // Identifier of the object to release.
@@ -460,6 +824,21 @@ func NewReleaseObjectArgs(objectID RemoteObjectID) *ReleaseObjectArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReleaseObjectArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectId")
+	b = fastjson.AppendString(b, string(a.ObjectID))
+	b = append(b, '}')
+	return b, err
+}
+
 // ReleaseObjectGroupArgs represents the arguments for ReleaseObjectGroup in the Runtime domain.
 type ReleaseObjectGroupArgs struct {
 	ObjectGroup string `json:"objectGroup"` // Symbolic object group name.
@@ -472,6 +851,21 @@ func NewReleaseObjectGroupArgs(objectGroup string) *ReleaseObjectGroupArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ReleaseObjectGroupArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "objectGroup")
+	b = fastjson.AppendString(b, a.ObjectGroup)
+	b = append(b, '}')
+	return b, err
+}
+
 // RunScriptArgs represents the arguments for RunScript in the Runtime domain.
 type RunScriptArgs struct {
 	ScriptID              ScriptID            `json:"scriptId"`                        // Id of the script to run.
@@ -491,6 +885,49 @@ func NewRunScriptArgs(scriptID ScriptID) *RunScriptArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RunScriptArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scriptId")
+	b = fastjson.AppendString(b, string(a.ScriptID))
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	if a.ObjectGroup != nil {
+		b = fastjson.AppendKey(b, "objectGroup")
+		b = fastjson.AppendString(b, *a.ObjectGroup)
+	}
+	if a.Silent != nil {
+		b = fastjson.AppendKey(b, "silent")
+		b = fastjson.AppendBool(b, *a.Silent)
+	}
+	if a.IncludeCommandLineAPI != nil {
+		b = fastjson.AppendKey(b, "includeCommandLineAPI")
+		b = fastjson.AppendBool(b, *a.IncludeCommandLineAPI)
+	}
+	if a.ReturnByValue != nil {
+		b = fastjson.AppendKey(b, "returnByValue")
+		b = fastjson.AppendBool(b, *a.ReturnByValue)
+	}
+	if a.GeneratePreview != nil {
+		b = fastjson.AppendKey(b, "generatePreview")
+		b = fastjson.AppendBool(b, *a.GeneratePreview)
+	}
+	if a.AwaitPromise != nil {
+		b = fastjson.AppendKey(b, "awaitPromise")
+		b = fastjson.AppendBool(b, *a.AwaitPromise)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetExecutionContextID sets the ExecutionContextID optional argument.
 // Specifies in which execution context to perform script run. If the
 // parameter is omitted the evaluation will be performed in the context
@@ -552,6 +989,20 @@ type RunScriptReply struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"` // Exception details.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RunScriptReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "result":
+			err = json.Unmarshal(value, &r.Result)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // SetCustomObjectFormatterEnabledArgs represents the arguments for SetCustomObjectFormatterEnabled in the Runtime domain.
 type SetCustomObjectFormatterEnabledArgs struct {
 	Enabled bool `json:"enabled"` // No description.
@@ -564,6 +1015,21 @@ func NewSetCustomObjectFormatterEnabledArgs(enabled bool) *SetCustomObjectFormat
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCustomObjectFormatterEnabledArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "enabled")
+	b = fastjson.AppendBool(b, a.Enabled)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetMaxCallStackSizeToCaptureArgs represents the arguments for SetMaxCallStackSizeToCapture in the Runtime domain.
 type SetMaxCallStackSizeToCaptureArgs struct {
 	Size int `json:"size"` // No description.
@@ -576,6 +1042,21 @@ func NewSetMaxCallStackSizeToCaptureArgs(size int) *SetMaxCallStackSizeToCapture
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetMaxCallStackSizeToCaptureArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "size")
+	b = fastjson.AppendInt(b, a.Size)
+	b = append(b, '}')
+	return b, err
+}
+
 // AddBindingArgs represents the arguments for AddBinding in the Runtime domain.
 type AddBindingArgs struct {
 	Name               string              `json:"name"`                         // No description.
@@ -589,6 +1070,25 @@ func NewAddBindingArgs(name string) *AddBindingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddBindingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	if a.ExecutionContextID != nil {
+		b = fastjson.AppendKey(b, "executionContextId")
+		b = fastjson.AppendInt(b, int(*a.ExecutionContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetExecutionContextID sets the ExecutionContextID optional argument.
 func (a *AddBindingArgs) SetExecutionContextID(executionContextID ExecutionContextID) *AddBindingArgs {
 	a.ExecutionContextID = &executionContextID
@@ -606,3 +1106,18 @@ func NewRemoveBindingArgs(name string) *RemoveBindingArgs {
 	args.Name = name
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveBindingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "name")
+	b = fastjson.AppendString(b, a.Name)
+	b = append(b, '}')
+	return b, err
+}
@@ -11,6 +11,7 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -312,14 +313,29 @@ func (d *domainClient) RemoveBinding(ctx context.Context, args *RemoveBindingArg
 	return
 }
 
-func (d *domainClient) BindingCalled(ctx context.Context) (BindingCalledClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.bindingCalled", d.conn)
+func (d *domainClient) BindingCalled(ctx context.Context, opts ...rpcc.StreamOption) (BindingCalledClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.bindingCalled", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &bindingCalledClient{Stream: s}, nil
 }
 
+// OnBindingCalled registers fn to be called for each
+// BindingCalled event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnBindingCalled(fn func(*BindingCalledReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.bindingCalled", d.conn, func(args []byte) error {
+		ev := new(BindingCalledReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type bindingCalledClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -333,14 +349,29 @@ func (c *bindingCalledClient) Recv() (*BindingCalledReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ConsoleAPICalled(ctx context.Context) (ConsoleAPICalledClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.consoleAPICalled", d.conn)
+func (d *domainClient) ConsoleAPICalled(ctx context.Context, opts ...rpcc.StreamOption) (ConsoleAPICalledClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.consoleAPICalled", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &consoleAPICalledClient{Stream: s}, nil
 }
 
+// OnConsoleAPICalled registers fn to be called for each
+// ConsoleAPICalled event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnConsoleAPICalled(fn func(*ConsoleAPICalledReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.consoleAPICalled", d.conn, func(args []byte) error {
+		ev := new(ConsoleAPICalledReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type consoleAPICalledClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -354,14 +385,29 @@ func (c *consoleAPICalledClient) Recv() (*ConsoleAPICalledReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ExceptionRevoked(ctx context.Context) (ExceptionRevokedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.exceptionRevoked", d.conn)
+func (d *domainClient) ExceptionRevoked(ctx context.Context, opts ...rpcc.StreamOption) (ExceptionRevokedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.exceptionRevoked", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &exceptionRevokedClient{Stream: s}, nil
 }
 
+// OnExceptionRevoked registers fn to be called for each
+// ExceptionRevoked event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExceptionRevoked(fn func(*ExceptionRevokedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.exceptionRevoked", d.conn, func(args []byte) error {
+		ev := new(ExceptionRevokedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type exceptionRevokedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -375,14 +421,29 @@ func (c *exceptionRevokedClient) Recv() (*ExceptionRevokedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ExceptionThrown(ctx context.Context) (ExceptionThrownClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.exceptionThrown", d.conn)
+func (d *domainClient) ExceptionThrown(ctx context.Context, opts ...rpcc.StreamOption) (ExceptionThrownClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.exceptionThrown", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &exceptionThrownClient{Stream: s}, nil
 }
 
+// OnExceptionThrown registers fn to be called for each
+// ExceptionThrown event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExceptionThrown(fn func(*ExceptionThrownReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.exceptionThrown", d.conn, func(args []byte) error {
+		ev := new(ExceptionThrownReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type exceptionThrownClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -396,14 +457,29 @@ func (c *exceptionThrownClient) Recv() (*ExceptionThrownReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ExecutionContextCreated(ctx context.Context) (ExecutionContextCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.executionContextCreated", d.conn)
+func (d *domainClient) ExecutionContextCreated(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &executionContextCreatedClient{Stream: s}, nil
 }
 
+// OnExecutionContextCreated registers fn to be called for each
+// ExecutionContextCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextCreated(fn func(*ExecutionContextCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextCreated", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type executionContextCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -417,14 +493,29 @@ func (c *executionContextCreatedClient) Recv() (*ExecutionContextCreatedReply, e
 	return event, nil
 }
 
-func (d *domainClient) ExecutionContextDestroyed(ctx context.Context) (ExecutionContextDestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.executionContextDestroyed", d.conn)
+func (d *domainClient) ExecutionContextDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &executionContextDestroyedClient{Stream: s}, nil
 }
 
+// OnExecutionContextDestroyed registers fn to be called for each
+// ExecutionContextDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextDestroyed(fn func(*ExecutionContextDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextDestroyed", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type executionContextDestroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -438,14 +529,29 @@ func (c *executionContextDestroyedClient) Recv() (*ExecutionContextDestroyedRepl
 	return event, nil
 }
 
-func (d *domainClient) ExecutionContextsCleared(ctx context.Context) (ExecutionContextsClearedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.executionContextsCleared", d.conn)
+func (d *domainClient) ExecutionContextsCleared(ctx context.Context, opts ...rpcc.StreamOption) (ExecutionContextsClearedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.executionContextsCleared", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &executionContextsClearedClient{Stream: s}, nil
 }
 
+// OnExecutionContextsCleared registers fn to be called for each
+// ExecutionContextsCleared event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnExecutionContextsCleared(fn func(*ExecutionContextsClearedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.executionContextsCleared", d.conn, func(args []byte) error {
+		ev := new(ExecutionContextsClearedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type executionContextsClearedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -459,14 +565,29 @@ func (c *executionContextsClearedClient) Recv() (*ExecutionContextsClearedReply,
 	return event, nil
 }
 
-func (d *domainClient) InspectRequested(ctx context.Context) (InspectRequestedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Runtime.inspectRequested", d.conn)
+func (d *domainClient) InspectRequested(ctx context.Context, opts ...rpcc.StreamOption) (InspectRequestedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Runtime.inspectRequested", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &inspectRequestedClient{Stream: s}, nil
 }
 
+// OnInspectRequested registers fn to be called for each
+// InspectRequested event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnInspectRequested(fn func(*InspectRequestedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Runtime.inspectRequested", d.conn, func(args []byte) error {
+		ev := new(InspectRequestedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type inspectRequestedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
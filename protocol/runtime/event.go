@@ -5,6 +5,7 @@ package runtime
 import (
 	"encoding/json"
 
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -24,6 +25,24 @@ type BindingCalledReply struct {
 	ExecutionContextID ExecutionContextID `json:"executionContextId"` // Identifier of the context where the call was made.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *BindingCalledReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "name":
+			r.Name, err = fastjson.String(value)
+		case "payload":
+			r.Payload, err = fastjson.String(value)
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = ExecutionContextID(v)
+		}
+		return err
+	})
+}
+
 // ConsoleAPICalledClient is a client for ConsoleAPICalled events. Issued when
 // console API was called.
 type ConsoleAPICalledClient interface {
@@ -51,6 +70,38 @@ type ConsoleAPICalledReply struct {
 	Context *string `json:"context,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ConsoleAPICalledReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "type":
+			r.Type, err = fastjson.String(value)
+		case "args":
+			err = json.Unmarshal(value, &r.Args)
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = ExecutionContextID(v)
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = Timestamp(v)
+		case "stackTrace":
+			err = json.Unmarshal(value, &r.StackTrace)
+		case "context":
+			if fastjson.IsNull(value) {
+				r.Context = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Context = &v
+		}
+		return err
+	})
+}
+
 // ExceptionRevokedClient is a client for ExceptionRevoked events. Issued when
 // unhandled exception was revoked.
 type ExceptionRevokedClient interface {
@@ -66,6 +117,20 @@ type ExceptionRevokedReply struct {
 	ExceptionID int    `json:"exceptionId"` // The id of revoked exception, as reported in `exceptionThrown`.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExceptionRevokedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "reason":
+			r.Reason, err = fastjson.String(value)
+		case "exceptionId":
+			r.ExceptionID, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // ExceptionThrownClient is a client for ExceptionThrown events. Issued when
 // exception was thrown and unhandled.
 type ExceptionThrownClient interface {
@@ -81,6 +146,22 @@ type ExceptionThrownReply struct {
 	ExceptionDetails ExceptionDetails `json:"exceptionDetails"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExceptionThrownReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "timestamp":
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Timestamp = Timestamp(v)
+		case "exceptionDetails":
+			err = json.Unmarshal(value, &r.ExceptionDetails)
+		}
+		return err
+	})
+}
+
 // ExecutionContextCreatedClient is a client for ExecutionContextCreated events.
 // Issued when new execution context is created.
 type ExecutionContextCreatedClient interface {
@@ -95,6 +176,18 @@ type ExecutionContextCreatedReply struct {
 	Context ExecutionContextDescription `json:"context"` // A newly created execution context.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExecutionContextCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "context":
+			err = json.Unmarshal(value, &r.Context)
+		}
+		return err
+	})
+}
+
 // ExecutionContextDestroyedClient is a client for ExecutionContextDestroyed events.
 // Issued when execution context is destroyed.
 type ExecutionContextDestroyedClient interface {
@@ -109,6 +202,20 @@ type ExecutionContextDestroyedReply struct {
 	ExecutionContextID ExecutionContextID `json:"executionContextId"` // Id of the destroyed context
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ExecutionContextDestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "executionContextId":
+			var v int
+			v, err = fastjson.Int(value)
+			r.ExecutionContextID = ExecutionContextID(v)
+		}
+		return err
+	})
+}
+
 // ExecutionContextsClearedClient is a client for ExecutionContextsCleared events.
 // Issued when all executionContexts were cleared in browser
 type ExecutionContextsClearedClient interface {
@@ -137,3 +244,17 @@ type InspectRequestedReply struct {
 	Object RemoteObject    `json:"object"` // No description.
 	Hints  json.RawMessage `json:"hints"`  // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *InspectRequestedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "object":
+			err = json.Unmarshal(value, &r.Object)
+		case "hints":
+			err = json.Unmarshal(value, &r.Hints)
+		}
+		return err
+	})
+}
@@ -2,7 +2,25 @@
 
 package schema
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetDomainsReply represents the return values for GetDomains in the Schema domain.
 type GetDomainsReply struct {
 	Domains []Domain `json:"domains"` // List of supported domains.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetDomainsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "domains":
+			err = json.Unmarshal(value, &r.Domains)
+		}
+		return err
+	})
+}
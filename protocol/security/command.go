@@ -2,6 +2,10 @@
 
 package security
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // SetIgnoreCertificateErrorsArgs represents the arguments for SetIgnoreCertificateErrors in the Security domain.
 type SetIgnoreCertificateErrorsArgs struct {
 	Ignore bool `json:"ignore"` // If true, all certificate errors will be ignored.
@@ -14,6 +18,21 @@ func NewSetIgnoreCertificateErrorsArgs(ignore bool) *SetIgnoreCertificateErrorsA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetIgnoreCertificateErrorsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "ignore")
+	b = fastjson.AppendBool(b, a.Ignore)
+	b = append(b, '}')
+	return b, err
+}
+
 // HandleCertificateErrorArgs represents the arguments for HandleCertificateError in the Security domain.
 type HandleCertificateErrorArgs struct {
 	EventID int                    `json:"eventId"` // The ID of the event.
@@ -28,6 +47,23 @@ func NewHandleCertificateErrorArgs(eventID int, action CertificateErrorAction) *
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *HandleCertificateErrorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "eventId")
+	b = fastjson.AppendInt(b, a.EventID)
+	b = fastjson.AppendKey(b, "action")
+	b = fastjson.AppendString(b, string(a.Action))
+	b = append(b, '}')
+	return b, err
+}
+
 // SetOverrideCertificateErrorsArgs represents the arguments for SetOverrideCertificateErrors in the Security domain.
 type SetOverrideCertificateErrorsArgs struct {
 	Override bool `json:"override"` // If true, certificate errors will be overridden.
@@ -39,3 +75,18 @@ func NewSetOverrideCertificateErrorsArgs(override bool) *SetOverrideCertificateE
 	args.Override = override
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetOverrideCertificateErrorsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "override")
+	b = fastjson.AppendBool(b, a.Override)
+	b = append(b, '}')
+	return b, err
+}
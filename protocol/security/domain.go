@@ -5,6 +5,7 @@ package security
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -82,14 +83,29 @@ func (d *domainClient) SetOverrideCertificateErrors(ctx context.Context, args *S
 	return
 }
 
-func (d *domainClient) CertificateError(ctx context.Context) (CertificateErrorClient, error) {
-	s, err := rpcc.NewStream(ctx, "Security.certificateError", d.conn)
+func (d *domainClient) CertificateError(ctx context.Context, opts ...rpcc.StreamOption) (CertificateErrorClient, error) {
+	s, err := rpcc.NewStream(ctx, "Security.certificateError", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &certificateErrorClient{Stream: s}, nil
 }
 
+// OnCertificateError registers fn to be called for each
+// CertificateError event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCertificateError(fn func(*CertificateErrorReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Security.certificateError", d.conn, func(args []byte) error {
+		ev := new(CertificateErrorReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type certificateErrorClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -103,14 +119,29 @@ func (c *certificateErrorClient) Recv() (*CertificateErrorReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) VisibleSecurityStateChanged(ctx context.Context) (VisibleSecurityStateChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Security.visibleSecurityStateChanged", d.conn)
+func (d *domainClient) VisibleSecurityStateChanged(ctx context.Context, opts ...rpcc.StreamOption) (VisibleSecurityStateChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Security.visibleSecurityStateChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &visibleSecurityStateChangedClient{Stream: s}, nil
 }
 
+// OnVisibleSecurityStateChanged registers fn to be called for each
+// VisibleSecurityStateChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnVisibleSecurityStateChanged(fn func(*VisibleSecurityStateChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Security.visibleSecurityStateChanged", d.conn, func(args []byte) error {
+		ev := new(VisibleSecurityStateChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type visibleSecurityStateChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -124,14 +155,29 @@ func (c *visibleSecurityStateChangedClient) Recv() (*VisibleSecurityStateChanged
 	return event, nil
 }
 
-func (d *domainClient) SecurityStateChanged(ctx context.Context) (StateChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Security.securityStateChanged", d.conn)
+func (d *domainClient) SecurityStateChanged(ctx context.Context, opts ...rpcc.StreamOption) (StateChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Security.securityStateChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &stateChangedClient{Stream: s}, nil
 }
 
+// OnSecurityStateChanged registers fn to be called for each
+// SecurityStateChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnSecurityStateChanged(fn func(*StateChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Security.securityStateChanged", d.conn, func(args []byte) error {
+		ev := new(StateChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type stateChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package security
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -26,6 +29,22 @@ type CertificateErrorReply struct {
 	RequestURL string `json:"requestURL"` // The url that was requested.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CertificateErrorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "eventId":
+			r.EventID, err = fastjson.Int(value)
+		case "errorType":
+			r.ErrorType, err = fastjson.String(value)
+		case "requestURL":
+			r.RequestURL, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // VisibleSecurityStateChangedClient is a client for VisibleSecurityStateChanged events.
 // The security state of the page changed.
 type VisibleSecurityStateChangedClient interface {
@@ -40,6 +59,18 @@ type VisibleSecurityStateChangedReply struct {
 	VisibleSecurityState VisibleSecurityState `json:"visibleSecurityState"` // Security state information about the page.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *VisibleSecurityStateChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "visibleSecurityState":
+			err = json.Unmarshal(value, &r.VisibleSecurityState)
+		}
+		return err
+	})
+}
+
 // StateChangedClient is a client for SecurityStateChanged events. The
 // security state of the page changed.
 type StateChangedClient interface {
@@ -64,3 +95,31 @@ type StateChangedReply struct {
 	InsecureContentStatus InsecureContentStatus `json:"insecureContentStatus"`
 	Summary               *string               `json:"summary,omitempty"` // Overrides user-visible description of the state.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *StateChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "securityState":
+			var v string
+			v, err = fastjson.String(value)
+			r.SecurityState = State(v)
+		case "schemeIsCryptographic":
+			r.SchemeIsCryptographic, err = fastjson.Bool(value)
+		case "explanations":
+			err = json.Unmarshal(value, &r.Explanations)
+		case "insecureContentStatus":
+			err = json.Unmarshal(value, &r.InsecureContentStatus)
+		case "summary":
+			if fastjson.IsNull(value) {
+				r.Summary = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			r.Summary = &v
+		}
+		return err
+	})
+}
@@ -2,6 +2,10 @@
 
 package serviceworker
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // DeliverPushMessageArgs represents the arguments for DeliverPushMessage in the ServiceWorker domain.
 type DeliverPushMessageArgs struct {
 	Origin         string         `json:"origin"`         // No description.
@@ -18,6 +22,25 @@ func NewDeliverPushMessageArgs(origin string, registrationID RegistrationID, dat
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DeliverPushMessageArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = fastjson.AppendKey(b, "registrationId")
+	b = fastjson.AppendString(b, string(a.RegistrationID))
+	b = fastjson.AppendKey(b, "data")
+	b = fastjson.AppendString(b, a.Data)
+	b = append(b, '}')
+	return b, err
+}
+
 // DispatchSyncEventArgs represents the arguments for DispatchSyncEvent in the ServiceWorker domain.
 type DispatchSyncEventArgs struct {
 	Origin         string         `json:"origin"`         // No description.
@@ -36,6 +59,27 @@ func NewDispatchSyncEventArgs(origin string, registrationID RegistrationID, tag
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DispatchSyncEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = fastjson.AppendKey(b, "registrationId")
+	b = fastjson.AppendString(b, string(a.RegistrationID))
+	b = fastjson.AppendKey(b, "tag")
+	b = fastjson.AppendString(b, a.Tag)
+	b = fastjson.AppendKey(b, "lastChance")
+	b = fastjson.AppendBool(b, a.LastChance)
+	b = append(b, '}')
+	return b, err
+}
+
 // DispatchPeriodicSyncEventArgs represents the arguments for DispatchPeriodicSyncEvent in the ServiceWorker domain.
 type DispatchPeriodicSyncEventArgs struct {
 	Origin         string         `json:"origin"`         // No description.
@@ -52,6 +96,25 @@ func NewDispatchPeriodicSyncEventArgs(origin string, registrationID Registration
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DispatchPeriodicSyncEventArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = fastjson.AppendKey(b, "registrationId")
+	b = fastjson.AppendString(b, string(a.RegistrationID))
+	b = fastjson.AppendKey(b, "tag")
+	b = fastjson.AppendString(b, a.Tag)
+	b = append(b, '}')
+	return b, err
+}
+
 // InspectWorkerArgs represents the arguments for InspectWorker in the ServiceWorker domain.
 type InspectWorkerArgs struct {
 	VersionID string `json:"versionId"` // No description.
@@ -64,6 +127,21 @@ func NewInspectWorkerArgs(versionID string) *InspectWorkerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *InspectWorkerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "versionId")
+	b = fastjson.AppendString(b, a.VersionID)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetForceUpdateOnPageLoadArgs represents the arguments for SetForceUpdateOnPageLoad in the ServiceWorker domain.
 type SetForceUpdateOnPageLoadArgs struct {
 	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"` // No description.
@@ -76,6 +154,21 @@ func NewSetForceUpdateOnPageLoadArgs(forceUpdateOnPageLoad bool) *SetForceUpdate
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetForceUpdateOnPageLoadArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "forceUpdateOnPageLoad")
+	b = fastjson.AppendBool(b, a.ForceUpdateOnPageLoad)
+	b = append(b, '}')
+	return b, err
+}
+
 // SkipWaitingArgs represents the arguments for SkipWaiting in the ServiceWorker domain.
 type SkipWaitingArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -88,6 +181,21 @@ func NewSkipWaitingArgs(scopeURL string) *SkipWaitingArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SkipWaitingArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scopeURL")
+	b = fastjson.AppendString(b, a.ScopeURL)
+	b = append(b, '}')
+	return b, err
+}
+
 // StartWorkerArgs represents the arguments for StartWorker in the ServiceWorker domain.
 type StartWorkerArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -100,6 +208,21 @@ func NewStartWorkerArgs(scopeURL string) *StartWorkerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartWorkerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scopeURL")
+	b = fastjson.AppendString(b, a.ScopeURL)
+	b = append(b, '}')
+	return b, err
+}
+
 // StopWorkerArgs represents the arguments for StopWorker in the ServiceWorker domain.
 type StopWorkerArgs struct {
 	VersionID string `json:"versionId"` // No description.
@@ -112,6 +235,21 @@ func NewStopWorkerArgs(versionID string) *StopWorkerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StopWorkerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "versionId")
+	b = fastjson.AppendString(b, a.VersionID)
+	b = append(b, '}')
+	return b, err
+}
+
 // UnregisterArgs represents the arguments for Unregister in the ServiceWorker domain.
 type UnregisterArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -124,6 +262,21 @@ func NewUnregisterArgs(scopeURL string) *UnregisterArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *UnregisterArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scopeURL")
+	b = fastjson.AppendString(b, a.ScopeURL)
+	b = append(b, '}')
+	return b, err
+}
+
 // UpdateRegistrationArgs represents the arguments for UpdateRegistration in the ServiceWorker domain.
 type UpdateRegistrationArgs struct {
 	ScopeURL string `json:"scopeURL"` // No description.
@@ -135,3 +288,18 @@ func NewUpdateRegistrationArgs(scopeURL string) *UpdateRegistrationArgs {
 	args.ScopeURL = scopeURL
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *UpdateRegistrationArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "scopeURL")
+	b = fastjson.AppendString(b, a.ScopeURL)
+	b = append(b, '}')
+	return b, err
+}
@@ -5,6 +5,7 @@ package serviceworker
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -175,14 +176,29 @@ func (d *domainClient) UpdateRegistration(ctx context.Context, args *UpdateRegis
 	return
 }
 
-func (d *domainClient) WorkerErrorReported(ctx context.Context) (WorkerErrorReportedClient, error) {
-	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerErrorReported", d.conn)
+func (d *domainClient) WorkerErrorReported(ctx context.Context, opts ...rpcc.StreamOption) (WorkerErrorReportedClient, error) {
+	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerErrorReported", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &workerErrorReportedClient{Stream: s}, nil
 }
 
+// OnWorkerErrorReported registers fn to be called for each
+// WorkerErrorReported event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWorkerErrorReported(fn func(*WorkerErrorReportedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("ServiceWorker.workerErrorReported", d.conn, func(args []byte) error {
+		ev := new(WorkerErrorReportedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type workerErrorReportedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -196,14 +212,29 @@ func (c *workerErrorReportedClient) Recv() (*WorkerErrorReportedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) WorkerRegistrationUpdated(ctx context.Context) (WorkerRegistrationUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerRegistrationUpdated", d.conn)
+func (d *domainClient) WorkerRegistrationUpdated(ctx context.Context, opts ...rpcc.StreamOption) (WorkerRegistrationUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerRegistrationUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &workerRegistrationUpdatedClient{Stream: s}, nil
 }
 
+// OnWorkerRegistrationUpdated registers fn to be called for each
+// WorkerRegistrationUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWorkerRegistrationUpdated(fn func(*WorkerRegistrationUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("ServiceWorker.workerRegistrationUpdated", d.conn, func(args []byte) error {
+		ev := new(WorkerRegistrationUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type workerRegistrationUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -217,14 +248,29 @@ func (c *workerRegistrationUpdatedClient) Recv() (*WorkerRegistrationUpdatedRepl
 	return event, nil
 }
 
-func (d *domainClient) WorkerVersionUpdated(ctx context.Context) (WorkerVersionUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerVersionUpdated", d.conn)
+func (d *domainClient) WorkerVersionUpdated(ctx context.Context, opts ...rpcc.StreamOption) (WorkerVersionUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "ServiceWorker.workerVersionUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &workerVersionUpdatedClient{Stream: s}, nil
 }
 
+// OnWorkerVersionUpdated registers fn to be called for each
+// WorkerVersionUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnWorkerVersionUpdated(fn func(*WorkerVersionUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("ServiceWorker.workerVersionUpdated", d.conn, func(args []byte) error {
+		ev := new(WorkerVersionUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type workerVersionUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
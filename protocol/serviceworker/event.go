@@ -3,6 +3,9 @@
 package serviceworker
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -19,6 +22,18 @@ type WorkerErrorReportedReply struct {
 	ErrorMessage ErrorMessage `json:"errorMessage"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WorkerErrorReportedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "errorMessage":
+			err = json.Unmarshal(value, &r.ErrorMessage)
+		}
+		return err
+	})
+}
+
 // WorkerRegistrationUpdatedClient is a client for WorkerRegistrationUpdated events.
 type WorkerRegistrationUpdatedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -32,6 +47,18 @@ type WorkerRegistrationUpdatedReply struct {
 	Registrations []Registration `json:"registrations"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WorkerRegistrationUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "registrations":
+			err = json.Unmarshal(value, &r.Registrations)
+		}
+		return err
+	})
+}
+
 // WorkerVersionUpdatedClient is a client for WorkerVersionUpdated events.
 type WorkerVersionUpdatedClient interface {
 	// Recv calls RecvMsg on rpcc.Stream, blocks until the event is
@@ -44,3 +71,15 @@ type WorkerVersionUpdatedClient interface {
 type WorkerVersionUpdatedReply struct {
 	Versions []Version `json:"versions"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *WorkerVersionUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "versions":
+			err = json.Unmarshal(value, &r.Versions)
+		}
+		return err
+	})
+}
@@ -3,6 +3,9 @@
 package storage
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/browser"
 	"github.com/mafredri/cdp/protocol/network"
 )
@@ -21,6 +24,23 @@ func NewClearDataForOriginArgs(origin string, storageTypes string) *ClearDataFor
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearDataForOriginArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = fastjson.AppendKey(b, "storageTypes")
+	b = fastjson.AppendString(b, a.StorageTypes)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCookiesArgs represents the arguments for GetCookies in the Storage domain.
 type GetCookiesArgs struct {
 	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"` // Browser context to use when called on the browser endpoint.
@@ -33,6 +53,23 @@ func NewGetCookiesArgs() *GetCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBrowserContextID sets the BrowserContextID optional argument.
 // Browser context to use when called on the browser endpoint.
 func (a *GetCookiesArgs) SetBrowserContextID(browserContextID browser.ContextID) *GetCookiesArgs {
@@ -45,6 +82,18 @@ type GetCookiesReply struct {
 	Cookies []network.Cookie `json:"cookies"` // Array of cookie objects.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCookiesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "cookies":
+			err = json.Unmarshal(value, &r.Cookies)
+		}
+		return err
+	})
+}
+
 // SetCookiesArgs represents the arguments for SetCookies in the Storage domain.
 type SetCookiesArgs struct {
 	Cookies          []network.CookieParam `json:"cookies"`                    // Cookies to be set.
@@ -58,6 +107,27 @@ func NewSetCookiesArgs(cookies []network.CookieParam) *SetCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "cookies")
+	if b, err = fastjson.AppendValue(b, a.Cookies); err != nil {
+		return nil, err
+	}
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBrowserContextID sets the BrowserContextID optional argument.
 // Browser context to use when called on the browser endpoint.
 func (a *SetCookiesArgs) SetBrowserContextID(browserContextID browser.ContextID) *SetCookiesArgs {
@@ -77,6 +147,23 @@ func NewClearCookiesArgs() *ClearCookiesArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearCookiesArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBrowserContextID sets the BrowserContextID optional argument.
 // Browser context to use when called on the browser endpoint.
 func (a *ClearCookiesArgs) SetBrowserContextID(browserContextID browser.ContextID) *ClearCookiesArgs {
@@ -96,6 +183,21 @@ func NewGetUsageAndQuotaArgs(origin string) *GetUsageAndQuotaArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetUsageAndQuotaArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetUsageAndQuotaReply represents the return values for GetUsageAndQuota in the Storage domain.
 type GetUsageAndQuotaReply struct {
 	Usage          float64        `json:"usage"`          // Storage usage (bytes).
@@ -103,6 +205,22 @@ type GetUsageAndQuotaReply struct {
 	UsageBreakdown []UsageForType `json:"usageBreakdown"` // Storage usage per type (bytes).
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetUsageAndQuotaReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "usage":
+			r.Usage, err = fastjson.Float(value)
+		case "quota":
+			r.Quota, err = fastjson.Float(value)
+		case "usageBreakdown":
+			err = json.Unmarshal(value, &r.UsageBreakdown)
+		}
+		return err
+	})
+}
+
 // TrackCacheStorageForOriginArgs represents the arguments for TrackCacheStorageForOrigin in the Storage domain.
 type TrackCacheStorageForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -115,6 +233,21 @@ func NewTrackCacheStorageForOriginArgs(origin string) *TrackCacheStorageForOrigi
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *TrackCacheStorageForOriginArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
+
 // TrackIndexedDBForOriginArgs represents the arguments for TrackIndexedDBForOrigin in the Storage domain.
 type TrackIndexedDBForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -127,6 +260,21 @@ func NewTrackIndexedDBForOriginArgs(origin string) *TrackIndexedDBForOriginArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *TrackIndexedDBForOriginArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
+
 // UntrackCacheStorageForOriginArgs represents the arguments for UntrackCacheStorageForOrigin in the Storage domain.
 type UntrackCacheStorageForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -139,6 +287,21 @@ func NewUntrackCacheStorageForOriginArgs(origin string) *UntrackCacheStorageForO
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *UntrackCacheStorageForOriginArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
+
 // UntrackIndexedDBForOriginArgs represents the arguments for UntrackIndexedDBForOrigin in the Storage domain.
 type UntrackIndexedDBForOriginArgs struct {
 	Origin string `json:"origin"` // Security origin.
@@ -150,3 +313,18 @@ func NewUntrackIndexedDBForOriginArgs(origin string) *UntrackIndexedDBForOriginA
 	args.Origin = origin
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *UntrackIndexedDBForOriginArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "origin")
+	b = fastjson.AppendString(b, a.Origin)
+	b = append(b, '}')
+	return b, err
+}
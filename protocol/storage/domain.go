@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -142,14 +143,29 @@ func (d *domainClient) UntrackIndexedDBForOrigin(ctx context.Context, args *Untr
 	return
 }
 
-func (d *domainClient) CacheStorageContentUpdated(ctx context.Context) (CacheStorageContentUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Storage.cacheStorageContentUpdated", d.conn)
+func (d *domainClient) CacheStorageContentUpdated(ctx context.Context, opts ...rpcc.StreamOption) (CacheStorageContentUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Storage.cacheStorageContentUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &cacheStorageContentUpdatedClient{Stream: s}, nil
 }
 
+// OnCacheStorageContentUpdated registers fn to be called for each
+// CacheStorageContentUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCacheStorageContentUpdated(fn func(*CacheStorageContentUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Storage.cacheStorageContentUpdated", d.conn, func(args []byte) error {
+		ev := new(CacheStorageContentUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type cacheStorageContentUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -163,14 +179,29 @@ func (c *cacheStorageContentUpdatedClient) Recv() (*CacheStorageContentUpdatedRe
 	return event, nil
 }
 
-func (d *domainClient) CacheStorageListUpdated(ctx context.Context) (CacheStorageListUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Storage.cacheStorageListUpdated", d.conn)
+func (d *domainClient) CacheStorageListUpdated(ctx context.Context, opts ...rpcc.StreamOption) (CacheStorageListUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Storage.cacheStorageListUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &cacheStorageListUpdatedClient{Stream: s}, nil
 }
 
+// OnCacheStorageListUpdated registers fn to be called for each
+// CacheStorageListUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnCacheStorageListUpdated(fn func(*CacheStorageListUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Storage.cacheStorageListUpdated", d.conn, func(args []byte) error {
+		ev := new(CacheStorageListUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type cacheStorageListUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -184,14 +215,29 @@ func (c *cacheStorageListUpdatedClient) Recv() (*CacheStorageListUpdatedReply, e
 	return event, nil
 }
 
-func (d *domainClient) IndexedDBContentUpdated(ctx context.Context) (IndexedDBContentUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Storage.indexedDBContentUpdated", d.conn)
+func (d *domainClient) IndexedDBContentUpdated(ctx context.Context, opts ...rpcc.StreamOption) (IndexedDBContentUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Storage.indexedDBContentUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &indexedDBContentUpdatedClient{Stream: s}, nil
 }
 
+// OnIndexedDBContentUpdated registers fn to be called for each
+// IndexedDBContentUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnIndexedDBContentUpdated(fn func(*IndexedDBContentUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Storage.indexedDBContentUpdated", d.conn, func(args []byte) error {
+		ev := new(IndexedDBContentUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type indexedDBContentUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -205,14 +251,29 @@ func (c *indexedDBContentUpdatedClient) Recv() (*IndexedDBContentUpdatedReply, e
 	return event, nil
 }
 
-func (d *domainClient) IndexedDBListUpdated(ctx context.Context) (IndexedDBListUpdatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Storage.indexedDBListUpdated", d.conn)
+func (d *domainClient) IndexedDBListUpdated(ctx context.Context, opts ...rpcc.StreamOption) (IndexedDBListUpdatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Storage.indexedDBListUpdated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &indexedDBListUpdatedClient{Stream: s}, nil
 }
 
+// OnIndexedDBListUpdated registers fn to be called for each
+// IndexedDBListUpdated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnIndexedDBListUpdated(fn func(*IndexedDBListUpdatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Storage.indexedDBListUpdated", d.conn, func(args []byte) error {
+		ev := new(IndexedDBListUpdatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type indexedDBListUpdatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
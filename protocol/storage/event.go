@@ -3,6 +3,7 @@
 package storage
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -21,6 +22,20 @@ type CacheStorageContentUpdatedReply struct {
 	CacheName string `json:"cacheName"` // Name of cache in origin.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CacheStorageContentUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "origin":
+			r.Origin, err = fastjson.String(value)
+		case "cacheName":
+			r.CacheName, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // CacheStorageListUpdatedClient is a client for CacheStorageListUpdated events.
 // A cache has been added/deleted.
 type CacheStorageListUpdatedClient interface {
@@ -35,6 +50,18 @@ type CacheStorageListUpdatedReply struct {
 	Origin string `json:"origin"` // Origin to update.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CacheStorageListUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "origin":
+			r.Origin, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // IndexedDBContentUpdatedClient is a client for IndexedDBContentUpdated events.
 // The origin's IndexedDB object store has been modified.
 type IndexedDBContentUpdatedClient interface {
@@ -51,6 +78,22 @@ type IndexedDBContentUpdatedReply struct {
 	ObjectStoreName string `json:"objectStoreName"` // ObjectStore to update.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *IndexedDBContentUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "origin":
+			r.Origin, err = fastjson.String(value)
+		case "databaseName":
+			r.DatabaseName, err = fastjson.String(value)
+		case "objectStoreName":
+			r.ObjectStoreName, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // IndexedDBListUpdatedClient is a client for IndexedDBListUpdated events. The
 // origin's IndexedDB database list has been modified.
 type IndexedDBListUpdatedClient interface {
@@ -64,3 +107,15 @@ type IndexedDBListUpdatedClient interface {
 type IndexedDBListUpdatedReply struct {
 	Origin string `json:"origin"` // Origin to update.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *IndexedDBListUpdatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "origin":
+			r.Origin, err = fastjson.String(value)
+		}
+		return err
+	})
+}
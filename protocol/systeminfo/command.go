@@ -2,6 +2,12 @@
 
 package systeminfo
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetInfoReply represents the return values for GetInfo in the SystemInfo domain.
 type GetInfoReply struct {
 	GPU          GPUInfo `json:"gpu"`          // Information about the GPUs on the system.
@@ -10,7 +16,37 @@ type GetInfoReply struct {
 	CommandLine  string  `json:"commandLine"`  // The command line string used to launch the browser. Will be the empty string if not supported.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "gpu":
+			err = json.Unmarshal(value, &r.GPU)
+		case "modelName":
+			r.ModelName, err = fastjson.String(value)
+		case "modelVersion":
+			r.ModelVersion, err = fastjson.String(value)
+		case "commandLine":
+			r.CommandLine, err = fastjson.String(value)
+		}
+		return err
+	})
+}
+
 // GetProcessInfoReply represents the return values for GetProcessInfo in the SystemInfo domain.
 type GetProcessInfoReply struct {
 	ProcessInfo []ProcessInfo `json:"processInfo"` // An array of process info blocks.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetProcessInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "processInfo":
+			err = json.Unmarshal(value, &r.ProcessInfo)
+		}
+		return err
+	})
+}
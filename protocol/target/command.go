@@ -3,6 +3,9 @@
 package target
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/internal"
 )
 
@@ -18,6 +21,21 @@ func NewActivateTargetArgs(targetID ID) *ActivateTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ActivateTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "targetId")
+	b = fastjson.AppendString(b, string(a.TargetID))
+	b = append(b, '}')
+	return b, err
+}
+
 // AttachToTargetArgs represents the arguments for AttachToTarget in the Target domain.
 type AttachToTargetArgs struct {
 	TargetID ID    `json:"targetId"`          // No description.
@@ -31,6 +49,25 @@ func NewAttachToTargetArgs(targetID ID) *AttachToTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AttachToTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "targetId")
+	b = fastjson.AppendString(b, string(a.TargetID))
+	if a.Flatten != nil {
+		b = fastjson.AppendKey(b, "flatten")
+		b = fastjson.AppendBool(b, *a.Flatten)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFlatten sets the Flatten optional argument. Enables "flat"
 // access to the session via specifying sessionId attribute in the
 // commands. We plan to make this the default, deprecate non-flattened
@@ -45,11 +82,39 @@ type AttachToTargetReply struct {
 	SessionID SessionID `json:"sessionId"` // Id assigned to the session.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AttachToTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sessionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SessionID = SessionID(v)
+		}
+		return err
+	})
+}
+
 // AttachToBrowserTargetReply represents the return values for AttachToBrowserTarget in the Target domain.
 type AttachToBrowserTargetReply struct {
 	SessionID SessionID `json:"sessionId"` // Id assigned to the session.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AttachToBrowserTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sessionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SessionID = SessionID(v)
+		}
+		return err
+	})
+}
+
 // CloseTargetArgs represents the arguments for CloseTarget in the Target domain.
 type CloseTargetArgs struct {
 	TargetID ID `json:"targetId"` // No description.
@@ -62,11 +127,38 @@ func NewCloseTargetArgs(targetID ID) *CloseTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CloseTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "targetId")
+	b = fastjson.AppendString(b, string(a.TargetID))
+	b = append(b, '}')
+	return b, err
+}
+
 // CloseTargetReply represents the return values for CloseTarget in the Target domain.
 type CloseTargetReply struct {
 	Success bool `json:"success"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CloseTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "success":
+			r.Success, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // ExposeDevToolsProtocolArgs represents the arguments for ExposeDevToolsProtocol in the Target domain.
 type ExposeDevToolsProtocolArgs struct {
 	TargetID    ID      `json:"targetId"`              // No description.
@@ -80,6 +172,25 @@ func NewExposeDevToolsProtocolArgs(targetID ID) *ExposeDevToolsProtocolArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ExposeDevToolsProtocolArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "targetId")
+	b = fastjson.AppendString(b, string(a.TargetID))
+	if a.BindingName != nil {
+		b = fastjson.AppendKey(b, "bindingName")
+		b = fastjson.AppendString(b, *a.BindingName)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetBindingName sets the BindingName optional argument. Binding
 // name, 'cdp' if not specified.
 func (a *ExposeDevToolsProtocolArgs) SetBindingName(bindingName string) *ExposeDevToolsProtocolArgs {
@@ -99,6 +210,23 @@ func NewCreateBrowserContextArgs() *CreateBrowserContextArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CreateBrowserContextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.DisposeOnDetach != nil {
+		b = fastjson.AppendKey(b, "disposeOnDetach")
+		b = fastjson.AppendBool(b, *a.DisposeOnDetach)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDisposeOnDetach sets the DisposeOnDetach optional argument. If
 // specified, disposes this context when debugging session disconnects.
 func (a *CreateBrowserContextArgs) SetDisposeOnDetach(disposeOnDetach bool) *CreateBrowserContextArgs {
@@ -111,11 +239,37 @@ type CreateBrowserContextReply struct {
 	BrowserContextID internal.BrowserContextID `json:"browserContextId"` // The id of the context created.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreateBrowserContextReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "browserContextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.BrowserContextID = internal.BrowserContextID(v)
+		}
+		return err
+	})
+}
+
 // GetBrowserContextsReply represents the return values for GetBrowserContexts in the Target domain.
 type GetBrowserContextsReply struct {
 	BrowserContextIDs []internal.BrowserContextID `json:"browserContextIds"` // An array of browser context ids.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetBrowserContextsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "browserContextIds":
+			err = json.Unmarshal(value, &r.BrowserContextIDs)
+		}
+		return err
+	})
+}
+
 // CreateTargetArgs represents the arguments for CreateTarget in the Target domain.
 type CreateTargetArgs struct {
 	URL              string                     `json:"url"`                        // The initial URL the page will be navigated to.
@@ -139,6 +293,45 @@ func NewCreateTargetArgs(url string) *CreateTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *CreateTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "url")
+	b = fastjson.AppendString(b, a.URL)
+	if a.Width != nil {
+		b = fastjson.AppendKey(b, "width")
+		b = fastjson.AppendInt(b, *a.Width)
+	}
+	if a.Height != nil {
+		b = fastjson.AppendKey(b, "height")
+		b = fastjson.AppendInt(b, *a.Height)
+	}
+	if a.BrowserContextID != nil {
+		b = fastjson.AppendKey(b, "browserContextId")
+		b = fastjson.AppendString(b, string(*a.BrowserContextID))
+	}
+	if a.EnableBeginFrameControl != nil {
+		b = fastjson.AppendKey(b, "enableBeginFrameControl")
+		b = fastjson.AppendBool(b, *a.EnableBeginFrameControl)
+	}
+	if a.NewWindow != nil {
+		b = fastjson.AppendKey(b, "newWindow")
+		b = fastjson.AppendBool(b, *a.NewWindow)
+	}
+	if a.Background != nil {
+		b = fastjson.AppendKey(b, "background")
+		b = fastjson.AppendBool(b, *a.Background)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetWidth sets the Width optional argument. Frame width in DIP
 // (headless chrome only).
 func (a *CreateTargetArgs) SetWidth(width int) *CreateTargetArgs {
@@ -191,6 +384,20 @@ type CreateTargetReply struct {
 	TargetID ID `json:"targetId"` // The id of the page opened.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreateTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.TargetID = ID(v)
+		}
+		return err
+	})
+}
+
 // DetachFromTargetArgs represents the arguments for DetachFromTarget in the Target domain.
 type DetachFromTargetArgs struct {
 	SessionID *SessionID `json:"sessionId,omitempty"` // Session to detach.
@@ -207,6 +414,27 @@ func NewDetachFromTargetArgs() *DetachFromTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DetachFromTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.SessionID != nil {
+		b = fastjson.AppendKey(b, "sessionId")
+		b = fastjson.AppendString(b, string(*a.SessionID))
+	}
+	if a.TargetID != nil {
+		b = fastjson.AppendKey(b, "targetId")
+		b = fastjson.AppendString(b, string(*a.TargetID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetSessionID sets the SessionID optional argument. Session to
 // detach.
 func (a *DetachFromTargetArgs) SetSessionID(sessionID SessionID) *DetachFromTargetArgs {
@@ -234,6 +462,21 @@ func NewDisposeBrowserContextArgs(browserContextID internal.BrowserContextID) *D
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *DisposeBrowserContextArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "browserContextId")
+	b = fastjson.AppendString(b, string(a.BrowserContextID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetTargetInfoArgs represents the arguments for GetTargetInfo in the Target domain.
 type GetTargetInfoArgs struct {
 	TargetID *ID `json:"targetId,omitempty"` // No description.
@@ -246,6 +489,23 @@ func NewGetTargetInfoArgs() *GetTargetInfoArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetTargetInfoArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.TargetID != nil {
+		b = fastjson.AppendKey(b, "targetId")
+		b = fastjson.AppendString(b, string(*a.TargetID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetTargetID sets the TargetID optional argument.
 func (a *GetTargetInfoArgs) SetTargetID(targetID ID) *GetTargetInfoArgs {
 	a.TargetID = &targetID
@@ -257,11 +517,35 @@ type GetTargetInfoReply struct {
 	TargetInfo Info `json:"targetInfo"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetTargetInfoReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetInfo":
+			err = json.Unmarshal(value, &r.TargetInfo)
+		}
+		return err
+	})
+}
+
 // GetTargetsReply represents the return values for GetTargets in the Target domain.
 type GetTargetsReply struct {
 	TargetInfos []Info `json:"targetInfos"` // The list of targets.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetTargetsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetInfos":
+			err = json.Unmarshal(value, &r.TargetInfos)
+		}
+		return err
+	})
+}
+
 // SendMessageToTargetArgs represents the arguments for SendMessageToTarget in the Target domain.
 type SendMessageToTargetArgs struct {
 	Message   string     `json:"message"`             // No description.
@@ -279,6 +563,29 @@ func NewSendMessageToTargetArgs(message string) *SendMessageToTargetArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SendMessageToTargetArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "message")
+	b = fastjson.AppendString(b, a.Message)
+	if a.SessionID != nil {
+		b = fastjson.AppendKey(b, "sessionId")
+		b = fastjson.AppendString(b, string(*a.SessionID))
+	}
+	if a.TargetID != nil {
+		b = fastjson.AppendKey(b, "targetId")
+		b = fastjson.AppendString(b, string(*a.TargetID))
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetSessionID sets the SessionID optional argument. Identifier of
 // the session.
 func (a *SendMessageToTargetArgs) SetSessionID(sessionID SessionID) *SendMessageToTargetArgs {
@@ -309,6 +616,27 @@ func NewSetAutoAttachArgs(autoAttach bool, waitForDebuggerOnStart bool) *SetAuto
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetAutoAttachArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "autoAttach")
+	b = fastjson.AppendBool(b, a.AutoAttach)
+	b = fastjson.AppendKey(b, "waitForDebuggerOnStart")
+	b = fastjson.AppendBool(b, a.WaitForDebuggerOnStart)
+	if a.Flatten != nil {
+		b = fastjson.AppendKey(b, "flatten")
+		b = fastjson.AppendBool(b, *a.Flatten)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetFlatten sets the Flatten optional argument. Enables "flat"
 // access to the session via specifying sessionId attribute in the
 // commands. We plan to make this the default, deprecate non-flattened
@@ -330,6 +658,21 @@ func NewSetDiscoverTargetsArgs(discover bool) *SetDiscoverTargetsArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetDiscoverTargetsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "discover")
+	b = fastjson.AppendBool(b, a.Discover)
+	b = append(b, '}')
+	return b, err
+}
+
 // SetRemoteLocationsArgs represents the arguments for SetRemoteLocations in the Target domain.
 type SetRemoteLocationsArgs struct {
 	Locations []RemoteLocation `json:"locations"` // List of remote locations.
@@ -341,3 +684,20 @@ func NewSetRemoteLocationsArgs(locations []RemoteLocation) *SetRemoteLocationsAr
 	args.Locations = locations
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetRemoteLocationsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "locations")
+	if b, err = fastjson.AppendValue(b, a.Locations); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
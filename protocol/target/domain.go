@@ -6,6 +6,7 @@ package target
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -251,14 +252,29 @@ func (d *domainClient) SetRemoteLocations(ctx context.Context, args *SetRemoteLo
 	return
 }
 
-func (d *domainClient) AttachedToTarget(ctx context.Context) (AttachedToTargetClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.attachedToTarget", d.conn)
+func (d *domainClient) AttachedToTarget(ctx context.Context, opts ...rpcc.StreamOption) (AttachedToTargetClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.attachedToTarget", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &attachedToTargetClient{Stream: s}, nil
 }
 
+// OnAttachedToTarget registers fn to be called for each
+// AttachedToTarget event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAttachedToTarget(fn func(*AttachedToTargetReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.attachedToTarget", d.conn, func(args []byte) error {
+		ev := new(AttachedToTargetReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type attachedToTargetClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -272,14 +288,29 @@ func (c *attachedToTargetClient) Recv() (*AttachedToTargetReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DetachedFromTarget(ctx context.Context) (DetachedFromTargetClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.detachedFromTarget", d.conn)
+func (d *domainClient) DetachedFromTarget(ctx context.Context, opts ...rpcc.StreamOption) (DetachedFromTargetClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.detachedFromTarget", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &detachedFromTargetClient{Stream: s}, nil
 }
 
+// OnDetachedFromTarget registers fn to be called for each
+// DetachedFromTarget event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDetachedFromTarget(fn func(*DetachedFromTargetReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.detachedFromTarget", d.conn, func(args []byte) error {
+		ev := new(DetachedFromTargetReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type detachedFromTargetClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -293,14 +324,29 @@ func (c *detachedFromTargetClient) Recv() (*DetachedFromTargetReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ReceivedMessageFromTarget(ctx context.Context) (ReceivedMessageFromTargetClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.receivedMessageFromTarget", d.conn)
+func (d *domainClient) ReceivedMessageFromTarget(ctx context.Context, opts ...rpcc.StreamOption) (ReceivedMessageFromTargetClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.receivedMessageFromTarget", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &receivedMessageFromTargetClient{Stream: s}, nil
 }
 
+// OnReceivedMessageFromTarget registers fn to be called for each
+// ReceivedMessageFromTarget event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnReceivedMessageFromTarget(fn func(*ReceivedMessageFromTargetReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.receivedMessageFromTarget", d.conn, func(args []byte) error {
+		ev := new(ReceivedMessageFromTargetReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type receivedMessageFromTargetClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -314,14 +360,29 @@ func (c *receivedMessageFromTargetClient) Recv() (*ReceivedMessageFromTargetRepl
 	return event, nil
 }
 
-func (d *domainClient) TargetCreated(ctx context.Context) (CreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.targetCreated", d.conn)
+func (d *domainClient) TargetCreated(ctx context.Context, opts ...rpcc.StreamOption) (CreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.targetCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &createdClient{Stream: s}, nil
 }
 
+// OnTargetCreated registers fn to be called for each
+// TargetCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetCreated(fn func(*CreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.targetCreated", d.conn, func(args []byte) error {
+		ev := new(CreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type createdClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -335,14 +396,29 @@ func (c *createdClient) Recv() (*CreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TargetDestroyed(ctx context.Context) (DestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.targetDestroyed", d.conn)
+func (d *domainClient) TargetDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (DestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.targetDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &destroyedClient{Stream: s}, nil
 }
 
+// OnTargetDestroyed registers fn to be called for each
+// TargetDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetDestroyed(fn func(*DestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.targetDestroyed", d.conn, func(args []byte) error {
+		ev := new(DestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type destroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -356,14 +432,29 @@ func (c *destroyedClient) Recv() (*DestroyedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TargetCrashed(ctx context.Context) (CrashedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.targetCrashed", d.conn)
+func (d *domainClient) TargetCrashed(ctx context.Context, opts ...rpcc.StreamOption) (CrashedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.targetCrashed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &crashedClient{Stream: s}, nil
 }
 
+// OnTargetCrashed registers fn to be called for each
+// TargetCrashed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetCrashed(fn func(*CrashedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.targetCrashed", d.conn, func(args []byte) error {
+		ev := new(CrashedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type crashedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -377,14 +468,29 @@ func (c *crashedClient) Recv() (*CrashedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TargetInfoChanged(ctx context.Context) (InfoChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Target.targetInfoChanged", d.conn)
+func (d *domainClient) TargetInfoChanged(ctx context.Context, opts ...rpcc.StreamOption) (InfoChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Target.targetInfoChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &infoChangedClient{Stream: s}, nil
 }
 
+// OnTargetInfoChanged registers fn to be called for each
+// TargetInfoChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTargetInfoChanged(fn func(*InfoChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Target.targetInfoChanged", d.conn, func(args []byte) error {
+		ev := new(InfoChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type infoChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package target
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -22,6 +25,24 @@ type AttachedToTargetReply struct {
 	WaitingForDebugger bool      `json:"waitingForDebugger"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AttachedToTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sessionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SessionID = SessionID(v)
+		case "targetInfo":
+			err = json.Unmarshal(value, &r.TargetInfo)
+		case "waitingForDebugger":
+			r.WaitingForDebugger, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // DetachedFromTargetClient is a client for DetachedFromTarget events. Issued
 // when detached from target for any reason (including `detachFromTarget`
 // command). Can be issued multiple times per target if multiple sessions have
@@ -42,6 +63,29 @@ type DetachedFromTargetReply struct {
 	TargetID *ID `json:"targetId,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DetachedFromTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sessionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SessionID = SessionID(v)
+		case "targetId":
+			if fastjson.IsNull(value) {
+				r.TargetID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := ID(v)
+			r.TargetID = &c
+		}
+		return err
+	})
+}
+
 // ReceivedMessageFromTargetClient is a client for ReceivedMessageFromTarget events.
 // Notifies about a new protocol message received from the session (as reported
 // in `attachedToTarget` event).
@@ -62,6 +106,31 @@ type ReceivedMessageFromTargetReply struct {
 	TargetID *ID `json:"targetId,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ReceivedMessageFromTargetReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "sessionId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SessionID = SessionID(v)
+		case "message":
+			r.Message, err = fastjson.String(value)
+		case "targetId":
+			if fastjson.IsNull(value) {
+				r.TargetID = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := ID(v)
+			r.TargetID = &c
+		}
+		return err
+	})
+}
+
 // CreatedClient is a client for TargetCreated events. Issued when a possible
 // inspection target is created.
 type CreatedClient interface {
@@ -76,6 +145,18 @@ type CreatedReply struct {
 	TargetInfo Info `json:"targetInfo"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetInfo":
+			err = json.Unmarshal(value, &r.TargetInfo)
+		}
+		return err
+	})
+}
+
 // DestroyedClient is a client for TargetDestroyed events. Issued when a
 // target is destroyed.
 type DestroyedClient interface {
@@ -90,6 +171,20 @@ type DestroyedReply struct {
 	TargetID ID `json:"targetId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.TargetID = ID(v)
+		}
+		return err
+	})
+}
+
 // CrashedClient is a client for TargetCrashed events. Issued when a target
 // has crashed.
 type CrashedClient interface {
@@ -106,6 +201,24 @@ type CrashedReply struct {
 	ErrorCode int    `json:"errorCode"` // Termination error code.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CrashedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetId":
+			var v string
+			v, err = fastjson.String(value)
+			r.TargetID = ID(v)
+		case "status":
+			r.Status, err = fastjson.String(value)
+		case "errorCode":
+			r.ErrorCode, err = fastjson.Int(value)
+		}
+		return err
+	})
+}
+
 // InfoChangedClient is a client for TargetInfoChanged events. Issued when
 // some information about a target has changed. This only happens between
 // `targetCreated` and `targetDestroyed`.
@@ -120,3 +233,15 @@ type InfoChangedClient interface {
 type InfoChangedReply struct {
 	TargetInfo Info `json:"targetInfo"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *InfoChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "targetInfo":
+			err = json.Unmarshal(value, &r.TargetInfo)
+		}
+		return err
+	})
+}
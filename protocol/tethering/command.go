@@ -2,6 +2,10 @@
 
 package tethering
 
+import (
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // BindArgs represents the arguments for Bind in the Tethering domain.
 type BindArgs struct {
 	Port int `json:"port"` // Port number to bind.
@@ -14,6 +18,21 @@ func NewBindArgs(port int) *BindArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *BindArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "port")
+	b = fastjson.AppendInt(b, a.Port)
+	b = append(b, '}')
+	return b, err
+}
+
 // UnbindArgs represents the arguments for Unbind in the Tethering domain.
 type UnbindArgs struct {
 	Port int `json:"port"` // Port number to unbind.
@@ -25,3 +44,18 @@ func NewUnbindArgs(port int) *UnbindArgs {
 	args.Port = port
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *UnbindArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "port")
+	b = fastjson.AppendInt(b, a.Port)
+	b = append(b, '}')
+	return b, err
+}
@@ -6,6 +6,7 @@ package tethering
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -46,14 +47,29 @@ func (d *domainClient) Unbind(ctx context.Context, args *UnbindArgs) (err error)
 	return
 }
 
-func (d *domainClient) Accepted(ctx context.Context) (AcceptedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Tethering.accepted", d.conn)
+func (d *domainClient) Accepted(ctx context.Context, opts ...rpcc.StreamOption) (AcceptedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Tethering.accepted", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &acceptedClient{Stream: s}, nil
 }
 
+// OnAccepted registers fn to be called for each
+// Accepted event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAccepted(fn func(*AcceptedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Tethering.accepted", d.conn, func(args []byte) error {
+		ev := new(AcceptedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type acceptedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
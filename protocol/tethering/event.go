@@ -3,6 +3,7 @@
 package tethering
 
 import (
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -20,3 +21,17 @@ type AcceptedReply struct {
 	Port         int    `json:"port"`         // Port number that was successfully bound.
 	ConnectionID string `json:"connectionId"` // Connection id to be used.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AcceptedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "port":
+			r.Port, err = fastjson.Int(value)
+		case "connectionId":
+			r.ConnectionID, err = fastjson.String(value)
+		}
+		return err
+	})
+}
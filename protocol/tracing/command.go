@@ -2,11 +2,29 @@
 
 package tracing
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetCategoriesReply represents the return values for GetCategories in the Tracing domain.
 type GetCategoriesReply struct {
 	Categories []string `json:"categories"` // A list of supported tracing categories.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCategoriesReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "categories":
+			err = json.Unmarshal(value, &r.Categories)
+		}
+		return err
+	})
+}
+
 // RecordClockSyncMarkerArgs represents the arguments for RecordClockSyncMarker in the Tracing domain.
 type RecordClockSyncMarkerArgs struct {
 	SyncID string `json:"syncId"` // The ID of this clock sync marker
@@ -19,6 +37,21 @@ func NewRecordClockSyncMarkerArgs(syncID string) *RecordClockSyncMarkerArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RecordClockSyncMarkerArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "syncId")
+	b = fastjson.AppendString(b, a.SyncID)
+	b = append(b, '}')
+	return b, err
+}
+
 // RequestMemoryDumpArgs represents the arguments for RequestMemoryDump in the Tracing domain.
 type RequestMemoryDumpArgs struct {
 	Deterministic *bool `json:"deterministic,omitempty"` // Enables more deterministic results by forcing garbage collection
@@ -31,6 +64,23 @@ func NewRequestMemoryDumpArgs() *RequestMemoryDumpArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RequestMemoryDumpArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Deterministic != nil {
+		b = fastjson.AppendKey(b, "deterministic")
+		b = fastjson.AppendBool(b, *a.Deterministic)
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetDeterministic sets the Deterministic optional argument. Enables
 // more deterministic results by forcing garbage collection
 func (a *RequestMemoryDumpArgs) SetDeterministic(deterministic bool) *RequestMemoryDumpArgs {
@@ -44,6 +94,20 @@ type RequestMemoryDumpReply struct {
 	Success  bool   `json:"success"`  // True iff the global memory dump succeeded.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *RequestMemoryDumpReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "dumpGuid":
+			r.DumpGUID, err = fastjson.String(value)
+		case "success":
+			r.Success, err = fastjson.Bool(value)
+		}
+		return err
+	})
+}
+
 // StartArgs represents the arguments for Start in the Tracing domain.
 type StartArgs struct {
 	// Categories is deprecated.
@@ -73,6 +137,51 @@ func NewStartArgs() *StartArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *StartArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	if a.Categories != nil {
+		b = fastjson.AppendKey(b, "categories")
+		b = fastjson.AppendString(b, *a.Categories)
+	}
+	if a.Options != nil {
+		b = fastjson.AppendKey(b, "options")
+		b = fastjson.AppendString(b, *a.Options)
+	}
+	if a.BufferUsageReportingInterval != nil {
+		b = fastjson.AppendKey(b, "bufferUsageReportingInterval")
+		if b, err = fastjson.AppendFloat(b, *a.BufferUsageReportingInterval); err != nil {
+			return nil, err
+		}
+	}
+	if a.TransferMode != nil {
+		b = fastjson.AppendKey(b, "transferMode")
+		b = fastjson.AppendString(b, *a.TransferMode)
+	}
+	if a.StreamFormat != "" {
+		b = fastjson.AppendKey(b, "streamFormat")
+		b = fastjson.AppendString(b, string(a.StreamFormat))
+	}
+	if a.StreamCompression != "" {
+		b = fastjson.AppendKey(b, "streamCompression")
+		b = fastjson.AppendString(b, string(a.StreamCompression))
+	}
+	if a.TraceConfig != nil {
+		b = fastjson.AppendKey(b, "traceConfig")
+		if b, err = fastjson.AppendValue(b, a.TraceConfig); err != nil {
+			return nil, err
+		}
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // SetCategories sets the Categories optional argument.
 //
 // Deprecated: Category/tag
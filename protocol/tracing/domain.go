@@ -5,6 +5,7 @@ package tracing
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -79,14 +80,29 @@ func (d *domainClient) Start(ctx context.Context, args *StartArgs) (err error) {
 	return
 }
 
-func (d *domainClient) BufferUsage(ctx context.Context) (BufferUsageClient, error) {
-	s, err := rpcc.NewStream(ctx, "Tracing.bufferUsage", d.conn)
+func (d *domainClient) BufferUsage(ctx context.Context, opts ...rpcc.StreamOption) (BufferUsageClient, error) {
+	s, err := rpcc.NewStream(ctx, "Tracing.bufferUsage", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &bufferUsageClient{Stream: s}, nil
 }
 
+// OnBufferUsage registers fn to be called for each
+// BufferUsage event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnBufferUsage(fn func(*BufferUsageReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Tracing.bufferUsage", d.conn, func(args []byte) error {
+		ev := new(BufferUsageReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type bufferUsageClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -100,14 +116,29 @@ func (c *bufferUsageClient) Recv() (*BufferUsageReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) DataCollected(ctx context.Context) (DataCollectedClient, error) {
-	s, err := rpcc.NewStream(ctx, "Tracing.dataCollected", d.conn)
+func (d *domainClient) DataCollected(ctx context.Context, opts ...rpcc.StreamOption) (DataCollectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "Tracing.dataCollected", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &dataCollectedClient{Stream: s}, nil
 }
 
+// OnDataCollected registers fn to be called for each
+// DataCollected event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnDataCollected(fn func(*DataCollectedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Tracing.dataCollected", d.conn, func(args []byte) error {
+		ev := new(DataCollectedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type dataCollectedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -121,14 +152,29 @@ func (c *dataCollectedClient) Recv() (*DataCollectedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) TracingComplete(ctx context.Context) (CompleteClient, error) {
-	s, err := rpcc.NewStream(ctx, "Tracing.tracingComplete", d.conn)
+func (d *domainClient) TracingComplete(ctx context.Context, opts ...rpcc.StreamOption) (CompleteClient, error) {
+	s, err := rpcc.NewStream(ctx, "Tracing.tracingComplete", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &completeClient{Stream: s}, nil
 }
 
+// OnTracingComplete registers fn to be called for each
+// TracingComplete event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnTracingComplete(fn func(*CompleteReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("Tracing.tracingComplete", d.conn, func(args []byte) error {
+		ev := new(CompleteReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type completeClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -5,6 +5,7 @@ package tracing
 import (
 	"encoding/json"
 
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/protocol/io"
 	"github.com/mafredri/cdp/rpcc"
 )
@@ -24,6 +25,40 @@ type BufferUsageReply struct {
 	Value       *float64 `json:"value,omitempty"`       // A number in range [0..1] that indicates the used size of event buffer as a fraction of its total size.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *BufferUsageReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "percentFull":
+			if fastjson.IsNull(value) {
+				r.PercentFull = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.PercentFull = &v
+		case "eventCount":
+			if fastjson.IsNull(value) {
+				r.EventCount = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.EventCount = &v
+		case "value":
+			if fastjson.IsNull(value) {
+				r.Value = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.Value = &v
+		}
+		return err
+	})
+}
+
 // DataCollectedClient is a client for DataCollected events. Contains an
 // bucket of collected trace events. When tracing is stopped collected events
 // will be send as a sequence of dataCollected events followed by
@@ -40,6 +75,18 @@ type DataCollectedReply struct {
 	Value []json.RawMessage `json:"value"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *DataCollectedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "value":
+			err = json.Unmarshal(value, &r.Value)
+		}
+		return err
+	})
+}
+
 // CompleteClient is a client for TracingComplete events. Signals that tracing
 // is stopped and there is no trace buffers pending flush, all data were
 // delivered via dataCollected events.
@@ -57,3 +104,32 @@ type CompleteReply struct {
 	TraceFormat       StreamFormat      `json:"traceFormat,omitempty"`       // Trace data format of returned stream.
 	StreamCompression StreamCompression `json:"streamCompression,omitempty"` // Compression format of returned stream.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *CompleteReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "dataLossOccurred":
+			r.DataLossOccurred, err = fastjson.Bool(value)
+		case "stream":
+			if fastjson.IsNull(value) {
+				r.Stream = nil
+				break
+			}
+			var v string
+			v, err = fastjson.String(value)
+			c := io.StreamHandle(v)
+			r.Stream = &c
+		case "traceFormat":
+			var v string
+			v, err = fastjson.String(value)
+			r.TraceFormat = StreamFormat(v)
+		case "streamCompression":
+			var v string
+			v, err = fastjson.String(value)
+			r.StreamCompression = StreamCompression(v)
+		}
+		return err
+	})
+}
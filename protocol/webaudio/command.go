@@ -2,6 +2,12 @@
 
 package webaudio
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // GetRealtimeDataArgs represents the arguments for GetRealtimeData in the WebAudio domain.
 type GetRealtimeDataArgs struct {
 	ContextID GraphObjectID `json:"contextId"` // No description.
@@ -14,7 +20,34 @@ func NewGetRealtimeDataArgs(contextID GraphObjectID) *GetRealtimeDataArgs {
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetRealtimeDataArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "contextId")
+	b = fastjson.AppendString(b, string(a.ContextID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetRealtimeDataReply represents the return values for GetRealtimeData in the WebAudio domain.
 type GetRealtimeDataReply struct {
 	RealtimeData ContextRealtimeData `json:"realtimeData"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetRealtimeDataReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "realtimeData":
+			err = json.Unmarshal(value, &r.RealtimeData)
+		}
+		return err
+	})
+}
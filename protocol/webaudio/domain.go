@@ -6,6 +6,7 @@ package webaudio
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/mafredri/cdp/protocol/internal"
 	"github.com/mafredri/cdp/rpcc"
@@ -54,14 +55,29 @@ func (d *domainClient) GetRealtimeData(ctx context.Context, args *GetRealtimeDat
 	return
 }
 
-func (d *domainClient) ContextCreated(ctx context.Context) (ContextCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.contextCreated", d.conn)
+func (d *domainClient) ContextCreated(ctx context.Context, opts ...rpcc.StreamOption) (ContextCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.contextCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contextCreatedClient{Stream: s}, nil
 }
 
+// OnContextCreated registers fn to be called for each
+// ContextCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnContextCreated(fn func(*ContextCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.contextCreated", d.conn, func(args []byte) error {
+		ev := new(ContextCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type contextCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -75,14 +91,29 @@ func (c *contextCreatedClient) Recv() (*ContextCreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) ContextWillBeDestroyed(ctx context.Context) (ContextWillBeDestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.contextWillBeDestroyed", d.conn)
+func (d *domainClient) ContextWillBeDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (ContextWillBeDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.contextWillBeDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contextWillBeDestroyedClient{Stream: s}, nil
 }
 
+// OnContextWillBeDestroyed registers fn to be called for each
+// ContextWillBeDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnContextWillBeDestroyed(fn func(*ContextWillBeDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.contextWillBeDestroyed", d.conn, func(args []byte) error {
+		ev := new(ContextWillBeDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type contextWillBeDestroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -96,14 +127,29 @@ func (c *contextWillBeDestroyedClient) Recv() (*ContextWillBeDestroyedReply, err
 	return event, nil
 }
 
-func (d *domainClient) ContextChanged(ctx context.Context) (ContextChangedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.contextChanged", d.conn)
+func (d *domainClient) ContextChanged(ctx context.Context, opts ...rpcc.StreamOption) (ContextChangedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.contextChanged", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &contextChangedClient{Stream: s}, nil
 }
 
+// OnContextChanged registers fn to be called for each
+// ContextChanged event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnContextChanged(fn func(*ContextChangedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.contextChanged", d.conn, func(args []byte) error {
+		ev := new(ContextChangedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type contextChangedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -117,14 +163,29 @@ func (c *contextChangedClient) Recv() (*ContextChangedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AudioListenerCreated(ctx context.Context) (AudioListenerCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioListenerCreated", d.conn)
+func (d *domainClient) AudioListenerCreated(ctx context.Context, opts ...rpcc.StreamOption) (AudioListenerCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioListenerCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioListenerCreatedClient{Stream: s}, nil
 }
 
+// OnAudioListenerCreated registers fn to be called for each
+// AudioListenerCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioListenerCreated(fn func(*AudioListenerCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioListenerCreated", d.conn, func(args []byte) error {
+		ev := new(AudioListenerCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioListenerCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -138,14 +199,29 @@ func (c *audioListenerCreatedClient) Recv() (*AudioListenerCreatedReply, error)
 	return event, nil
 }
 
-func (d *domainClient) AudioListenerWillBeDestroyed(ctx context.Context) (AudioListenerWillBeDestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioListenerWillBeDestroyed", d.conn)
+func (d *domainClient) AudioListenerWillBeDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (AudioListenerWillBeDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioListenerWillBeDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioListenerWillBeDestroyedClient{Stream: s}, nil
 }
 
+// OnAudioListenerWillBeDestroyed registers fn to be called for each
+// AudioListenerWillBeDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioListenerWillBeDestroyed(fn func(*AudioListenerWillBeDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioListenerWillBeDestroyed", d.conn, func(args []byte) error {
+		ev := new(AudioListenerWillBeDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioListenerWillBeDestroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -159,14 +235,29 @@ func (c *audioListenerWillBeDestroyedClient) Recv() (*AudioListenerWillBeDestroy
 	return event, nil
 }
 
-func (d *domainClient) AudioNodeCreated(ctx context.Context) (AudioNodeCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioNodeCreated", d.conn)
+func (d *domainClient) AudioNodeCreated(ctx context.Context, opts ...rpcc.StreamOption) (AudioNodeCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioNodeCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioNodeCreatedClient{Stream: s}, nil
 }
 
+// OnAudioNodeCreated registers fn to be called for each
+// AudioNodeCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioNodeCreated(fn func(*AudioNodeCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioNodeCreated", d.conn, func(args []byte) error {
+		ev := new(AudioNodeCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioNodeCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -180,14 +271,29 @@ func (c *audioNodeCreatedClient) Recv() (*AudioNodeCreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AudioNodeWillBeDestroyed(ctx context.Context) (AudioNodeWillBeDestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioNodeWillBeDestroyed", d.conn)
+func (d *domainClient) AudioNodeWillBeDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (AudioNodeWillBeDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioNodeWillBeDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioNodeWillBeDestroyedClient{Stream: s}, nil
 }
 
+// OnAudioNodeWillBeDestroyed registers fn to be called for each
+// AudioNodeWillBeDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioNodeWillBeDestroyed(fn func(*AudioNodeWillBeDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioNodeWillBeDestroyed", d.conn, func(args []byte) error {
+		ev := new(AudioNodeWillBeDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioNodeWillBeDestroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -201,14 +307,29 @@ func (c *audioNodeWillBeDestroyedClient) Recv() (*AudioNodeWillBeDestroyedReply,
 	return event, nil
 }
 
-func (d *domainClient) AudioParamCreated(ctx context.Context) (AudioParamCreatedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioParamCreated", d.conn)
+func (d *domainClient) AudioParamCreated(ctx context.Context, opts ...rpcc.StreamOption) (AudioParamCreatedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioParamCreated", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioParamCreatedClient{Stream: s}, nil
 }
 
+// OnAudioParamCreated registers fn to be called for each
+// AudioParamCreated event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioParamCreated(fn func(*AudioParamCreatedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioParamCreated", d.conn, func(args []byte) error {
+		ev := new(AudioParamCreatedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioParamCreatedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -222,14 +343,29 @@ func (c *audioParamCreatedClient) Recv() (*AudioParamCreatedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) AudioParamWillBeDestroyed(ctx context.Context) (AudioParamWillBeDestroyedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.audioParamWillBeDestroyed", d.conn)
+func (d *domainClient) AudioParamWillBeDestroyed(ctx context.Context, opts ...rpcc.StreamOption) (AudioParamWillBeDestroyedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.audioParamWillBeDestroyed", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &audioParamWillBeDestroyedClient{Stream: s}, nil
 }
 
+// OnAudioParamWillBeDestroyed registers fn to be called for each
+// AudioParamWillBeDestroyed event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnAudioParamWillBeDestroyed(fn func(*AudioParamWillBeDestroyedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.audioParamWillBeDestroyed", d.conn, func(args []byte) error {
+		ev := new(AudioParamWillBeDestroyedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type audioParamWillBeDestroyedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -243,14 +379,29 @@ func (c *audioParamWillBeDestroyedClient) Recv() (*AudioParamWillBeDestroyedRepl
 	return event, nil
 }
 
-func (d *domainClient) NodesConnected(ctx context.Context) (NodesConnectedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.nodesConnected", d.conn)
+func (d *domainClient) NodesConnected(ctx context.Context, opts ...rpcc.StreamOption) (NodesConnectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.nodesConnected", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &nodesConnectedClient{Stream: s}, nil
 }
 
+// OnNodesConnected registers fn to be called for each
+// NodesConnected event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNodesConnected(fn func(*NodesConnectedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.nodesConnected", d.conn, func(args []byte) error {
+		ev := new(NodesConnectedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type nodesConnectedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -264,14 +415,29 @@ func (c *nodesConnectedClient) Recv() (*NodesConnectedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) NodesDisconnected(ctx context.Context) (NodesDisconnectedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.nodesDisconnected", d.conn)
+func (d *domainClient) NodesDisconnected(ctx context.Context, opts ...rpcc.StreamOption) (NodesDisconnectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.nodesDisconnected", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &nodesDisconnectedClient{Stream: s}, nil
 }
 
+// OnNodesDisconnected registers fn to be called for each
+// NodesDisconnected event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNodesDisconnected(fn func(*NodesDisconnectedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.nodesDisconnected", d.conn, func(args []byte) error {
+		ev := new(NodesDisconnectedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type nodesDisconnectedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -285,14 +451,29 @@ func (c *nodesDisconnectedClient) Recv() (*NodesDisconnectedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) NodeParamConnected(ctx context.Context) (NodeParamConnectedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.nodeParamConnected", d.conn)
+func (d *domainClient) NodeParamConnected(ctx context.Context, opts ...rpcc.StreamOption) (NodeParamConnectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.nodeParamConnected", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &nodeParamConnectedClient{Stream: s}, nil
 }
 
+// OnNodeParamConnected registers fn to be called for each
+// NodeParamConnected event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNodeParamConnected(fn func(*NodeParamConnectedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.nodeParamConnected", d.conn, func(args []byte) error {
+		ev := new(NodeParamConnectedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type nodeParamConnectedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -306,14 +487,29 @@ func (c *nodeParamConnectedClient) Recv() (*NodeParamConnectedReply, error) {
 	return event, nil
 }
 
-func (d *domainClient) NodeParamDisconnected(ctx context.Context) (NodeParamDisconnectedClient, error) {
-	s, err := rpcc.NewStream(ctx, "WebAudio.nodeParamDisconnected", d.conn)
+func (d *domainClient) NodeParamDisconnected(ctx context.Context, opts ...rpcc.StreamOption) (NodeParamDisconnectedClient, error) {
+	s, err := rpcc.NewStream(ctx, "WebAudio.nodeParamDisconnected", d.conn, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &nodeParamDisconnectedClient{Stream: s}, nil
 }
 
+// OnNodeParamDisconnected registers fn to be called for each
+// NodeParamDisconnected event. Handlers are called in the order that events
+// arrive on the connection, see rpcc.Handle. Events that cannot be
+// decoded are reported to rpcc.WithHandlerError and not passed to fn.
+func (d *domainClient) OnNodeParamDisconnected(fn func(*NodeParamDisconnectedReply)) (unsubscribe func(), err error) {
+	return rpcc.Handle("WebAudio.nodeParamDisconnected", d.conn, func(args []byte) error {
+		ev := new(NodeParamDisconnectedReply)
+		if err := json.Unmarshal(args, ev); err != nil {
+			return err
+		}
+		fn(ev)
+		return nil
+	})
+}
+
 type nodeParamDisconnectedClient struct{ rpcc.Stream }
 
 // GetStream returns the original Stream for use with cdp.Sync.
@@ -3,6 +3,9 @@
 package webaudio
 
 import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
 	"github.com/mafredri/cdp/rpcc"
 )
 
@@ -20,6 +23,18 @@ type ContextCreatedReply struct {
 	Context BaseAudioContext `json:"context"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ContextCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "context":
+			err = json.Unmarshal(value, &r.Context)
+		}
+		return err
+	})
+}
+
 // ContextWillBeDestroyedClient is a client for ContextWillBeDestroyed events.
 // Notifies that an existing BaseAudioContext will be destroyed.
 type ContextWillBeDestroyedClient interface {
@@ -34,6 +49,20 @@ type ContextWillBeDestroyedReply struct {
 	ContextID GraphObjectID `json:"contextId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ContextWillBeDestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		}
+		return err
+	})
+}
+
 // ContextChangedClient is a client for ContextChanged events. Notifies that
 // existing BaseAudioContext has changed some properties (id stays the same)..
 type ContextChangedClient interface {
@@ -48,6 +77,18 @@ type ContextChangedReply struct {
 	Context BaseAudioContext `json:"context"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *ContextChangedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "context":
+			err = json.Unmarshal(value, &r.Context)
+		}
+		return err
+	})
+}
+
 // AudioListenerCreatedClient is a client for AudioListenerCreated events.
 // Notifies that the construction of an AudioListener has finished.
 type AudioListenerCreatedClient interface {
@@ -62,6 +103,18 @@ type AudioListenerCreatedReply struct {
 	Listener AudioListener `json:"listener"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioListenerCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "listener":
+			err = json.Unmarshal(value, &r.Listener)
+		}
+		return err
+	})
+}
+
 // AudioListenerWillBeDestroyedClient is a client for AudioListenerWillBeDestroyed events.
 // Notifies that a new AudioListener has been created.
 type AudioListenerWillBeDestroyedClient interface {
@@ -77,6 +130,24 @@ type AudioListenerWillBeDestroyedReply struct {
 	ListenerID GraphObjectID `json:"listenerId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioListenerWillBeDestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "listenerId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ListenerID = GraphObjectID(v)
+		}
+		return err
+	})
+}
+
 // AudioNodeCreatedClient is a client for AudioNodeCreated events. Notifies
 // that a new AudioNode has been created.
 type AudioNodeCreatedClient interface {
@@ -91,6 +162,18 @@ type AudioNodeCreatedReply struct {
 	Node AudioNode `json:"node"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioNodeCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "node":
+			err = json.Unmarshal(value, &r.Node)
+		}
+		return err
+	})
+}
+
 // AudioNodeWillBeDestroyedClient is a client for AudioNodeWillBeDestroyed events.
 // Notifies that an existing AudioNode has been destroyed.
 type AudioNodeWillBeDestroyedClient interface {
@@ -106,6 +189,24 @@ type AudioNodeWillBeDestroyedReply struct {
 	NodeID    GraphObjectID `json:"nodeId"`    // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioNodeWillBeDestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "nodeId":
+			var v string
+			v, err = fastjson.String(value)
+			r.NodeID = GraphObjectID(v)
+		}
+		return err
+	})
+}
+
 // AudioParamCreatedClient is a client for AudioParamCreated events. Notifies
 // that a new AudioParam has been created.
 type AudioParamCreatedClient interface {
@@ -120,6 +221,18 @@ type AudioParamCreatedReply struct {
 	Param AudioParam `json:"param"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioParamCreatedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "param":
+			err = json.Unmarshal(value, &r.Param)
+		}
+		return err
+	})
+}
+
 // AudioParamWillBeDestroyedClient is a client for AudioParamWillBeDestroyed events.
 // Notifies that an existing AudioParam has been destroyed.
 type AudioParamWillBeDestroyedClient interface {
@@ -136,6 +249,28 @@ type AudioParamWillBeDestroyedReply struct {
 	ParamID   GraphObjectID `json:"paramId"`   // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AudioParamWillBeDestroyedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "nodeId":
+			var v string
+			v, err = fastjson.String(value)
+			r.NodeID = GraphObjectID(v)
+		case "paramId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ParamID = GraphObjectID(v)
+		}
+		return err
+	})
+}
+
 // NodesConnectedClient is a client for NodesConnected events. Notifies that
 // two AudioNodes are connected.
 type NodesConnectedClient interface {
@@ -154,6 +289,44 @@ type NodesConnectedReply struct {
 	DestinationInputIndex *float64      `json:"destinationInputIndex,omitempty"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NodesConnectedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "sourceId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceID = GraphObjectID(v)
+		case "destinationId":
+			var v string
+			v, err = fastjson.String(value)
+			r.DestinationID = GraphObjectID(v)
+		case "sourceOutputIndex":
+			if fastjson.IsNull(value) {
+				r.SourceOutputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.SourceOutputIndex = &v
+		case "destinationInputIndex":
+			if fastjson.IsNull(value) {
+				r.DestinationInputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.DestinationInputIndex = &v
+		}
+		return err
+	})
+}
+
 // NodesDisconnectedClient is a client for NodesDisconnected events. Notifies
 // that AudioNodes are disconnected. The destination can be null, and it means
 // all the outgoing connections from the source are disconnected.
@@ -173,6 +346,44 @@ type NodesDisconnectedReply struct {
 	DestinationInputIndex *float64      `json:"destinationInputIndex,omitempty"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NodesDisconnectedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "sourceId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceID = GraphObjectID(v)
+		case "destinationId":
+			var v string
+			v, err = fastjson.String(value)
+			r.DestinationID = GraphObjectID(v)
+		case "sourceOutputIndex":
+			if fastjson.IsNull(value) {
+				r.SourceOutputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.SourceOutputIndex = &v
+		case "destinationInputIndex":
+			if fastjson.IsNull(value) {
+				r.DestinationInputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.DestinationInputIndex = &v
+		}
+		return err
+	})
+}
+
 // NodeParamConnectedClient is a client for NodeParamConnected events.
 // Notifies that an AudioNode is connected to an AudioParam.
 type NodeParamConnectedClient interface {
@@ -190,6 +401,36 @@ type NodeParamConnectedReply struct {
 	SourceOutputIndex *float64      `json:"sourceOutputIndex,omitempty"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NodeParamConnectedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "sourceId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceID = GraphObjectID(v)
+		case "destinationId":
+			var v string
+			v, err = fastjson.String(value)
+			r.DestinationID = GraphObjectID(v)
+		case "sourceOutputIndex":
+			if fastjson.IsNull(value) {
+				r.SourceOutputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.SourceOutputIndex = &v
+		}
+		return err
+	})
+}
+
 // NodeParamDisconnectedClient is a client for NodeParamDisconnected events.
 // Notifies that an AudioNode is disconnected to an AudioParam.
 type NodeParamDisconnectedClient interface {
@@ -206,3 +447,33 @@ type NodeParamDisconnectedReply struct {
 	DestinationID     GraphObjectID `json:"destinationId"`               // No description.
 	SourceOutputIndex *float64      `json:"sourceOutputIndex,omitempty"` // No description.
 }
+
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *NodeParamDisconnectedReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "contextId":
+			var v string
+			v, err = fastjson.String(value)
+			r.ContextID = GraphObjectID(v)
+		case "sourceId":
+			var v string
+			v, err = fastjson.String(value)
+			r.SourceID = GraphObjectID(v)
+		case "destinationId":
+			var v string
+			v, err = fastjson.String(value)
+			r.DestinationID = GraphObjectID(v)
+		case "sourceOutputIndex":
+			if fastjson.IsNull(value) {
+				r.SourceOutputIndex = nil
+				break
+			}
+			var v float64
+			v, err = fastjson.Float(value)
+			r.SourceOutputIndex = &v
+		}
+		return err
+	})
+}
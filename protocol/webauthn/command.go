@@ -2,6 +2,12 @@
 
 package webauthn
 
+import (
+	"encoding/json"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
 // AddVirtualAuthenticatorArgs represents the arguments for AddVirtualAuthenticator in the WebAuthn domain.
 type AddVirtualAuthenticatorArgs struct {
 	Options VirtualAuthenticatorOptions `json:"options"` // No description.
@@ -14,11 +20,42 @@ func NewAddVirtualAuthenticatorArgs(options VirtualAuthenticatorOptions) *AddVir
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddVirtualAuthenticatorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "options")
+	if b, err = fastjson.AppendValue(b, a.Options); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // AddVirtualAuthenticatorReply represents the return values for AddVirtualAuthenticator in the WebAuthn domain.
 type AddVirtualAuthenticatorReply struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *AddVirtualAuthenticatorReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "authenticatorId":
+			var v string
+			v, err = fastjson.String(value)
+			r.AuthenticatorID = AuthenticatorID(v)
+		}
+		return err
+	})
+}
+
 // RemoveVirtualAuthenticatorArgs represents the arguments for RemoveVirtualAuthenticator in the WebAuthn domain.
 type RemoveVirtualAuthenticatorArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -31,6 +68,21 @@ func NewRemoveVirtualAuthenticatorArgs(authenticatorID AuthenticatorID) *RemoveV
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveVirtualAuthenticatorArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = append(b, '}')
+	return b, err
+}
+
 // AddCredentialArgs represents the arguments for AddCredential in the WebAuthn domain.
 type AddCredentialArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -45,6 +97,25 @@ func NewAddCredentialArgs(authenticatorID AuthenticatorID, credential Credential
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *AddCredentialArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = fastjson.AppendKey(b, "credential")
+	if b, err = fastjson.AppendValue(b, a.Credential); err != nil {
+		return nil, err
+	}
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCredentialArgs represents the arguments for GetCredential in the WebAuthn domain.
 type GetCredentialArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -59,11 +130,40 @@ func NewGetCredentialArgs(authenticatorID AuthenticatorID, credentialID string)
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCredentialArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = fastjson.AppendKey(b, "credentialId")
+	b = fastjson.AppendString(b, a.CredentialID)
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCredentialReply represents the return values for GetCredential in the WebAuthn domain.
 type GetCredentialReply struct {
 	Credential Credential `json:"credential"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCredentialReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "credential":
+			err = json.Unmarshal(value, &r.Credential)
+		}
+		return err
+	})
+}
+
 // GetCredentialsArgs represents the arguments for GetCredentials in the WebAuthn domain.
 type GetCredentialsArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -76,11 +176,38 @@ func NewGetCredentialsArgs(authenticatorID AuthenticatorID) *GetCredentialsArgs
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *GetCredentialsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = append(b, '}')
+	return b, err
+}
+
 // GetCredentialsReply represents the return values for GetCredentials in the WebAuthn domain.
 type GetCredentialsReply struct {
 	Credentials []Credential `json:"credentials"` // No description.
 }
 
+// UnmarshalJSON implements json.Unmarshaler, it avoids reflection for
+// properties of a primitive type.
+func (r *GetCredentialsReply) UnmarshalJSON(data []byte) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "credentials":
+			err = json.Unmarshal(value, &r.Credentials)
+		}
+		return err
+	})
+}
+
 // RemoveCredentialArgs represents the arguments for RemoveCredential in the WebAuthn domain.
 type RemoveCredentialArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -95,6 +222,23 @@ func NewRemoveCredentialArgs(authenticatorID AuthenticatorID, credentialID strin
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *RemoveCredentialArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = fastjson.AppendKey(b, "credentialId")
+	b = fastjson.AppendString(b, a.CredentialID)
+	b = append(b, '}')
+	return b, err
+}
+
 // ClearCredentialsArgs represents the arguments for ClearCredentials in the WebAuthn domain.
 type ClearCredentialsArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -107,6 +251,21 @@ func NewClearCredentialsArgs(authenticatorID AuthenticatorID) *ClearCredentialsA
 	return args
 }
 
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *ClearCredentialsArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = append(b, '}')
+	return b, err
+}
+
 // SetUserVerifiedArgs represents the arguments for SetUserVerified in the WebAuthn domain.
 type SetUserVerifiedArgs struct {
 	AuthenticatorID AuthenticatorID `json:"authenticatorId"` // No description.
@@ -120,3 +279,20 @@ func NewSetUserVerifiedArgs(authenticatorID AuthenticatorID, isUserVerified bool
 	args.IsUserVerified = isUserVerified
 	return args
 }
+
+// MarshalJSON implements json.Marshaler, it avoids reflection for
+// properties of a primitive type.
+func (a *SetUserVerifiedArgs) MarshalJSON() ([]byte, error) {
+	if a == nil {
+		return []byte("null"), nil
+	}
+	var err error
+	b := make([]byte, 0, 128)
+	b = append(b, '{')
+	b = fastjson.AppendKey(b, "authenticatorId")
+	b = fastjson.AppendString(b, string(a.AuthenticatorID))
+	b = fastjson.AppendKey(b, "isUserVerified")
+	b = fastjson.AppendBool(b, a.IsUserVerified)
+	b = append(b, '}')
+	return b, err
+}
@@ -0,0 +1,306 @@
+package rpcc
+
+import (
+	"context"
+	"sync"
+)
+
+const defaultBatchLimit = 64
+
+// BatchOption represents an option for NewBatch.
+type BatchOption func(*Batch)
+
+// WithBatchLimit returns a BatchOption that limits the number of calls
+// that are in-flight (sent but not yet answered) at the same time. The
+// default is 64.
+func WithBatchLimit(n int) BatchOption {
+	return func(b *Batch) {
+		b.limit = n
+	}
+}
+
+// BatchCall represents a call queued in a Batch.
+type BatchCall struct {
+	Method string
+	Args   interface{}
+	Reply  interface{}
+
+	fn       func(err error)
+	id       uint64 // Request ID, zero until sent.
+	finished bool   // Only accessed by the goroutine calling Do.
+	err      error
+	done     chan struct{}
+}
+
+// Done returns a channel that is closed when the call is done.
+func (c *BatchCall) Done() <-chan struct{} { return c.done }
+
+// Err returns the error for the call, if any. It must not be called
+// before the call is done.
+func (c *BatchCall) Err() error { return c.err }
+
+// finish completes the call, it returns false if the call was already
+// finished.
+func (c *BatchCall) finish(err error) bool {
+	if c.finished {
+		return false
+	}
+	c.finished = true
+	c.err = err
+	close(c.done)
+	if c.fn != nil {
+		c.fn(err)
+	}
+	return true
+}
+
+// Batch issues many calls concurrently over a connection. Calls are
+// queued with Add or AddFunc and sent with Do. Queued requests are
+// written back-to-back, without waiting for responses, while the
+// number of calls in-flight is kept within the limit (WithBatchLimit).
+//
+// Batch is not safe for concurrent use.
+type Batch struct {
+	conn  *Conn
+	limit int
+	calls []*BatchCall
+}
+
+// NewBatch returns a new Batch for conn.
+func NewBatch(conn *Conn, opts ...BatchOption) *Batch {
+	b := &Batch{conn: conn, limit: defaultBatchLimit}
+	for _, o := range opts {
+		o(b)
+	}
+	if b.limit <= 0 {
+		b.limit = defaultBatchLimit
+	}
+	return b
+}
+
+// Add queues a call to method. The result is decoded into reply, once
+// the call is done (BatchCall.Done).
+func (b *Batch) Add(method string, args, reply interface{}) *BatchCall {
+	return b.AddFunc(method, args, reply, nil)
+}
+
+// AddFunc queues a call to method, like Add, and calls fn when the call
+// is done. Callbacks are called sequentially, from the goroutine that
+// called Do.
+func (b *Batch) AddFunc(method string, args, reply interface{}, fn func(err error)) *BatchCall {
+	c := &BatchCall{
+		Method: method,
+		Args:   args,
+		Reply:  reply,
+		fn:     fn,
+		done:   make(chan struct{}),
+	}
+	b.calls = append(b.calls, c)
+	return c
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int { return len(b.calls) }
+
+// Do sends the queued calls and waits for all of them to be done. It
+// returns the error of the first (queued) call that failed, if any. The
+// results of individual calls are available via the BatchCall.
+//
+// When ctx is done, all calls that are not done fail with the context
+// error and responses that arrive afterwards are discarded. The queue
+// is empty after Do returns, the Batch can be reused.
+//
+// If the connection uses unary interceptors (WithUnaryInterceptor) the
+// calls are made via Invoke instead, still within the limit.
+func (b *Batch) Do(ctx context.Context) error {
+	calls := b.calls
+	b.calls = nil
+	if len(calls) == 0 {
+		return nil
+	}
+
+	if b.conn.unary != nil {
+		b.invokeAll(ctx, calls)
+	} else {
+		b.sendAll(ctx, calls)
+	}
+
+	for _, c := range calls {
+		if c.err != nil {
+			return c.err
+		}
+	}
+	return nil
+}
+
+// sendAll writes the calls in chunks, within the limit, and waits for
+// the responses.
+func (b *Batch) sendAll(ctx context.Context, calls []*BatchCall) {
+	results := make(chan *rpcCall, len(calls))
+	rpcCalls := make([]*rpcCall, len(calls))
+	for i, c := range calls {
+		rpcCalls[i] = &rpcCall{
+			Method: c.Method,
+			Args:   c.Args,
+			Reply:  c.Reply,
+			batch:  results,
+			index:  i,
+		}
+	}
+
+	var next, inflight, completed int
+	for completed < len(calls) {
+		if n := b.limit - inflight; n > 0 && next < len(calls) {
+			end := next + n
+			if end > len(calls) {
+				end = len(calls)
+			}
+			ids, err := b.conn.sendBatch(ctx, rpcCalls[next:end])
+			for i, id := range ids {
+				calls[next+i].id = id
+			}
+			inflight += len(ids)
+			for _, c := range calls[next+len(ids) : end] {
+				if c.finish(err) {
+					completed++
+				}
+			}
+			next = end
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			var ids []uint64
+			for _, c := range calls[:next] {
+				if c.id != 0 {
+					ids = append(ids, c.id)
+				}
+			}
+			b.conn.forget(ids)
+			// Calls that were done before they were forgotten.
+			for len(results) > 0 {
+				r := <-results
+				calls[r.index].finish(r.err)
+			}
+			for _, c := range calls {
+				c.finish(ctx.Err())
+			}
+			return
+		case r := <-results:
+			c := calls[r.index]
+			if c.finish(r.err) {
+				completed++
+				if c.id != 0 {
+					inflight--
+				}
+			}
+		}
+	}
+}
+
+// invokeAll makes the calls via Invoke, within the limit.
+func (b *Batch) invokeAll(ctx context.Context, calls []*BatchCall) {
+	type result struct {
+		c   *BatchCall
+		err error
+	}
+	results := make(chan result, len(calls))
+	sem := make(chan struct{}, b.limit)
+	var wg sync.WaitGroup
+	go func() {
+		for _, c := range calls {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(c *BatchCall) {
+				defer wg.Done()
+				err := Invoke(ctx, c.Method, c.Args, c.Reply, b.conn)
+				<-sem
+				results <- result{c, err}
+			}(c)
+		}
+	}()
+	for range calls {
+		r := <-results
+		r.c.finish(r.err)
+	}
+	wg.Wait()
+}
+
+// sendBatch writes the requests for calls back-to-back. It returns the
+// IDs of the requests that were written, the error applies to the
+// remaining calls.
+func (c *Conn) sendBatch(ctx context.Context, calls []*rpcCall) (ids []uint64, err error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, c.err
+	}
+	if c.resetErr != nil {
+		c.mu.Unlock()
+		return nil, c.resetErr
+	}
+	ids = make([]uint64, len(calls))
+	for i, call := range calls {
+		c.reqSeq++
+		ids[i] = c.reqSeq
+		c.pending[ids[i]] = call
+	}
+	c.mu.Unlock()
+
+	type result struct {
+		sent int
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		c.reqMu.Lock()
+		defer c.reqMu.Unlock()
+		var r result
+		for i, call := range calls {
+			if r.err = ctx.Err(); r.err != nil {
+				break
+			}
+			c.req.ID = ids[i]
+			c.req.Method = call.Method
+			c.req.Args = call.Args
+			if r.err = c.codec.WriteRequest(&c.req); r.err != nil {
+				break
+			}
+			r.sent++
+		}
+		c.req.Args = nil
+		done <- r
+	}()
+
+	var r result
+	select {
+	case <-ctx.Done():
+		// The calls are abandoned by the caller (Batch.sendAll)
+		// which forgets all IDs.
+		return ids, nil
+	case r = <-done:
+	}
+
+	if r.err != nil {
+		c.mu.Lock()
+		if c.closed {
+			r.err = c.err
+		} else if _, ok := c.pending[ids[r.sent]]; ctx.Err() == nil && (c.resetErr != nil || !ok) {
+			r.err = &resetError{err: r.err}
+		}
+		c.mu.Unlock()
+		c.forget(ids[r.sent:])
+	}
+	return ids[:r.sent], r.err
+}
+
+// forget removes the pending calls with ids, their responses are
+// discarded.
+func (c *Conn) forget(ids []uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		delete(c.pending, id)
+	}
+}
@@ -0,0 +1,170 @@
+package rpcc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestBatch(t *testing.T) {
+	const limit = 4
+
+	var (
+		mu             sync.Mutex
+		pending        []*Request
+		maxOutstanding int
+	)
+	flush := func(conn *websocket.Conn) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, req := range pending {
+			resp := Response{ID: req.ID}
+			var n int
+			fmt.Sscanf(req.Method, "test.Square%d", &n)
+			if n == 3 {
+				resp.Error = &ResponseError{Code: CodeServerError, Message: "bad number"}
+			} else {
+				resp.Result = []byte(fmt.Sprint(n * n))
+			}
+			if err := conn.WriteJSON(&resp); err != nil {
+				return err
+			}
+		}
+		pending = nil
+		return nil
+	}
+	var timer *time.Timer
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		mu.Lock()
+		pending = append(pending, req)
+		if len(pending) > maxOutstanding {
+			maxOutstanding = len(pending)
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		// Respond when no more requests arrive.
+		timer = time.AfterFunc(20*time.Millisecond, func() { flush(conn) })
+		mu.Unlock()
+		return nil
+	})
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	b := NewBatch(srv.conn, WithBatchLimit(limit))
+	replies := make([]int, 10)
+	var calls []*BatchCall
+	var callbacks int
+	for i := range replies {
+		calls = append(calls, b.AddFunc(fmt.Sprintf("test.Square%d", i), nil, &replies[i], func(err error) {
+			callbacks++
+		}))
+	}
+	if b.Len() != 10 {
+		t.Errorf("Len: got %d, want 10", b.Len())
+	}
+
+	err := b.Do(ctx)
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("Do: got %v, want ErrServer", err)
+	}
+	for i, c := range calls {
+		select {
+		case <-c.Done():
+		default:
+			t.Errorf("call %d: not done", i)
+			continue
+		}
+		if i == 3 {
+			if c.Err() == nil {
+				t.Errorf("call %d: want error", i)
+			}
+			continue
+		}
+		if c.Err() != nil {
+			t.Errorf("call %d: got error %v", i, c.Err())
+		}
+		if replies[i] != i*i {
+			t.Errorf("call %d: got reply %d, want %d", i, replies[i], i*i)
+		}
+	}
+	if callbacks != 10 {
+		t.Errorf("callbacks: got %d, want 10", callbacks)
+	}
+	mu.Lock()
+	if maxOutstanding > limit {
+		t.Errorf("got %d calls in-flight, want at most %d", maxOutstanding, limit)
+	}
+	mu.Unlock()
+	if b.Len() != 0 {
+		t.Errorf("Len after Do: got %d, want 0", b.Len())
+	}
+}
+
+func TestBatch_Cancel(t *testing.T) {
+	srv := newTestServer(t, nil) // Never responds.
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	b := NewBatch(srv.conn, WithBatchLimit(2))
+	var calls []*BatchCall
+	for i := 0; i < 5; i++ {
+		calls = append(calls, b.Add("test.Hang", nil, nil))
+	}
+	err := b.Do(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Do: got %v, want %v", err, context.DeadlineExceeded)
+	}
+	for i, c := range calls {
+		if c.Err() != context.DeadlineExceeded {
+			t.Errorf("call %d: got %v, want %v", i, c.Err(), context.DeadlineExceeded)
+		}
+	}
+
+	srv.conn.mu.Lock()
+	n := len(srv.conn.pending)
+	srv.conn.mu.Unlock()
+	if n != 0 {
+		t.Errorf("got %d pending calls, want 0", n)
+	}
+}
+
+func TestBatch_Interceptor(t *testing.T) {
+	var intercepted int
+	var mu sync.Mutex
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		return conn.WriteJSON(&Response{ID: req.ID, Result: []byte(`"ok"`)})
+	}, WithUnaryInterceptor(func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error {
+		mu.Lock()
+		intercepted++
+		mu.Unlock()
+		return invoker(ctx, method, args, reply, conn)
+	}))
+	defer srv.Close()
+
+	b := NewBatch(srv.conn)
+	replies := make([]string, 3)
+	for i := range replies {
+		b.Add("test.Ok", nil, &replies[i])
+	}
+	if err := b.Do(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range replies {
+		if r != "ok" {
+			t.Errorf("reply %d: got %q, want %q", i, r, "ok")
+		}
+	}
+	if intercepted != 3 {
+		t.Errorf("intercepted: got %d, want 3", intercepted)
+	}
+}
@@ -7,20 +7,45 @@ type rpcCall struct {
 	Args   interface{}
 	Reply  interface{}
 	Error  chan error
+
+	// Used by Batch instead of Error, receives the call when done.
+	batch chan *rpcCall
+	err   error
+	index int
+
+	// Used by Go instead of Error.
+	async *Call
 }
 
 func (c *rpcCall) done(err error) {
+	if c.async != nil {
+		c.async.finish(err)
+		return
+	}
+	if c.batch != nil {
+		c.err = err
+		c.batch <- c
+		return
+	}
 	c.Error <- err
 }
 
 // Invoke sends an RPC request and blocks until the response is received.
 // This function is called by generated code but can be used to issue
-// requests manually.
+// requests manually. The call passes through the unary interceptors of
+// conn, if any (WithUnaryInterceptor).
 func Invoke(ctx context.Context, method string, args, reply interface{}, conn *Conn) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if conn.unary != nil {
+		return conn.unary(ctx, method, args, reply, conn, invoke)
+	}
+	return invoke(ctx, method, args, reply, conn)
+}
 
+// invoke implements Invoker, it is the final step of Invoke.
+func invoke(ctx context.Context, method string, args, reply interface{}, conn *Conn) error {
 	call := &rpcCall{
 		Method: method,
 		Args:   args,
@@ -40,3 +65,94 @@ func Invoke(ctx context.Context, method string, args, reply interface{}, conn *C
 		return err
 	}
 }
+
+// Call represents an asynchronous call, see Go.
+type Call struct {
+	Method string      // The invoked method.
+	Args   interface{} // The arguments to the method.
+	Reply  interface{} // The reply from the method.
+	Error  error       // After completion, the error status.
+	Done   chan *Call  // Receives the Call when it is complete.
+
+	finished chan struct{} // Closed when complete.
+}
+
+func (c *Call) finish(err error) {
+	c.Error = err
+	close(c.finished)
+	c.Done <- c // Buffered, does not block.
+}
+
+// Go invokes the method asynchronously, in the spirit of net/rpc. The
+// request is written before Go returns, the returned Call receives
+// itself on the Done channel when the response has arrived. It is safe
+// to wait for events while the call is in-flight:
+//
+//	call := rpcc.Go(ctx, "Page.navigate", args, &reply, conn)
+//	ev, err := loadEventFired.Recv()
+//	// ...
+//	<-call.Done
+//	if call.Error != nil {
+//		// Handle error.
+//	}
+//
+// When ctx is done before the response arrives the call completes with
+// the context error. The call passes through the unary interceptors of
+// conn, if any, in which case it is invoked in a new goroutine.
+func Go(ctx context.Context, method string, args, reply interface{}, conn *Conn) *Call {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	call := &Call{
+		Method:   method,
+		Args:     args,
+		Reply:    reply,
+		Done:     make(chan *Call, 1),
+		finished: make(chan struct{}),
+	}
+
+	if conn.unary != nil {
+		go func() {
+			call.finish(Invoke(ctx, method, args, reply, conn))
+		}()
+		return call
+	}
+
+	if err := ctx.Err(); err != nil {
+		call.finish(err)
+		return call
+	}
+	rc := &rpcCall{
+		Method: method,
+		Args:   args,
+		Reply:  reply,
+		async:  call,
+	}
+	reqID, err := conn.register(rc)
+	if err != nil {
+		call.finish(err)
+		return call
+	}
+	if err = conn.write(reqID, rc); err != nil {
+		// If conn was closed, the call was failed by close.
+		if conn.cancelCall(reqID) {
+			call.finish(err)
+		}
+		return call
+	}
+
+	// Pending calls are failed when conn is closed, only watch ctx
+	// when it can be done before that.
+	if ctx.Done() != nil && ctx != conn.ctx {
+		go func() {
+			select {
+			case <-ctx.Done():
+				if conn.cancelCall(reqID) {
+					call.finish(ctx.Err())
+				}
+			case <-call.finished:
+			}
+		}()
+	}
+	return call
+}
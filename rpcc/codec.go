@@ -0,0 +1,163 @@
+package rpcc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/mafredri/cdp/internal/fastjson"
+)
+
+// maxPooledBuffer is the largest buffer that is returned to the pool,
+// large messages (e.g. screenshots) would otherwise stay in memory.
+const maxPooledBuffer = 4 << 20
+
+// bufPool holds the buffers used for reading responses.
+var bufPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// writePool holds the buffers used for encoding requests.
+var writePool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 512)
+		return &b
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	b := bufPool.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBuffer {
+		return
+	}
+	bufPool.Put(b)
+}
+
+// messageReader is implemented by the WebSocket connection, it allows
+// reading one message at a time.
+type messageReader interface {
+	NextReader() (messageType int, r io.Reader, err error)
+}
+
+// fastCodec implements Codec.
+type fastCodec struct {
+	conn io.ReadWriter
+	mr   messageReader
+	dec  *json.Decoder // Used when conn is not a messageReader.
+	raw  json.RawMessage
+}
+
+var _ Codec = (*fastCodec)(nil)
+
+// NewFastCodec returns a Codec that avoids the reflection of
+// encoding/json for the message envelope. Only the envelope (id,
+// method and sessionId) is decoded when reading, the result and params
+// are kept as raw JSON until they are received, and read buffers are
+// pooled. Request arguments are encoded with their MarshalJSON method,
+// if any (see the -fast-json flag of cdpgen).
+//
+//	conn, err := rpcc.Dial(url, rpcc.WithCodec(rpcc.NewFastCodec))
+func NewFastCodec(conn io.ReadWriter) Codec {
+	c := &fastCodec{conn: conn}
+	if ws, ok := conn.(*wsReadWriteCloser); ok {
+		c.mr = ws.wsConn
+	} else if mr, ok := conn.(messageReader); ok {
+		c.mr = mr
+	} else {
+		c.dec = json.NewDecoder(conn)
+	}
+	return c
+}
+
+// WriteRequest implements Codec.
+func (c *fastCodec) WriteRequest(r *Request) (err error) {
+	bp := writePool.Get().(*[]byte)
+	b := (*bp)[:0]
+	defer func() {
+		if cap(b) <= maxPooledBuffer {
+			*bp = b
+			writePool.Put(bp)
+		}
+	}()
+
+	b = append(b, `{"id":`...)
+	b = strconv.AppendUint(b, r.ID, 10)
+	b = fastjson.AppendKey(b, "method")
+	b = fastjson.AppendString(b, r.Method)
+	if r.Args != nil {
+		b = fastjson.AppendKey(b, "params")
+		if b, err = fastjson.AppendValue(b, r.Args); err != nil {
+			return err
+		}
+	}
+	if r.SessionID != "" {
+		b = fastjson.AppendKey(b, "sessionId")
+		b = fastjson.AppendString(b, r.SessionID)
+	}
+	b = append(b, '}')
+
+	_, err = c.conn.Write(b)
+	return err
+}
+
+// ReadResponse implements Codec.
+func (c *fastCodec) ReadResponse(r *Response) error {
+	if c.dec != nil {
+		c.raw = c.raw[:0]
+		if err := c.dec.Decode(&c.raw); err != nil {
+			return err
+		}
+		return decodeResponse(c.raw, r)
+	}
+
+	_, mr, err := c.mr.NextReader()
+	if err != nil {
+		return err
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if _, err = buf.ReadFrom(mr); err != nil {
+		return err
+	}
+	return decodeResponse(buf.Bytes(), r)
+}
+
+// decodeResponse decodes the envelope in data into r, the result and
+// params are copied since data may be reused.
+func decodeResponse(data []byte, r *Response) error {
+	return fastjson.ObjectEach(data, func(key, value []byte) (err error) {
+		switch string(key) {
+		case "id":
+			r.ID, err = fastjson.Uint64(value)
+		case "method":
+			r.Method, err = fastjson.String(value)
+		case "sessionId":
+			r.SessionID, err = fastjson.String(value)
+		case "result":
+			r.Result = copyRaw(value)
+		case "params":
+			r.Args = copyRaw(value)
+		case "error":
+			if fastjson.IsNull(value) {
+				return nil
+			}
+			r.Error = new(ResponseError)
+			err = json.Unmarshal(value, r.Error)
+		}
+		return err
+	})
+}
+
+func copyRaw(value []byte) json.RawMessage {
+	if fastjson.IsNull(value) {
+		return nil
+	}
+	return append(json.RawMessage(nil), value...)
+}
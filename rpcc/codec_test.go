@@ -0,0 +1,204 @@
+package rpcc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
+)
+
+// messageConn implements io.ReadWriter and messageReader, it returns
+// msg for every call to NextReader.
+type messageConn struct {
+	msg []byte
+	r   bytes.Reader
+}
+
+func (c *messageConn) NextReader() (int, io.Reader, error) {
+	c.r.Reset(c.msg)
+	return websocket.TextMessage, &c.r, nil
+}
+func (c *messageConn) Read(p []byte) (int, error)  { return 0, io.EOF }
+func (c *messageConn) Write(p []byte) (int, error) { return len(p), nil }
+
+func TestFastCodec_ReadResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want Response
+	}{
+		{"Result", `{"id":1,"result":{"a":"b"}}`, Response{ID: 1, Result: json.RawMessage(`{"a":"b"}`)}},
+		{"Error", `{"id":2,"error":{"code":-32601,"message":"not found","data":"x"}}`, Response{ID: 2, Error: &ResponseError{Code: CodeMethodNotFound, Message: "not found", Data: "x"}}},
+		{"Notification", `{"method":"Page.loadEventFired","params":{"timestamp":1.5},"sessionId":"s1"}`, Response{Method: "Page.loadEventFired", Args: json.RawMessage(`{"timestamp":1.5}`), SessionID: "s1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, conn := range []io.ReadWriter{
+				&messageConn{msg: []byte(tt.msg)},
+				bytes.NewBufferString(tt.msg + "\n"),
+			} {
+				var got Response
+				if err := NewFastCodec(conn).ReadResponse(&got); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("ReadResponse() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFastCodec_WriteRequest(t *testing.T) {
+	for _, r := range []*Request{
+		{ID: 1, Method: "Page.enable"},
+		{ID: 2, Method: "Page.navigate", Args: map[string]string{"url": "https://example.com/?a=<b>&c"}},
+		{ID: 3, Method: "Runtime.evaluate", Args: struct{}{}, SessionID: "s1"},
+	} {
+		var want, got bytes.Buffer
+		if err := json.NewEncoder(&want).Encode(r); err != nil {
+			t.Fatal(err)
+		}
+		if err := NewFastCodec(&got).WriteRequest(r); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(string(bytes.TrimSpace(want.Bytes())), got.String()); diff != "" {
+			t.Errorf("WriteRequest() diff (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFastCodec_Conn(t *testing.T) {
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		err := conn.WriteMessage(websocket.TextMessage, []byte(`{"method":"test.Event","params":{"n":1}}`))
+		if err != nil {
+			return err
+		}
+		return conn.WriteJSON(&Response{ID: req.ID, Result: []byte(`{"hello":"world"}`)})
+	}, WithCodec(NewFastCodec))
+	defer srv.Close()
+
+	ctx := context.Background()
+	s, err := NewStream(ctx, "test.Event", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	var reply struct{ Hello string }
+	if err = Invoke(ctx, "test.Invoke", nil, &reply, srv.conn); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Hello != "world" {
+		t.Errorf("Invoke() reply = %q, want %q", reply.Hello, "world")
+	}
+
+	var raw json.RawMessage
+	if err = s.RecvMsg(&raw); err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"n":1}` {
+		t.Errorf("RecvMsg() = %s, want %s", raw, `{"n":1}`)
+	}
+}
+
+// lenient implements json.Unmarshaler without validating the input.
+type lenient struct{}
+
+func (*lenient) UnmarshalJSON([]byte) error { return nil }
+
+func TestFastCodec_ValidatesParams(t *testing.T) {
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		return conn.WriteMessage(websocket.TextMessage, []byte(`{"method":"test.Event","params":{"n":1,}}`))
+	}, WithCodec(NewFastCodec))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := NewStream(ctx, "test.Event", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	go Invoke(ctx, "test.Invoke", nil, nil, srv.conn)
+
+	// The params are not scanned by the fast codec.
+	if err = s.RecvMsg(new(lenient)); err == nil {
+		t.Error("RecvMsg() error = nil, want syntax error")
+	}
+}
+
+var benchResponse = []byte(`{"method":"Network.dataReceived","params":{"requestId":"1000.42","timestamp":12345.678901,"dataLength":65536,"encodedDataLength":8192},"sessionId":"8A7D1E2F3C4B5A69788796A5B4C3D2E1"}`)
+
+func BenchmarkCodec_ReadResponse(b *testing.B) {
+	for _, bb := range []struct {
+		name     string
+		newCodec func(conn io.ReadWriter) Codec
+	}{
+		{"JSON", func(conn io.ReadWriter) Codec { return &jsonCodec{dec: json.NewDecoder(conn)} }},
+		{"Fast", NewFastCodec},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			conn := &messageConn{msg: benchResponse}
+			codec := bb.newCodec(&wsReadWriteCloser{wsConn: &wsMessageConn{conn}})
+			b.SetBytes(int64(len(benchResponse)))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			var resp Response
+			for i := 0; i < b.N; i++ {
+				resp.reset()
+				if err := codec.ReadResponse(&resp); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCodec_WriteRequest(b *testing.B) {
+	args := struct {
+		URL            string  `json:"url"`
+		Referrer       *string `json:"referrer,omitempty"`
+		TransitionType string  `json:"transitionType,omitempty"`
+	}{URL: "https://github.com/mafredri/cdp", TransitionType: "link"}
+	req := &Request{ID: 1, Method: "Page.navigate", Args: &args, SessionID: "8A7D1E2F3C4B5A69788796A5B4C3D2E1"}
+
+	for _, bb := range []struct {
+		name     string
+		newCodec func(conn io.ReadWriter) Codec
+	}{
+		{"JSON", func(conn io.ReadWriter) Codec { return &jsonCodec{enc: json.NewEncoder(conn)} }},
+		{"Fast", NewFastCodec},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			codec := bb.newCodec(&messageConn{})
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				req.ID = uint64(i)
+				if err := codec.WriteRequest(req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// wsMessageConn implements wsConn for messageConn.
+type wsMessageConn struct{ *messageConn }
+
+func (c *wsMessageConn) NextWriter(int) (io.WriteCloser, error) { return nopWriteCloser{}, nil }
+func (c *wsMessageConn) Close() error                           { return nil }
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+func (nopWriteCloser) Close() error                { return nil }
@@ -9,6 +9,7 @@ import (
 	"io"
 	"log"
 	"net"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/websocket"
@@ -29,6 +30,14 @@ func (e *closeError) Closed() bool  { return true }
 func (e *closeError) Cause() error  { return e.err }
 func (e *closeError) Unwrap() error { return e.err }
 
+// Is reports whether target is the same kind of close error, e.g.
+// ErrConnClosing matches the error of a connection that was closed due
+// to a read error.
+func (e *closeError) Is(target error) bool {
+	t, ok := target.(*closeError)
+	return ok && t.msg == e.msg
+}
+
 var (
 	// ErrConnClosing indicates that the operation is illegal because
 	// the connection is closing.
@@ -93,9 +102,13 @@ func WithTLSClientConfig(c *tls.Config) DialOption {
 }
 
 type dialOptions struct {
-	codec    func(io.ReadWriter) Codec
-	dialer   func(context.Context, string) (io.ReadWriteCloser, error)
-	wsDialer websocket.Dialer
+	codec      func(io.ReadWriter) Codec
+	dialer     func(context.Context, string) (io.ReadWriteCloser, error)
+	wsDialer   websocket.Dialer
+	unary      []UnaryInterceptor
+	stream     []StreamInterceptor
+	reconnect  reconnectOptions
+	handlerErr func(method string, err error)
 }
 
 // Dial connects to target and returns an active connection. The target
@@ -132,6 +145,7 @@ func DialContext(ctx context.Context, target string, opts ...DialOption) (conn *
 	for _, o := range opts {
 		o(&c.dialOpts)
 	}
+	c.unary = chainUnaryInterceptors(c.dialOpts.unary)
 
 	netDial := c.dialOpts.dialer
 	if netDial == nil {
@@ -164,7 +178,13 @@ func DialContext(ctx context.Context, target string, opts ...DialOption) (conn *
 			}
 
 			if ws.EnableCompression {
+				c.mu.Lock()
 				c.compressionLevel = wsConn.SetCompressionLevel
+				if c.compression != nil {
+					// Restore the level on reconnect.
+					wsConn.SetCompressionLevel(*c.compression)
+				}
+				c.mu.Unlock()
 			}
 
 			return &wsReadWriteCloser{wsConn: wsConn}, nil
@@ -175,18 +195,27 @@ func DialContext(ctx context.Context, target string, opts ...DialOption) (conn *
 	if err != nil {
 		return nil, err
 	}
-	newCodec := c.dialOpts.codec
-	if newCodec == nil {
-		newCodec = func(conn io.ReadWriter) Codec {
+	c.redial = func(ctx context.Context) (io.ReadWriteCloser, error) {
+		return netDial(ctx, target)
+	}
+	c.newCodec = c.dialOpts.codec
+	if c.newCodec == nil {
+		c.newCodec = func(conn io.ReadWriter) Codec {
 			return &jsonCodec{
 				enc: json.NewEncoder(conn),
 				dec: json.NewDecoder(conn),
 			}
 		}
 	}
-	c.codec = newCodec(c.conn)
+	c.codec = c.newCodec(c.conn)
 
-	recvDone := func(err error) {
+	notify := c.notifier()
+	var recvDone func(error)
+	recvDone = func(err error) {
+		if c.reconnect(err) {
+			go c.recv(notify, recvDone)
+			return
+		}
 		// When we receive Inspector.detached the remote will close
 		// the connection afterwards and recvDone will return. Maybe
 		// we could give the user time to react to the event before
@@ -194,7 +223,7 @@ func DialContext(ctx context.Context, target string, opts ...DialOption) (conn *
 		// TODO(mafredri): Do we want to close here, like this?
 		c.close(err)
 	}
-	go c.recv(c.notify, recvDone)
+	go c.recv(notify, recvDone)
 
 	return c, nil
 }
@@ -251,15 +280,27 @@ type Conn struct {
 
 	dialOpts dialOptions
 	conn     io.ReadWriteCloser
+	unary    UnaryInterceptor // Chained dialOpts.unary, nil if none.
 
-	compressionLevel func(level int) error
+	// Used to re-establish the connection, see WithReconnect.
+	redial   func(context.Context) (io.ReadWriteCloser, error)
+	newCodec func(io.ReadWriter) Codec
+
+	sessionID string // Set for session connections, see NewSession.
 
-	mu      sync.Mutex // Protects following.
-	reqSeq  uint64
-	pending map[uint64]*rpcCall
-	streams map[string]*streamClients
-	closed  bool
-	err     error // Protected by mu and closed until context is cancelled.
+	mu         sync.Mutex // Protects following.
+	reqSeq     uint64
+	pending    map[uint64]*rpcCall
+	streams    map[string]*streamClients
+	dispatcher *dispatcher // Calls handlers, see Handle.
+	sessions   map[string]*sessionCodec
+	closed     bool
+	err        error // Protected by mu and closed until context is cancelled.
+	resetErr   error // Set while reconnecting.
+
+	// Protected by mu, replaced on reconnect.
+	compressionLevel func(level int) error
+	compression      *int // Level set by SetCompressionLevel, if any.
 
 	reqMu sync.Mutex // Protects following.
 	req   Request
@@ -278,6 +319,9 @@ type Response struct {
 	// RPC notification from remote.
 	Method string          `json:"method"` // Method invokation requested by remote.
 	Args   json.RawMessage `json:"params"` // Method parameters, if any.
+
+	// Session the response or notification belongs to, if any.
+	SessionID string `json:"sessionId"`
 }
 
 func (r *Response) reset() {
@@ -286,19 +330,26 @@ func (r *Response) reset() {
 	r.Error = nil
 	r.Method = ""
 	r.Args = nil
+	r.SessionID = ""
 }
 
 func (r *Response) String() string {
+	var session string
+	if r.SessionID != "" {
+		session = fmt.Sprintf("SessionID = %s, ", r.SessionID)
+	}
 	if r.Method != "" {
-		return fmt.Sprintf("Method = %s, Params = %s", r.Method, r.Args)
+		return fmt.Sprintf("%sMethod = %s, Params = %s", session, r.Method, r.Args)
 	}
 	if r.Error != nil {
-		return fmt.Sprintf("ID = %d, Error = %s", r.ID, r.Error.Error())
+		return fmt.Sprintf("%sID = %d, Error = %s", session, r.ID, r.Error.Error())
 	}
-	return fmt.Sprintf("ID = %d, Result = %s", r.ID, r.Result)
+	return fmt.Sprintf("%sID = %d, Result = %s", session, r.ID, r.Result)
 }
 
 // ResponseError represents the RPC response error sent by the server.
+// Use errors.Is with the sentinel errors (e.g. ErrMethodNotFound) to
+// check the error code.
 type ResponseError struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
@@ -335,6 +386,26 @@ func (c *Conn) recv(notify func(string, []byte), done func(error)) {
 			return
 		}
 
+		// Route responses and notifications for session
+		// connections, if any, created via NewSession.
+		if resp.SessionID != "" && resp.SessionID != c.sessionID {
+			c.mu.Lock()
+			s := c.sessions[resp.SessionID]
+			c.mu.Unlock()
+			if s != nil {
+				s.write(resp)
+				continue
+			}
+			// Sessions have their own request ID space, a
+			// message for an unknown (e.g. closed) session must
+			// never resolve a call or reach the streams of
+			// this connection.
+			if enableDebug {
+				log.Println("rpcc: no session: " + resp.String())
+			}
+			continue
+		}
+
 		// Check if this is an RPC notification from the server.
 		if resp.Method != "" {
 			// Method represents the event that was triggered over the
@@ -372,9 +443,10 @@ func (c *Conn) recv(notify func(string, []byte), done func(error)) {
 
 // Request represents an RPC request to be sent to the server.
 type Request struct {
-	ID     uint64      `json:"id"`               // ID chosen by client.
-	Method string      `json:"method"`           // Method invoked on remote.
-	Args   interface{} `json:"params,omitempty"` // Method parameters, if any.
+	ID        uint64      `json:"id"`                  // ID chosen by client.
+	Method    string      `json:"method"`              // Method invoked on remote.
+	Args      interface{} `json:"params,omitempty"`    // Method parameters, if any.
+	SessionID string      `json:"sessionId,omitempty"` // Session the request is intended for, if any.
 }
 
 // send returns after the call has successfully been dispatched over
@@ -389,28 +461,14 @@ func (c *Conn) send(ctx context.Context, call *rpcCall) (err error) {
 		}
 	}()
 
-	c.mu.Lock()
-	if c.closed {
-		c.mu.Unlock()
-		return c.err
+	reqID, err := c.register(call)
+	if err != nil {
+		return err
 	}
-	c.reqSeq++
-	reqID := c.reqSeq
-	c.pending[reqID] = call
-	c.mu.Unlock()
 
 	done := make(chan error, 1)
 	go func() {
-		c.reqMu.Lock()
-		c.req.ID = reqID
-		c.req.Method = call.Method
-		c.req.Args = call.Args
-
-		err := c.codec.WriteRequest(&c.req)
-
-		c.req.Args = nil
-		c.reqMu.Unlock()
-		done <- err
+		done <- c.write(reqID, call)
 	}()
 
 	// Abort on user or connection cancellation.
@@ -421,43 +479,115 @@ func (c *Conn) send(ctx context.Context, call *rpcCall) (err error) {
 	}
 
 	if err != nil {
-		c.mu.Lock()
-		if c.closed {
-			// There is a chance that WriteRequest is executed in
-			// parallel with the closing of Conn. If it happens,
-			// err will be a "use of closed network connection"
-			// error, but we want to return the error that closed
-			// Conn.
-			err = c.err
-		} else {
-			// Remove reference on error, avoid
-			// unnecessary work in recv.
-			delete(c.pending, reqID)
-		}
-		c.mu.Unlock()
-		return err
+		return c.sendFailed(reqID, err)
 	}
 
 	return nil
 }
 
+// register adds call to the pending calls and returns the request ID.
+func (c *Conn) register(call *rpcCall) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return 0, c.err
+	}
+	if c.resetErr != nil {
+		return 0, c.resetErr
+	}
+	c.reqSeq++
+	c.pending[c.reqSeq] = call
+	return c.reqSeq, nil
+}
+
+// write writes the request for call onto the connection.
+func (c *Conn) write(reqID uint64, call *rpcCall) error {
+	c.reqMu.Lock()
+	defer c.reqMu.Unlock()
+
+	c.req.ID = reqID
+	c.req.Method = call.Method
+	c.req.Args = call.Args
+
+	err := c.codec.WriteRequest(&c.req)
+
+	c.req.Args = nil
+	return err
+}
+
+// sendFailed removes the pending call after the request could not be
+// sent and returns the error for the caller.
+func (c *Conn) sendFailed(reqID uint64, err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		// There is a chance that WriteRequest is executed in
+		// parallel with the closing of Conn. If it happens,
+		// err will be a "use of closed network connection"
+		// error, but we want to return the error that closed
+		// Conn.
+		return c.err
+	}
+	_, ok := c.pending[reqID]
+	if c.resetErr != nil || !ok {
+		// The connection was lost during the write (the call
+		// was failed by reconnect), the call can be retried.
+		return &resetError{err: err}
+	}
+	// Remove reference on error, avoid
+	// unnecessary work in recv.
+	delete(c.pending, reqID)
+	return err
+}
+
+// cancelCall removes the pending call for reqID, it returns false if the
+// call is no longer pending (i.e. it is done).
+func (c *Conn) cancelCall(reqID uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.pending[reqID]
+	delete(c.pending, reqID)
+	return ok
+}
+
 // notify handles RPC notifications and sends them
 // to the appropriate stream listeners.
 func (c *Conn) notify(method string, data []byte) {
+	var domain string
+	if i := strings.IndexByte(method, '.'); i != -1 {
+		domain = method[:i+1] + "*"
+	}
+
 	c.mu.Lock()
-	stream := c.streams[method]
-	if stream != nil {
-		// Stream writer must be able to handle incoming writes
-		// even after it has been removed (unsubscribed).
-		stream.write(method, data)
+	streams := [...]*streamClients{
+		c.streams[method],
+		c.streams[domain],
+		c.streams["*"],
 	}
 	c.mu.Unlock()
+
+	for _, stream := range streams {
+		if stream != nil {
+			// Stream writer must be able to handle incoming
+			// writes even after it has been removed
+			// (unsubscribed). The lock is not held because
+			// writes can block.
+			stream.write(method, data)
+		}
+	}
 }
 
 // listen registers a new stream listener (chan) for the RPC notification
-// method. Returns a function for removing the listener. Error if the
-// connection is closed.
+// method, or pattern. Returns a function for removing the listener.
+// Error if the connection is closed or the pattern is invalid.
 func (c *Conn) listen(method string, w streamWriter) (func(), error) {
+	if !validPattern(method) {
+		return nil, errors.New("rpcc: invalid method pattern: " + method)
+	}
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -467,7 +597,7 @@ func (c *Conn) listen(method string, w streamWriter) (func(), error) {
 
 	stream, ok := c.streams[method]
 	if !ok {
-		stream = newStreamClients()
+		stream = newStreamClients(method)
 		c.streams[method] = stream
 	}
 	seq := stream.add(w)
@@ -476,6 +606,17 @@ func (c *Conn) listen(method string, w streamWriter) (func(), error) {
 	return unsub, nil
 }
 
+// validPattern reports whether the method is valid for listen, a
+// wildcard ("*") is only allowed as the entire method or after the
+// domain ("Domain.*").
+func validPattern(method string) bool {
+	i := strings.IndexByte(method, '*')
+	if i == -1 {
+		return method != ""
+	}
+	return i == len(method)-1 && (i == 0 || i > 1 && method[i-1] == '.')
+}
+
 // Close closes the connection. Subsequent calls to Close will return the error
 // that closed the connection.
 func (c *Conn) close(err error) error {
@@ -523,10 +664,17 @@ func (c *Conn) close(err error) error {
 // range is [-2, 9]. Returns error if compression is not enabled for Conn. See
 // package compress/flate for a description of compression levels.
 func (c *Conn) SetCompressionLevel(level int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.compressionLevel == nil {
 		return errors.New("rpcc: compression is not enabled for Conn")
 	}
-	return c.compressionLevel(level)
+	if err := c.compressionLevel(level); err != nil {
+		return err
+	}
+	c.compression = &level
+	return nil
 }
 
 // Close closes the connection.
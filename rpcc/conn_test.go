@@ -27,7 +27,7 @@ func (ts *testServer) Close() error {
 	return ts.conn.Close()
 }
 
-func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error) *testServer {
+func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error, opts ...DialOption) *testServer {
 	// Timeouts to prevent tests from running forever.
 	timeout := 5 * time.Second
 
@@ -71,7 +71,8 @@ func newTestServer(t testing.TB, respond func(*websocket.Conn, *Request) error)
 		}
 	}))
 
-	ts.conn, err = Dial("ws"+strings.TrimPrefix(ts.srv.URL, "http"), WithCompression())
+	opts = append([]DialOption{WithCompression()}, opts...)
+	ts.conn, err = Dial("ws"+strings.TrimPrefix(ts.srv.URL, "http"), opts...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -160,6 +161,23 @@ func TestConn_InvokeRemoteDisconnected(t *testing.T) {
 	}
 }
 
+func TestConn_InvokeAfterRemoteDisconnected(t *testing.T) {
+	srv := newTestServer(t, nil)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv.wsConn.Close()
+	<-srv.conn.Context().Done()
+
+	// The close error is ErrConnClosing, not ErrStreamClosing.
+	err := Invoke(ctx, "test.Hello", nil, nil, srv.conn)
+	if !errors.Is(err, ErrConnClosing) || errors.Is(err, ErrStreamClosing) {
+		t.Errorf("Invoke error: got %v, want ErrConnClosing", err)
+	}
+}
+
 func TestConn_InvokeConnectionClosed(t *testing.T) {
 	srv := newTestServer(t, nil)
 	defer srv.Close()
@@ -459,3 +477,65 @@ func TestMain(m *testing.M) {
 	enableDebug = true
 	os.Exit(m.Run())
 }
+
+func TestResponseError_Is(t *testing.T) {
+	var err error = &ResponseError{Code: CodeMethodNotFound, Message: "'Foo.bar' wasn't found"}
+	err = fmt.Errorf("wrapped: %w", err)
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Errorf("errors.Is(%v, ErrMethodNotFound) = false, want true", err)
+	}
+	if errors.Is(err, ErrServer) {
+		t.Errorf("errors.Is(%v, ErrServer) = true, want false", err)
+	}
+}
+
+func TestGo(t *testing.T) {
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		if req.Method == "test.Hang" {
+			return nil
+		}
+		return conn.WriteJSON(&Response{ID: req.ID, Result: []byte(`"hello"`)})
+	})
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var reply string
+	call := Go(ctx, "test.Hello", nil, &reply, srv.conn)
+	select {
+	case c := <-call.Done:
+		if c != call {
+			t.Errorf("Done: got %p, want %p", c, call)
+		}
+		if c.Error != nil {
+			t.Error(c.Error)
+		}
+		if reply != "hello" {
+			t.Errorf("test.Hello: got reply %q, want %q", reply, "hello")
+		}
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+
+	hangCtx, hangCancel := context.WithCancel(ctx)
+	call = Go(hangCtx, "test.Hang", nil, nil, srv.conn)
+	hangCancel()
+	<-call.Done
+	if call.Error != context.Canceled {
+		t.Errorf("test.Hang: got %v, want %v", call.Error, context.Canceled)
+	}
+
+	call = Go(context.Background(), "test.Hang", nil, nil, srv.conn)
+	srv.conn.Close()
+	<-call.Done
+	if call.Error != ErrConnClosing {
+		t.Errorf("test.Hang: got %v, want %v", call.Error, ErrConnClosing)
+	}
+
+	call = Go(ctx, "test.Hello", nil, nil, srv.conn)
+	<-call.Done
+	if call.Error != ErrConnClosing {
+		t.Errorf("test.Hello after Close: got %v, want %v", call.Error, ErrConnClosing)
+	}
+}
@@ -34,6 +34,32 @@ over other protocols.
 	conn, err := rpcc.Dial("127.0.0.1:9999", rpcc.WithDialer(netDial))
 	// ...
 
+Interceptors can be used to wrap calls to Invoke and to inspect (or
+modify) notifications before they reach streams, e.g. for logging:
+
+	logger := func(ctx context.Context, method string, args, reply interface{}, conn *rpcc.Conn, invoker rpcc.Invoker) error {
+		start := time.Now()
+		err := invoker(ctx, method, args, reply, conn)
+		log.Printf("%s took %s, err = %v", method, time.Since(start), err)
+		return err
+	}
+	conn, err := rpcc.Dial("ws://127.0.0.1:9999/f39a3624-e972-4a77-8a5f-6f8c42ef5129",
+		rpcc.WithUnaryInterceptor(logger))
+	// ...
+
+Long-lived connections can be re-established automatically when lost,
+streams are kept and the setup function can restore state:
+
+	conn, err := rpcc.Dial("ws://127.0.0.1:9999/f39a3624-e972-4a77-8a5f-6f8c42ef5129",
+		rpcc.WithReconnect(func(ctx context.Context, conn *rpcc.Conn) error {
+			return rpcc.Invoke(ctx, "Network.enable", nil, nil, conn)
+		}))
+	// ...
+	err = rpcc.Invoke(ctx, "Domain.method", args, reply, conn)
+	if errors.Is(err, rpcc.ErrConnReset) {
+		// Retry.
+	}
+
 Communicating with the server
 
 Send a request using Invoke:
@@ -43,6 +69,36 @@ Send a request using Invoke:
 	err := rpcc.Invoke(ctx, "Domain.method", args, reply, conn)
 	// ...
 
+Go invokes a method asynchronously, the call is done when it receives
+itself on the Done channel:
+
+	call := rpcc.Go(ctx, "Page.navigate", args, &reply, conn)
+	// Receive events while the call is in-flight.
+	// ...
+	<-call.Done
+	if call.Error != nil {
+		// Handle error.
+	}
+
+Many calls can be issued concurrently with a Batch, the requests are
+written back-to-back while the number of calls in-flight is limited:
+
+	b := rpcc.NewBatch(conn, rpcc.WithBatchLimit(100))
+	replies := make([]dom.DescribeNodeReply, len(nodeIDs))
+	for i, id := range nodeIDs {
+		b.Add("DOM.describeNode", dom.NewDescribeNodeArgs().SetNodeID(id), &replies[i])
+	}
+	err := b.Do(ctx)
+	// ...
+
+Errors sent by the server are returned as a *ResponseError, the
+well-known error codes can be checked with errors.Is:
+
+	err := rpcc.Invoke(ctx, "Domain.method", args, reply, conn)
+	if errors.Is(err, rpcc.ErrMethodNotFound) {
+		// The server does not implement Domain.method.
+	}
+
 Receive a notification using NewStream:
 
 	stream, err := rpcc.NewStream(ctx, "Domain.event", conn)
@@ -63,6 +119,28 @@ memory:
 	}
 	defer stream.Close()
 
+A stream can listen to all notifications in a domain ("Domain.*") or
+to all notifications ("*"), use Message to find out the method:
+
+	stream, err := rpcc.NewStream(ctx, "Network.*", conn)
+	// ...
+	var m rpcc.Message
+	err = stream.RecvMsg(&m)
+	// ...
+	fmt.Println(m.Method, string(m.Args))
+
+By default all messages are kept until they are received. For high
+volume notifications the backlog can be limited, with a policy for
+when it is full:
+
+	stream, err := rpcc.NewStream(ctx, "Domain.event", conn,
+		rpcc.WithBacklog(100, rpcc.OverflowDropOldest))
+	// ...
+	// Dropped is not part of the Stream interface.
+	if d, ok := stream.(interface{ Dropped() uint64 }); ok {
+		fmt.Println("Dropped:", d.Dropped())
+	}
+
 When order is important, two streams can be synchronized with Sync:
 
 	err := rpcc.Sync(stream1, stream2)
@@ -70,5 +148,16 @@ When order is important, two streams can be synchronized with Sync:
 		// Handle error.
 	}
 
+For high volume notifications the fast codec reduces the cost of
+decoding. It only decodes the message envelope and pools the read
+buffers, the result and params are validated by json.Unmarshal when
+they are decoded. A *json.RawMessage can be passed to RecvMsg to receive the
+arguments without copying:
+
+	conn, err := rpcc.Dial(url, rpcc.WithCodec(rpcc.NewFastCodec))
+	// ...
+	var args json.RawMessage
+	err = stream.RecvMsg(&args)
+
 */
 package rpcc
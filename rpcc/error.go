@@ -0,0 +1,47 @@
+package rpcc
+
+import "fmt"
+
+// Well-known error codes sent by the server in a ResponseError, as
+// defined by JSON-RPC 2.0 and used by the Chrome DevTools Protocol.
+const (
+	CodeParseError     = -32700 // Invalid JSON was received.
+	CodeInvalidRequest = -32600 // The request is not a valid request object.
+	CodeMethodNotFound = -32601 // The method does not exist or is not available.
+	CodeInvalidParams  = -32602 // Invalid method parameters.
+	CodeInternalError  = -32603 // Internal error.
+	CodeServerError    = -32000 // Server error, e.g. "No node with given id found".
+)
+
+// Sentinel errors for the well-known error codes. A ResponseError
+// matches the sentinel for its code, use errors.Is to check for them:
+//
+//	err := c.Page.Navigate(ctx, args)
+//	if errors.Is(err, rpcc.ErrMethodNotFound) {
+//		// ...
+//	}
+var (
+	ErrParse          error = &codeError{code: CodeParseError, msg: "parse error"}
+	ErrInvalidRequest error = &codeError{code: CodeInvalidRequest, msg: "invalid request"}
+	ErrMethodNotFound error = &codeError{code: CodeMethodNotFound, msg: "method not found"}
+	ErrInvalidParams  error = &codeError{code: CodeInvalidParams, msg: "invalid params"}
+	ErrInternal       error = &codeError{code: CodeInternalError, msg: "internal error"}
+	ErrServer         error = &codeError{code: CodeServerError, msg: "server error"}
+)
+
+// codeError is a sentinel error for a ResponseError code.
+type codeError struct {
+	code int64
+	msg  string
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("rpcc: %s (code = %d)", e.msg, e.code)
+}
+
+// Is implements errors.Is, the ResponseError matches the sentinel
+// error for its code (e.g. ErrMethodNotFound).
+func (e *ResponseError) Is(target error) bool {
+	ce, ok := target.(*codeError)
+	return ok && ce.code == e.Code
+}
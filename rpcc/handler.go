@@ -0,0 +1,191 @@
+package rpcc
+
+import (
+	"log"
+	"sync"
+)
+
+// WithHandlerError sets fn to be called when a handler registered
+// with Handle returns an error, e.g. when an event cannot be decoded.
+// The error is otherwise only logged in debug mode. Handlers are not
+// called again for the notification. Session connections (NewSession)
+// inherit the function.
+func WithHandlerError(fn func(method string, err error)) DialOption {
+	return func(o *dialOptions) {
+		o.handlerErr = fn
+	}
+}
+
+// Handle registers fn to be called with the arguments of each
+// notification for method (or pattern, see NewStream) on conn. It
+// returns a function for
+// unsubscribing, fn is not called after unsubscribe has returned
+// (unless it is already running).
+//
+// An error returned by fn (e.g. a decoding error) is reported to the
+// function set by WithHandlerError.
+//
+// All handlers registered for conn are called in the order that the
+// notifications arrived, one at a time, on a dispatcher goroutine. A
+// slow handler delays all other handlers on conn, notifications are
+// queued meanwhile.
+func Handle(method string, conn *Conn, fn func(args []byte) error) (unsubscribe func(), err error) {
+	conn.mu.Lock()
+	if conn.closed {
+		conn.mu.Unlock()
+		return nil, conn.err
+	}
+	d := conn.dispatcher
+	if d == nil {
+		d = newDispatcher(conn)
+		conn.dispatcher = d
+		go d.run()
+	}
+	conn.mu.Unlock()
+
+	return d.add(method, fn)
+}
+
+type handler struct {
+	fn     func([]byte) error
+	active bool // Protected by dispatcher.mu.
+}
+
+// dispatcher calls handlers for notifications on a Conn, in the order
+// they arrived.
+type dispatcher struct {
+	conn  *Conn
+	ready chan struct{} // Signaled when queue is appended to.
+
+	mu       sync.Mutex // Protects following.
+	handlers map[string][]*handler
+	remove   map[string]func() // Unsubscribes method from conn.
+	queue    []message
+}
+
+func newDispatcher(conn *Conn) *dispatcher {
+	return &dispatcher{
+		conn:     conn,
+		ready:    make(chan struct{}, 1),
+		handlers: make(map[string][]*handler),
+		remove:   make(map[string]func()),
+	}
+}
+
+func (d *dispatcher) add(method string, fn func([]byte) error) (func(), error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.remove[method]; !ok {
+		remove, err := d.conn.listen(method, d)
+		if err != nil {
+			return nil, err
+		}
+		d.remove[method] = remove
+	}
+
+	h := &handler{fn: fn, active: true}
+	d.handlers[method] = append(d.handlers[method], h)
+
+	var once sync.Once
+	unsub := func() {
+		once.Do(func() {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+
+			h.active = false
+			hs := d.handlers[method]
+			for i := range hs {
+				if hs[i] == h {
+					hs = append(hs[:i:i], hs[i+1:]...)
+					break
+				}
+			}
+			if len(hs) > 0 {
+				d.handlers[method] = hs
+				return
+			}
+			delete(d.handlers, method)
+			if remove, ok := d.remove[method]; ok {
+				delete(d.remove, method)
+				remove()
+			}
+		})
+	}
+	return unsub, nil
+}
+
+// write implements streamWriter.
+func (d *dispatcher) write(m message) {
+	d.mu.Lock()
+	d.queue = append(d.queue, m)
+	d.mu.Unlock()
+
+	select {
+	case d.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next message and its handlers, ok is false when the
+// queue is empty.
+func (d *dispatcher) next() (m message, hs []*handler, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.queue) == 0 {
+		return m, nil, false
+	}
+	m = d.queue[0]
+	d.queue[0] = message{} // Remove reference from underlying array.
+	d.queue = d.queue[1:]
+	return m, d.handlers[m.pattern], true
+}
+
+func (d *dispatcher) pending() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.queue) > 0
+}
+
+func (d *dispatcher) isActive(h *handler) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return h.active
+}
+
+func (d *dispatcher) run() {
+	for {
+		m, hs, ok := d.next()
+		if !ok {
+			select {
+			case <-d.ready:
+				continue
+			case <-d.conn.ctx.Done():
+				// Deliver notifications that arrived before
+				// the connection was closed.
+				if d.pending() {
+					continue
+				}
+				return
+			}
+		}
+
+		for _, h := range hs {
+			if d.isActive(h) {
+				if err := h.fn(m.data); err != nil {
+					d.handleError(m.method, err)
+				}
+			}
+		}
+	}
+}
+
+func (d *dispatcher) handleError(method string, err error) {
+	if enableDebug {
+		log.Printf("rpcc: handler for %s: %v", method, err)
+	}
+	if fn := d.conn.dialOpts.handlerErr; fn != nil {
+		fn(method, err)
+	}
+}
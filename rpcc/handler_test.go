@@ -0,0 +1,151 @@
+package rpcc
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHandle(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	got := make(chan string, 10)
+	handle := func(method string) func() {
+		unsub, err := Handle(method, conn, func(args []byte) error {
+			got <- method + ":" + string(args)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return unsub
+	}
+	unsub1 := handle("test1")
+	unsub2 := handle("test2")
+	defer unsub2()
+
+	recv := func(n int) (order []string) {
+		for len(order) < n {
+			select {
+			case m := <-got:
+				order = append(order, m)
+			case <-time.After(time.Second):
+				t.Fatalf("timed out, got %v", order)
+			}
+		}
+		return order
+	}
+
+	for i := 0; i < 3; i++ {
+		conn.notify("test1", []byte(strconv.Itoa(i)))
+		conn.notify("test2", []byte(strconv.Itoa(i)))
+	}
+	order := recv(6)
+	want := []string{"test1:0", "test2:0", "test1:1", "test2:1", "test1:2", "test2:2"}
+	if diff := cmp.Diff(order, want); diff != "" {
+		t.Errorf("Output differs (-got +want)\n%s", diff)
+	}
+
+	unsub1()
+	unsub1() // No-op.
+	conn.notify("test1", []byte("3"))
+	conn.notify("test2", []byte("3"))
+
+	order = recv(1)
+	if diff := cmp.Diff(order, []string{"test2:3"}); diff != "" {
+		t.Errorf("Output differs (-got +want)\n%s", diff)
+	}
+}
+
+func TestHandle_Unsubscribe(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	called := 0
+	unsub, err := Handle("test", conn, func([]byte) error { called++; return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsub()
+
+	done := make(chan struct{})
+	unsub, err = Handle("done", conn, func([]byte) error { close(done); return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsub()
+
+	conn.notify("test", nil)
+	conn.notify("done", nil)
+	<-done
+
+	if called != 0 {
+		t.Errorf("handler called %d times after unsubscribe", called)
+	}
+	if len(conn.streams["test"].writers) != 0 {
+		t.Error("dispatcher still listening after last handler unsubscribed")
+	}
+}
+
+func TestHandle_AfterClose(t *testing.T) {
+	srv := newTestServer(t, nil)
+	defer srv.Close()
+
+	srv.conn.Close()
+	_, err := Handle("test", srv.conn, func([]byte) error { return nil })
+	if err != ErrConnClosing {
+		t.Errorf("Handle() after closed conn; got %v, want %v", err, ErrConnClosing)
+	}
+}
+
+func TestHandle_Error(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	type handlerErr struct {
+		method string
+		err    error
+	}
+	errC := make(chan handlerErr, 1)
+	WithHandlerError(func(method string, err error) {
+		errC <- handlerErr{method, err}
+	})(&conn.dialOpts)
+
+	errDecode := errors.New("decode failed")
+	called := make(chan string, 2)
+	unsub, err := Handle("test", conn, func(args []byte) error {
+		if string(args) == "bad" {
+			return errDecode
+		}
+		called <- string(args)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsub()
+
+	conn.notify("test", []byte("bad"))
+	conn.notify("test", []byte("good"))
+
+	select {
+	case got := <-errC:
+		if got.method != "test" || got.err != errDecode {
+			t.Errorf("handler error = %q, %v; want %q, %v", got.method, got.err, "test", errDecode)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for handler error")
+	}
+	select {
+	case args := <-called:
+		if args != "good" {
+			t.Errorf("handler called with %q, want %q", args, "good")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for handler")
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package instrument records spans and metrics for rpcc connections.
+
+An Instrumentation wraps the codec of a connection and records a Span
+for every call (method, request and response size, error code and
+latency). Events are counted per method and the backlog depth of
+watched streams is recorded. All measurements are passed on to an
+Exporter, the interface is vendor-neutral and can be implemented for
+any tracing or metrics system.
+
+	exp := instrument.NewMetricsExporter()
+	in := instrument.New(exp)
+	conn, err := rpcc.Dial(wsURL, in.DialOptions()...)
+	// ...
+	http.Handle("/metrics", exp) // Prometheus text format.
+	exp.Publish("cdp")           // Or, via expvar.
+
+The MemoryExporter keeps all measurements in memory and is intended for
+tests.
+*/
+package instrument
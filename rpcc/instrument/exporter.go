@@ -0,0 +1,257 @@
+package instrument
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemoryExporter keeps all measurements in memory, it is intended for
+// tests.
+type MemoryExporter struct {
+	mu      sync.Mutex
+	spans   []Span
+	events  map[string]int
+	backlog map[string]int
+}
+
+var _ Exporter = (*MemoryExporter)(nil)
+
+// NewMemoryExporter returns a new MemoryExporter.
+func NewMemoryExporter() *MemoryExporter {
+	return &MemoryExporter{
+		events:  make(map[string]int),
+		backlog: make(map[string]int),
+	}
+}
+
+// ExportSpan implements Exporter.
+func (e *MemoryExporter) ExportSpan(s Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, s)
+}
+
+// CountEvent implements Exporter.
+func (e *MemoryExporter) CountEvent(method string, size int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events[method]++
+}
+
+// RecordBacklog implements Exporter.
+func (e *MemoryExporter) RecordBacklog(method string, depth int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.backlog[method] = depth
+}
+
+// Spans returns all exported spans, in order.
+func (e *MemoryExporter) Spans() []Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Span(nil), e.spans...)
+}
+
+// Events returns the number of events received per method.
+func (e *MemoryExporter) Events() map[string]int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return copyMap(e.events)
+}
+
+// Backlog returns the last recorded backlog depth per method.
+func (e *MemoryExporter) Backlog() map[string]int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return copyMap(e.backlog)
+}
+
+func copyMap(m map[string]int) map[string]int {
+	c := make(map[string]int, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// callStats are the aggregated spans for a method.
+type callStats struct {
+	Count         int64   `json:"count"`
+	Errors        int64   `json:"errors"`
+	Seconds       float64 `json:"seconds"`
+	RequestBytes  int64   `json:"request_bytes"`
+	ResponseBytes int64   `json:"response_bytes"`
+}
+
+// MetricsExporter aggregates measurements per method. The metrics can
+// be served in the Prometheus text format (ServeHTTP) or published via
+// expvar (Publish).
+type MetricsExporter struct {
+	mu      sync.Mutex
+	calls   map[string]*callStats
+	codes   map[string]map[int64]int64 // Errors per method and code.
+	events  map[string]int64
+	backlog map[string]int
+}
+
+var (
+	_ Exporter     = (*MetricsExporter)(nil)
+	_ http.Handler = (*MetricsExporter)(nil)
+)
+
+// NewMetricsExporter returns a new MetricsExporter.
+func NewMetricsExporter() *MetricsExporter {
+	return &MetricsExporter{
+		calls:   make(map[string]*callStats),
+		codes:   make(map[string]map[int64]int64),
+		events:  make(map[string]int64),
+		backlog: make(map[string]int),
+	}
+}
+
+// ExportSpan implements Exporter.
+func (e *MetricsExporter) ExportSpan(s Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	st, ok := e.calls[s.Method]
+	if !ok {
+		st = new(callStats)
+		e.calls[s.Method] = st
+	}
+	st.Count++
+	st.Seconds += s.Latency.Seconds()
+	st.RequestBytes += int64(s.RequestSize)
+	st.ResponseBytes += int64(s.ResponseSize)
+	if s.Err != "" {
+		st.Errors++
+		codes, ok := e.codes[s.Method]
+		if !ok {
+			codes = make(map[int64]int64)
+			e.codes[s.Method] = codes
+		}
+		codes[s.ErrorCode]++
+	}
+}
+
+// CountEvent implements Exporter.
+func (e *MetricsExporter) CountEvent(method string, size int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events[method]++
+}
+
+// RecordBacklog implements Exporter.
+func (e *MetricsExporter) RecordBacklog(method string, depth int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.backlog[method] = depth
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format.
+func (e *MetricsExporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	e.WriteText(w)
+}
+
+// WriteText writes the metrics in the Prometheus text format to w.
+func (e *MetricsExporter) WriteText(w io.Writer) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var b strings.Builder
+	metric := func(name, typ, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+	}
+
+	methods := sortedKeys(e.calls)
+	metric("cdp_calls_total", "counter", "Number of CDP calls.")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "cdp_calls_total{method=%q} %d\n", m, e.calls[m].Count)
+	}
+	metric("cdp_call_errors_total", "counter", "Number of failed CDP calls by error code.")
+	for _, m := range methods {
+		codes := e.codes[m]
+		var keys []int64
+		for code := range codes {
+			keys = append(keys, code)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, code := range keys {
+			fmt.Fprintf(&b, "cdp_call_errors_total{method=%q,code=\"%d\"} %d\n", m, code, codes[code])
+		}
+	}
+	metric("cdp_call_duration_seconds_total", "counter", "Total latency of CDP calls.")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "cdp_call_duration_seconds_total{method=%q} %g\n", m, e.calls[m].Seconds)
+	}
+	metric("cdp_call_request_bytes_total", "counter", "Total size of CDP requests.")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "cdp_call_request_bytes_total{method=%q} %d\n", m, e.calls[m].RequestBytes)
+	}
+	metric("cdp_call_response_bytes_total", "counter", "Total size of CDP responses.")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "cdp_call_response_bytes_total{method=%q} %d\n", m, e.calls[m].ResponseBytes)
+	}
+	metric("cdp_events_total", "counter", "Number of CDP events received.")
+	for _, m := range sortedKeys(e.events) {
+		fmt.Fprintf(&b, "cdp_events_total{method=%q} %d\n", m, e.events[m])
+	}
+	metric("cdp_stream_backlog", "gauge", "Backlog depth of watched streams.")
+	for _, m := range sortedKeys(e.backlog) {
+		fmt.Fprintf(&b, "cdp_stream_backlog{method=%q} %d\n", m, e.backlog[m])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Publish publishes the metrics as an expvar variable with name. Like
+// expvar.Publish, it panics if the name is already registered.
+func (e *MetricsExporter) Publish(name string) {
+	expvar.Publish(name, expvar.Func(e.snapshot))
+}
+
+func (e *MetricsExporter) snapshot() interface{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	calls := make(map[string]callStats, len(e.calls))
+	for m, st := range e.calls {
+		calls[m] = *st
+	}
+	events := make(map[string]int64, len(e.events))
+	for m, n := range e.events {
+		events[m] = n
+	}
+	return map[string]interface{}{
+		"calls":   calls,
+		"events":  events,
+		"backlog": copyMap(e.backlog),
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*callStats:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]int64:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]int:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
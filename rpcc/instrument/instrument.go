@@ -0,0 +1,247 @@
+package instrument
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// Span represents a completed call (request and response).
+type Span struct {
+	Method       string        // Name of the span, e.g. "Page.navigate".
+	SessionID    string        // Session ID, if any.
+	Start        time.Time     // Time the request was written.
+	Latency      time.Duration // Time until the response was read.
+	RequestSize  int           // Size of the encoded request in bytes.
+	ResponseSize int           // Size of the result (or error) in bytes.
+	ErrorCode    int64         // Error code from rpcc.ResponseError, if any.
+	Err          string        // Error message, empty on success.
+}
+
+// Exporter receives the recorded measurements. The methods are called
+// synchronously, from the goroutines that read and write the
+// connection, and must not block.
+type Exporter interface {
+	// ExportSpan is called for each completed call.
+	ExportSpan(s Span)
+	// CountEvent is called for each event (notification) received,
+	// size is the size of the arguments in bytes.
+	CountEvent(method string, size int)
+	// RecordBacklog is called with the backlog depth of a watched
+	// stream (WatchStream) after each matching event has been
+	// delivered, method is the method (or pattern) of the stream.
+	RecordBacklog(method string, depth int)
+}
+
+// Instrumentation records spans and metrics for an rpcc.Conn and
+// passes them on to an Exporter.
+type Instrumentation struct {
+	exp Exporter
+
+	mu      sync.Mutex // Protects following.
+	watched []*watch
+}
+
+// watch is a stream watched by WatchStream.
+type watch struct {
+	pattern string
+	s       interface{ Len() int }
+}
+
+// match reports whether method is delivered to a stream for pattern,
+// see rpcc.NewStream.
+func (w *watch) match(method string) bool {
+	if w.pattern == "*" {
+		return true
+	}
+	if strings.HasSuffix(w.pattern, ".*") {
+		return strings.HasPrefix(method, w.pattern[:len(w.pattern)-1])
+	}
+	return w.pattern == method
+}
+
+// New returns a new Instrumentation that uses exp.
+func New(exp Exporter) *Instrumentation {
+	return &Instrumentation{exp: exp}
+}
+
+// DialOptions returns the options that enable instrumentation for a
+// connection, it uses the default codec.
+//
+//	in := instrument.New(exp)
+//	conn, err := rpcc.Dial(url, in.DialOptions()...)
+func (in *Instrumentation) DialOptions() []rpcc.DialOption {
+	return []rpcc.DialOption{
+		rpcc.WithCodec(in.Codec(nil)),
+		rpcc.WithStreamInterceptor(in.StreamInterceptor()),
+	}
+}
+
+// Codec returns a function for use with rpcc.WithCodec that records a
+// Span for every call. The codec created by newCodec is wrapped, if
+// newCodec is nil the default JSON codec is used.
+func (in *Instrumentation) Codec(newCodec func(conn io.ReadWriter) rpcc.Codec) func(conn io.ReadWriter) rpcc.Codec {
+	if newCodec == nil {
+		newCodec = func(conn io.ReadWriter) rpcc.Codec {
+			return &jsonCodec{
+				enc: json.NewEncoder(conn),
+				dec: json.NewDecoder(conn),
+			}
+		}
+	}
+	return func(conn io.ReadWriter) rpcc.Codec {
+		cw := &countWriter{ReadWriter: conn}
+		return &codec{
+			in:      in,
+			next:    newCodec(cw),
+			cw:      cw,
+			pending: make(map[callKey]*Span),
+		}
+	}
+}
+
+// StreamInterceptor returns an interceptor that counts events and
+// records the backlog of watched streams.
+func (in *Instrumentation) StreamInterceptor() rpcc.StreamInterceptor {
+	return func(method string, args []byte, notifier rpcc.Notifier) {
+		in.exp.CountEvent(method, len(args))
+		notifier(method, args)
+
+		in.mu.Lock()
+		watched := in.watched
+		in.mu.Unlock()
+		for _, w := range watched {
+			if w.match(method) {
+				in.exp.RecordBacklog(w.pattern, w.s.Len())
+			}
+		}
+	}
+}
+
+// WatchStream records the backlog depth of s each time an event that
+// matches method (or pattern, see rpcc.NewStream) is received. Streams
+// created by rpcc.NewStream implement interface{ Len() int }. Call
+// unwatch when the stream is closed.
+func (in *Instrumentation) WatchStream(method string, s interface{ Len() int }) (unwatch func()) {
+	w := &watch{pattern: method, s: s}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	// Copy, the old slice may be in use.
+	in.watched = append(in.watched[:len(in.watched):len(in.watched)], w)
+
+	return func() {
+		in.mu.Lock()
+		defer in.mu.Unlock()
+		for i := range in.watched {
+			if in.watched[i] == w {
+				in.watched = append(in.watched[:i:i], in.watched[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+type callKey struct {
+	sessionID string
+	id        uint64
+}
+
+// codec implements rpcc.Codec and records spans.
+type codec struct {
+	in   *Instrumentation
+	next rpcc.Codec
+	cw   *countWriter
+
+	mu      sync.Mutex // Protects following.
+	pending map[callKey]*Span
+}
+
+var _ rpcc.Codec = (*codec)(nil)
+
+// WriteRequest implements rpcc.Codec. Writes are serialized by
+// rpcc.Conn.
+func (c *codec) WriteRequest(r *rpcc.Request) error {
+	s := &Span{
+		Method:    r.Method,
+		SessionID: r.SessionID,
+		Start:     time.Now(),
+	}
+	c.cw.n = 0
+	err := c.next.WriteRequest(r)
+	s.RequestSize = c.cw.n
+	if err != nil {
+		s.Err = err.Error()
+		c.in.exp.ExportSpan(*s)
+		return err
+	}
+
+	c.mu.Lock()
+	c.pending[callKey{r.SessionID, r.ID}] = s
+	c.mu.Unlock()
+	return nil
+}
+
+// ReadResponse implements rpcc.Codec.
+func (c *codec) ReadResponse(r *rpcc.Response) error {
+	if err := c.next.ReadResponse(r); err != nil {
+		// The connection is done, end all pending spans.
+		c.mu.Lock()
+		pending := c.pending
+		c.pending = make(map[callKey]*Span)
+		c.mu.Unlock()
+		for _, s := range pending {
+			s.Latency = time.Since(s.Start)
+			s.Err = err.Error()
+			c.in.exp.ExportSpan(*s)
+		}
+		return err
+	}
+	if r.Method != "" {
+		return nil // Events are handled by StreamInterceptor.
+	}
+
+	key := callKey{r.SessionID, r.ID}
+	c.mu.Lock()
+	s, ok := c.pending[key]
+	delete(c.pending, key)
+	c.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	s.Latency = time.Since(s.Start)
+	s.ResponseSize = len(r.Result)
+	if r.Error != nil {
+		s.ErrorCode = r.Error.Code
+		s.Err = r.Error.Message
+		s.ResponseSize = len(r.Error.Message) + len(r.Error.Data)
+	}
+	c.in.exp.ExportSpan(*s)
+	return nil
+}
+
+// countWriter counts the bytes written.
+type countWriter struct {
+	io.ReadWriter
+	n int
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.ReadWriter.Write(p)
+	w.n += n
+	return n, err
+}
+
+// jsonCodec is the default codec, same as in rpcc.
+type jsonCodec struct {
+	enc *json.Encoder
+	dec *json.Decoder
+}
+
+func (c *jsonCodec) WriteRequest(r *rpcc.Request) error  { return c.enc.Encode(r) }
+func (c *jsonCodec) ReadResponse(r *rpcc.Response) error { return c.dec.Decode(r) }
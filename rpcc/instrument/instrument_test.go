@@ -0,0 +1,115 @@
+package instrument_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/protocol/page"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/rpcc/instrument"
+)
+
+func TestInstrumentation(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Page.navigate", cdptest.Reply(&page.NavigateReply{FrameID: "frame1"}))
+	srv.Handle("Page.reload", func(req *cdptest.Request) (interface{}, error) {
+		return nil, errors.New("reload failed")
+	})
+
+	mem := instrument.NewMemoryExporter()
+	in := instrument.New(mem)
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL, in.DialOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := cdp.NewClient(conn)
+
+	// Pattern streams are watched by their pattern.
+	nav, err := rpcc.NewStream(ctx, "Page.*", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nav.Close()
+	unwatch := in.WatchStream("Page.*", nav.(interface{ Len() int }))
+	defer unwatch()
+
+	_, err = c.Page.Navigate(ctx, page.NewNavigateArgs("https://example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Page.Reload(ctx, nil)
+	if err == nil {
+		t.Fatal("Reload: want error")
+	}
+
+	for i := 0; i < 2; i++ {
+		err = srv.Event("Page.frameNavigated", map[string]interface{}{
+			"frame": map[string]string{"id": "frame1"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err = nav.RecvMsg(new(page.FrameNavigatedReply)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	spans := mem.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("Spans: got %d, want 2", len(spans))
+	}
+	nspan := spans[0]
+	if nspan.Method != "Page.navigate" || nspan.Err != "" || nspan.RequestSize == 0 || nspan.ResponseSize == 0 {
+		t.Errorf("Spans: got %+v, want successful Page.navigate", nspan)
+	}
+	rspan := spans[1]
+	if rspan.Method != "Page.reload" || rspan.ErrorCode != cdptest.ErrorCodeServer || rspan.Err != "reload failed" {
+		t.Errorf("Spans: got %+v, want failed Page.reload", rspan)
+	}
+	if got := mem.Events()["Page.frameNavigated"]; got != 2 {
+		t.Errorf("Events: got %d, want 2", got)
+	}
+	if _, ok := mem.Backlog()["Page.*"]; !ok {
+		t.Errorf("Backlog: no depth recorded for Page.*")
+	}
+}
+
+func TestMetricsExporter(t *testing.T) {
+	exp := instrument.NewMetricsExporter()
+	exp.ExportSpan(instrument.Span{Method: "Page.navigate", Latency: time.Second, RequestSize: 10, ResponseSize: 20})
+	exp.ExportSpan(instrument.Span{Method: "Page.navigate", Latency: time.Second, RequestSize: 10, ErrorCode: -32000, Err: "failed"})
+	exp.CountEvent("Page.loadEventFired", 5)
+	exp.RecordBacklog("Page.loadEventFired", 3)
+
+	rec := httptest.NewRecorder()
+	exp.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		`cdp_calls_total{method="Page.navigate"} 2`,
+		`cdp_call_errors_total{method="Page.navigate",code="-32000"} 1`,
+		`cdp_call_duration_seconds_total{method="Page.navigate"} 2`,
+		`cdp_call_request_bytes_total{method="Page.navigate"} 20`,
+		`cdp_call_response_bytes_total{method="Page.navigate"} 20`,
+		`cdp_events_total{method="Page.loadEventFired"} 1`,
+		`cdp_stream_backlog{method="Page.loadEventFired"} 3`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("ServeHTTP: missing %q in:\n%s", want, body)
+		}
+	}
+}
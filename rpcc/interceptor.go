@@ -0,0 +1,102 @@
+package rpcc
+
+import "context"
+
+// Invoker is called by UnaryInterceptor to complete the call.
+type Invoker func(ctx context.Context, method string, args, reply interface{}, conn *Conn) error
+
+// UnaryInterceptor intercepts calls to Invoke. It is responsible for
+// calling invoker to complete the call, args and reply can be modified
+// or replaced before and after.
+type UnaryInterceptor func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error
+
+// Notifier is called by StreamInterceptor to deliver the notification
+// to streams and handlers.
+type Notifier func(method string, args []byte)
+
+// StreamInterceptor intercepts notifications before they reach streams
+// and handlers. It is responsible for calling notifier to deliver the
+// notification, args can be modified and the notification is dropped
+// if notifier is not called.
+//
+// A StreamInterceptor is called from the goroutine that reads from the
+// connection, blocking delays all responses and notifications.
+type StreamInterceptor func(method string, args []byte, notifier Notifier)
+
+// WithUnaryInterceptor returns a DialOption that adds interceptors for
+// calls to Invoke. The first interceptor is the outermost, i.e. it is
+// called first. Session connections (NewSession) inherit the
+// interceptors.
+func WithUnaryInterceptor(interceptors ...UnaryInterceptor) DialOption {
+	return func(o *dialOptions) {
+		o.unary = append(o.unary, interceptors...)
+	}
+}
+
+// WithStreamInterceptor returns a DialOption that adds interceptors for
+// notifications. The first interceptor is the outermost, i.e. it is
+// called first. Session connections (NewSession) inherit the
+// interceptors.
+func WithStreamInterceptor(interceptors ...StreamInterceptor) DialOption {
+	return func(o *dialOptions) {
+		o.stream = append(o.stream, interceptors...)
+	}
+}
+
+// chainUnaryInterceptors returns a single interceptor that calls the
+// interceptors in order, nil if there are none.
+func chainUnaryInterceptors(ints []UnaryInterceptor) UnaryInterceptor {
+	switch len(ints) {
+	case 0:
+		return nil
+	case 1:
+		return ints[0]
+	}
+	return func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error {
+		return ints[0](ctx, method, args, reply, conn, chainedInvoker(ints[1:], invoker))
+	}
+}
+
+func chainedInvoker(ints []UnaryInterceptor, final Invoker) Invoker {
+	if len(ints) == 0 {
+		return final
+	}
+	return func(ctx context.Context, method string, args, reply interface{}, conn *Conn) error {
+		return ints[0](ctx, method, args, reply, conn, chainedInvoker(ints[1:], final))
+	}
+}
+
+// chainStreamInterceptors returns a single interceptor that calls the
+// interceptors in order, nil if there are none.
+func chainStreamInterceptors(ints []StreamInterceptor) StreamInterceptor {
+	switch len(ints) {
+	case 0:
+		return nil
+	case 1:
+		return ints[0]
+	}
+	return func(method string, args []byte, notifier Notifier) {
+		ints[0](method, args, chainedNotifier(ints[1:], notifier))
+	}
+}
+
+func chainedNotifier(ints []StreamInterceptor, final Notifier) Notifier {
+	if len(ints) == 0 {
+		return final
+	}
+	return func(method string, args []byte) {
+		ints[0](method, args, chainedNotifier(ints[1:], final))
+	}
+}
+
+// notifier returns the function used by recv for notifications, it
+// passes through the stream interceptors.
+func (c *Conn) notifier() func(method string, args []byte) {
+	intercept := chainStreamInterceptors(c.dialOpts.stream)
+	if intercept == nil {
+		return c.notify
+	}
+	return func(method string, args []byte) {
+		intercept(method, args, c.notify)
+	}
+}
@@ -0,0 +1,136 @@
+package rpcc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
+)
+
+func TestWithUnaryInterceptor(t *testing.T) {
+	var order []string
+	logger := func(name string) UnaryInterceptor {
+		return func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error {
+			order = append(order, name+" before "+method)
+			err := invoker(ctx, method, args, reply, conn)
+			order = append(order, name+" after "+method)
+			return err
+		}
+	}
+	errFault := errors.New("injected fault")
+	fault := func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error {
+		if method == "test.Fault" {
+			return errFault
+		}
+		// Redact params.
+		return invoker(ctx, method, "redacted", reply, conn)
+	}
+
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		return conn.WriteJSON(&Response{
+			ID:     req.ID,
+			Result: []byte(fmt.Sprintf("%q", req.Args)),
+		})
+	}, WithUnaryInterceptor(logger("first"), logger("second")), WithUnaryInterceptor(fault))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var reply string
+	if err := Invoke(ctx, "test.Hello", "secret", &reply, srv.conn); err != nil {
+		t.Fatal(err)
+	}
+	if reply != "redacted" {
+		t.Errorf("Invoke: got reply %q, want %q", reply, "redacted")
+	}
+	if err := Invoke(ctx, "test.Fault", nil, nil, srv.conn); err != errFault {
+		t.Errorf("Invoke: got %v, want %v", err, errFault)
+	}
+
+	want := []string{
+		"first before test.Hello", "second before test.Hello",
+		"second after test.Hello", "first after test.Hello",
+		"first before test.Fault", "second before test.Fault",
+		"second after test.Fault", "first after test.Fault",
+	}
+	if diff := cmp.Diff(order, want); diff != "" {
+		t.Errorf("Output differs (-got +want)\n%s", diff)
+	}
+}
+
+func TestWithStreamInterceptor(t *testing.T) {
+	drop := func(method string, args []byte, notifier Notifier) {
+		if method == "test.Drop" {
+			return
+		}
+		notifier(method, args)
+	}
+	modify := func(method string, args []byte, notifier Notifier) {
+		notifier(method, []byte(`"modified"`))
+	}
+
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		for _, method := range []string{"test.Drop", "test.Event"} {
+			err := conn.WriteJSON(&Response{Method: method, Args: []byte(`"original"`)})
+			if err != nil {
+				return err
+			}
+		}
+		return conn.WriteJSON(&Response{ID: req.ID})
+	}, WithStreamInterceptor(drop, modify))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s, err := NewStream(ctx, "*", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err = Invoke(ctx, "test.Notify", nil, nil, srv.conn); err != nil {
+		t.Fatal(err)
+	}
+
+	var m Message
+	if err = s.RecvMsg(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Method != "test.Event" || string(m.Args) != `"modified"` {
+		t.Errorf("RecvMsg: got %s %s, want test.Event \"modified\"", m.Method, m.Args)
+	}
+}
+
+func TestNewSession_InheritsInterceptors(t *testing.T) {
+	var methods []string
+	unary := func(ctx context.Context, method string, args, reply interface{}, conn *Conn, invoker Invoker) error {
+		methods = append(methods, method)
+		return invoker(ctx, method, args, reply, conn)
+	}
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		return conn.WriteJSON(&Response{ID: req.ID, SessionID: req.SessionID})
+	}, WithUnaryInterceptor(unary))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sc, err := NewSession("session1", srv.conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+
+	if err = Invoke(ctx, "test.Session", nil, nil, sc); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(methods, []string{"test.Session"}); diff != "" {
+		t.Errorf("Output differs (-got +want)\n%s", diff)
+	}
+}
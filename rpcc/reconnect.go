@@ -0,0 +1,166 @@
+package rpcc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrConnReset indicates that the connection was lost and is being
+// re-established (WithReconnect). The call did not complete, it is safe
+// to retry once the connection has been re-established. Use errors.Is
+// to check for ErrConnReset.
+var ErrConnReset = errors.New("rpcc: the connection was reset")
+
+// resetError wraps the error that caused the connection reset.
+type resetError struct{ err error }
+
+func (e *resetError) Error() string {
+	if e.err != nil {
+		return ErrConnReset.Error() + ": " + e.err.Error()
+	}
+	return ErrConnReset.Error()
+}
+func (e *resetError) Is(target error) bool { return target == ErrConnReset }
+func (e *resetError) Unwrap() error        { return e.err }
+func (e *resetError) Temporary() bool      { return true }
+
+const (
+	defaultReconnectMinDelay = 100 * time.Millisecond
+	defaultReconnectMaxDelay = 10 * time.Second
+)
+
+type reconnectOptions struct {
+	setup    func(ctx context.Context, conn *Conn) error
+	minDelay time.Duration
+	maxDelay time.Duration
+	attempts int // Unlimited when zero.
+	enabled  bool
+}
+
+// WithReconnect returns a DialOption that enables automatic reconnect.
+// When the connection is lost it is re-established (redialed) with
+// exponential backoff, see WithReconnectBackoff.
+//
+// Calls that are in-flight when the connection is lost, or made before
+// it has been re-established, fail with ErrConnReset. Streams and
+// handlers are kept, they continue to receive notifications after the
+// reconnect. Session connections (NewSession) are closed, the sessions
+// do not survive a reconnect.
+//
+// The setup function (if not nil) is called after each reconnect, it
+// can be used to re-run Enable calls and other setup that is lost with
+// the connection. If setup returns an error the connection is closed.
+func WithReconnect(setup func(ctx context.Context, conn *Conn) error) DialOption {
+	return func(o *dialOptions) {
+		o.reconnect.enabled = true
+		o.reconnect.setup = setup
+	}
+}
+
+// WithReconnectBackoff returns a DialOption that sets the delay before
+// reconnect attempts (WithReconnect). The delay starts at min and is
+// doubled after each failed attempt, up to max. The connection is
+// closed after attempts failed attempts, zero means unlimited.
+//
+// The defaults are 100ms, 10s and unlimited attempts.
+func WithReconnectBackoff(min, max time.Duration, attempts int) DialOption {
+	return func(o *dialOptions) {
+		o.reconnect.minDelay = min
+		o.reconnect.maxDelay = max
+		o.reconnect.attempts = attempts
+	}
+}
+
+// reconnect re-establishes the connection after it was lost due to
+// cause. It returns true if the connection was re-established, in which
+// case recv should be restarted.
+func (c *Conn) reconnect(cause error) bool {
+	o := c.dialOpts.reconnect
+	if !o.enabled || c.redial == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return false
+	}
+	c.resetErr = &resetError{err: cause}
+	for id, call := range c.pending {
+		delete(c.pending, id)
+		call.done(c.resetErr)
+	}
+	// Session IDs are not valid on the new connection.
+	sessions := c.sessions
+	c.sessions = nil
+	old := c.conn
+	c.mu.Unlock()
+
+	for _, s := range sessions {
+		close(s.done)
+	}
+	old.Close()
+
+	if o.minDelay <= 0 {
+		o.minDelay = defaultReconnectMinDelay
+	}
+	if o.maxDelay <= 0 {
+		o.maxDelay = defaultReconnectMaxDelay
+	}
+
+	delay := o.minDelay
+	for attempt := 1; o.attempts == 0 || attempt <= o.attempts; attempt++ {
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-c.ctx.Done():
+			t.Stop()
+			return false
+		}
+
+		conn, err := c.redial(c.ctx)
+		if err != nil {
+			delay *= 2
+			if delay > o.maxDelay {
+				delay = o.maxDelay
+			}
+			continue
+		}
+
+		if !c.swap(conn) {
+			conn.Close()
+			return false
+		}
+
+		if o.setup != nil {
+			// Run setup concurrently, recv must be restarted
+			// for the calls made by setup to complete.
+			go func() {
+				if err := o.setup(c.ctx, c); err != nil {
+					c.close(err)
+				}
+			}()
+		}
+		return true
+	}
+	return false
+}
+
+// swap replaces the underlying connection and codec, returns false if
+// Conn was closed meanwhile.
+func (c *Conn) swap(conn io.ReadWriteCloser) bool {
+	c.reqMu.Lock()
+	defer c.reqMu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return false
+	}
+	c.conn = conn
+	c.codec = c.newCodec(conn)
+	c.resetErr = nil
+	return true
+}
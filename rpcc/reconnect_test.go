@@ -0,0 +1,208 @@
+package rpcc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWithReconnect(t *testing.T) {
+	var mu sync.Mutex
+	var conns []*websocket.Conn
+	upgrader := &websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		mu.Lock()
+		conns = append(conns, conn)
+		mu.Unlock()
+
+		for {
+			var req Request
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			switch req.Method {
+			case "test.Drop":
+				// Drop the connection without responding.
+				return
+			case "test.Notify":
+				err = conn.WriteJSON(&Response{Method: "test.Event", Args: []byte(`"event"`)})
+				if err != nil {
+					return
+				}
+			}
+			if err = conn.WriteJSON(&Response{ID: req.ID, Result: []byte(`"ok"`)}); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	setupDone := make(chan struct{}, 1)
+	setup := func(ctx context.Context, conn *Conn) error {
+		err := Invoke(ctx, "test.Enable", nil, nil, conn)
+		setupDone <- struct{}{}
+		return err
+	}
+
+	conn, err := DialContext(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"),
+		WithReconnect(setup),
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	s, err := NewStream(ctx, "test.Event", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	err = Invoke(ctx, "test.Drop", nil, nil, conn)
+	if !errors.Is(err, ErrConnReset) {
+		t.Fatalf("Invoke: got %v, want %v", err, ErrConnReset)
+	}
+
+	select {
+	case <-setupDone:
+	case <-ctx.Done():
+		t.Fatal("setup was not called after reconnect")
+	}
+
+	mu.Lock()
+	n := len(conns)
+	mu.Unlock()
+	if n != 2 {
+		t.Errorf("got %d connections, want 2", n)
+	}
+
+	// The stream survives the reconnect.
+	if err = Invoke(ctx, "test.Notify", nil, nil, conn); err != nil {
+		t.Fatal(err)
+	}
+	var ev string
+	if err = s.RecvMsg(&ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev != "event" {
+		t.Errorf("RecvMsg: got %q, want %q", ev, "event")
+	}
+}
+
+func TestWithReconnect_Attempts(t *testing.T) {
+	upgrader := &websocket.Upgrader{}
+	var once sync.Once
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served := false
+		once.Do(func() { served = true })
+		if !served {
+			http.Error(w, "gone", http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		// Drop the connection immediately.
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := DialContext(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"),
+		WithReconnect(nil),
+		WithReconnectBackoff(time.Millisecond, time.Millisecond, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	select {
+	case <-conn.Context().Done():
+	case <-ctx.Done():
+		t.Fatal("connection was not closed after failed reconnect attempts")
+	}
+}
+
+// resetTestConn blocks writes until the connection is closed.
+type resetTestConn struct {
+	writing chan struct{}
+	fail    chan struct{} // Closed to fail reads.
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func (c *resetTestConn) Read(p []byte) (int, error) {
+	<-c.fail
+	return 0, io.ErrUnexpectedEOF
+}
+
+func (c *resetTestConn) Write(p []byte) (int, error) {
+	c.writing <- struct{}{}
+	<-c.closed
+	return 0, errors.New("write: broken pipe")
+}
+
+func (c *resetTestConn) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+func TestWithReconnect_WriteError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tc := &resetTestConn{
+		writing: make(chan struct{}, 1),
+		fail:    make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	dial := func(ctx context.Context, _ string) (io.ReadWriteCloser, error) {
+		return tc, nil
+	}
+	conn, err := DialContext(ctx, "", WithDialer(dial),
+		WithReconnect(nil),
+		WithReconnectBackoff(time.Hour, time.Hour, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- Invoke(ctx, "test.Write", nil, nil, conn)
+	}()
+
+	<-tc.writing
+	close(tc.fail) // Connection is lost while writing.
+
+	select {
+	case err = <-errC:
+		if !errors.Is(err, ErrConnReset) {
+			t.Errorf("Invoke: got %v, want %v", err, ErrConnReset)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for Invoke")
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Package replay records rpcc traffic and replays it, allowing tests that
+were recorded against a browser once to run without one.
+
+A Recorder wraps the codec of a connection and writes every request,
+response and notification as a line of JSON (JSONL):
+
+	f, err := os.Create("testdata/session.jsonl")
+	// ...
+	rec := replay.NewRecorder(f)
+	conn, err := rpcc.Dial(wsURL, rpcc.WithCodec(rec.Codec(nil)))
+	// ...
+	c := cdp.NewClient(conn)
+
+The recording can then be replayed with Dial, the returned connection
+answers requests with the recorded responses and emits the recorded
+events:
+
+	f, err := os.Open("testdata/session.jsonl")
+	// ...
+	conn, err := replay.Dial(ctx, f)
+	// ...
+	c := cdp.NewClient(conn)
+
+Requests are matched by method, session ID and params, the request IDs
+do not need to match. For a successful replay the client should issue
+the same requests as during recording, in roughly the same order.
+*/
+package replay
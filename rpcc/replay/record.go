@@ -0,0 +1,145 @@
+package replay
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// EntryType represents the type of a recorded Entry.
+type EntryType string
+
+// EntryType enums.
+const (
+	Request  EntryType = "request"  // Request sent by the client.
+	Response EntryType = "response" // Response to a Request.
+	Event    EntryType = "event"    // Notification sent by the server.
+)
+
+// Entry represents a recorded message, it is stored as one line of
+// JSON.
+type Entry struct {
+	Time      time.Time           `json:"time"`
+	Type      EntryType           `json:"type"`
+	ID        uint64              `json:"id,omitempty"`
+	SessionID string              `json:"sessionId,omitempty"`
+	Method    string              `json:"method,omitempty"`
+	Params    json.RawMessage     `json:"params,omitempty"`
+	Result    json.RawMessage     `json:"result,omitempty"`
+	Error     *rpcc.ResponseError `json:"error,omitempty"`
+}
+
+// Recorder records all requests, responses and notifications on a
+// connection as JSON lines (JSONL).
+type Recorder struct {
+	mu  sync.Mutex // Protects following.
+	enc *json.Encoder
+	err error
+}
+
+// NewRecorder returns a new Recorder that writes entries to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Codec returns a function for use with rpcc.WithCodec. The codec
+// created by newCodec is wrapped so that all messages are recorded, if
+// newCodec is nil the default JSON codec is used.
+//
+//	rec := replay.NewRecorder(f)
+//	conn, err := rpcc.Dial(url, rpcc.WithCodec(rec.Codec(nil)))
+func (r *Recorder) Codec(newCodec func(conn io.ReadWriter) rpcc.Codec) func(conn io.ReadWriter) rpcc.Codec {
+	if newCodec == nil {
+		newCodec = func(conn io.ReadWriter) rpcc.Codec {
+			return &jsonCodec{
+				enc: json.NewEncoder(conn),
+				dec: json.NewDecoder(conn),
+			}
+		}
+	}
+	return func(conn io.ReadWriter) rpcc.Codec {
+		return &recordCodec{rec: r, next: newCodec(conn)}
+	}
+}
+
+// Err returns the first error encountered while recording, if any.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) record(e *Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return
+	}
+	e.Time = time.Now()
+	r.err = r.enc.Encode(e)
+}
+
+// recordCodec implements rpcc.Codec and records all messages.
+type recordCodec struct {
+	rec  *Recorder
+	next rpcc.Codec
+}
+
+var _ rpcc.Codec = (*recordCodec)(nil)
+
+func (c *recordCodec) WriteRequest(req *rpcc.Request) error {
+	if err := c.next.WriteRequest(req); err != nil {
+		return err
+	}
+
+	e := &Entry{
+		Type:      Request,
+		ID:        req.ID,
+		SessionID: req.SessionID,
+		Method:    req.Method,
+	}
+	if req.Args != nil {
+		params, err := json.Marshal(req.Args)
+		if err != nil {
+			return err
+		}
+		e.Params = params
+	}
+	c.rec.record(e)
+
+	return nil
+}
+
+func (c *recordCodec) ReadResponse(resp *rpcc.Response) error {
+	if err := c.next.ReadResponse(resp); err != nil {
+		return err
+	}
+
+	e := &Entry{SessionID: resp.SessionID}
+	if resp.Method != "" {
+		e.Type = Event
+		e.Method = resp.Method
+		e.Params = resp.Args
+	} else {
+		e.Type = Response
+		e.ID = resp.ID
+		e.Result = resp.Result
+		e.Error = resp.Error
+	}
+	c.rec.record(e)
+
+	return nil
+}
+
+// jsonCodec is the default codec, same as in rpcc.
+type jsonCodec struct {
+	enc *json.Encoder
+	dec *json.Decoder
+}
+
+func (c *jsonCodec) WriteRequest(r *rpcc.Request) error  { return c.enc.Encode(r) }
+func (c *jsonCodec) ReadResponse(r *rpcc.Response) error { return c.dec.Decode(r) }
@@ -0,0 +1,291 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// ErrorCodeNotRecorded is the error code used in responses to requests
+// that have no matching request in the recording.
+const ErrorCodeNotRecorded = -32601
+
+// Load reads all entries written by a Recorder from r.
+func Load(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 64<<20) // Responses (e.g. screenshots) can be large.
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("replay: line %d: %v", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Dial returns a connection that replays the recording read from r.
+//
+// A request is answered with the recorded response of the first
+// unanswered recorded request with the same method, session ID and
+// params (compared as JSON values). Requests without a match are
+// answered with an error (ErrorCodeNotRecorded). Recorded events are
+// emitted in order, an event is held back until all the requests
+// recorded before it have been sent.
+//
+// The options are applied before the replay codec and dialer, they can
+// be used for options that do not concern the underlying connection.
+func Dial(ctx context.Context, r io.Reader, opts ...rpcc.DialOption) (*rpcc.Conn, error) {
+	entries, err := Load(r)
+	if err != nil {
+		return nil, err
+	}
+	return DialEntries(ctx, entries, opts...)
+}
+
+// DialEntries is like Dial, with already loaded entries.
+func DialEntries(ctx context.Context, entries []Entry, opts ...rpcc.DialOption) (*rpcc.Conn, error) {
+	p, err := newPlayer(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append(opts,
+		rpcc.WithDialer(func(context.Context, string) (io.ReadWriteCloser, error) {
+			return p, nil
+		}),
+		rpcc.WithCodec(func(io.ReadWriter) rpcc.Codec {
+			return p
+		}),
+	)
+	return rpcc.DialContext(ctx, "replay", opts...)
+}
+
+// player replays the recorded entries, it implements both rpcc.Codec
+// and io.ReadWriteCloser (for the underlying connection).
+type player struct {
+	entries []Entry
+	params  []string // Canonical params for request entries.
+	answer  []int    // Index of the response for request entries, -1 if missing.
+
+	mu       sync.Mutex // Protects following.
+	answered []bool
+	next     int // Index of the first unanswered request.
+	pos      int // Index of the next entry to consider for events.
+	queue    []*rpcc.Response
+
+	ready     chan struct{} // Signaled when the queue is appended to.
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var (
+	_ rpcc.Codec         = (*player)(nil)
+	_ io.ReadWriteCloser = (*player)(nil)
+)
+
+func newPlayer(entries []Entry) (*player, error) {
+	p := &player{
+		entries:  entries,
+		params:   make([]string, len(entries)),
+		answer:   make([]int, len(entries)),
+		answered: make([]bool, len(entries)),
+		ready:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	// Pair requests with responses by ID and session. The response is
+	// usually recorded after its request, but the recorder can read
+	// it before the request has been recorded.
+	claimed := make([]bool, len(entries))
+	isAnswer := func(i, j int) bool {
+		r := entries[j]
+		return !claimed[j] && r.Type == Response && r.ID == entries[i].ID && r.SessionID == entries[i].SessionID
+	}
+	for i, e := range entries {
+		p.answer[i] = -1
+		if e.Type != Request {
+			continue
+		}
+		params, err := canonical(e.Params)
+		if err != nil {
+			return nil, fmt.Errorf("replay: entry %d: %s: %v", i, e.Method, err)
+		}
+		p.params[i] = params
+		for j := i + 1; j < len(entries); j++ {
+			if isAnswer(i, j) {
+				p.answer[i], claimed[j] = j, true
+				break
+			}
+		}
+	}
+	for i, e := range entries {
+		if e.Type != Request || p.answer[i] != -1 {
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if isAnswer(i, j) {
+				p.answer[i], claimed[j] = j, true
+				break
+			}
+		}
+	}
+
+	p.mu.Lock()
+	p.advance()
+	p.release(len(entries))
+	p.mu.Unlock()
+
+	return p, nil
+}
+
+// canonical returns the JSON value in a form that can be compared.
+func canonical(data json.RawMessage) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// WriteRequest implements rpcc.Codec.
+func (p *player) WriteRequest(req *rpcc.Request) error {
+	var params string
+	if req.Args != nil {
+		b, err := json.Marshal(req.Args)
+		if err != nil {
+			return err
+		}
+		if params, err = canonical(b); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := p.next; i < len(p.entries); i++ {
+		e := p.entries[i]
+		if e.Type != Request || p.answered[i] ||
+			e.Method != req.Method || e.SessionID != req.SessionID || p.params[i] != params {
+			continue
+		}
+
+		p.answered[i] = true
+		p.advance()
+
+		j := p.answer[i]
+		if j == -1 {
+			// The response was never recorded.
+			p.release(len(p.entries))
+			return nil
+		}
+
+		// Emit the events that were received before the response.
+		p.release(j)
+		r := p.entries[j]
+		p.push(&rpcc.Response{
+			ID:        req.ID,
+			SessionID: r.SessionID,
+			Result:    r.Result,
+			Error:     r.Error,
+		})
+		p.release(len(p.entries))
+		return nil
+	}
+
+	p.push(&rpcc.Response{
+		ID:        req.ID,
+		SessionID: req.SessionID,
+		Error: &rpcc.ResponseError{
+			Code:    ErrorCodeNotRecorded,
+			Message: "replay: no recorded request matches",
+			Data:    req.Method,
+		},
+	})
+	return nil
+}
+
+// advance moves next to the first unanswered request.
+func (p *player) advance() {
+	for p.next < len(p.entries) && (p.entries[p.next].Type != Request || p.answered[p.next]) {
+		p.next++
+	}
+}
+
+// release queues the events recorded before index limit and before
+// the first unanswered request.
+func (p *player) release(limit int) {
+	for p.pos < limit && p.pos < p.next {
+		e := p.entries[p.pos]
+		if e.Type == Event {
+			p.push(&rpcc.Response{
+				SessionID: e.SessionID,
+				Method:    e.Method,
+				Args:      e.Params,
+			})
+		}
+		p.pos++
+	}
+}
+
+func (p *player) push(resp *rpcc.Response) {
+	p.queue = append(p.queue, resp)
+	select {
+	case p.ready <- struct{}{}:
+	default:
+	}
+}
+
+// ReadResponse implements rpcc.Codec.
+func (p *player) ReadResponse(resp *rpcc.Response) error {
+	for {
+		p.mu.Lock()
+		if len(p.queue) > 0 {
+			*resp = *p.queue[0]
+			p.queue[0] = nil
+			p.queue = p.queue[1:]
+			p.mu.Unlock()
+			return nil
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-p.ready:
+		case <-p.done:
+			return io.EOF
+		}
+	}
+}
+
+// Read is not used, reading is handled by ReadResponse.
+func (p *player) Read(b []byte) (int, error) { return 0, io.EOF }
+
+// Write is not used, writing is handled by WriteRequest.
+func (p *player) Write(b []byte) (int, error) { return 0, io.ErrClosedPipe }
+
+// Close stops the replay.
+func (p *player) Close() error {
+	p.closeOnce.Do(func() { close(p.done) })
+	return nil
+}
@@ -0,0 +1,224 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/mafredri/cdp/rpcc"
+)
+
+type testArgs struct {
+	A int    `json:"a"`
+	B string `json:"b"`
+}
+
+// newTestServer returns a server that echoes params as result and sends
+// a test.Event before responding to test.Notify.
+func newTestServer(t *testing.T) *httptest.Server {
+	upgrader := &websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			var req struct {
+				ID     uint64          `json:"id"`
+				Method string          `json:"method"`
+				Params json.RawMessage `json:"params"`
+			}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			if req.Method == "test.Notify" {
+				err = conn.WriteJSON(&rpcc.Response{Method: "test.Event", Args: req.Params})
+				if err != nil {
+					return
+				}
+			}
+			result := req.Params
+			if result == nil {
+				result = []byte("{}")
+			}
+			if err = conn.WriteJSON(&rpcc.Response{ID: req.ID, Result: result}); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+// session runs the same calls against conn, both when recording and
+// replaying.
+func session(ctx context.Context, t *testing.T, conn *rpcc.Conn) {
+	t.Helper()
+
+	var reply testArgs
+	err := rpcc.Invoke(ctx, "test.Echo", &testArgs{A: 1, B: "one"}, &reply, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (testArgs{A: 1, B: "one"}); reply != want {
+		t.Errorf("Invoke: got %v, want %v", reply, want)
+	}
+
+	s, err := rpcc.NewStream(ctx, "test.Event", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	for _, n := range []int{2, 3} {
+		err = rpcc.Invoke(ctx, "test.Notify", &testArgs{A: n}, nil, conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, n := range []int{2, 3} {
+		var ev testArgs
+		if err = s.RecvMsg(&ev); err != nil {
+			t.Fatal(err)
+		}
+		if ev.A != n {
+			t.Errorf("RecvMsg: got %d, want %d", ev.A, n)
+		}
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var buf bytes.Buffer
+	rec := NewRecorder(&buf)
+	conn, err := rpcc.DialContext(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"), rpcc.WithCodec(rec.Codec(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	session(ctx, t, conn)
+	conn.Close()
+
+	if err = rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	recording := buf.String()
+	entries, err := Load(strings.NewReader(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []EntryType
+	for _, e := range entries {
+		if e.Time.IsZero() {
+			t.Errorf("entry %v has no time", e)
+		}
+		types = append(types, e.Type)
+	}
+	want := []EntryType{Request, Response, Request, Event, Response, Request, Event, Response}
+	if len(types) != len(want) {
+		t.Fatalf("recorded entries: got %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("recorded entries: got %v, want %v", types, want)
+		}
+	}
+
+	// The server is no longer needed.
+	srv.Close()
+
+	conn, err = Dial(ctx, strings.NewReader(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	session(ctx, t, conn)
+
+	// A request that was never recorded.
+	err = rpcc.Invoke(ctx, "test.Echo", &testArgs{A: 2}, nil, conn)
+	if rerr, ok := err.(*rpcc.ResponseError); !ok || rerr.Code != ErrorCodeNotRecorded {
+		t.Errorf("Invoke: got %v, want ResponseError with code %d", err, ErrorCodeNotRecorded)
+	}
+}
+
+func TestReplay_ParamsOrder(t *testing.T) {
+	recording := `{"type":"request","id":1,"method":"test.Echo","params":{"b":"x","a":1}}
+{"type":"event","method":"test.Event","params":{"a":1}}
+{"type":"response","id":1,"result":{"a":1,"b":"x"}}
+`
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := Dial(ctx, strings.NewReader(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	s, err := rpcc.NewStream(ctx, "test.Event", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// The event is held back until the request has been sent.
+	select {
+	case <-s.Ready():
+		t.Error("event emitted before request")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	var reply testArgs
+	err = rpcc.Invoke(ctx, "test.Echo", &testArgs{A: 1, B: "x"}, &reply, conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (testArgs{A: 1, B: "x"}); reply != want {
+		t.Errorf("Invoke: got %v, want %v", reply, want)
+	}
+	var ev testArgs
+	if err = s.RecvMsg(&ev); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplay_ResponseBeforeRequest(t *testing.T) {
+	// The response was read (and recorded) before the request.
+	recording := `{"type":"response","id":1,"result":{"a":1,"b":"x"}}
+{"type":"request","id":1,"method":"test.Echo","params":{"a":1,"b":"x"}}
+{"type":"request","id":2,"method":"test.Echo","params":{"a":2,"b":"y"}}
+{"type":"response","id":2,"result":{"a":2,"b":"y"}}
+`
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := Dial(ctx, strings.NewReader(recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, want := range []testArgs{{A: 1, B: "x"}, {A: 2, B: "y"}} {
+		var reply testArgs
+		err = rpcc.Invoke(ctx, "test.Echo", &want, &reply, conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reply != want {
+			t.Errorf("Invoke: got %v, want %v", reply, want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package rpcc
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	errSessionDone = errors.New("rpcc: session is done")
+	errSessionNoIO = errors.New("rpcc: session connection does not support read or write")
+)
+
+// NewSession creates a session connection for sessionID that is
+// multiplexed over conn, also known as flat session mode. Requests
+// sent over the session connection are tagged with sessionID and
+// written onto conn. Responses and notifications tagged with sessionID
+// are routed to the session connection instead of conn.
+//
+// Closing the session connection stops the routing of messages and
+// calls onClose (if not nil), conn is left open. The session connection
+// is closed when conn is closed.
+func NewSession(sessionID string, conn *Conn, onClose func() error) (*Conn, error) {
+	if sessionID == "" {
+		return nil, errors.New("rpcc: NewSession: sessionID must not be empty")
+	}
+
+	s := &sessionCodec{
+		id:     sessionID,
+		parent: conn,
+		recvC:  make(chan *Response),
+		done:   make(chan struct{}),
+	}
+
+	conn.mu.Lock()
+	if conn.closed {
+		conn.mu.Unlock()
+		return nil, conn.err
+	}
+	if _, ok := conn.sessions[sessionID]; ok {
+		conn.mu.Unlock()
+		return nil, errors.New("rpcc: NewSession: session already exists: " + sessionID)
+	}
+	if conn.sessions == nil {
+		conn.sessions = make(map[string]*sessionCodec)
+	}
+	conn.sessions[sessionID] = s
+	conn.mu.Unlock()
+
+	c := &Conn{
+		pending: make(map[uint64]*rpcCall),
+		streams: make(map[string]*streamClients),
+		codec:   s,
+		dialOpts: dialOptions{
+			unary:      conn.dialOpts.unary,
+			stream:     conn.dialOpts.stream,
+			handlerErr: conn.dialOpts.handlerErr,
+		},
+		unary:     conn.unary,
+		sessionID: sessionID,
+		conn: &sessionCloser{close: func() error {
+			conn.removeSession(s)
+			if onClose != nil {
+				return onClose()
+			}
+			return nil
+		}},
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+
+	recvDone := func(err error) {
+		if err == errSessionDone {
+			err = nil
+		}
+		c.close(err)
+	}
+	go c.recv(c.notifier(), recvDone)
+
+	return c, nil
+}
+
+// removeSession stops routing of messages to the session.
+func (c *Conn) removeSession(s *sessionCodec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sessions[s.id] == s {
+		delete(c.sessions, s.id)
+		close(s.done)
+	}
+}
+
+// sessionCodec implements Codec for session connections. Requests are
+// written onto the parent connection and responses are routed from the
+// parent connection.
+type sessionCodec struct {
+	id     string
+	parent *Conn
+	recvC  chan *Response
+	done   chan struct{} // Closed when the session is removed from parent.
+}
+
+var _ Codec = (*sessionCodec)(nil)
+
+// WriteRequest implements Codec.
+func (s *sessionCodec) WriteRequest(r *Request) error {
+	r.SessionID = s.id
+
+	s.parent.reqMu.Lock()
+	defer s.parent.reqMu.Unlock()
+	return s.parent.codec.WriteRequest(r)
+}
+
+// ReadResponse implements Codec.
+func (s *sessionCodec) ReadResponse(r *Response) error {
+	select {
+	case resp := <-s.recvC:
+		*r = *resp
+		return nil
+	case <-s.done:
+		return errSessionDone
+	case <-s.parent.ctx.Done():
+		return errSessionDone
+	}
+}
+
+// write forwards the response to the session connection. The response
+// is copied as the parent connection re-uses it between reads.
+func (s *sessionCodec) write(resp Response) {
+	select {
+	case s.recvC <- &resp:
+	case <-s.done:
+	}
+}
+
+// sessionCloser implements io.ReadWriteCloser for session connections,
+// reading and writing is handled by sessionCodec.
+type sessionCloser struct{ close func() error }
+
+func (c *sessionCloser) Close() error                      { return c.close() }
+func (c *sessionCloser) Read(b []byte) (n int, err error)  { return 0, errSessionNoIO }
+func (c *sessionCloser) Write(b []byte) (n int, err error) { return 0, errSessionNoIO }
@@ -0,0 +1,207 @@
+package rpcc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func newTestSessionServer(t *testing.T) *testServer {
+	return newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		if req.Method == "test.Notify" {
+			// Notify both the session and the parent connection.
+			for _, id := range []string{req.SessionID, ""} {
+				err := conn.WriteJSON(&Response{
+					Method:    "test.Event",
+					Args:      []byte(fmt.Sprintf("%q", id)),
+					SessionID: id,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return conn.WriteJSON(&Response{
+			ID:        req.ID,
+			Result:    []byte(fmt.Sprintf("%q", req.SessionID)),
+			SessionID: req.SessionID,
+		})
+	})
+}
+
+func TestNewSession(t *testing.T) {
+	srv := newTestSessionServer(t)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	closed := make(chan struct{})
+	sc, err := NewSession("session1", srv.conn, func() error {
+		close(closed)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+
+	_, err = NewSession("session1", srv.conn, nil)
+	if err == nil {
+		t.Error("NewSession: duplicate session ID, want error, got nil")
+	}
+
+	var reply string
+	for _, c := range []struct {
+		conn *Conn
+		want string
+	}{
+		{sc, "session1"},
+		{srv.conn, ""},
+		{sc, "session1"},
+	} {
+		if err = Invoke(ctx, "test.Hello", nil, &reply, c.conn); err != nil {
+			t.Fatal(err)
+		}
+		if reply != c.want {
+			t.Errorf("Invoke: got reply %q, want %q", reply, c.want)
+		}
+	}
+
+	s1, err := NewStream(ctx, "test.Event", sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s1.Close()
+	s2, err := NewStream(ctx, "test.Event", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s2.Close()
+
+	if err = Invoke(ctx, "test.Notify", nil, nil, sc); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []struct {
+		stream Stream
+		want   string
+	}{
+		{s1, "session1"},
+		{s2, ""},
+	} {
+		if err = s.stream.RecvMsg(&reply); err != nil {
+			t.Fatal(err)
+		}
+		if reply != s.want {
+			t.Errorf("RecvMsg: got %q, want %q", reply, s.want)
+		}
+	}
+
+	if err = sc.Close(); err != nil {
+		t.Error(err)
+	}
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		t.Error("onClose was not called")
+	}
+
+	err = Invoke(ctx, "test.Hello", nil, nil, sc)
+	if err != ErrConnClosing {
+		t.Errorf("Invoke after Close: got %v, want %v", err, ErrConnClosing)
+	}
+
+	// The session is no longer routed, the same ID can be used again.
+	sc, err = NewSession("session1", srv.conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+}
+
+func TestNewSession_ParentClosed(t *testing.T) {
+	srv := newTestSessionServer(t)
+	defer srv.Close()
+
+	sc, err := NewSession("session1", srv.conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+
+	srv.conn.Close()
+	select {
+	case <-sc.Context().Done():
+	case <-time.After(time.Second):
+		t.Error("session connection was not closed with parent")
+	}
+
+	_, err = NewSession("session2", srv.conn, nil)
+	if err != ErrConnClosing {
+		t.Errorf("NewSession on closed conn: got %v, want %v", err, ErrConnClosing)
+	}
+}
+
+func TestNewSession_UnknownSession(t *testing.T) {
+	srv := newTestServer(t, func(conn *websocket.Conn, req *Request) error {
+		if req.SessionID == "" {
+			// A late response and event for a closed session,
+			// sharing the request ID with the parent call.
+			for _, resp := range []*Response{
+				{ID: req.ID, Result: []byte(`"closed"`), SessionID: "closed"},
+				{Method: "test.Event", Args: []byte(`"closed"`), SessionID: "closed"},
+				{Method: "test.Event", Args: []byte(`""`)},
+			} {
+				if err := conn.WriteJSON(resp); err != nil {
+					return err
+				}
+			}
+		}
+		return conn.WriteJSON(&Response{
+			ID:        req.ID,
+			Result:    []byte(fmt.Sprintf("%q", req.SessionID)),
+			SessionID: req.SessionID,
+		})
+	})
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sc, err := NewSession("session1", srv.conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sc.Close()
+
+	s, err := NewStream(ctx, "test.Event", srv.conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// The first request of both connections has the same ID.
+	var reply string
+	if err = Invoke(ctx, "test.Hello", nil, &reply, sc); err != nil {
+		t.Fatal(err)
+	}
+	if reply != "session1" {
+		t.Errorf("Invoke(session): got reply %q, want %q", reply, "session1")
+	}
+	if err = Invoke(ctx, "test.Hello", nil, &reply, srv.conn); err != nil {
+		t.Fatal(err)
+	}
+	if reply != "" {
+		t.Errorf("Invoke(parent): got reply %q, want %q", reply, "")
+	}
+
+	if err = s.RecvMsg(&reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply != "" {
+		t.Errorf("RecvMsg: got %q, want event of the parent connection", reply)
+	}
+}
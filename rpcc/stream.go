@@ -5,19 +5,73 @@ import (
 	"encoding/json"
 	"errors"
 	"sync"
+	"sync/atomic"
 )
 
 var (
 	// ErrStreamClosing indicates that the operation is illegal because
 	// the stream is closing and there are no pending messages.
 	ErrStreamClosing = &closeError{msg: "rpcc: the stream is closing"}
+	// ErrStreamOverflow indicates that the stream was closed because
+	// the backlog was full (OverflowClose) and there are no pending
+	// messages.
+	ErrStreamOverflow = errors.New("rpcc: the stream backlog overflowed")
 )
 
+// OverflowPolicy decides what happens to a new message when the
+// backlog of a stream is full.
+type OverflowPolicy int
+
+// OverflowPolicy enums.
+const (
+	// OverflowBlock blocks until there is room in the backlog. All
+	// messages on the connection are delayed until then.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest drops the oldest message in the backlog.
+	OverflowDropOldest
+	// OverflowDropNewest drops the new message.
+	OverflowDropNewest
+	// OverflowClose closes the stream, RecvMsg will return
+	// ErrStreamOverflow once all pending messages have been
+	// received.
+	OverflowClose
+)
+
+// StreamOption represents a function that sets a Stream option.
+type StreamOption func(*streamOptions)
+
+// WithBacklog returns a StreamOption that limits the number of pending
+// messages on the stream to max, policy decides what happens when the
+// backlog is full. By default the backlog is unbounded.
+//
+// The limit is not enforced for streams that are synchronized via Sync,
+// they receive one message at a time.
+func WithBacklog(max int, policy OverflowPolicy) StreamOption {
+	return func(o *streamOptions) {
+		o.backlog = max
+		o.policy = policy
+	}
+}
+
+type streamOptions struct {
+	backlog int // Unbounded when zero (or negative).
+	policy  OverflowPolicy
+}
+
 // message contains the invoked method name, data and next func.
 type message struct {
-	method string
-	data   []byte
-	next   func()
+	method  string
+	pattern string // Method (or pattern) the receiver listens to.
+	data    []byte
+	next    func()
+}
+
+// Message represents a notification. It can be passed to RecvMsg to
+// receive the method along with the undecoded arguments, useful for
+// streams that listen to a pattern.
+type Message struct {
+	Method string          // Method, e.g. "Network.requestWillBeSent".
+	Args   json.RawMessage // Arguments (params) of the notification.
 }
 
 // messageBuffer is an unbounded channel of message.
@@ -79,6 +133,36 @@ func (b *messageBuffer) clear() {
 	}
 }
 
+// removeOldest removes the oldest message from the buffer, returns nil
+// if the buffer is empty.
+func (b *messageBuffer) removeOldest() *message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var m *message
+	select {
+	case m = <-b.c:
+	default:
+	}
+	if len(b.backlog) > 0 {
+		next := b.backlog[0]
+		b.backlog[0] = nil // Remove reference from underlying array.
+		b.backlog = b.backlog[1:]
+		if m == nil {
+			return next
+		}
+		b.c <- next
+	}
+	return m
+}
+
+// len returns the number of messages in the buffer.
+func (b *messageBuffer) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.c) + len(b.backlog)
+}
+
 func (b *messageBuffer) get() <-chan *message {
 	return b.c
 }
@@ -105,7 +189,10 @@ type Stream interface {
 	// closed.
 	//
 	// When m is a *[]byte the message will not be decoded and the
-	// raw bytes are copied into m.
+	// raw bytes are copied into m. When m is a *json.RawMessage the
+	// raw bytes are stored in m without copying, they are shared
+	// with other streams and must not be modified. When m is a
+	// *Message the method and raw bytes are stored in m.
 	RecvMsg(m interface{}) error
 	// Close closes the stream and no new messages will be received.
 	// RecvMsg will return ErrStreamClosing once all pending messages
@@ -115,23 +202,31 @@ type Stream interface {
 
 // NewStream creates a new stream that listens to notifications from the
 // RPC server. This function is called by generated code.
-func NewStream(ctx context.Context, method string, conn *Conn) (Stream, error) {
+//
+// The method can also be a pattern: "Domain.*" matches all
+// notifications in the domain and "*" matches all notifications. Use
+// Message with RecvMsg to find out which method a message belongs to.
+func NewStream(ctx context.Context, method string, conn *Conn, opts ...StreamOption) (Stream, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	return newStreamClient(ctx, method, conn)
+	return newStreamClient(ctx, method, conn, opts...)
 }
 
-func newStreamClient(ctx context.Context, method string, conn *Conn) (*streamClient, error) {
+func newStreamClient(ctx context.Context, method string, conn *Conn, opts ...StreamOption) (*streamClient, error) {
 	s := &streamClient{
 		conn:   conn,
 		method: method,
 		ctx:    ctx,
 		mbuf:   newMessageBuffer(),
+		space:  make(chan struct{}, 1),
 		ready:  make(chan struct{}),
 		done:   make(chan struct{}),
 	}
+	for _, o := range opts {
+		o(&s.opts)
+	}
 
 	remove, err := conn.listen(method, s)
 	if err != nil {
@@ -145,16 +240,20 @@ func newStreamClient(ctx context.Context, method string, conn *Conn) (*streamCli
 }
 
 type streamClient struct {
+	dropped uint64 // Atomic, first for 64-bit alignment.
+
 	// Used to sync streams.
 	conn   *Conn
 	method string
 
-	// User provided context.
-	ctx context.Context
+	// User provided context and options.
+	ctx  context.Context
+	opts streamOptions
 
 	// mbuf stores all incoming messages
 	// until they are ready to be received.
-	mbuf *messageBuffer
+	mbuf  *messageBuffer
+	space chan struct{} // Signaled when a message has been received.
 
 	readyMu     sync.Mutex // Protects following.
 	ready       chan struct{}
@@ -189,9 +288,17 @@ func (s *streamClient) RecvMsg(m interface{}) (err error) {
 		return err
 	}
 
-	if m, ok := m.(*[]byte); ok {
+	switch m := m.(type) {
+	case *[]byte:
 		*m = append(*m, msg.data...)
 		return nil
+	case *json.RawMessage:
+		*m = msg.data
+		return nil
+	case *Message:
+		m.Method = msg.method
+		m.Args = msg.data
+		return nil
 	}
 
 	return json.Unmarshal(msg.data, m)
@@ -231,7 +338,56 @@ func (s *streamClient) recv() (m *message, err error) {
 	return m, nil
 }
 
+// Dropped returns the number of messages that have been dropped
+// because the backlog was full, see WithBacklog. It is not part of the
+// Stream interface, streams created by NewStream implement
+// interface{ Dropped() uint64 }.
+func (s *streamClient) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Len returns the number of pending messages (backlog). It is not part
+// of the Stream interface, streams created by NewStream implement
+// interface{ Len() int }.
+func (s *streamClient) Len() int {
+	return s.mbuf.len()
+}
+
+// reserve applies the overflow policy when the backlog is full. Returns
+// false if the message should not be stored.
+func (s *streamClient) reserve() bool {
+	for s.mbuf.len() >= s.opts.backlog {
+		switch s.opts.policy {
+		case OverflowDropOldest:
+			m := s.mbuf.removeOldest()
+			if m == nil {
+				return true
+			}
+			atomic.AddUint64(&s.dropped, 1)
+			m.next()
+		case OverflowDropNewest:
+			atomic.AddUint64(&s.dropped, 1)
+			return false
+		case OverflowClose:
+			atomic.AddUint64(&s.dropped, 1)
+			s.close(ErrStreamOverflow)
+			return false
+		default: // OverflowBlock.
+			select {
+			case <-s.space:
+			case <-s.done:
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (s *streamClient) write(m message) {
+	if s.opts.backlog > 0 && !s.reserve() {
+		return
+	}
+
 	s.readyMu.Lock()
 	defer s.readyMu.Unlock()
 
@@ -258,6 +414,12 @@ func (s *streamClient) write(m message) {
 		// Prime the next item (if any).
 		s.mbuf.load()
 
+		// Notify a blocked writer.
+		select {
+		case s.space <- struct{}{}:
+		default:
+		}
+
 		if next != nil {
 			next() // Call the prior next func.
 		}
@@ -307,13 +469,15 @@ type streamWriter interface {
 // streamClients handles multiple streams and allows the
 // same message to be sent to one or more streamSender.
 type streamClients struct {
+	pattern string
 	mu      sync.Mutex
 	seq     uint64
 	writers map[uint64]streamWriter
 }
 
-func newStreamClients() *streamClients {
+func newStreamClients(pattern string) *streamClients {
 	return &streamClients{
+		pattern: pattern,
 		writers: make(map[uint64]streamWriter),
 	}
 }
@@ -334,11 +498,18 @@ func (s *streamClients) remove(seq uint64) {
 }
 
 func (s *streamClients) write(method string, args []byte) {
-	m := message{method: method, data: args}
+	m := message{method: method, pattern: s.pattern, data: args}
 
+	// Writes can block (OverflowBlock), the lock is not held so
+	// that writers can be removed meanwhile.
 	s.mu.Lock()
+	writers := make([]streamWriter, 0, len(s.writers))
 	for _, w := range s.writers {
-		w.write(m)
+		writers = append(writers, w)
 	}
 	s.mu.Unlock()
+
+	for _, w := range writers {
+		w.write(m)
+	}
 }
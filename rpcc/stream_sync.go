@@ -79,7 +79,7 @@ func (s *syncMessageStore) write(m message) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	w, ok := s.writers[m.method]
+	w, ok := s.writers[m.pattern]
 	if !ok {
 		// Exit early if writer has already
 		// unsubscribed or store is closed.
@@ -115,7 +115,7 @@ func (s *syncMessageStore) load() {
 		s.backlog = s.backlog[1:]
 
 		// Check if the writer has already unsubscribed.
-		if w, ok := s.writers[m.method]; ok {
+		if w, ok := s.writers[m.pattern]; ok {
 			// A write here means that this message must be
 			// processed (calling m.next) by the recipient.
 			// Failure to do so will prevent future messages
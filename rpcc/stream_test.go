@@ -292,3 +292,201 @@ func TestMessageBuffer(t *testing.T) {
 		}
 	}
 }
+
+func TestStream_Backlog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recvAll := func(t *testing.T, s Stream) (got []int) {
+		t.Helper()
+		for {
+			select {
+			case <-s.Ready():
+			default:
+				return got
+			}
+			var x int
+			if err := s.RecvMsg(&x); err != nil {
+				return got
+			}
+			got = append(got, x)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policy      OverflowPolicy
+		want        []int
+		wantDropped uint64
+		wantErr     error
+	}{
+		{"DropOldest", OverflowDropOldest, []int{3, 4}, 3, nil},
+		{"DropNewest", OverflowDropNewest, []int{0, 1}, 3, nil},
+		{"Close", OverflowClose, []int{0, 1}, 1, ErrStreamOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, connCancel := newTestStreamConn()
+			defer connCancel()
+
+			s, err := NewStream(ctx, "test", conn, WithBacklog(2, tt.policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			for i := 0; i < 5; i++ {
+				conn.notify("test", []byte(strconv.Itoa(i)))
+			}
+
+			if n := backlog(s); n != len(tt.want) {
+				t.Errorf("Len() = %d, want %d", n, len(tt.want))
+			}
+			got := recvAll(t, s)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Output differs (-got +want)\n%s", diff)
+			}
+			if d := dropped(s); d != tt.wantDropped {
+				t.Errorf("Dropped() = %d, want %d", d, tt.wantDropped)
+			}
+			if tt.wantErr != nil {
+				if err = s.RecvMsg(nil); err != tt.wantErr {
+					t.Errorf("RecvMsg() = %v, want %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestStream_BacklogBlock(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := NewStream(ctx, "test", conn, WithBacklog(1, OverflowBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 3; i++ {
+			conn.notify("test", []byte(strconv.Itoa(i)))
+		}
+	}()
+
+	for i := 0; i < 3; i++ {
+		var x int
+		if err = s.RecvMsg(&x); err != nil {
+			t.Fatal(err)
+		}
+		if x != i {
+			t.Errorf("RecvMsg() = %d, want %d", x, i)
+		}
+	}
+	<-done
+	if d := dropped(s); d != 0 {
+		t.Errorf("Dropped() = %d, want 0", d)
+	}
+
+	// A blocked writer is released when the stream is closed.
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 3; i++ {
+			conn.notify("test", []byte(strconv.Itoa(i)))
+		}
+	}()
+	s.Close()
+	<-done
+}
+
+func TestStream_Pattern(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newStream := func(method string) Stream {
+		s, err := NewStream(ctx, method, conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	exact := newStream("Network.requestWillBeSent")
+	defer exact.Close()
+	domain := newStream("Network.*")
+	defer domain.Close()
+	all := newStream("*")
+	defer all.Close()
+
+	conn.notify("Network.requestWillBeSent", []byte("1"))
+	conn.notify("Network.loadingFinished", []byte("2"))
+	conn.notify("Page.loadEventFired", []byte("3"))
+
+	recvAll := func(s Stream) (got []Message) {
+		for {
+			select {
+			case <-s.Ready():
+			default:
+				return got
+			}
+			var m Message
+			if err := s.RecvMsg(&m); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, m)
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		stream Stream
+		want   []Message
+	}{
+		{"Exact", exact, []Message{
+			{Method: "Network.requestWillBeSent", Args: []byte("1")},
+		}},
+		{"Domain", domain, []Message{
+			{Method: "Network.requestWillBeSent", Args: []byte("1")},
+			{Method: "Network.loadingFinished", Args: []byte("2")},
+		}},
+		{"All", all, []Message{
+			{Method: "Network.requestWillBeSent", Args: []byte("1")},
+			{Method: "Network.loadingFinished", Args: []byte("2")},
+			{Method: "Page.loadEventFired", Args: []byte("3")},
+		}},
+	} {
+		got := recvAll(tt.stream)
+		if diff := cmp.Diff(got, tt.want); diff != "" {
+			t.Errorf("%s: Output differs (-got +want)\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestNewStream_InvalidPattern(t *testing.T) {
+	conn, connCancel := newTestStreamConn()
+	defer connCancel()
+
+	for _, method := range []string{"", "Network*", ".*", "*.requestWillBeSent", "Network.**"} {
+		if _, err := NewStream(context.Background(), method, conn); err == nil {
+			t.Errorf("NewStream(%q): got nil, want error", method)
+		}
+	}
+}
+
+// dropped returns the number of dropped messages, the method is not
+// part of the Stream interface.
+func dropped(s Stream) uint64 {
+	return s.(interface{ Dropped() uint64 }).Dropped()
+}
+
+func backlog(s Stream) int {
+	return s.(interface{ Len() int }).Len()
+}
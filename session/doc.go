@@ -29,6 +29,46 @@ Use the session connection.
 	err = pageClient.Page.Enable(context.TODO())
 	// ...
 
+In flat session mode, session connections are multiplexed directly over
+the websocket connection instead of being wrapped in Target domain
+messages. This mode requires a recent version of Chrome.
+
+	m, err := session.NewFlatManager(conn) // rpcc.Conn with websocket connection.
+	if err != nil {
+		// Handle error.
+	}
+	defer m.Close()
+
+The Manager can be configured via options, e.g. to bound the time spent
+detaching sessions on a slow connection and to close the Manager along with
+the underlying connection.
+
+	m, err := session.NewManager(c,
+		session.WithDetachTimeout(time.Second),
+		session.WithConnContext(),
+	)
+
+A Watcher auto-attaches to targets, including popups, out-of-process iframes
+and workers, and hands each session connection to a callback before the
+target is resumed. It requires flat session mode.
+
+	w, err := session.NewWatcher(ctx, m, func(ctx context.Context, conn *rpcc.Conn, info target.Info) error {
+		// Enable domains before the target starts running.
+		return cdp.NewClient(conn).Runtime.Enable(ctx)
+	})
+	if err != nil {
+		// Handle error.
+	}
+	defer w.Close()
+
+	for {
+		ev, err := w.Recv() // Target created, changed, crashed or destroyed.
+		if err != nil {
+			break
+		}
+		log.Println(ev.Type, ev.TargetID)
+	}
+
 If session connections are behaving unexpectedly, you can debug the session
 Manager by checking the error channel.
 
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/mafredri/cdp"
@@ -14,11 +15,16 @@ import (
 type Manager struct {
 	ctx    context.Context
 	cancel context.CancelFunc
+	opts   managerOptions
 
 	c    *cdp.Client
-	sC   chan *session
+	conn *rpcc.Conn // Set in flat session mode.
 	done chan error
 	errC chan error
+
+	mu       sync.Mutex // Protects following.
+	sessions map[target.SessionID]*session
+	closed   bool
 }
 
 const (
@@ -30,32 +36,124 @@ const (
 	//
 	// The default timeout is a compromise between not blocking for
 	// extended periods of time and allowing slow connections to
-	// deliver the message.
-	//
-	// TODO(mafredri): Should we allow configuring the timeout?
+	// deliver the message, it can be changed with WithDetachTimeout.
 	defaultDetachTimeout = 5 * time.Second
 )
 
+// ManagerOption represents a function that sets a Manager option.
+type ManagerOption func(*managerOptions)
+
+type managerOptions struct {
+	detachTimeout time.Duration
+	errBufferSize int
+	connContext   bool
+}
+
+// WithDetachTimeout returns a ManagerOption that sets the timeout for
+// invoking DetachFromTarget when a session connection is closed, the
+// default is 5 seconds. The default is used if d is not positive.
+func WithDetachTimeout(d time.Duration) ManagerOption {
+	return func(o *managerOptions) {
+		o.detachTimeout = d
+	}
+}
+
+// WithErrorBufferSize returns a ManagerOption that sets the buffer
+// size of the error channel (Err), the default is 1. Errors are
+// discarded when the buffer is full. The default is used if n is less
+// than 1, an unbuffered channel would discard all errors.
+func WithErrorBufferSize(n int) ManagerOption {
+	return func(o *managerOptions) {
+		o.errBufferSize = n
+	}
+}
+
+// WithConnContext returns a ManagerOption that makes the Manager
+// inherit the context of the underlying rpcc.Conn. The Manager, and
+// all session connections, are closed when the rpcc.Conn is closed.
+func WithConnContext() ManagerOption {
+	return func(o *managerOptions) {
+		o.connContext = true
+	}
+}
+
+// Session represents an active session connection established by the
+// Manager.
+type Session struct {
+	ID       target.SessionID
+	TargetID target.ID
+	Conn     *rpcc.Conn
+}
+
 // Dial establishes a target session and creates a lightweight rpcc.Conn
 // that uses SendMessageToTarget and ReceivedMessageFromTarget from the
-// Target domain instead of a new websocket connection.
+// Target domain instead of a new websocket connection. In flat session
+// mode (NewFlatManager) the rpcc.Conn is instead multiplexed over the
+// websocket connection, see rpcc.NewSession.
 //
 // Dial will invoke AttachToTarget. Close (rpcc.Conn) will invoke
 // DetachFromTarget.
 func (m *Manager) Dial(ctx context.Context, id target.ID) (*rpcc.Conn, error) {
-	s, err := dial(ctx, id, m.c, defaultDetachTimeout)
+	var s *session
+	var err error
+	if m.conn != nil {
+		s, err = dialFlat(ctx, id, m.c, m.conn, m.opts.detachTimeout)
+	} else {
+		s, err = dial(ctx, id, m.c, m.opts.detachTimeout)
+	}
 	if err != nil {
 		return nil, err
 	}
-	select {
-	case m.sC <- s:
-	case <-m.ctx.Done():
+	if !m.add(s) {
 		s.Close()
 		return nil, errors.New("session.Manager: Dial failed: Manager is closed")
 	}
 	return s.Conn(), nil
 }
 
+// add registers the session with the Manager, false is returned if the
+// Manager is closed.
+func (m *Manager) add(s *session) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed || m.ctx.Err() != nil {
+		return false
+	}
+	m.sessions[s.ID] = s
+	return true
+}
+
+// remove unregisters the session from the Manager.
+func (m *Manager) remove(id target.SessionID) *session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[id]
+	if ok {
+		delete(m.sessions, id)
+	}
+	return s
+}
+
+// Sessions returns the active session connections, in no particular
+// order.
+func (m *Manager) Sessions() []Session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sessions := make([]Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		if s.Conn().Context().Err() != nil {
+			continue // Closed, pending removal.
+		}
+		sessions = append(sessions, Session{
+			ID:       s.ID,
+			TargetID: s.TargetID,
+			Conn:     s.Conn(),
+		})
+	}
+	return sessions
+}
+
 // Close closes the Manager and all active sessions. All rpcc.Conn
 // created by Dial will be closed.
 func (m *Manager) Close() error {
@@ -66,7 +164,7 @@ func (m *Manager) Close() error {
 	return nil
 }
 
-func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC chan<- error) {
+func (m *Manager) watch(ev *sessionEvents, done, errC chan<- error) {
 	defer ev.Close()
 
 	isClosing := func(err error) bool {
@@ -87,12 +185,22 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 		return false
 	}
 
-	sessions := make(map[target.SessionID]*session)
 	defer func() {
+		m.mu.Lock()
+		m.closed = true
+		sessions := m.sessions
+		m.sessions = nil
+		m.mu.Unlock()
+
+		// Close (detach) sessions concurrently, each detach can
+		// take up to the detach timeout.
+		errC2 := make(chan error, len(sessions))
+		for _, s := range sessions {
+			go func(s *session) { errC2 <- s.Close() }(s)
+		}
 		var err []error
-		for _, ss := range sessions {
-			// TODO(mafredri): Speed up by closing sessions concurrently.
-			err = append(err, ss.Close())
+		for range sessions {
+			err = append(err, <-errC2)
 		}
 		done <- errors.Merge(err...)
 		close(done)
@@ -101,9 +209,6 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 
 	for {
 		select {
-		case s := <-created:
-			sessions[s.ID] = s
-
 		// Checking detached should be sufficient for monitoring the
 		// session. A DetachedFromTarget event is always sent before
 		// TargetDestroyed.
@@ -118,12 +223,11 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 				continue
 			}
 
-			if s, ok := sessions[ev.SessionID]; ok {
-				delete(sessions, s.ID)
+			if s := m.remove(ev.SessionID); s != nil {
 				s.Close()
 			}
 
-		case <-ev.message.Ready():
+		case <-ev.messageReady():
 			ev, err := ev.message.Recv()
 			if err != nil {
 				if isClosing(err) {
@@ -134,7 +238,10 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 				continue
 			}
 
-			if s, ok := sessions[ev.SessionID]; ok {
+			m.mu.Lock()
+			s, ok := m.sessions[ev.SessionID]
+			m.mu.Unlock()
+			if ok {
 				// We rely on the implementation of *rpcc.Conn
 				// to read this message in a reasonably short
 				// amount of time. Blocking here can potentially
@@ -142,7 +249,7 @@ func (m *Manager) watch(ev *sessionEvents, created <-chan *session, done, errC c
 				// not happen.
 				err = s.Write([]byte(ev.Message))
 				if err != nil {
-					delete(sessions, s.ID)
+					m.remove(s.ID)
 				}
 			}
 		}
@@ -170,18 +277,51 @@ func (m *Manager) Err() <-chan error {
 // The cdp.Client will be used to listen to events and invoke commands
 // on the Target domain. It will also be used by all rpcc.Conn created
 // by Dial.
-func NewManager(c *cdp.Client) (*Manager, error) {
+func NewManager(c *cdp.Client, opts ...ManagerOption) (*Manager, error) {
+	return newManager(c, nil, opts)
+}
+
+// NewFlatManager creates a new session Manager that uses flat session
+// mode. Instead of wrapping messages in SendMessageToTarget and
+// ReceivedMessageFromTarget, all session messages are sent directly
+// over conn, tagged with the session ID.
+//
+// The rpcc.Conn will be used to listen to events and invoke commands
+// on the Target domain. It is shared by all rpcc.Conn created by Dial.
+func NewFlatManager(conn *rpcc.Conn, opts ...ManagerOption) (*Manager, error) {
+	return newManager(cdp.NewClient(conn), conn, opts)
+}
+
+func newManager(c *cdp.Client, conn *rpcc.Conn, opts []ManagerOption) (*Manager, error) {
 	m := &Manager{
-		c:    c,
-		sC:   make(chan *session),
-		errC: make(chan error, 1),
+		opts: managerOptions{
+			detachTimeout: defaultDetachTimeout,
+			errBufferSize: 1,
+		},
+		c:        c,
+		conn:     conn,
+		sessions: make(map[target.SessionID]*session),
+	}
+	for _, o := range opts {
+		o(&m.opts)
 	}
+	if m.opts.detachTimeout <= 0 {
+		m.opts.detachTimeout = defaultDetachTimeout
+	}
+	if m.opts.errBufferSize < 1 {
+		m.opts.errBufferSize = 1
+	}
+	m.errC = make(chan error, m.opts.errBufferSize)
 
-	// TODO(mafredri): Inherit the context from rpcc.Conn in cdp.Client.
-	// cdp.Client does not yet expose the context, nor rpcc.Conn.
-	m.ctx, m.cancel = context.WithCancel(context.TODO())
+	ctx := context.TODO()
+	if m.opts.connContext {
+		if conn := c.Conn(); conn != nil {
+			ctx = conn.Context()
+		}
+	}
+	m.ctx, m.cancel = context.WithCancel(ctx)
 
-	ev, err := newSessionEvents(m.ctx, c)
+	ev, err := newSessionEvents(m.ctx, c, conn != nil)
 	if err != nil {
 		close(m.errC)
 		m.Close()
@@ -189,7 +329,7 @@ func NewManager(c *cdp.Client) (*Manager, error) {
 	}
 
 	m.done = make(chan error, 1)
-	go m.watch(ev, m.sC, m.done, m.errC)
+	go m.watch(ev, m.done, m.errC)
 	return m, nil
 }
 
@@ -198,7 +338,7 @@ type sessionEvents struct {
 	message  target.ReceivedMessageFromTargetClient
 }
 
-func newSessionEvents(ctx context.Context, c *cdp.Client) (events *sessionEvents, err error) {
+func newSessionEvents(ctx context.Context, c *cdp.Client, flat bool) (events *sessionEvents, err error) {
 	ev := new(sessionEvents)
 	defer func() {
 		if err != nil {
@@ -210,6 +350,10 @@ func newSessionEvents(ctx context.Context, c *cdp.Client) (events *sessionEvents
 	if err != nil {
 		return nil, err
 	}
+	if flat {
+		// Messages are routed by rpcc in flat session mode.
+		return ev, nil
+	}
 	ev.message, err = c.Target.ReceivedMessageFromTarget(ctx)
 	if err != nil {
 		return nil, err
@@ -223,6 +367,15 @@ func newSessionEvents(ctx context.Context, c *cdp.Client) (events *sessionEvents
 	return ev, nil
 }
 
+// messageReady returns the ready channel for the message stream, nil
+// (block forever) in flat session mode.
+func (ev *sessionEvents) messageReady() <-chan struct{} {
+	if ev.message == nil {
+		return nil
+	}
+	return ev.message.Ready()
+}
+
 func (ev *sessionEvents) Close() (err error) {
 	for _, c := range []interface {
 		Close() error
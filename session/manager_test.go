@@ -1,8 +1,13 @@
 package session
 
 import (
+	"context"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/mafredri/cdp/cdptest"
 	"github.com/mafredri/cdp/internal/errors"
 	"github.com/mafredri/cdp/protocol/target"
 	"github.com/mafredri/cdp/rpcc"
@@ -56,7 +61,7 @@ func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 	}
 
 	m := Manager{cancel: func() {}, errC: make(chan error, 1)}
-	go m.watch(ev, nil, make(chan error, 1), m.errC)
+	go m.watch(ev, make(chan error, 1), m.errC)
 
 	message.next()
 
@@ -82,3 +87,197 @@ func TestManager_ErrorsAreSentOnErrChan(t *testing.T) {
 	detached.err = rpcc.ErrConnClosing
 	detached.markReady()
 }
+
+// newTestFlatManager returns a flat session Manager for a cdptest
+// server, detach (if not nil) is called before Target.detachFromTarget
+// is invoked.
+func newTestFlatManager(t *testing.T, detach func(ctx context.Context) error, opts ...ManagerOption) (*Manager, func()) {
+	srv := cdptest.NewServer()
+
+	intercept := func(ctx context.Context, method string, args, reply interface{}, cc *rpcc.Conn, invoke rpcc.Invoker) error {
+		if method == "Target.detachFromTarget" && detach != nil {
+			if err := detach(ctx); err != nil {
+				return err
+			}
+		}
+		return invoke(ctx, method, args, reply, cc)
+	}
+	conn, err := rpcc.Dial(srv.WebSocketURL, rpcc.WithUnaryInterceptor(intercept))
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	m, err := NewFlatManager(conn, opts...)
+	if err != nil {
+		conn.Close()
+		srv.Close()
+		t.Fatal(err)
+	}
+	return m, func() {
+		m.Close()
+		conn.Close()
+		srv.Close()
+	}
+}
+
+func TestManager_Options(t *testing.T) {
+	// Invalid values fall back to the defaults.
+	m, cleanup := newTestFlatManager(t, nil, WithErrorBufferSize(-1), WithDetachTimeout(0))
+	defer cleanup()
+
+	if m.opts.errBufferSize != 1 || cap(m.errC) != 1 {
+		t.Errorf("errBufferSize = %d, want 1", m.opts.errBufferSize)
+	}
+	if m.opts.detachTimeout != defaultDetachTimeout {
+		t.Errorf("detachTimeout = %v, want %v", m.opts.detachTimeout, defaultDetachTimeout)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := m.Dial(ctx, "target1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = conn.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+}
+
+func TestManager_ErrorBufferSizeZero(t *testing.T) {
+	// An unbuffered error channel would discard all errors.
+	m, cleanup := newTestFlatManager(t, nil, WithErrorBufferSize(0))
+	defer cleanup()
+
+	if m.opts.errBufferSize != 1 || cap(m.errC) != 1 {
+		t.Errorf("errBufferSize = %d, want 1", m.opts.errBufferSize)
+	}
+}
+
+func TestManager_DetachTimeout(t *testing.T) {
+	// Detach never completes.
+	block := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	m, cleanup := newTestFlatManager(t, block, WithDetachTimeout(10*time.Millisecond))
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := m.Dial(ctx, "target1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.Close()
+	if err == nil || !strings.Contains(err.Error(), "detach timed out") {
+		t.Errorf("Close() = %v, want detach timed out", err)
+	}
+}
+
+func TestManager_CloseDetachesConcurrently(t *testing.T) {
+	const n = 3
+
+	// Each detach completes once all sessions are being detached,
+	// the detach times out if the sessions are closed one by one.
+	var mu sync.Mutex
+	detaching := 0
+	all := make(chan struct{})
+	barrier := func(ctx context.Context) error {
+		mu.Lock()
+		detaching++
+		if detaching == n {
+			close(all)
+		}
+		mu.Unlock()
+
+		select {
+		case <-all:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	m, cleanup := newTestFlatManager(t, barrier, WithDetachTimeout(time.Second))
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var conns []*rpcc.Conn
+	for _, id := range []target.ID{"target1", "target2", "target3"} {
+		conn, err := m.Dial(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn)
+	}
+
+	if err := m.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+	for _, conn := range conns {
+		if conn.Context().Err() == nil {
+			t.Error("session connection was not closed")
+		}
+	}
+}
+
+func TestManager_DialDetachesOnSessionError(t *testing.T) {
+	var detached int
+	m, cleanup := newTestFlatManager(t, func(context.Context) error {
+		detached++
+		return nil
+	})
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Occupy the session ID that the server assigns next.
+	conn, err := rpcc.NewSession("session-1", m.conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err = m.Dial(ctx, "target1"); err == nil {
+		t.Fatal("Dial() error = nil, want error")
+	}
+	if detached != 1 {
+		t.Errorf("detachFromTarget called %d times, want 1", detached)
+	}
+}
+
+func TestManager_RemovesDetachedSessions(t *testing.T) {
+	m, cleanup := newTestFlatManager(t, nil)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := m.Dial(ctx, "target1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.Dial(ctx, "target2"); err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+
+	// The session is removed on Target.detachedFromTarget.
+	for {
+		m.mu.Lock()
+		n := len(m.sessions)
+		m.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatalf("got %d sessions, want 1", n)
+		}
+	}
+}
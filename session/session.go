@@ -17,10 +17,12 @@ import (
 type session struct {
 	ID       target.SessionID
 	TargetID target.ID
-	recvC    chan []byte
-	send     func([]byte) error
 
-	init chan struct{} // Protect conn from early read.
+	// Only used when not in flat session mode.
+	recvC chan []byte
+	send  func([]byte) error
+	init  chan struct{} // Protect conn from early read.
+
 	conn *rpcc.Conn
 }
 
@@ -105,25 +107,17 @@ func dial(ctx context.Context, id target.ID, tc *cdp.Client, detachTimeout time.
 		init:     make(chan struct{}),
 		send: func(data []byte) error {
 			<-s.init
-			// TODO(maf): Use async invocation.
+			// Wait for the reply, errors are returned to the
+			// waiting request and messages stay in order.
+			// rpcc.Go guarantees neither when conn has
+			// interceptors.
 			return tc.Target.SendMessageToTarget(s.conn.Context(),
 				target.NewSendMessageToTargetArgs(string(data)).
 					SetSessionID(s.ID))
 		},
 	}
 
-	detach := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), detachTimeout)
-		defer cancel()
-
-		err := tc.Target.DetachFromTarget(ctx,
-			target.NewDetachFromTargetArgs().SetSessionID(s.ID))
-		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("session: detach timed out for session %s", s.ID)
-		}
-		return errors.Wrapf(err, "session: detach failed for session %s", s.ID)
-	}
-
+	detach := detacher(tc, s.ID, detachTimeout)
 	s.conn, err = rpcc.DialContext(ctx, "", sessionDetachConn(detach), sessionCodec(s))
 	if err != nil {
 		return nil, err
@@ -132,3 +126,44 @@ func dial(ctx context.Context, id target.ID, tc *cdp.Client, detachTimeout time.
 
 	return s, nil
 }
+
+// dialFlat attaches to the target via the provided *cdp.Client using
+// flat session mode. The session connection is multiplexed over conn,
+// which must be the underlying *rpcc.Conn for the provided *cdp.Client.
+func dialFlat(ctx context.Context, id target.ID, tc *cdp.Client, conn *rpcc.Conn, detachTimeout time.Duration) (s *session, err error) {
+	args := target.NewAttachToTargetArgs(id).SetFlatten(true)
+	reply, err := tc.Target.AttachToTarget(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	s = &session{
+		TargetID: id,
+		ID:       reply.SessionID,
+	}
+
+	detach := detacher(tc, s.ID, detachTimeout)
+	s.conn, err = rpcc.NewSession(string(s.ID), conn, detach)
+	if err != nil {
+		// Don't leave the target attached without a session.
+		return nil, errors.Merge(err, detach())
+	}
+
+	return s, nil
+}
+
+// detacher returns a function that invokes DetachFromTarget for the
+// session, it is used when the session connection is closed.
+func detacher(tc *cdp.Client, id target.SessionID, detachTimeout time.Duration) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), detachTimeout)
+		defer cancel()
+
+		err := tc.Target.DetachFromTarget(ctx,
+			target.NewDetachFromTargetArgs().SetSessionID(id))
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("session: detach timed out for session %s", id)
+		}
+		return errors.Wrapf(err, "session: detach failed for session %s", id)
+	}
+}
@@ -3,6 +3,7 @@ package session_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"os"
 	"strings"
@@ -10,9 +11,12 @@ import (
 	"time"
 
 	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
 	"github.com/mafredri/cdp/internal/testutil"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
 	"github.com/mafredri/cdp/session"
 )
 
@@ -99,6 +103,45 @@ func TestManager(t *testing.T) {
 	}
 }
 
+func TestFlatManager(t *testing.T) {
+	checkBrowser(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	c := testutil.NewClient(ctx, t)
+	m, err := session.NewFlatManager(c.Conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	newPage := c.NewPage(ctx)
+
+	pageConn, err := m.Dial(ctx, newPage.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pageConn.Close()
+
+	pageC := cdp.NewClient(pageConn)
+	eval, err := pageC.Runtime.Evaluate(ctx, runtime.NewEvaluateArgs(`1 + 1`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(eval.Result.Value) != "2" {
+		t.Errorf("Evaluate: got %s, want 2", eval.Result.Value)
+	}
+
+	// Close the page, this should also close pageConn.
+	newPage.Close()
+	select {
+	case <-pageConn.Context().Done():
+	case <-ctx.Done():
+		t.Error("timed out waiting for session to close")
+	}
+}
+
 func TestManager_Close(t *testing.T) {
 	checkBrowser(t)
 
@@ -194,6 +237,143 @@ func TestManager_NewOnClosedConn(t *testing.T) {
 	}
 }
 
+func TestManager_Sessions(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	m, err := session.NewFlatManager(conn,
+		session.WithDetachTimeout(time.Second),
+		session.WithErrorBufferSize(10),
+		session.WithConnContext(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	var conns []*rpcc.Conn
+	for _, id := range []target.ID{"target1", "target2", "target3"} {
+		sconn, err := m.Dial(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, sconn)
+	}
+
+	sessions := m.Sessions()
+	if len(sessions) != 3 {
+		t.Fatalf("Sessions() = %v, want 3 sessions", sessions)
+	}
+	for _, s := range sessions {
+		if s.Conn != conns[s.TargetID[len(s.TargetID)-1]-'1'] {
+			t.Errorf("Sessions() session %s: got wrong Conn for target %s", s.ID, s.TargetID)
+		}
+	}
+
+	// Closed sessions are not returned, see also
+	// TestManager_RemovesDetachedSessions.
+	conns[0].Close()
+	for len(m.Sessions()) != 2 {
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatalf("Sessions() = %v, want 2 sessions", m.Sessions())
+		}
+	}
+
+	// Closing the underlying connection closes the Manager and the
+	// session connections.
+	conn.Close()
+	for _, sconn := range conns[1:] {
+		select {
+		case <-sconn.Context().Done():
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for session to close")
+		}
+	}
+	if _, err = m.Dial(ctx, "target4"); err == nil {
+		t.Error("Dial: expected error after conn was closed, got nil")
+	}
+	if s := m.Sessions(); len(s) != 0 {
+		t.Errorf("Sessions() = %v, want none", s)
+	}
+}
+
+func TestManager_SendMessageToTarget(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, method := range []string{"Test.slow", "Test.fast"} {
+		srv.Handle(method, cdptest.Reply(nil))
+	}
+
+	// Delay and fail messages to the target, in the parent connection.
+	intercept := func(ctx context.Context, method string, args, reply interface{}, cc *rpcc.Conn, invoke rpcc.Invoker) error {
+		if a, ok := args.(*target.SendMessageToTargetArgs); ok {
+			switch {
+			case strings.Contains(a.Message, "Test.slow"):
+				time.Sleep(20 * time.Millisecond)
+			case strings.Contains(a.Message, "Test.fail"):
+				return errors.New("send failed")
+			}
+		}
+		return invoke(ctx, method, args, reply, cc)
+	}
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL, rpcc.WithUnaryInterceptor(intercept))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	m, err := session.NewManager(cdp.NewClient(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	sconn, err := m.Dial(ctx, "target1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sconn.Close()
+
+	// Messages are sent in order.
+	slow := rpcc.Go(ctx, "Test.slow", nil, nil, sconn)
+	fast := rpcc.Go(ctx, "Test.fast", nil, nil, sconn)
+	for _, call := range []*rpcc.Call{slow, fast} {
+		if <-call.Done; call.Error != nil {
+			t.Fatalf("%s: %v", call.Method, call.Error)
+		}
+	}
+	var got []string
+	for _, req := range srv.Calls() {
+		if strings.HasPrefix(req.Method, "Test.") {
+			got = append(got, req.Method)
+		}
+	}
+	if want := []string{"Test.slow", "Test.fast"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Calls() = %v, want %v", got, want)
+	}
+
+	// Errors are returned to the waiting request.
+	err = rpcc.Invoke(ctx, "Test.fail", nil, nil, sconn)
+	if err == nil || !strings.Contains(err.Error(), "send failed") {
+		t.Errorf("Invoke: got %v, want send failed", err)
+	}
+}
+
 var (
 	browserFlag = flag.Bool("browser", false, "Test with browser")
 )
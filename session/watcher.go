@@ -0,0 +1,390 @@
+package session
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/internal/errors"
+	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+)
+
+// EventType represents the type of a target lifecycle Event.
+type EventType int
+
+// EventType enums.
+const (
+	TargetCreated   EventType = iota + 1 // Target.targetCreated.
+	TargetChanged                        // Target.targetInfoChanged.
+	TargetCrashed                        // Target.targetCrashed.
+	TargetDestroyed                      // Target.targetDestroyed.
+)
+
+func (t EventType) String() string {
+	switch t {
+	case TargetCreated:
+		return "TargetCreated"
+	case TargetChanged:
+		return "TargetChanged"
+	case TargetCrashed:
+		return "TargetCrashed"
+	case TargetDestroyed:
+		return "TargetDestroyed"
+	}
+	return "EventType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// Event represents a change in the lifecycle of a target.
+type Event struct {
+	Type     EventType
+	TargetID target.ID
+	// Info is set for TargetCreated and TargetChanged.
+	Info *target.Info
+	// Status and ErrorCode (termination status) are set for
+	// TargetCrashed.
+	Status    string
+	ErrorCode int
+}
+
+// AttachFunc is called with the session connection to each target
+// that is auto-attached by the Watcher. The target is paused (waiting
+// for the debugger) until AttachFunc returns, this allows e.g. domains
+// to be enabled before the target starts running. When an error is
+// returned, the session connection is closed.
+type AttachFunc func(ctx context.Context, conn *rpcc.Conn, info target.Info) error
+
+// WatcherOption represents a function that sets a Watcher option.
+type WatcherOption func(*watcherOptions)
+
+type watcherOptions struct {
+	types map[string]bool
+}
+
+// WithAttachTypes returns a WatcherOption that sets the target types
+// that are auto-attached, other targets are resumed and detached. The
+// default types are page (including popups), iframe (out-of-process
+// iframes), worker, shared_worker and service_worker.
+func WithAttachTypes(types ...string) WatcherOption {
+	return func(o *watcherOptions) {
+		o.types = make(map[string]bool)
+		for _, t := range types {
+			o.types[t] = true
+		}
+	}
+}
+
+// Watcher watches the lifecycle of targets (Target.setDiscoverTargets)
+// and auto-attaches to targets (Target.setAutoAttach), including the
+// children of attached targets, e.g. out-of-process iframes and
+// workers. Each attached target is handed to the AttachFunc before it
+// is resumed (Runtime.runIfWaitingForDebugger).
+type Watcher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	m      *Manager
+	fn     AttachFunc
+	opts   watcherOptions
+	ev     *watcherEvents
+	wg     sync.WaitGroup
+	errC   chan error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewWatcher creates a new Watcher that establishes the session
+// connections via m, which must be in flat session mode
+// (NewFlatManager). Session connections are owned by the Manager and
+// closed when the target is detached or the Manager is closed.
+func NewWatcher(ctx context.Context, m *Manager, fn AttachFunc, opts ...WatcherOption) (*Watcher, error) {
+	if m.conn == nil {
+		return nil, errors.New("session.NewWatcher: Manager must be in flat session mode")
+	}
+
+	w := &Watcher{
+		m:    m,
+		fn:   fn,
+		errC: make(chan error, m.opts.errBufferSize),
+	}
+	WithAttachTypes("page", "iframe", "worker", "shared_worker", "service_worker")(&w.opts)
+	for _, o := range opts {
+		o(&w.opts)
+	}
+	w.ctx, w.cancel = context.WithCancel(m.ctx)
+
+	var err error
+	w.ev, err = newWatcherEvents(w.ctx, m.c)
+	if err != nil {
+		w.Close()
+		return nil, err
+	}
+	err = m.c.Target.SetDiscoverTargets(ctx, target.NewSetDiscoverTargetsArgs(true))
+	if err != nil {
+		w.Close()
+		return nil, errors.Wrapf(err, "session.NewWatcher: discover targets failed")
+	}
+	err = w.autoAttach(ctx, m.conn)
+	if err != nil {
+		w.Close()
+		return nil, errors.Wrapf(err, "session.NewWatcher: auto-attach failed")
+	}
+	return w, nil
+}
+
+// autoAttach enables auto-attach on conn, the browser or a session
+// connection, and watches for attached and detached sessions.
+func (w *Watcher) autoAttach(ctx context.Context, conn *rpcc.Conn) (err error) {
+	c := cdp.NewClient(conn)
+	attached, err := c.Target.AttachedToTarget(w.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			attached.Close()
+		}
+	}()
+	detached, err := c.Target.DetachedFromTarget(w.ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			detached.Close()
+		}
+	}()
+	if err = cdp.Sync(attached, detached); err != nil {
+		return err
+	}
+
+	args := target.NewSetAutoAttachArgs(true, true).SetFlatten(true)
+	if err = c.Target.SetAutoAttach(ctx, args); err != nil {
+		return err
+	}
+
+	w.wg.Add(1)
+	go w.watch(c, attached, detached)
+	return nil
+}
+
+func (w *Watcher) watch(c *cdp.Client, attached target.AttachedToTargetClient, detached target.DetachedFromTargetClient) {
+	defer w.wg.Done()
+	defer attached.Close()
+	defer detached.Close()
+
+	isClosing := func(err error) bool {
+		var e interface{ Closed() bool }
+		if errors.As(err, &e) && e.Closed() {
+			return true
+		}
+		return errors.Is(err, context.Canceled)
+	}
+
+	for {
+		select {
+		case <-attached.Ready():
+			ev, err := attached.Recv()
+			if err != nil {
+				if isClosing(err) {
+					return
+				}
+				err = errors.Wrapf(err, "Watcher.watch: error receiving attached event")
+				sendOrDiscardErr(w.errC, err)
+				continue
+			}
+			w.wg.Add(1)
+			go w.attach(c, ev)
+
+		case <-detached.Ready():
+			ev, err := detached.Recv()
+			if err != nil {
+				if isClosing(err) {
+					return
+				}
+				err = errors.Wrapf(err, "Watcher.watch: error receiving detached event")
+				sendOrDiscardErr(w.errC, err)
+				continue
+			}
+
+			if s := w.m.remove(ev.SessionID); s != nil {
+				s.Close()
+			}
+		}
+	}
+}
+
+// attach sets up the session connection for an auto-attached target,
+// c is the client for the parent connection.
+func (w *Watcher) attach(c *cdp.Client, ev *target.AttachedToTargetReply) {
+	defer w.wg.Done()
+
+	s := &session{ID: ev.SessionID, TargetID: ev.TargetInfo.TargetID}
+	detach := detacher(c, s.ID, w.m.opts.detachTimeout)
+	conn, err := rpcc.NewSession(string(s.ID), w.m.conn, detach)
+	if err != nil {
+		sendOrDiscardErr(w.errC, errors.Wrapf(err, "Watcher.attach: session %s failed", s.ID))
+		return
+	}
+	s.conn = conn
+
+	resume := func() error {
+		if !ev.WaitingForDebugger {
+			return nil
+		}
+		return runtime.NewClient(conn).RunIfWaitingForDebugger(w.ctx)
+	}
+
+	if !w.opts.types[ev.TargetInfo.Type] {
+		// Not watched, let the target run.
+		err = resume()
+		err = errors.Merge(err, conn.Close())
+		if err != nil {
+			sendOrDiscardErr(w.errC, errors.Wrapf(err, "Watcher.attach: resume target %s failed", s.TargetID))
+		}
+		return
+	}
+
+	if !w.m.add(s) {
+		conn.Close()
+		return
+	}
+
+	// Auto-attach to the children (e.g. iframes) before the target
+	// is resumed. Not all targets support the Target domain.
+	err = w.autoAttach(w.ctx, conn)
+	if errors.Is(err, rpcc.ErrMethodNotFound) {
+		err = nil
+	}
+	if err == nil {
+		err = w.fn(w.ctx, conn, ev.TargetInfo)
+	}
+	if err == nil {
+		err = resume()
+	}
+	if err != nil {
+		conn.Close()
+		sendOrDiscardErr(w.errC, errors.Wrapf(err, "Watcher.attach: target %s failed", s.TargetID))
+	}
+}
+
+// Recv blocks until the next target lifecycle event is received. Events
+// are buffered until received.
+func (w *Watcher) Recv() (*Event, error) {
+	return w.ev.recv()
+}
+
+// Err is a channel that blocks until the Watcher encounters an error,
+// e.g. when attaching to a target or the AttachFunc fails. The channel
+// is closed if Watcher is closed.
+func (w *Watcher) Err() <-chan error {
+	return w.errC
+}
+
+// Close stops the Watcher and disables auto-attach. Session
+// connections established by the Watcher are left open.
+func (w *Watcher) Close() error {
+	w.closeOnce.Do(func() {
+		w.cancel()
+		w.wg.Wait()
+
+		if w.ev != nil {
+			// The streams are closed via the context, errors
+			// are expected.
+			_ = w.ev.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), w.m.opts.detachTimeout)
+			defer cancel()
+			w.closeErr = errors.Merge(
+				w.m.c.Target.SetAutoAttach(ctx, target.NewSetAutoAttachArgs(false, false)),
+				w.m.c.Target.SetDiscoverTargets(ctx, target.NewSetDiscoverTargetsArgs(false)),
+			)
+		}
+		close(w.errC)
+	})
+	return errors.Wrapf(w.closeErr, "session.Watcher: close failed")
+}
+
+type watcherEvents struct {
+	created   target.CreatedClient
+	changed   target.InfoChangedClient
+	crashed   target.CrashedClient
+	destroyed target.DestroyedClient
+}
+
+func newWatcherEvents(ctx context.Context, c *cdp.Client) (events *watcherEvents, err error) {
+	ev := new(watcherEvents)
+	defer func() {
+		if err != nil {
+			ev.Close()
+		}
+	}()
+
+	if ev.created, err = c.Target.TargetCreated(ctx); err != nil {
+		return nil, err
+	}
+	if ev.changed, err = c.Target.TargetInfoChanged(ctx); err != nil {
+		return nil, err
+	}
+	if ev.crashed, err = c.Target.TargetCrashed(ctx); err != nil {
+		return nil, err
+	}
+	if ev.destroyed, err = c.Target.TargetDestroyed(ctx); err != nil {
+		return nil, err
+	}
+	if err = cdp.Sync(ev.created, ev.changed, ev.crashed, ev.destroyed); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// recv receives the next event, in order of arrival.
+func (ev *watcherEvents) recv() (*Event, error) {
+	select {
+	case <-ev.created.Ready():
+		r, err := ev.created.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &Event{Type: TargetCreated, TargetID: r.TargetInfo.TargetID, Info: &r.TargetInfo}, nil
+	case <-ev.changed.Ready():
+		r, err := ev.changed.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &Event{Type: TargetChanged, TargetID: r.TargetInfo.TargetID, Info: &r.TargetInfo}, nil
+	case <-ev.crashed.Ready():
+		r, err := ev.crashed.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &Event{Type: TargetCrashed, TargetID: r.TargetID, Status: r.Status, ErrorCode: r.ErrorCode}, nil
+	case <-ev.destroyed.Ready():
+		r, err := ev.destroyed.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &Event{Type: TargetDestroyed, TargetID: r.TargetID}, nil
+	}
+}
+
+func (ev *watcherEvents) Close() (err error) {
+	for _, c := range []interface {
+		Close() error
+	}{
+		ev.created,
+		ev.changed,
+		ev.crashed,
+		ev.destroyed,
+	} {
+		if c != nil {
+			e := c.Close()
+			if err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package session_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/cdptest"
+	"github.com/mafredri/cdp/protocol/target"
+	"github.com/mafredri/cdp/rpcc"
+	"github.com/mafredri/cdp/session"
+)
+
+func TestWatcher(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv.Handle("Target.setDiscoverTargets", cdptest.Reply(nil))
+	srv.Handle("Runtime.runIfWaitingForDebugger", cdptest.Reply(nil))
+
+	var mu sync.Mutex
+	sessions := make(map[string]target.ID) // Session ID to target.
+	srv.Handle("Target.setAutoAttach", func(req *cdptest.Request) (interface{}, error) {
+		var args target.SetAutoAttachArgs
+		if err := req.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		if !args.AutoAttach {
+			return nil, nil
+		}
+		if args.Flatten == nil || !*args.Flatten || !args.WaitForDebuggerOnStart {
+			t.Errorf("setAutoAttach args = %+v, want flatten and waitForDebuggerOnStart", args)
+		}
+
+		var children []target.Info
+		mu.Lock()
+		switch sessions[req.SessionID] {
+		case "": // Browser.
+			children = []target.Info{
+				{TargetID: "page", Type: "page"},
+				{TargetID: "other", Type: "other"},
+			}
+		case "page":
+			children = []target.Info{{TargetID: "iframe", Type: "iframe"}}
+		}
+		mu.Unlock()
+
+		for _, info := range children {
+			id, err := req.Attach(info, true)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			sessions[id] = info.TargetID
+			mu.Unlock()
+		}
+		return nil, nil
+	})
+
+	conn, err := rpcc.DialContext(ctx, srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	m, err := session.NewFlatManager(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	attachedC := make(chan target.ID, 4)
+	w, err := session.NewWatcher(ctx, m, func(ctx context.Context, sconn *rpcc.Conn, info target.Info) error {
+		// The target must not have been resumed yet.
+		mu.Lock()
+		defer mu.Unlock()
+		for _, req := range srv.CallsTo("Runtime.runIfWaitingForDebugger") {
+			if sessions[req.SessionID] == info.TargetID {
+				t.Errorf("target %s resumed before AttachFunc returned", info.TargetID)
+			}
+		}
+		attachedC <- info.TargetID
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	var attached []target.ID
+	for len(attached) < 2 {
+		select {
+		case id := <-attachedC:
+			attached = append(attached, id)
+		case <-ctx.Done():
+			t.Fatalf("attached = %v, want [page iframe]", attached)
+		}
+	}
+	if attached[0] != "page" || attached[1] != "iframe" {
+		t.Errorf("attached = %v, want [page iframe]", attached)
+	}
+
+	// All targets, including the ignored one, are resumed.
+	if _, err = srv.WaitCall(ctx, "Runtime.runIfWaitingForDebugger", 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = srv.WaitCall(ctx, "Target.detachFromTarget", 1); err != nil {
+		t.Fatal(err)
+	}
+	req := srv.CallsTo("Target.detachFromTarget")[0]
+	var args target.DetachFromTargetArgs
+	if err = req.Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	detached := sessions[string(*args.SessionID)]
+	mu.Unlock()
+	if detached != "other" {
+		t.Errorf("detached target = %s, want other", detached)
+	}
+
+	info := target.Info{TargetID: "popup", Type: "page"}
+	for _, ev := range []struct {
+		method string
+		params interface{}
+	}{
+		{"Target.targetCreated", &target.CreatedReply{TargetInfo: info}},
+		{"Target.targetInfoChanged", &target.InfoChangedReply{TargetInfo: info}},
+		{"Target.targetCrashed", &target.CrashedReply{TargetID: "popup", Status: "crashed", ErrorCode: 1}},
+		{"Target.targetDestroyed", &target.DestroyedReply{TargetID: "popup"}},
+	} {
+		if err = srv.Event(ev.method, ev.params); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range []session.EventType{
+		session.TargetCreated,
+		session.TargetChanged,
+		session.TargetCrashed,
+		session.TargetDestroyed,
+	} {
+		ev, err := w.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ev.Type != want || ev.TargetID != "popup" {
+			t.Errorf("Recv() = %v %s, want %v popup", ev.Type, ev.TargetID, want)
+		}
+		if want == session.TargetCrashed && (ev.Status != "crashed" || ev.ErrorCode != 1) {
+			t.Errorf("Recv() crash status = %q (%d), want crashed (1)", ev.Status, ev.ErrorCode)
+		}
+	}
+
+	if err = w.Close(); err != nil {
+		t.Error(err)
+	}
+	if _, err = w.Recv(); err == nil {
+		t.Error("Recv() after Close: error = nil, want error")
+	}
+}
+
+func TestWatcher_RequiresFlat(t *testing.T) {
+	srv := cdptest.NewServer()
+	defer srv.Close()
+
+	conn, err := rpcc.Dial(srv.WebSocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	m, err := session.NewManager(cdp.NewClient(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	_, err = session.NewWatcher(context.Background(), m, nil)
+	if err == nil {
+		t.Error("NewWatcher() error = nil, want error")
+	}
+}